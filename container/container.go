@@ -4,23 +4,40 @@ import (
 	"context"
 
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/config"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/aggregaterepo"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/client"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/eventstore"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/eventstore/deserializer"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/eventstore/personaldata"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/idempotency"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/keystore"
 	outboxRepo "github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/outbox"
+	approvalReadModel "github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/readmodel/approval"
 	cartReadModel "github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/readmodel/cart"
+	checkoutReadModel "github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/readmodel/checkout"
+	couponReadModel "github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/readmodel/coupon"
+	savedListReadModel "github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/readmodel/savedlist"
 	tenantReadModel "github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/readmodel/tenant"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/transaction"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/delayqueue"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/messaging/kafka"
 	outboxPublisher "github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/messaging/outbox"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/metrics"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/payment"
+	approvalProjector "github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/projector/approval"
 	cartProjector "github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/projector/cart"
+	checkoutProjector "github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/projector/checkout"
+	couponProjector "github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/projector/coupon"
+	savedListProjector "github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/projector/savedlist"
 	projectorService "github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/projector/service"
 	tenantProjector "github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/projector/tenant"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/subscriber"
 	cartAbandonmentService "github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/subscriber/service"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/bus"
 	commandUseCase "github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/gateway"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/messaging"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore"
@@ -35,37 +52,133 @@ type Container struct {
 	Transaction  repository.Transaction
 	EventStore   repository.EventStore
 	OutboxRepo   repository.OutboxRepository
+	KeyStore     repository.KeyStore
+	Serializer   repository.EventSerializer
 	Deserializer repository.EventDeserializer
 
+	// Aggregate repositories
+	CartRepo           repository.AggregateRepository[*aggregate.CartAggregate]
+	TenantPolicyRepo   repository.AggregateRepository[*aggregate.TenantCartAbandonedPolicyAggregate]
+	DataSubjectRepo    repository.AggregateRepository[*aggregate.DataSubjectRequestAggregate]
+	PaymentRepo        repository.AggregateRepository[*aggregate.PaymentAggregate]
+	CheckoutSagaRepo   repository.AggregateRepository[*aggregate.CheckoutSagaAggregate]
+	CouponRepo         repository.AggregateRepository[*aggregate.CouponAggregate]
+	TaxSettingsRepo    repository.AggregateRepository[*aggregate.TenantTaxSettingsAggregate]
+	ShippingRatesRepo  repository.AggregateRepository[*aggregate.TenantShippingRatesAggregate]
+	SavedListRepo      repository.AggregateRepository[*aggregate.SavedListAggregate]
+	ApprovalPolicyRepo repository.AggregateRepository[*aggregate.TenantApprovalPolicyAggregate]
+	CartRulesRepo      repository.AggregateRepository[*aggregate.TenantCartRulesAggregate]
+	TenantRepo         repository.AggregateRepository[*aggregate.TenantAggregate]
+	StreamWriter       repository.StreamWriter
+
 	// Messaging
 	MessageProducer messaging.MessageProducer
 	TopicRouter     messaging.TopicRouter
 	DelayQueue      messaging.DelayQueue
 	OutboxPublisher messaging.OutboxPublisher
 
+	// Gateways
+	PaymentGateway gateway.PaymentGateway
+
+	// Command bus
+	CommandBus       bus.CommandBusInterface
+	CommandMetrics   bus.CommandMetrics
+	IdempotencyStore bus.IdempotencyStore
+
 	// Read model
-	CartStore         readmodelstore.CartStore
-	TenantPolicyStore readmodelstore.TenantPolicyStore
+	CartStore           readmodelstore.CartStore
+	TenantPolicyStore   readmodelstore.TenantPolicyStore
+	CheckoutSagaStore   readmodelstore.CheckoutSagaStore
+	CouponStore         readmodelstore.CouponStore
+	TaxSettingsStore    readmodelstore.TenantTaxSettingsStore
+	ShippingRatesStore  readmodelstore.TenantShippingRatesStore
+	SavedListStore      readmodelstore.SavedListStore
+	ApprovalPolicyStore readmodelstore.TenantApprovalPolicyStore
+	CartApprovalStore   readmodelstore.CartApprovalStore
+	CartRulesStore      readmodelstore.TenantCartRulesStore
+	TenantStore         readmodelstore.TenantStore
 
 	// Subscribers
-	CartAbandonmentSubscriber messaging.Subscriber
-	CartProjector             gateway.Projector
-	TenantPolicyProjector     gateway.Projector
+	CartAbandonmentSubscriber      messaging.Subscriber
+	CheckoutSagaSubscriber         messaging.ProcessManager
+	CartExpirySubscriber           messaging.ProcessManager
+	CartApprovalDeadlineSubscriber messaging.ProcessManager
+	CartProjector                  gateway.Projector
+	TenantPolicyProjector          gateway.Projector
+	CheckoutSagaProjector          gateway.Projector
+	CouponProjector                gateway.Projector
+	TaxSettingsProjector           gateway.Projector
+	ShippingRatesProjector         gateway.Projector
+	SavedListProjector             gateway.Projector
+	ApprovalPolicyProjector        gateway.Projector
+	CartApprovalProjector          gateway.Projector
+	CartRulesProjector             gateway.Projector
+	TenantProjector                gateway.Projector
 
 	// Consumer Groups
-	CartAbandonmentConsumer messaging.ConsumerGroup
-	ProjectorConsumer       messaging.ConsumerGroup
+	CartAbandonmentConsumer      messaging.ConsumerGroup
+	CheckoutSagaConsumer         messaging.ConsumerGroup
+	CartExpiryConsumer           messaging.ConsumerGroup
+	CartApprovalDeadlineConsumer messaging.ConsumerGroup
+	ProjectorConsumer            messaging.ConsumerGroup
 
 	// Use case layer
 	CartAddItemCommand                     commandUseCase.CartAddItemCommandInterface
+	SubmitCartCommand                      commandUseCase.SubmitCartCommandInterface
 	CreateTenantCartAbandonedPolicyCommand commandUseCase.CreateTenantCartAbandonedPolicyCommandInterface
 	UpdateTenantCartAbandonedPolicyCommand commandUseCase.UpdateTenantCartAbandonedPolicyCommandInterface
+	AuthorizePaymentCommand                commandUseCase.AuthorizePaymentCommandInterface
+	CapturePaymentCommand                  commandUseCase.CapturePaymentCommandInterface
+	RefundPaymentCommand                   commandUseCase.RefundPaymentCommandInterface
+	CreateCouponCommand                    commandUseCase.CreateCouponCommandInterface
+	ApplyCouponCommand                     commandUseCase.ApplyCouponCommandInterface
+	RemoveCouponCommand                    commandUseCase.RemoveCouponCommandInterface
+	ConfigureTenantTaxSettingsCommand      commandUseCase.ConfigureTenantTaxSettingsCommandInterface
+	SetShippingAddressCommand              commandUseCase.SetShippingAddressCommandInterface
+	SelectShippingMethodCommand            commandUseCase.SelectShippingMethodCommandInterface
+	SetShippingRatesCommand                commandUseCase.SetShippingRatesCommandInterface
+	MergeCartCommand                       commandUseCase.MergeCartCommandInterface
+	SaveItemCommand                        commandUseCase.SaveItemCommandInterface
+	RemoveSavedItemCommand                 commandUseCase.RemoveSavedItemCommandInterface
+	MoveSavedItemToCartCommand             commandUseCase.MoveSavedItemToCartCommandInterface
+	MoveCartItemToSavedListCommand         commandUseCase.MoveCartItemToSavedListCommandInterface
+	InviteCartMemberCommand                commandUseCase.InviteCartMemberCommandInterface
+	AcceptCartInvitationCommand            commandUseCase.AcceptCartInvitationCommandInterface
+	RemoveCartMemberCommand                commandUseCase.RemoveCartMemberCommandInterface
+	ConfigureTenantApprovalPolicyCommand   commandUseCase.ConfigureTenantApprovalPolicyCommandInterface
+	RequestCartApprovalCommand             commandUseCase.RequestCartApprovalCommandInterface
+	DecideCartApprovalCommand              commandUseCase.DecideCartApprovalCommandInterface
+	ConfigureTenantCartRulesCommand        commandUseCase.ConfigureTenantCartRulesCommandInterface
+	OnboardTenantCommand                   commandUseCase.OnboardTenantCommandInterface
+	UpdateTenantCommand                    commandUseCase.UpdateTenantCommandInterface
+	ChangeTenantStatusCommand              commandUseCase.ChangeTenantStatusCommandInterface
+	ConfigureAbandonmentSegmentCommand     commandUseCase.ConfigureCartAbandonedPolicySegmentCommandInterface
+	RemoveAbandonmentSegmentCommand        commandUseCase.RemoveCartAbandonedPolicySegmentCommandInterface
+	ExportDataSubjectCommand               commandUseCase.ExportDataSubjectCommandInterface
+	EraseDataSubjectCommand                commandUseCase.EraseDataSubjectCommandInterface
 	GetCartQuery                           queryUseCase.GetCartQueryInterface
+	GetCartAsOfQuery                       queryUseCase.GetCartAsOfQueryInterface
 	GetTenantPolicyQuery                   queryUseCase.GetTenantPolicyQueryInterface
+	GetCheckoutSagaQuery                   queryUseCase.GetCheckoutSagaQueryInterface
+	GetCouponQuery                         queryUseCase.GetCouponQueryInterface
+	GetTenantTaxSettingsQuery              queryUseCase.GetTenantTaxSettingsQueryInterface
+	GetTenantShippingRatesQuery            queryUseCase.GetTenantShippingRatesQueryInterface
+	GetSavedItemsQuery                     queryUseCase.GetSavedItemsQueryInterface
+	GetTenantApprovalPolicyQuery           queryUseCase.GetTenantApprovalPolicyQueryInterface
+	GetApprovalInboxQuery                  queryUseCase.GetApprovalInboxQueryInterface
+	GetTenantCartRulesQuery                queryUseCase.GetTenantCartRulesQueryInterface
+	GetTenantQuery                         queryUseCase.GetTenantQueryInterface
+	GetTenantPolicyHistoryQuery            queryUseCase.GetTenantPolicyHistoryQueryInterface
+	GetCartEventHistoryQuery               queryUseCase.GetEventHistoryQueryInterface
+	GetTenantPolicyEventHistoryQuery       queryUseCase.GetEventHistoryQueryInterface
+	GetDataSubjectRequestQuery             queryUseCase.GetDataSubjectRequestQueryInterface
 
 	// Services
-	CartAbandonmentService gateway.CartAbandonmentService
-	ProjectorService       gateway.ProjectorService
+	CartAbandonmentService      gateway.CartAbandonmentService
+	CheckoutSagaService         gateway.CheckoutSagaService
+	CartExpiryService           gateway.CartExpiryService
+	CartApprovalDeadlineService gateway.CartApprovalDeadlineService
+	ProjectorService            gateway.ProjectorService
 }
 
 func NewContainer() *Container {
@@ -81,9 +194,33 @@ func (c *Container) Inject(ctx context.Context, cfg *config.Config) error {
 	}
 
 	c.Transaction = transaction.NewTransaction(databaseClient.GetDB())
-	c.Deserializer = deserializer.NewEventDeserializer()
-	c.EventStore = eventstore.NewEventStore(c.Deserializer)
-	c.OutboxRepo = outboxRepo.NewOutboxRepository()
+	// Personal data in events is encrypted wherever they are stored
+	c.KeyStore = keystore.NewKeyStore(c.Transaction)
+	c.Serializer = personaldata.NewEventSerializer(c.KeyStore)
+	c.Deserializer = personaldata.NewEventDeserializer(deserializer.NewEventDeserializer(), c.KeyStore)
+	c.EventStore = eventstore.NewEventStore(c.Serializer, c.Deserializer)
+	c.OutboxRepo = outboxRepo.NewOutboxRepository(c.Serializer)
+
+	retryPolicy := aggregaterepo.RetryPolicy{
+		MaxAttempts: cfg.RetryConfig.MaxAttempts,
+		BaseDelay:   cfg.RetryConfig.BaseDelay,
+		MaxDelay:    cfg.RetryConfig.MaxDelay,
+		Jitter:      cfg.RetryConfig.Jitter,
+	}
+	c.CartRepo = aggregaterepo.NewAggregateRepository(c.Transaction, c.EventStore, c.OutboxRepo, aggregate.NewCartAggregate, retryPolicy)
+	c.TenantPolicyRepo = aggregaterepo.NewAggregateRepository(c.Transaction, c.EventStore, c.OutboxRepo, aggregate.NewTenantCartAbandonedPolicyAggregate, retryPolicy)
+	c.DataSubjectRepo = aggregaterepo.NewAggregateRepository(c.Transaction, c.EventStore, c.OutboxRepo, aggregate.NewDataSubjectRequestAggregate, retryPolicy)
+	c.PaymentRepo = aggregaterepo.NewAggregateRepository(c.Transaction, c.EventStore, c.OutboxRepo, aggregate.NewPaymentAggregate, retryPolicy)
+	c.CheckoutSagaRepo = aggregaterepo.NewAggregateRepository(c.Transaction, c.EventStore, c.OutboxRepo, aggregate.NewCheckoutSagaAggregate, retryPolicy)
+	c.CouponRepo = aggregaterepo.NewAggregateRepository(c.Transaction, c.EventStore, c.OutboxRepo, aggregate.NewCouponAggregate, retryPolicy)
+	c.TaxSettingsRepo = aggregaterepo.NewAggregateRepository(c.Transaction, c.EventStore, c.OutboxRepo, aggregate.NewTenantTaxSettingsAggregate, retryPolicy)
+	c.ShippingRatesRepo = aggregaterepo.NewAggregateRepository(c.Transaction, c.EventStore, c.OutboxRepo, aggregate.NewTenantShippingRatesAggregate, retryPolicy)
+	c.SavedListRepo = aggregaterepo.NewAggregateRepository(c.Transaction, c.EventStore, c.OutboxRepo, aggregate.NewSavedListAggregate, retryPolicy)
+	c.ApprovalPolicyRepo = aggregaterepo.NewAggregateRepository(c.Transaction, c.EventStore, c.OutboxRepo, aggregate.NewTenantApprovalPolicyAggregate, retryPolicy)
+	c.CartRulesRepo = aggregaterepo.NewAggregateRepository(c.Transaction, c.EventStore, c.OutboxRepo, aggregate.NewTenantCartRulesAggregate, retryPolicy)
+	c.TenantRepo = aggregaterepo.NewAggregateRepository(c.Transaction, c.EventStore, c.OutboxRepo, aggregate.NewTenantAggregate, retryPolicy)
+	// Commands that change several aggregates at once are retried as a whole
+	c.StreamWriter = aggregaterepo.NewStreamWriter(c.Transaction, c.EventStore, c.OutboxRepo, retryPolicy)
 
 	// Messaging infrastructure
 	c.MessageProducer, err = kafka.NewProducer(cfg.KafkaConfig.Brokers)
@@ -99,24 +236,158 @@ func (c *Container) Inject(ctx context.Context, cfg *config.Config) error {
 		c.TopicRouter,
 	)
 
-	c.CartAddItemCommand = commandUseCase.NewCartAddItemCommand(c.Transaction, c.EventStore, c.OutboxRepo)
-	c.CreateTenantCartAbandonedPolicyCommand = commandUseCase.NewCreateTenantCartAbandonedPolicyCommand(c.Transaction, c.EventStore, c.OutboxRepo)
-	c.UpdateTenantCartAbandonedPolicyCommand = commandUseCase.NewUpdateTenantCartAbandonedPolicyCommand(c.Transaction, c.EventStore, c.OutboxRepo)
+	// Only the in-process fake is available until a real provider is integrated
+	c.PaymentGateway = payment.NewFakePaymentGateway()
 
-	// Read model and queries
+	// Add item looks up automatic promotions, so the coupon read model comes first
+	c.CouponStore = couponReadModel.NewCouponReadModel(c.Transaction)
+	// Data subject requests export and anonymise carts and saved lists
 	c.CartStore = cartReadModel.NewCartReadModel(c.Transaction)
+	c.SavedListStore = savedListReadModel.NewSavedListReadModel(c.Transaction)
+
+	c.CartAddItemCommand = commandUseCase.NewCartAddItemCommand(c.CartRepo, c.TenantRepo, c.CartRulesRepo, c.CouponStore)
+	c.SubmitCartCommand = commandUseCase.NewSubmitCartCommand(c.CartRepo, c.TenantRepo, c.TaxSettingsRepo, c.ShippingRatesRepo, c.ApprovalPolicyRepo, c.CartRulesRepo)
+	c.CreateTenantCartAbandonedPolicyCommand = commandUseCase.NewCreateTenantCartAbandonedPolicyCommand(c.TenantPolicyRepo)
+	c.UpdateTenantCartAbandonedPolicyCommand = commandUseCase.NewUpdateTenantCartAbandonedPolicyCommand(c.TenantPolicyRepo)
+	c.AuthorizePaymentCommand = commandUseCase.NewAuthorizePaymentCommand(c.CartRepo, c.PaymentRepo, c.PaymentGateway)
+	c.CapturePaymentCommand = commandUseCase.NewCapturePaymentCommand(c.PaymentRepo, c.PaymentGateway)
+	c.RefundPaymentCommand = commandUseCase.NewRefundPaymentCommand(c.PaymentRepo, c.PaymentGateway)
+	c.CreateCouponCommand = commandUseCase.NewCreateCouponCommand(c.CouponRepo)
+	c.ApplyCouponCommand = commandUseCase.NewApplyCouponCommand(c.CartRepo, c.TenantRepo, c.CouponRepo, c.StreamWriter)
+	c.RemoveCouponCommand = commandUseCase.NewRemoveCouponCommand(c.CartRepo, c.TenantRepo, c.CouponRepo, c.StreamWriter)
+	c.ConfigureTenantTaxSettingsCommand = commandUseCase.NewConfigureTenantTaxSettingsCommand(c.TaxSettingsRepo)
+	c.SetShippingAddressCommand = commandUseCase.NewSetShippingAddressCommand(c.CartRepo, c.TenantRepo)
+	c.SelectShippingMethodCommand = commandUseCase.NewSelectShippingMethodCommand(c.CartRepo, c.TenantRepo, c.ShippingRatesRepo)
+	c.SetShippingRatesCommand = commandUseCase.NewSetShippingRatesCommand(c.ShippingRatesRepo)
+	c.MergeCartCommand = commandUseCase.NewMergeCartCommand(c.CartRepo, c.TenantRepo, c.CartRulesRepo, c.CouponRepo, c.StreamWriter)
+	c.SaveItemCommand = commandUseCase.NewSaveItemCommand(c.SavedListRepo)
+	c.RemoveSavedItemCommand = commandUseCase.NewRemoveSavedItemCommand(c.SavedListRepo)
+	c.MoveSavedItemToCartCommand = commandUseCase.NewMoveSavedItemToCartCommand(c.CartRepo, c.TenantRepo, c.SavedListRepo, c.CartRulesRepo, c.StreamWriter)
+	c.MoveCartItemToSavedListCommand = commandUseCase.NewMoveCartItemToSavedListCommand(c.CartRepo, c.TenantRepo, c.SavedListRepo, c.StreamWriter)
+	c.InviteCartMemberCommand = commandUseCase.NewInviteCartMemberCommand(c.CartRepo, c.TenantRepo)
+	c.AcceptCartInvitationCommand = commandUseCase.NewAcceptCartInvitationCommand(c.CartRepo, c.TenantRepo)
+	c.RemoveCartMemberCommand = commandUseCase.NewRemoveCartMemberCommand(c.CartRepo, c.TenantRepo)
+	c.ConfigureTenantApprovalPolicyCommand = commandUseCase.NewConfigureTenantApprovalPolicyCommand(c.ApprovalPolicyRepo)
+	c.RequestCartApprovalCommand = commandUseCase.NewRequestCartApprovalCommand(c.CartRepo, c.TenantRepo, c.ApprovalPolicyRepo)
+	c.DecideCartApprovalCommand = commandUseCase.NewDecideCartApprovalCommand(c.CartRepo, c.TenantRepo, c.ApprovalPolicyRepo)
+	c.ConfigureTenantCartRulesCommand = commandUseCase.NewConfigureTenantCartRulesCommand(c.CartRulesRepo)
+	c.OnboardTenantCommand = commandUseCase.NewOnboardTenantCommand(c.TenantRepo, c.TenantPolicyRepo, c.TaxSettingsRepo, c.StreamWriter)
+	c.UpdateTenantCommand = commandUseCase.NewUpdateTenantCommand(c.TenantRepo)
+	c.ChangeTenantStatusCommand = commandUseCase.NewChangeTenantStatusCommand(c.TenantRepo)
+	c.ConfigureAbandonmentSegmentCommand = commandUseCase.NewConfigureCartAbandonedPolicySegmentCommand(c.TenantPolicyRepo)
+	c.RemoveAbandonmentSegmentCommand = commandUseCase.NewRemoveCartAbandonedPolicySegmentCommand(c.TenantPolicyRepo)
+	c.ExportDataSubjectCommand = commandUseCase.NewExportDataSubjectCommand(c.Transaction, c.DataSubjectRepo, c.EventStore, c.CartStore, c.SavedListStore)
+	c.EraseDataSubjectCommand = commandUseCase.NewEraseDataSubjectCommand(c.Transaction, c.DataSubjectRepo, c.CartStore, c.SavedListStore, c.KeyStore)
+
+	// Every command goes through the same pipeline whichever entry point
+	// dispatches it
+	c.CommandMetrics = metrics.NewExpvarCommandMetrics()
+	c.IdempotencyStore = idempotency.NewProcessedCommandStore(c.Serializer, c.Deserializer)
+	commandBus := bus.NewCommandBus(
+		bus.Tracing(),
+		bus.Logging(),
+		bus.Metrics(c.CommandMetrics),
+		bus.Validation(),
+		bus.Preconditions(),
+		bus.Retry(retryPolicy.MaxAttempts, retryPolicy.Delay),
+		bus.Idempotency(c.Transaction, c.IdempotencyStore),
+	)
+	bus.Register[*input.AddItemToCartInput](commandBus, c.CartAddItemCommand)
+	bus.Register[*input.SubmitCartInput](commandBus, c.SubmitCartCommand)
+	bus.Register[*input.CreateTenantCartAbandonedPolicyInput](commandBus, c.CreateTenantCartAbandonedPolicyCommand)
+	bus.Register[*input.UpdateTenantCartAbandonedPolicyInput](commandBus, c.UpdateTenantCartAbandonedPolicyCommand)
+	bus.Register[*input.AuthorizePaymentInput](commandBus, c.AuthorizePaymentCommand)
+	bus.Register[*input.CapturePaymentInput](commandBus, c.CapturePaymentCommand)
+	bus.Register[*input.RefundPaymentInput](commandBus, c.RefundPaymentCommand)
+	bus.Register[*input.CreateCouponInput](commandBus, c.CreateCouponCommand)
+	bus.Register[*input.ApplyCouponInput](commandBus, c.ApplyCouponCommand)
+	bus.Register[*input.RemoveCouponInput](commandBus, c.RemoveCouponCommand)
+	bus.Register[*input.ConfigureTenantTaxSettingsInput](commandBus, c.ConfigureTenantTaxSettingsCommand)
+	bus.Register[*input.SetShippingAddressInput](commandBus, c.SetShippingAddressCommand)
+	bus.Register[*input.SelectShippingMethodInput](commandBus, c.SelectShippingMethodCommand)
+	bus.Register[*input.SetShippingRatesInput](commandBus, c.SetShippingRatesCommand)
+	bus.Register[*input.MergeCartInput](commandBus, c.MergeCartCommand)
+	bus.Register[*input.SaveItemInput](commandBus, c.SaveItemCommand)
+	bus.Register[*input.RemoveSavedItemInput](commandBus, c.RemoveSavedItemCommand)
+	bus.Register[*input.MoveSavedItemToCartInput](commandBus, c.MoveSavedItemToCartCommand)
+	bus.Register[*input.MoveCartItemToSavedListInput](commandBus, c.MoveCartItemToSavedListCommand)
+	bus.Register[*input.InviteCartMemberInput](commandBus, c.InviteCartMemberCommand)
+	bus.Register[*input.AcceptCartInvitationInput](commandBus, c.AcceptCartInvitationCommand)
+	bus.Register[*input.RemoveCartMemberInput](commandBus, c.RemoveCartMemberCommand)
+	bus.Register[*input.ConfigureTenantApprovalPolicyInput](commandBus, c.ConfigureTenantApprovalPolicyCommand)
+	bus.Register[*input.RequestCartApprovalInput](commandBus, c.RequestCartApprovalCommand)
+	bus.Register[*input.DecideCartApprovalInput](commandBus, c.DecideCartApprovalCommand)
+	bus.Register[*input.ConfigureTenantCartRulesInput](commandBus, c.ConfigureTenantCartRulesCommand)
+	bus.Register[*input.OnboardTenantInput](commandBus, c.OnboardTenantCommand)
+	bus.Register[*input.UpdateTenantInput](commandBus, c.UpdateTenantCommand)
+	bus.Register[*input.ChangeTenantStatusInput](commandBus, c.ChangeTenantStatusCommand)
+	bus.Register[*input.ConfigureCartAbandonedPolicySegmentInput](commandBus, c.ConfigureAbandonmentSegmentCommand)
+	bus.Register[*input.RemoveCartAbandonedPolicySegmentInput](commandBus, c.RemoveAbandonmentSegmentCommand)
+	bus.Register[*input.ExportDataSubjectInput](commandBus, c.ExportDataSubjectCommand)
+	bus.Register[*input.EraseDataSubjectInput](commandBus, c.EraseDataSubjectCommand)
+	c.CommandBus = commandBus
+
+	// Read model and queries
 	c.TenantPolicyStore = tenantReadModel.NewTenantPolicyReadModel(c.Transaction)
+	c.CheckoutSagaStore = checkoutReadModel.NewCheckoutSagaReadModel(c.Transaction)
+	c.TaxSettingsStore = tenantReadModel.NewTenantTaxSettingsReadModel(c.Transaction)
+	c.ShippingRatesStore = tenantReadModel.NewTenantShippingRatesReadModel(c.Transaction)
+	c.ApprovalPolicyStore = tenantReadModel.NewTenantApprovalPolicyReadModel(c.Transaction)
+	c.CartApprovalStore = approvalReadModel.NewCartApprovalReadModel(c.Transaction)
+	c.CartRulesStore = tenantReadModel.NewTenantCartRulesReadModel(c.Transaction)
+	c.TenantStore = tenantReadModel.NewTenantReadModel(c.Transaction)
 	c.GetCartQuery = queryUseCase.NewGetCartQuery(c.CartStore)
+	c.GetCartAsOfQuery = queryUseCase.NewGetCartAsOfQuery(c.Transaction, c.EventStore)
 	c.GetTenantPolicyQuery = queryUseCase.NewGetTenantPolicyQuery(c.TenantPolicyStore)
+	c.GetCheckoutSagaQuery = queryUseCase.NewGetCheckoutSagaQuery(c.CheckoutSagaStore)
+	c.GetCouponQuery = queryUseCase.NewGetCouponQuery(c.CouponStore)
+	c.GetTenantTaxSettingsQuery = queryUseCase.NewGetTenantTaxSettingsQuery(c.TaxSettingsStore)
+	c.GetTenantShippingRatesQuery = queryUseCase.NewGetTenantShippingRatesQuery(c.ShippingRatesStore)
+	c.GetSavedItemsQuery = queryUseCase.NewGetSavedItemsQuery(c.SavedListStore)
+	c.GetTenantApprovalPolicyQuery = queryUseCase.NewGetTenantApprovalPolicyQuery(c.ApprovalPolicyStore)
+	c.GetApprovalInboxQuery = queryUseCase.NewGetApprovalInboxQuery(c.ApprovalPolicyStore, c.CartApprovalStore)
+	c.GetTenantCartRulesQuery = queryUseCase.NewGetTenantCartRulesQuery(c.CartRulesStore)
+	c.GetTenantQuery = queryUseCase.NewGetTenantQuery(c.TenantStore)
+	c.GetTenantPolicyHistoryQuery = queryUseCase.NewGetTenantPolicyHistoryQuery(c.Transaction, c.EventStore)
+	c.GetCartEventHistoryQuery = queryUseCase.NewGetEventHistoryQuery(c.Transaction, c.EventStore, "Cart")
+	c.GetTenantPolicyEventHistoryQuery = queryUseCase.NewGetEventHistoryQuery(c.Transaction, c.EventStore, "TenantCartAbandonedPolicy")
+	c.GetDataSubjectRequestQuery = queryUseCase.NewGetDataSubjectRequestQuery(c.Transaction, c.EventStore)
 
 	// Subscribers
 	c.CartAbandonmentSubscriber = subscriber.NewCartAbandonmentSubscriber(
 		c.Transaction,
 		c.EventStore,
+		c.TenantPolicyRepo,
+		c.DelayQueue,
+		c.CartStore,
+	)
+	c.CheckoutSagaSubscriber = subscriber.NewCheckoutSagaSubscriber(
+		c.CheckoutSagaRepo,
+		c.DelayQueue,
+	)
+	c.CartExpirySubscriber = subscriber.NewCartExpirySubscriber(
+		c.Transaction,
+		c.CartRepo,
+		c.CouponRepo,
+		c.TenantPolicyRepo,
+		c.StreamWriter,
+		c.DelayQueue,
+	)
+	c.CartApprovalDeadlineSubscriber = subscriber.NewCartApprovalDeadlineSubscriber(
+		c.CartRepo,
 		c.DelayQueue,
 	)
 	c.CartProjector = cartProjector.NewCartProjector(c.CartStore)
 	c.TenantPolicyProjector = tenantProjector.NewTenantPolicyProjector(c.TenantPolicyStore)
+	c.CheckoutSagaProjector = checkoutProjector.NewCheckoutSagaProjector(c.CheckoutSagaStore)
+	c.CouponProjector = couponProjector.NewCouponProjector(c.CouponStore)
+	c.TaxSettingsProjector = tenantProjector.NewTenantTaxSettingsProjector(c.TaxSettingsStore)
+	c.ShippingRatesProjector = tenantProjector.NewTenantShippingRatesProjector(c.ShippingRatesStore)
+	c.SavedListProjector = savedListProjector.NewSavedListProjector(c.SavedListStore)
+	c.ApprovalPolicyProjector = tenantProjector.NewTenantApprovalPolicyProjector(c.ApprovalPolicyStore)
+	c.CartApprovalProjector = approvalProjector.NewCartApprovalProjector(c.CartApprovalStore)
+	c.CartRulesProjector = tenantProjector.NewTenantCartRulesProjector(c.CartRulesStore)
+	c.TenantProjector = tenantProjector.NewTenantProjector(c.TenantStore)
 
 	// Consumer Groups
 	topics := []string{"ec.cart-events"}
@@ -124,7 +395,21 @@ func (c *Container) Inject(ctx context.Context, cfg *config.Config) error {
 	if err != nil {
 		return err
 	}
-	c.ProjectorConsumer, err = kafka.NewConsumerGroup(cfg.KafkaConfig.Brokers, "cart-projector-group", topics, c.Deserializer)
+	checkoutSagaTopics := []string{"ec.cart-events", "ec.checkout-replies"}
+	c.CheckoutSagaConsumer, err = kafka.NewConsumerGroup(cfg.KafkaConfig.Brokers, "checkout-saga-group", checkoutSagaTopics, c.Deserializer)
+	if err != nil {
+		return err
+	}
+	c.CartExpiryConsumer, err = kafka.NewConsumerGroup(cfg.KafkaConfig.Brokers, "cart-expiry-group", topics, c.Deserializer)
+	if err != nil {
+		return err
+	}
+	c.CartApprovalDeadlineConsumer, err = kafka.NewConsumerGroup(cfg.KafkaConfig.Brokers, "cart-approval-deadline-group", topics, c.Deserializer)
+	if err != nil {
+		return err
+	}
+	projectorTopics := []string{"ec.cart-events", "ec.checkout-events"}
+	c.ProjectorConsumer, err = kafka.NewConsumerGroup(cfg.KafkaConfig.Brokers, "cart-projector-group", projectorTopics, c.Deserializer)
 	if err != nil {
 		return err
 	}
@@ -137,8 +422,41 @@ func (c *Container) Inject(ctx context.Context, cfg *config.Config) error {
 		c.DelayQueue,
 	)
 
-	// Combined projector that handles both cart and tenant policy events
-	combinedProjector := projectorService.NewCombinedProjector(c.CartProjector, c.TenantPolicyProjector)
+	c.CheckoutSagaService = cartAbandonmentService.NewCheckoutSagaService(
+		c.Deserializer,
+		c.CheckoutSagaSubscriber,
+		c.CheckoutSagaConsumer,
+		c.DelayQueue,
+	)
+
+	c.CartExpiryService = cartAbandonmentService.NewCartExpiryService(
+		c.Deserializer,
+		c.CartExpirySubscriber,
+		c.CartExpiryConsumer,
+		c.DelayQueue,
+	)
+
+	c.CartApprovalDeadlineService = cartAbandonmentService.NewCartApprovalDeadlineService(
+		c.Deserializer,
+		c.CartApprovalDeadlineSubscriber,
+		c.CartApprovalDeadlineConsumer,
+		c.DelayQueue,
+	)
+
+	// Combined projector that handles cart, tenant, tenant policy, checkout saga, coupon, saved list, approval and cart rules events
+	combinedProjector := projectorService.NewCombinedProjector(
+		c.CartProjector,
+		c.TenantPolicyProjector,
+		c.TaxSettingsProjector,
+		c.ShippingRatesProjector,
+		c.CheckoutSagaProjector,
+		c.CouponProjector,
+		c.SavedListProjector,
+		c.ApprovalPolicyProjector,
+		c.CartApprovalProjector,
+		c.CartRulesProjector,
+		c.TenantProjector,
+	)
 
 	c.ProjectorService = projectorService.NewProjectorService(
 		c.Transaction,
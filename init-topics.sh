@@ -10,6 +10,27 @@ kafka-topics --create --if-not-exists \
   --partitions 3 \
   --replication-factor 1
 
+# Checkout saga events topic (step requests to participants)
+kafka-topics --create --if-not-exists \
+  --bootstrap-server kafka:9092 \
+  --topic ec.checkout-events \
+  --partitions 3 \
+  --replication-factor 1
+
+# Checkout participant replies topic
+kafka-topics --create --if-not-exists \
+  --bootstrap-server kafka:9092 \
+  --topic ec.checkout-replies \
+  --partitions 3 \
+  --replication-factor 1
+
+# Payment events topic
+kafka-topics --create --if-not-exists \
+  --bootstrap-server kafka:9092 \
+  --topic ec.payment-events \
+  --partitions 3 \
+  --replication-factor 1
+
 # Misc events topic (fallback)
 kafka-topics --create --if-not-exists \
   --bootstrap-server kafka:9092 \
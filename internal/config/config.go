@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/kelseyhightower/envconfig"
 )
 
@@ -8,6 +10,7 @@ type Config struct {
 	HTTPPort string `required:"true" envconfig:"HTTP_PORT"`
 	DatabaseConfig
 	KafkaConfig
+	RetryConfig
 }
 
 func NewConfig() (*Config, error) {
@@ -31,6 +34,14 @@ type KafkaConfig struct {
 	Brokers []string `required:"true" envconfig:"KAFKA_BROKERS"`
 }
 
+// RetryConfig is the backoff for commands that lose an optimistic lock.
+type RetryConfig struct {
+	MaxAttempts int           `default:"3" envconfig:"COMMAND_RETRY_MAX_ATTEMPTS"`
+	BaseDelay   time.Duration `default:"10ms" envconfig:"COMMAND_RETRY_BASE_DELAY"`
+	MaxDelay    time.Duration `default:"200ms" envconfig:"COMMAND_RETRY_MAX_DELAY"`
+	Jitter      float64       `default:"0.5" envconfig:"COMMAND_RETRY_JITTER"`
+}
+
 type TestDatabaseConfig struct {
 	User     string `required:"true" envconfig:"MYSQL_USER"`
 	Password string `required:"true" envconfig:"MYSQL_PASSWORD"`
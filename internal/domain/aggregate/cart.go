@@ -1,10 +1,16 @@
 package aggregate
 
 import (
+	"math"
+	"slices"
+	"strings"
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/entity"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/service"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
 )
@@ -12,6 +18,42 @@ import (
 var (
 	ErrItemNotFound = errors.NotFound.New("item not found in cart")
 	ErrCartClosed   = errors.UnpermittedOp.New("cart is already purchased")
+	ErrCartExpired  = errors.UnpermittedOp.New("cart was closed after a period of inactivity, please start a new cart")
+
+	ErrCartNotFound         = errors.NotFound.New("cart not found")
+	ErrCouponAlreadyApplied = errors.UnpermittedOp.New("coupon already applied to cart")
+	ErrCouponNotApplied     = errors.NotFound.New("coupon not applied to cart")
+	ErrCouponTenantMismatch = errors.UnpermittedOp.New("coupon belongs to another tenant")
+
+	ErrItemWeightInvalid         = errors.InvalidParameter.New("item weight must not be negative")
+	ErrShippingAddressNotSet     = errors.UnpermittedOp.New("shipping address is not set")
+	ErrShippingMethodNotSelected = errors.UnpermittedOp.New("shipping method is not selected")
+
+	ErrCartOwnerRequired   = errors.InvalidParameter.New("user_id or session_id is required")
+	ErrCartNotGuest        = errors.UnpermittedOp.New("only guest carts can be merged")
+	ErrCartMergeIntoSelf   = errors.InvalidParameter.New("cannot merge a cart into itself")
+	ErrCartOwnerMismatch   = errors.UnpermittedOp.New("cart belongs to another user")
+	ErrCartSessionMismatch = errors.UnpermittedOp.New("cart belongs to another session")
+	ErrCartTenantMismatch  = errors.UnpermittedOp.New("cart belongs to another tenant")
+	ErrCartGuestSave       = errors.UnpermittedOp.New("guest carts cannot save items for later")
+	ErrCartNotSubmitted    = errors.UnpermittedOp.New("cart has not been submitted")
+
+	ErrCartNotMember          = errors.UnpermittedOp.New("user is not a member of the cart")
+	ErrCartOwnerOnly          = errors.UnpermittedOp.New("only the cart owner can manage members")
+	ErrCartNotShareable       = errors.UnpermittedOp.New("guest carts cannot be shared")
+	ErrCartMemberRequired     = errors.InvalidParameter.New("member user_id is required")
+	ErrCartAlreadyMember      = errors.UnpermittedOp.New("user is already a member of the cart")
+	ErrCartInvitationNotFound = errors.NotFound.New("no pending invitation for the user")
+	ErrCartMemberNotFound     = errors.NotFound.New("member not found in cart")
+	ErrCartOwnerNotRemovable  = errors.UnpermittedOp.New("the cart owner cannot be removed")
+
+	ErrCartApprovalPending     = errors.UnpermittedOp.New("cart is locked while its approval is pending")
+	ErrCartApprovalRequired    = errors.UnpermittedOp.New("cart total exceeds the approval threshold, request an approval first")
+	ErrCartApprovalNotRequired = errors.UnpermittedOp.New("cart total does not need approval")
+	ErrCartApprovalNotPending  = errors.UnpermittedOp.New("cart has no pending approval")
+	ErrCartNotApprover         = errors.UnpermittedOp.New("user is not an approver of the tenant")
+	ErrCartSelfApproval        = errors.UnpermittedOp.New("approvers cannot decide on their own request")
+	ErrCartGuestApproval       = errors.UnpermittedOp.New("guest carts cannot request approval")
 )
 
 type CartStatus string
@@ -21,14 +63,45 @@ const (
 	CartStatusSubmitted CartStatus = "SUBMITTED"
 	CartStatusClosed    CartStatus = "CLOSED"
 	CartStatusAbandoned CartStatus = "ABANDONED"
+	CartStatusMerged    CartStatus = "MERGED"
+)
+
+type CartMemberRole string
+
+const (
+	CartMemberRoleOwner  CartMemberRole = "OWNER"
+	CartMemberRoleMember CartMemberRole = "MEMBER"
+)
+
+// CartApprovalStatus is where a cart stands in the tenant's approval flow.
+// Carts that never asked for approval have no status.
+type CartApprovalStatus string
+
+const (
+	CartApprovalPending          CartApprovalStatus = "PENDING"
+	CartApprovalApproved         CartApprovalStatus = "APPROVED"
+	CartApprovalRejected         CartApprovalStatus = "REJECTED"
+	CartApprovalChangesRequested CartApprovalStatus = "CHANGES_REQUESTED"
+	CartApprovalExpired          CartApprovalStatus = "EXPIRED"
 )
 
 type CartAggregate struct {
 	aggregateID       uuid.UUID
 	userID            uuid.UUID
+	sessionID         value.SessionID
 	tenantID          uuid.UUID
 	items             []*entity.CartItem
+	coupons           []*entity.AppliedCoupon
+	shippingAddress   *value.ShippingAddress
+	shippingMethod    value.ShippingMethod
+	shippingFee       float64
+	amountDue         float64
 	status            CartStatus
+	members           map[uuid.UUID]CartMemberRole
+	invitations       map[uuid.UUID]struct{}
+	approval          CartApprovalStatus
+	approvalRequester uuid.UUID
+	approvalVersion   int
 	version           int
 	uncommittedEvents []event.Event
 }
@@ -36,7 +109,10 @@ type CartAggregate struct {
 func NewCartAggregate() *CartAggregate {
 	return &CartAggregate{
 		items:             make([]*entity.CartItem, 0),
+		coupons:           make([]*entity.AppliedCoupon, 0),
 		status:            CartStatusOpen,
+		members:           make(map[uuid.UUID]CartMemberRole),
+		invitations:       make(map[uuid.UUID]struct{}),
 		version:           -1,
 		uncommittedEvents: make([]event.Event, 0),
 	}
@@ -50,6 +126,63 @@ func (a *CartAggregate) GetVersion() int {
 	return a.version
 }
 
+func (a *CartAggregate) GetTenantID() uuid.UUID {
+	return a.tenantID
+}
+
+func (a *CartAggregate) GetUserID() uuid.UUID {
+	return a.userID
+}
+
+func (a *CartAggregate) GetSessionID() value.SessionID {
+	return a.sessionID
+}
+
+func (a *CartAggregate) GetItems() []*entity.CartItem {
+	return a.items
+}
+
+// IsGuest reports whether the cart was created by an anonymous session.
+func (a *CartAggregate) IsGuest() bool {
+	return a.sessionID != ""
+}
+
+// GetMemberRole returns the role of a member of a user cart. The user who
+// created the cart is its owner.
+func (a *CartAggregate) GetMemberRole(userID uuid.UUID) (CartMemberRole, bool) {
+	role, ok := a.members[userID]
+	return role, ok
+}
+
+func (a *CartAggregate) IsInvited(userID uuid.UUID) bool {
+	_, ok := a.invitations[userID]
+	return ok
+}
+
+func (a *CartAggregate) GetStatus() CartStatus {
+	return a.status
+}
+
+func (a *CartAggregate) GetApprovalStatus() CartApprovalStatus {
+	return a.approval
+}
+
+func (a *CartAggregate) GetCoupons() []*entity.AppliedCoupon {
+	return a.coupons
+}
+
+func (a *CartAggregate) GetShippingAddress() *value.ShippingAddress {
+	return a.shippingAddress
+}
+
+func (a *CartAggregate) GetShippingMethod() value.ShippingMethod {
+	return a.shippingMethod
+}
+
+func (a *CartAggregate) GetShippingFee() float64 {
+	return a.shippingFee
+}
+
 func (a *CartAggregate) GetUncommittedEvents() []event.Event {
 	return a.uncommittedEvents
 }
@@ -63,22 +196,95 @@ func (a *CartAggregate) isNew() bool {
 }
 
 func (a *CartAggregate) isCartAvailable() bool {
-	return a.status != CartStatusSubmitted && a.status != CartStatusClosed
+	return a.status != CartStatusSubmitted && a.status != CartStatusClosed && a.status != CartStatusMerged
+}
+
+// unavailableError tells apart carts closed for inactivity from the ones that
+// were checked out or merged.
+func (a *CartAggregate) unavailableError() error {
+	if a.status == CartStatusClosed {
+		return ErrCartExpired
+	}
+	return ErrCartClosed
+}
+
+func (a *CartAggregate) isMember(userID uuid.UUID) bool {
+	_, ok := a.members[userID]
+	return ok
+}
+
+// checkMember lets only members change a user cart. Guest carts belong to a
+// session rather than to users and are never shared. uuid.Nil is what an
+// erased member reads as, so it never passes for one.
+func (a *CartAggregate) checkMember(userID uuid.UUID) error {
+	if a.IsGuest() {
+		return nil
+	}
+	if userID != uuid.Nil && a.isMember(userID) {
+		return nil
+	}
+	return ErrCartNotMember
+}
+
+// checkSession lets only the session that started a guest cart change it.
+func (a *CartAggregate) checkSession(sessionID value.SessionID) error {
+	if a.IsGuest() && sessionID != a.sessionID {
+		return ErrCartSessionMismatch
+	}
+	return nil
+}
+
+// checkUnlocked keeps the cart as the approver sees it until they decide.
+func (a *CartAggregate) checkUnlocked() error {
+	if a.approval == CartApprovalPending {
+		return ErrCartApprovalPending
+	}
+	return nil
+}
+
+// voidApproval drops an approval once the cart changes, since the approver
+// approved the cart as it was.
+func (a *CartAggregate) voidApproval() {
+	if a.approval == CartApprovalApproved {
+		a.approval = ""
+	}
 }
 
 func (a *CartAggregate) ExecuteAddItemToCartCommand(cmd command.AddItemToCartCommand) error {
+	if !a.isNew() {
+		if err := a.checkMember(cmd.UserID); err != nil {
+			return err
+		}
+		if err := a.checkSession(cmd.SessionID); err != nil {
+			return err
+		}
+	}
+
 	if !a.isCartAvailable() {
-		return ErrCartClosed
+		return a.unavailableError()
+	}
+
+	if err := a.checkUnlocked(); err != nil {
+		return err
 	}
 
 	if a.isNew() {
+		if cmd.UserID == uuid.Nil && cmd.SessionID == "" {
+			return ErrCartOwnerRequired
+		}
+
 		a.aggregateID = cmd.CartID
 		a.userID = cmd.UserID
 		a.tenantID = cmd.TenantID
+		if cmd.UserID == uuid.Nil {
+			a.sessionID = cmd.SessionID
+		} else {
+			a.members[cmd.UserID] = CartMemberRoleOwner
+		}
 		a.status = CartStatusOpen
 		a.version = 1
 
-		evt := event.NewCartCreatedEvent(a.aggregateID, a.version, a.userID, a.tenantID)
+		evt := event.NewCartCreatedEvent(a.aggregateID, a.version, a.userID, a.tenantID, a.sessionID.String())
 		a.uncommittedEvents = append(a.uncommittedEvents, evt)
 	}
 
@@ -87,47 +293,876 @@ func (a *CartAggregate) ExecuteAddItemToCartCommand(cmd command.AddItemToCartCom
 		return err
 	}
 
-	cartItem := entity.NewCartItem(cmd.ItemID, cmd.Name, price)
+	taxCategory, err := value.NewTaxCategory(cmd.TaxCategory)
+	if err != nil {
+		return err
+	}
+
+	if cmd.WeightGrams < 0 {
+		return ErrItemWeightInvalid
+	}
+
+	category := strings.TrimSpace(cmd.Category)
+	cartItem := entity.NewCartItem(cmd.ItemID, cmd.Name, price, taxCategory, cmd.WeightGrams, cmd.Options, category)
+	if _, err := value.NewPrice(cartItem.GetPrice().Float64()); err != nil {
+		return err
+	}
+
+	if err := a.checkRules(cmd.CartRules, append(slices.Clone(a.items), cartItem), false); err != nil {
+		return err
+	}
 	a.items = append(a.items, cartItem)
+	a.voidApproval()
 
 	a.version++
-	evt := event.NewItemAddedToCartEvent(a.aggregateID, a.version, cmd.ItemID, cmd.Name, price.Float64(), cmd.TenantID)
+	evt := event.NewItemAddedToCartEvent(a.aggregateID, a.version, cmd.ItemID, cmd.Name, price.Float64(), cmd.TenantID, taxCategory.String(), cmd.WeightGrams, cmd.UserID, event.NewItemOptions(cmd.Options), category)
 	a.uncommittedEvents = append(a.uncommittedEvents, evt)
 
 	return nil
 }
 
+// ResolveItemsAdded records our item adds again on the current cart. Ours
+// were decided on an older version of the cart; theirs were committed
+// concurrently. It succeeds when theirs only added other items and the cart
+// still keeps to the rules with both. Automatic promotions applied along with
+// the adds do not get in the way; ours are applied again unless theirs
+// already applied them. It returns false when the add has to be decided again
+// on the current cart instead.
+func (a *CartAggregate) ResolveItemsAdded(theirs, ours []event.Event, rules value.CartRules) bool {
+	if len(ours) == 0 {
+		return false
+	}
+
+	added := make([]*event.ItemAddedToCartEvent, 0, len(ours))
+	addedItems := make(map[uuid.UUID]struct{}, len(ours))
+	applied := make([]*event.CouponAppliedToCartEvent, 0)
+	promotions := make([]value.Promotion, 0)
+	for _, evt := range ours {
+		switch e := evt.(type) {
+		case *event.ItemAddedToCartEvent:
+			added = append(added, e)
+			addedItems[e.GetItemID()] = struct{}{}
+		case *event.CouponAppliedToCartEvent:
+			if !e.GetAutomatic() {
+				return false
+			}
+			promotion, err := value.NewPromotion(e.GetPromotionType(), e.GetValue(), e.GetBuyQuantity(), e.GetGetQuantity(), e.GetMinimumTotal())
+			if err != nil {
+				return false
+			}
+			applied = append(applied, e)
+			promotions = append(promotions, promotion)
+		default:
+			return false
+		}
+	}
+	if len(added) == 0 {
+		return false
+	}
+
+	for _, evt := range theirs {
+		switch e := evt.(type) {
+		case *event.ItemAddedToCartEvent:
+			if _, ok := addedItems[e.GetItemID()]; ok {
+				return false
+			}
+		case *event.CouponAppliedToCartEvent:
+			if !e.GetAutomatic() {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	items := slices.Clone(a.items)
+	for _, itemAdded := range added {
+		items = append(items, cartItemFromEvent(itemAdded))
+	}
+	if err := a.checkRules(rules, items, false); err != nil {
+		return false
+	}
+
+	for _, itemAdded := range added {
+		a.items = append(a.items, cartItemFromEvent(itemAdded))
+		a.voidApproval()
+
+		a.version++
+		evt := event.NewItemAddedToCartEvent(a.aggregateID, a.version, itemAdded.ItemID, itemAdded.Name, itemAdded.Price, itemAdded.TenantID, itemAdded.TaxCategory, itemAdded.WeightGrams, itemAdded.AddedBy, itemAdded.Options, itemAdded.Category)
+		a.uncommittedEvents = append(a.uncommittedEvents, evt)
+	}
+
+	for i, coupon := range applied {
+		if a.findCoupon(value.CouponCode(coupon.GetCode())) != nil {
+			continue
+		}
+
+		a.version++
+		evt := event.NewCouponAppliedToCartEvent(a.aggregateID, a.version, coupon.GetCouponID(), coupon.GetCode(), coupon.GetPromotionType(), coupon.GetValue(), coupon.GetBuyQuantity(), coupon.GetGetQuantity(), coupon.GetMinimumTotal(), true)
+		a.uncommittedEvents = append(a.uncommittedEvents, evt)
+		a.coupons = append(a.coupons, entity.NewAppliedCoupon(coupon.GetCouponID(), value.CouponCode(coupon.GetCode()), promotions[i], true))
+		a.voidApproval()
+	}
+
+	return true
+}
+
+func cartItemFromEvent(e *event.ItemAddedToCartEvent) *entity.CartItem {
+	price, _ := value.NewPrice(e.GetPrice())
+	taxCategory, _ := value.NewTaxCategory(e.GetTaxCategory())
+	return entity.NewCartItem(e.GetItemID(), e.GetName(), price, taxCategory, e.GetWeightGrams(), e.GetLineOptions(), e.GetCategory())
+}
+
 func (a *CartAggregate) ExecuteSubmitCartCommand(cmd command.SubmitCartCommand) error {
 	if a.isNew() {
 		return errors.UnpermittedOp.New("cannot submit empty cart")
 	}
 
+	if err := a.checkMember(cmd.UserID); err != nil {
+		return err
+	}
+
 	if !a.isCartAvailable() {
-		return ErrCartClosed
+		return a.unavailableError()
+	}
+
+	if err := a.checkUnlocked(); err != nil {
+		return err
 	}
 
 	if len(a.items) == 0 {
 		return errors.UnpermittedOp.New("cannot submit empty cart")
 	}
 
+	if a.shippingAddress == nil {
+		return ErrShippingAddressNotSet
+	}
+
+	if a.shippingMethod == "" {
+		return ErrShippingMethodNotSelected
+	}
+
+	// Re-quote the fee so a rate change since selection is honoured
+	fee, err := cmd.ShippingRates.FeeFor(a.shippingMethod, a.shippingAddress.Prefecture(), a.GetTotalWeightGrams())
+	if err != nil {
+		return err
+	}
+
+	if err := a.checkRules(cmd.CartRules, a.items, true); err != nil {
+		return err
+	}
+
+	_, itemTotal := a.applyPromotions()
+	if cmd.ApprovalPolicy.Requires(itemTotal+fee) && a.approval != CartApprovalApproved {
+		return ErrCartApprovalRequired
+	}
+	a.shippingFee = fee
+
+	settings := cmd.TaxSettings
+	if settings == (value.TaxSettings{}) {
+		settings = value.DefaultTaxSettings()
+	}
+
+	subtotal := a.GetSubtotal().Float64()
+	discountTotal := a.GetDiscountTotal()
+	tax := a.CalculateTax(settings)
+
 	a.version++
-	totalAmount := a.GetTotalAmount()
-	evt := event.NewCartSubmittedEvent(a.aggregateID, a.version, totalAmount.Float64())
+	evt := event.NewCartSubmittedEvent(
+		a.aggregateID,
+		a.version,
+		tax.Total(),
+		subtotal,
+		discountTotal,
+		string(tax.Display),
+		string(tax.RoundingMode),
+		tax.StandardTaxableAmount,
+		tax.StandardTaxAmount,
+		tax.ReducedTaxableAmount,
+		tax.ReducedTaxAmount,
+		a.shippingMethod.String(),
+		a.shippingFee,
+	)
 	a.uncommittedEvents = append(a.uncommittedEvents, evt)
+	a.amountDue = evt.GetTotalAmount()
 	a.status = CartStatusSubmitted
 
 	return nil
 }
 
-func (a *CartAggregate) GetTotalAmount() value.Price {
+// ExecuteMergeCartCommand moves the lines of this guest cart into the
+// shopper's cart, creating it if needed, and closes the guest cart. Only the
+// session that started the guest cart can merge it. A line that is in both
+// carts ends up with the units of both, held to the cart rules.
+func (a *CartAggregate) ExecuteMergeCartCommand(cmd command.MergeCartCommand, into *CartAggregate) error {
+	if a.isNew() {
+		return ErrCartNotFound
+	}
+
+	if !a.IsGuest() {
+		return ErrCartNotGuest
+	}
+
+	if err := a.checkSession(cmd.SessionID); err != nil {
+		return err
+	}
+
+	if !a.isCartAvailable() {
+		return a.unavailableError()
+	}
+
+	if cmd.IntoCartID == a.aggregateID {
+		return ErrCartMergeIntoSelf
+	}
+
+	if !into.isNew() {
+		if !into.isCartAvailable() {
+			return into.unavailableError()
+		}
+		if !into.isMember(cmd.UserID) {
+			return ErrCartOwnerMismatch
+		}
+		if into.tenantID != a.tenantID {
+			return ErrCartTenantMismatch
+		}
+	}
+
+	for _, item := range a.items {
+		err := into.ExecuteAddItemToCartCommand(command.AddItemToCartCommand{
+			CartID:      cmd.IntoCartID,
+			UserID:      cmd.UserID,
+			ItemID:      item.GetItemID(),
+			Name:        item.GetName(),
+			Price:       item.GetBasePrice().Float64(),
+			TenantID:    a.tenantID,
+			TaxCategory: item.GetTaxCategory().String(),
+			WeightGrams: item.GetWeightGrams(),
+			Options:     item.GetOptions(),
+			Category:    item.GetCategory(),
+			CartRules:   cmd.CartRules,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	a.version++
+	evt := event.NewCartMergedEvent(a.aggregateID, a.version, cmd.IntoCartID, cmd.UserID)
+	a.uncommittedEvents = append(a.uncommittedEvents, evt)
+	a.status = CartStatusMerged
+
+	return nil
+}
+
+// ExecuteMoveCartItemToSavedListCommand takes every unit of the line off the
+// cart and keeps the item, with its options, category and units, on the
+// mover's saved list. Both aggregates get new events, so the caller has to
+// save both streams together.
+func (a *CartAggregate) ExecuteMoveCartItemToSavedListCommand(cmd command.MoveCartItemToSavedListCommand, list *SavedListAggregate) error {
+	if a.isNew() {
+		return ErrCartNotFound
+	}
+
+	if a.IsGuest() {
+		return ErrCartGuestSave
+	}
+
+	if err := a.checkMember(cmd.UserID); err != nil {
+		return err
+	}
+
+	if !a.isCartAvailable() {
+		return a.unavailableError()
+	}
+
+	if err := a.checkUnlocked(); err != nil {
+		return err
+	}
+
+	var line *entity.CartItem
+	quantity := 0
+	for _, item := range a.items {
+		if item.GetLineID() == cmd.LineID {
+			line = item
+			quantity++
+		}
+	}
+	if line == nil {
+		return ErrItemNotFound
+	}
+
+	err := list.ExecuteSaveItemCommand(command.SaveItemCommand{
+		TenantID:    a.tenantID,
+		UserID:      cmd.UserID,
+		ItemID:      line.GetItemID(),
+		Name:        line.GetName(),
+		Price:       line.GetBasePrice().Float64(),
+		TaxCategory: line.GetTaxCategory().String(),
+		WeightGrams: line.GetWeightGrams(),
+		Options:     line.GetOptions(),
+		Category:    line.GetCategory(),
+		Quantity:    quantity,
+	})
+	if err != nil {
+		return err
+	}
+
+	a.removeLine(cmd.LineID)
+	a.voidApproval()
+
+	a.version++
+	evt := event.NewCartItemMovedToSavedListEvent(a.aggregateID, a.version, line.GetItemID(), cmd.LineID, list.GetAggregateID(), cmd.UserID)
+	a.uncommittedEvents = append(a.uncommittedEvents, evt)
+
+	return nil
+}
+
+func (a *CartAggregate) removeLine(lineID uuid.UUID) {
+	a.items = slices.DeleteFunc(a.items, func(item *entity.CartItem) bool {
+		return item.GetLineID() == lineID
+	})
+}
+
+// ExecuteCloseCartCommand closes an open cart that has seen no activity since
+// the expiry check was scheduled. A stale check is a no-op, since the activity
+// that made it stale scheduled a check of its own. So is a check on a cart
+// waiting for approval: the approval deadline decides that cart, and its
+// outcome restarts the clock.
+func (a *CartAggregate) ExecuteCloseCartCommand(cmd command.CloseCartCommand) error {
+	if a.isNew() {
+		return ErrCartNotFound
+	}
+
+	if a.status != CartStatusOpen || a.version != cmd.IdleSinceVersion || a.approval == CartApprovalPending {
+		return nil
+	}
+
+	a.version++
+	evt := event.NewCartClosedEvent(a.aggregateID, a.version)
+	a.uncommittedEvents = append(a.uncommittedEvents, evt)
+	a.status = CartStatusClosed
+
+	return nil
+}
+
+// ExecuteInviteCartMemberCommand invites a user to edit the cart. Inviting a
+// user who was already invited is a no-op.
+func (a *CartAggregate) ExecuteInviteCartMemberCommand(cmd command.InviteCartMemberCommand) error {
+	if a.isNew() {
+		return ErrCartNotFound
+	}
+
+	if a.IsGuest() {
+		return ErrCartNotShareable
+	}
+
+	if a.members[cmd.UserID] != CartMemberRoleOwner {
+		return ErrCartOwnerOnly
+	}
+
+	if !a.isCartAvailable() {
+		return a.unavailableError()
+	}
+
+	if cmd.InviteeID == uuid.Nil {
+		return ErrCartMemberRequired
+	}
+
+	if a.isMember(cmd.InviteeID) {
+		return ErrCartAlreadyMember
+	}
+
+	if a.IsInvited(cmd.InviteeID) {
+		return nil
+	}
+
+	a.version++
+	evt := event.NewCartMemberInvitedEvent(a.aggregateID, a.version, cmd.InviteeID, cmd.UserID)
+	a.uncommittedEvents = append(a.uncommittedEvents, evt)
+	a.invitations[cmd.InviteeID] = struct{}{}
+
+	return nil
+}
+
+func (a *CartAggregate) ExecuteAcceptCartInvitationCommand(cmd command.AcceptCartInvitationCommand) error {
+	if a.isNew() {
+		return ErrCartNotFound
+	}
+
+	if !a.IsInvited(cmd.UserID) {
+		return ErrCartInvitationNotFound
+	}
+
+	if !a.isCartAvailable() {
+		return a.unavailableError()
+	}
+
+	a.version++
+	evt := event.NewCartInvitationAcceptedEvent(a.aggregateID, a.version, cmd.UserID)
+	a.uncommittedEvents = append(a.uncommittedEvents, evt)
+	a.acceptInvitation(cmd.UserID)
+
+	return nil
+}
+
+// ExecuteRemoveCartMemberCommand removes a member or withdraws a pending
+// invitation. Lines the member added stay in the cart.
+func (a *CartAggregate) ExecuteRemoveCartMemberCommand(cmd command.RemoveCartMemberCommand) error {
+	if a.isNew() {
+		return ErrCartNotFound
+	}
+
+	if cmd.UserID != cmd.MemberID && a.members[cmd.UserID] != CartMemberRoleOwner {
+		return ErrCartOwnerOnly
+	}
+
+	if a.members[cmd.MemberID] == CartMemberRoleOwner {
+		return ErrCartOwnerNotRemovable
+	}
+
+	if !a.isMember(cmd.MemberID) && !a.IsInvited(cmd.MemberID) {
+		return ErrCartMemberNotFound
+	}
+
+	if !a.isCartAvailable() {
+		return a.unavailableError()
+	}
+
+	a.version++
+	evt := event.NewCartMemberRemovedEvent(a.aggregateID, a.version, cmd.MemberID, cmd.UserID)
+	a.uncommittedEvents = append(a.uncommittedEvents, evt)
+	a.removeMember(cmd.MemberID)
+
+	return nil
+}
+
+// ExecuteRequestCartApprovalCommand sends a cart above the tenant's threshold
+// to its approvers and locks it until one of them decides or the deadline
+// passes. Asking again for a cart that is already approved is a no-op.
+func (a *CartAggregate) ExecuteRequestCartApprovalCommand(cmd command.RequestCartApprovalCommand) error {
+	if a.isNew() {
+		return ErrCartNotFound
+	}
+
+	if a.IsGuest() {
+		return ErrCartGuestApproval
+	}
+
+	if err := a.checkMember(cmd.UserID); err != nil {
+		return err
+	}
+
+	if !a.isCartAvailable() {
+		return a.unavailableError()
+	}
+
+	if err := a.checkUnlocked(); err != nil {
+		return err
+	}
+
+	amount := a.GetTotalAmount().Float64()
+	if !cmd.ApprovalPolicy.Requires(amount) {
+		return ErrCartApprovalNotRequired
+	}
+
+	if a.approval == CartApprovalApproved {
+		return nil
+	}
+
+	a.version++
+	deadline := time.Now().Add(cmd.ApprovalPolicy.Deadline())
+	evt := event.NewCartApprovalRequestedEvent(a.aggregateID, a.version, a.tenantID, cmd.UserID, amount, deadline)
+	a.uncommittedEvents = append(a.uncommittedEvents, evt)
+	a.requestApproval(cmd.UserID, a.version)
+
+	return nil
+}
+
+// ExecuteDecideCartApprovalCommand records an approver's decision on the
+// pending request. Any decision unlocks the cart; only an approval lets it be
+// submitted.
+func (a *CartAggregate) ExecuteDecideCartApprovalCommand(cmd command.DecideCartApprovalCommand) error {
+	if a.isNew() {
+		return ErrCartNotFound
+	}
+
+	if a.approval != CartApprovalPending {
+		return ErrCartApprovalNotPending
+	}
+
+	if !cmd.ApprovalPolicy.IsApprover(cmd.UserID) {
+		return ErrCartNotApprover
+	}
+
+	if cmd.UserID == a.approvalRequester {
+		return ErrCartSelfApproval
+	}
+
+	version := a.version + 1
+	var evt event.Event
+	var status CartApprovalStatus
+	switch cmd.Decision {
+	case value.ApprovalDecisionApprove:
+		evt = event.NewCartApprovedEvent(a.aggregateID, version, cmd.UserID, cmd.Comment)
+		status = CartApprovalApproved
+	case value.ApprovalDecisionReject:
+		evt = event.NewCartApprovalRejectedEvent(a.aggregateID, version, cmd.UserID, cmd.Comment)
+		status = CartApprovalRejected
+	case value.ApprovalDecisionRequestChanges:
+		evt = event.NewCartChangesRequestedEvent(a.aggregateID, version, cmd.UserID, cmd.Comment)
+		status = CartApprovalChangesRequested
+	default:
+		return value.ErrApprovalDecisionInvalid
+	}
+
+	a.version = version
+	a.uncommittedEvents = append(a.uncommittedEvents, evt)
+	a.approval = status
+
+	return nil
+}
+
+// ExecuteExpireCartApprovalCommand lapses a request nobody decided on before
+// its deadline. A check for an earlier request is a no-op.
+func (a *CartAggregate) ExecuteExpireCartApprovalCommand(cmd command.ExpireCartApprovalCommand) error {
+	if a.isNew() {
+		return ErrCartNotFound
+	}
+
+	if a.approval != CartApprovalPending || a.approvalVersion != cmd.RequestedAtVersion {
+		return nil
+	}
+
+	a.version++
+	evt := event.NewCartApprovalExpiredEvent(a.aggregateID, a.version)
+	a.uncommittedEvents = append(a.uncommittedEvents, evt)
+	a.approval = CartApprovalExpired
+
+	return nil
+}
+
+func (a *CartAggregate) requestApproval(requester uuid.UUID, version int) {
+	a.approval = CartApprovalPending
+	a.approvalRequester = requester
+	a.approvalVersion = version
+}
+
+func (a *CartAggregate) acceptInvitation(userID uuid.UUID) {
+	delete(a.invitations, userID)
+	a.members[userID] = CartMemberRoleMember
+}
+
+func (a *CartAggregate) removeMember(userID uuid.UUID) {
+	delete(a.invitations, userID)
+	delete(a.members, userID)
+}
+
+func (a *CartAggregate) ExecuteSetShippingAddressCommand(cmd command.SetShippingAddressCommand) error {
+	if a.isNew() {
+		return ErrCartNotFound
+	}
+
+	if err := a.checkMember(cmd.UserID); err != nil {
+		return err
+	}
+
+	if !a.isCartAvailable() {
+		return a.unavailableError()
+	}
+
+	if err := a.checkUnlocked(); err != nil {
+		return err
+	}
+
+	if a.shippingAddress != nil && *a.shippingAddress == cmd.Address {
+		return nil
+	}
+
+	address := cmd.Address
+	a.version++
+	evt := event.NewShippingAddressSetEvent(
+		a.aggregateID,
+		a.version,
+		a.dataSubjectID(),
+		address.RecipientName(),
+		address.PostalCode(),
+		address.Prefecture().String(),
+		address.City(),
+		address.AddressLine1(),
+		address.AddressLine2(),
+		address.Phone(),
+	)
+	a.uncommittedEvents = append(a.uncommittedEvents, evt)
+	a.setShippingAddress(address)
+	a.voidApproval()
+
+	return nil
+}
+
+func (a *CartAggregate) ExecuteSelectShippingMethodCommand(cmd command.SelectShippingMethodCommand) error {
+	if a.isNew() {
+		return ErrCartNotFound
+	}
+
+	if err := a.checkMember(cmd.UserID); err != nil {
+		return err
+	}
+
+	if !a.isCartAvailable() {
+		return a.unavailableError()
+	}
+
+	if err := a.checkUnlocked(); err != nil {
+		return err
+	}
+
+	if a.shippingAddress == nil {
+		return ErrShippingAddressNotSet
+	}
+
+	fee, err := cmd.ShippingRates.FeeFor(cmd.Method, a.shippingAddress.Prefecture(), a.GetTotalWeightGrams())
+	if err != nil {
+		return err
+	}
+
+	if a.shippingMethod == cmd.Method && a.shippingFee == fee {
+		return nil
+	}
+
+	a.version++
+	evt := event.NewShippingMethodSelectedEvent(a.aggregateID, a.version, cmd.Method.String(), fee)
+	a.uncommittedEvents = append(a.uncommittedEvents, evt)
+	a.shippingMethod = cmd.Method
+	a.shippingFee = fee
+	a.voidApproval()
+
+	return nil
+}
+
+// dataSubjectID is whom the personal data on the cart is about: its owner,
+// or the cart itself for a guest.
+func (a *CartAggregate) dataSubjectID() uuid.UUID {
+	if a.userID == uuid.Nil {
+		return a.aggregateID
+	}
+	return a.userID
+}
+
+// setShippingAddress keeps the selected method only while the prefecture, and
+// with it the quoted fee, stays the same.
+func (a *CartAggregate) setShippingAddress(address value.ShippingAddress) {
+	if a.shippingAddress == nil || a.shippingAddress.Prefecture() != address.Prefecture() {
+		a.shippingMethod = ""
+		a.shippingFee = 0
+	}
+	a.shippingAddress = &address
+}
+
+func (a *CartAggregate) ExecuteApplyCouponToCartCommand(cmd command.ApplyCouponToCartCommand) error {
+	if a.isNew() {
+		return ErrCartNotFound
+	}
+
+	if err := a.checkMember(cmd.UserID); err != nil {
+		return err
+	}
+
+	if !a.isCartAvailable() {
+		return a.unavailableError()
+	}
+
+	if err := a.checkUnlocked(); err != nil {
+		return err
+	}
+
+	if a.findCoupon(cmd.Code) != nil {
+		return ErrCouponAlreadyApplied
+	}
+
+	a.version++
+	promotion := cmd.Promotion
+	evt := event.NewCouponAppliedToCartEvent(
+		a.aggregateID,
+		a.version,
+		cmd.CouponID,
+		cmd.Code.String(),
+		string(promotion.Type()),
+		promotion.Value(),
+		promotion.BuyQuantity(),
+		promotion.GetQuantity(),
+		promotion.MinimumTotal(),
+		cmd.Automatic,
+	)
+	a.uncommittedEvents = append(a.uncommittedEvents, evt)
+	a.coupons = append(a.coupons, entity.NewAppliedCoupon(cmd.CouponID, cmd.Code, promotion, cmd.Automatic))
+	a.voidApproval()
+
+	return nil
+}
+
+func (a *CartAggregate) ExecuteRemoveCouponFromCartCommand(cmd command.RemoveCouponFromCartCommand) error {
+	if a.isNew() {
+		return ErrCartNotFound
+	}
+
+	if err := a.checkMember(cmd.UserID); err != nil {
+		return err
+	}
+
+	if !a.isCartAvailable() {
+		return a.unavailableError()
+	}
+
+	if err := a.checkUnlocked(); err != nil {
+		return err
+	}
+
+	coupon := a.findCoupon(cmd.Code)
+	if coupon == nil {
+		return ErrCouponNotApplied
+	}
+
+	a.version++
+	evt := event.NewCouponRemovedFromCartEvent(a.aggregateID, a.version, coupon.GetCouponID(), coupon.GetCode().String())
+	a.uncommittedEvents = append(a.uncommittedEvents, evt)
+	a.removeCoupon(coupon.GetCode())
+	a.voidApproval()
+
+	return nil
+}
+
+func (a *CartAggregate) findCoupon(code value.CouponCode) *entity.AppliedCoupon {
+	for _, coupon := range a.coupons {
+		if coupon.GetCode() == code {
+			return coupon
+		}
+	}
+	return nil
+}
+
+func (a *CartAggregate) removeCoupon(code value.CouponCode) {
+	coupons := make([]*entity.AppliedCoupon, 0, len(a.coupons))
+	for _, coupon := range a.coupons {
+		if coupon.GetCode() != code {
+			coupons = append(coupons, coupon)
+		}
+	}
+	a.coupons = coupons
+}
+
+func (a *CartAggregate) GetSubtotal() value.Price {
 	total := 0.0
 	for _, item := range a.items {
 		total += item.GetPrice().Float64()
 	}
-	totalPrice, _ := value.NewPrice(total)
+	subtotal, _ := value.NewPrice(total)
+	return subtotal
+}
+
+// GetDiscounts returns the discount of each applied coupon, in the order the
+// coupons were applied.
+func (a *CartAggregate) GetDiscounts() []float64 {
+	discounts, _ := a.applyPromotions()
+	return discounts
+}
+
+func (a *CartAggregate) GetTotalWeightGrams() int {
+	total := 0
+	for _, item := range a.items {
+		total += item.GetWeightGrams()
+	}
+	return total
+}
+
+// GetAmountDue returns what the submitted cart is charged, consumption tax
+// included, as fixed on submission.
+func (a *CartAggregate) GetAmountDue() (float64, error) {
+	if a.isNew() {
+		return 0, ErrCartNotFound
+	}
+	if a.status != CartStatusSubmitted {
+		return 0, ErrCartNotSubmitted
+	}
+	return a.amountDue, nil
+}
+
+// GetTotalAmount returns the discounted item total plus the shipping fee.
+func (a *CartAggregate) GetTotalAmount() value.Price {
+	_, total := a.applyPromotions()
+	totalPrice, _ := value.NewPrice(total + a.shippingFee)
 	return totalPrice
 }
 
+// CalculateTax returns the consumption tax of the discounted cart. Shipping
+// is taxed at the standard rate and is never discounted.
+func (a *CartAggregate) CalculateTax(settings value.TaxSettings) service.TaxBreakdown {
+	lines := make([]service.TaxableLine, 0, len(a.items)+1)
+	for _, item := range a.items {
+		lines = append(lines, service.TaxableLine{
+			Category: item.GetTaxCategory(),
+			Amount:   item.GetPrice().Float64(),
+		})
+	}
+
+	if a.shippingFee > 0 {
+		lines = append(lines, service.TaxableLine{
+			Category:   value.TaxCategoryStandard,
+			Amount:     a.shippingFee,
+			NoDiscount: true,
+		})
+	}
+
+	return service.NewTaxCalculator().Calculate(lines, a.GetDiscountTotal(), settings)
+}
+
+// checkRules evaluates the tenant's cart rules against the cart as it would
+// be with the given items, reporting every rule it breaks at once.
+func (a *CartAggregate) checkRules(rules value.CartRules, items []*entity.CartItem, submitting bool) error {
+	lines := make([]service.RuleLine, 0, len(items))
+	for _, item := range items {
+		lines = append(lines, service.RuleLine{
+			LineID:   item.GetLineID(),
+			Name:     item.GetName(),
+			Category: item.GetCategory(),
+		})
+	}
+
+	_, orderValue := a.applyPromotions()
+	violations := service.NewCartRuleEvaluator().Evaluate(rules, lines, orderValue, submitting)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	details := make([]errors.Detail, 0, len(violations))
+	for _, violation := range violations {
+		details = append(details, errors.Detail{
+			Code:    string(violation.Rule),
+			Message: violation.Message,
+		})
+	}
+	return errors.InvalidParameter.NewWithDetails("cart breaks the tenant's cart rules", details)
+}
+
+// GetDiscountTotal returns what the applied coupons take off the items.
+func (a *CartAggregate) GetDiscountTotal() float64 {
+	_, total := a.applyPromotions()
+	return math.Round((a.GetSubtotal().Float64()-total)*100) / 100
+}
+
+func (a *CartAggregate) applyPromotions() ([]float64, float64) {
+	prices := make([]float64, 0, len(a.items))
+	for _, item := range a.items {
+		prices = append(prices, item.GetPrice().Float64())
+	}
+
+	promotions := make([]value.Promotion, 0, len(a.coupons))
+	for _, coupon := range a.coupons {
+		promotions = append(promotions, coupon.GetPromotion())
+	}
+
+	return value.ApplyPromotions(prices, promotions)
+}
+
 func (a *CartAggregate) Hydration(events []event.Event) error {
 	for _, evt := range events {
 		switch e := evt.(type) {
@@ -135,16 +1170,93 @@ func (a *CartAggregate) Hydration(events []event.Event) error {
 			a.aggregateID = e.GetAggregateID()
 			a.userID = e.GetUserID()
 			a.tenantID = e.GetTenantID()
+			a.sessionID = value.SessionID(e.GetSessionID())
+			if a.userID != uuid.Nil {
+				a.members[a.userID] = CartMemberRoleOwner
+			}
 			a.status = CartStatusOpen
 			a.version = e.GetVersion()
 		case *event.ItemAddedToCartEvent:
-			price, _ := value.NewPrice(e.GetPrice())
-			cartItem := entity.NewCartItem(e.GetItemID(), e.GetName(), price)
-			a.items = append(a.items, cartItem)
+			a.items = append(a.items, cartItemFromEvent(e))
+			a.voidApproval()
 			a.version = e.GetVersion()
 		case *event.CartSubmittedEvent:
+			a.shippingFee = e.GetShippingFee()
+			a.amountDue = e.GetTotalAmount()
 			a.status = CartStatusSubmitted
 			a.version = e.GetVersion()
+		case *event.CartMergedEvent:
+			a.status = CartStatusMerged
+			a.version = e.GetVersion()
+		case *event.CartClosedEvent:
+			a.status = CartStatusClosed
+			a.version = e.GetVersion()
+		case *event.CartItemMovedToSavedListEvent:
+			a.removeLine(e.GetLineID())
+			a.voidApproval()
+			a.version = e.GetVersion()
+		case *event.CartMemberInvitedEvent:
+			// An erased invitee reads as uuid.Nil and is no longer invited
+			if e.GetUserID() != uuid.Nil {
+				a.invitations[e.GetUserID()] = struct{}{}
+			}
+			a.version = e.GetVersion()
+		case *event.CartInvitationAcceptedEvent:
+			if e.GetUserID() != uuid.Nil {
+				a.acceptInvitation(e.GetUserID())
+			}
+			a.version = e.GetVersion()
+		case *event.CartMemberRemovedEvent:
+			a.removeMember(e.GetUserID())
+			a.version = e.GetVersion()
+		case *event.ShippingAddressSetEvent:
+			if e.IsRedacted() {
+				// An erased address is as good as never set
+				a.shippingAddress = nil
+				a.shippingMethod = ""
+				a.shippingFee = 0
+			} else {
+				address, err := value.NewShippingAddress(e.GetRecipientName(), e.GetPostalCode(), e.GetPrefecture(), e.GetCity(), e.GetAddressLine1(), e.GetAddressLine2(), e.GetPhone())
+				if err != nil {
+					return err
+				}
+				a.setShippingAddress(address)
+			}
+			a.voidApproval()
+			a.version = e.GetVersion()
+		case *event.ShippingMethodSelectedEvent:
+			a.shippingMethod = value.ShippingMethod(e.GetMethod())
+			a.shippingFee = e.GetFee()
+			a.voidApproval()
+			a.version = e.GetVersion()
+		case *event.CouponAppliedToCartEvent:
+			promotion, err := value.NewPromotion(e.GetPromotionType(), e.GetValue(), e.GetBuyQuantity(), e.GetGetQuantity(), e.GetMinimumTotal())
+			if err != nil {
+				return err
+			}
+			coupon := entity.NewAppliedCoupon(e.GetCouponID(), value.CouponCode(e.GetCode()), promotion, e.GetAutomatic())
+			a.coupons = append(a.coupons, coupon)
+			a.voidApproval()
+			a.version = e.GetVersion()
+		case *event.CouponRemovedFromCartEvent:
+			a.removeCoupon(value.CouponCode(e.GetCode()))
+			a.voidApproval()
+			a.version = e.GetVersion()
+		case *event.CartApprovalRequestedEvent:
+			a.requestApproval(e.GetRequestedBy(), e.GetVersion())
+			a.version = e.GetVersion()
+		case *event.CartApprovedEvent:
+			a.approval = CartApprovalApproved
+			a.version = e.GetVersion()
+		case *event.CartApprovalRejectedEvent:
+			a.approval = CartApprovalRejected
+			a.version = e.GetVersion()
+		case *event.CartChangesRequestedEvent:
+			a.approval = CartApprovalChangesRequested
+			a.version = e.GetVersion()
+		case *event.CartApprovalExpiredEvent:
+			a.approval = CartApprovalExpired
+			a.version = e.GetVersion()
 		}
 	}
 	return nil
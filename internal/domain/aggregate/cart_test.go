@@ -2,6 +2,7 @@ package aggregate_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -122,7 +123,7 @@ func TestCartAggregate_ExecuteAddItemToCartCommand(t *testing.T) {
 			},
 			wantErr:       aggregate.ErrCartClosed,
 			wantEventsLen: 0,
-			wantVersion:   3,
+			wantVersion:   5,
 		},
 	}
 
@@ -138,7 +139,8 @@ func TestCartAggregate_ExecuteAddItemToCartCommand(t *testing.T) {
 			cart.MarkEventsAsCommitted()
 
 			if tt.isSubmitted {
-				cart.ExecuteSubmitCartCommand(command.SubmitCartCommand{CartID: cartID})
+				rates := shipToTokyo(t, cart, cartID, 0)
+				cart.ExecuteSubmitCartCommand(command.SubmitCartCommand{CartID: cartID, UserID: cart.GetUserID(), ShippingRates: rates})
 				cart.MarkEventsAsCommitted()
 			}
 
@@ -163,8 +165,20 @@ func TestCartAggregate_ExecuteSubmitCartCommand(t *testing.T) {
 	userID := uuid.New()
 	itemID := uuid.New()
 
+	existingItems := []command.AddItemToCartCommand{
+		{
+			CartID:   cartID,
+			UserID:   userID,
+			ItemID:   itemID,
+			Name:     "Test Item",
+			Price:    100.0,
+			TenantID: uuid.New(),
+		},
+	}
+
 	tests := map[string]struct {
 		existingItems []command.AddItemToCartCommand
+		shipped       bool
 		cmd           command.SubmitCartCommand
 		wantErr       error
 		wantEventsLen int
@@ -172,26 +186,25 @@ func TestCartAggregate_ExecuteSubmitCartCommand(t *testing.T) {
 	}{
 		"should return error for new cart": {
 			existingItems: nil,
-			cmd:           command.SubmitCartCommand{CartID: cartID},
+			cmd:           command.SubmitCartCommand{CartID: cartID, UserID: userID},
 			wantErr:       errors.UnpermittedOp.New("cannot submit empty cart"),
 			wantEventsLen: 0,
 			wantVersion:   -1,
 		},
+		"should return error without shipping address": {
+			existingItems: existingItems,
+			cmd:           command.SubmitCartCommand{CartID: cartID, UserID: userID},
+			wantErr:       aggregate.ErrShippingAddressNotSet,
+			wantEventsLen: 0,
+			wantVersion:   2,
+		},
 		"should successfully submit cart with items": {
-			existingItems: []command.AddItemToCartCommand{
-				{
-					CartID:   cartID,
-					UserID:   userID,
-					ItemID:   itemID,
-					Name:     "Test Item",
-					Price:    100.0,
-					TenantID: uuid.New(),
-				},
-			},
-			cmd:           command.SubmitCartCommand{CartID: cartID},
+			existingItems: existingItems,
+			shipped:       true,
+			cmd:           command.SubmitCartCommand{CartID: cartID, UserID: userID},
 			wantErr:       nil,
 			wantEventsLen: 1,
-			wantVersion:   3,
+			wantVersion:   5,
 		},
 	}
 
@@ -204,15 +217,19 @@ func TestCartAggregate_ExecuteSubmitCartCommand(t *testing.T) {
 			for _, existingCmd := range tt.existingItems {
 				cart.ExecuteAddItemToCartCommand(existingCmd)
 			}
+			cmd := tt.cmd
+			if tt.shipped {
+				cmd.ShippingRates = shipToTokyo(t, cart, cartID, 0)
+			}
 			cart.MarkEventsAsCommitted()
 
 			// Act
-			err := cart.ExecuteSubmitCartCommand(tt.cmd)
+			err := cart.ExecuteSubmitCartCommand(cmd)
 
 			// Assert
 			if tt.wantErr != nil {
 				assert.Error(t, err)
-				assert.Contains(t, err.Error(), "cannot submit empty cart")
+				assert.Contains(t, err.Error(), tt.wantErr.Error())
 			} else {
 				assert.NoError(t, err)
 			}
@@ -326,31 +343,31 @@ func TestCartAggregate_Hydration(t *testing.T) {
 		},
 		"should hydrate cart with CartCreatedEvent": {
 			events: []event.Event{
-				event.NewCartCreatedEvent(cartID, 1, userID, uuid.New()),
+				event.NewCartCreatedEvent(cartID, 1, userID, uuid.New(), ""),
 			},
 			wantVersion: 1,
 		},
 		"should hydrate cart with full event sequence": {
 			events: []event.Event{
-				event.NewCartCreatedEvent(cartID, 1, userID, uuid.New()),
-				event.NewItemAddedToCartEvent(cartID, 2, itemID, "Test Item", 50.0, uuid.New()),
-				event.NewCartSubmittedEvent(cartID, 3, 50.0),
+				event.NewCartCreatedEvent(cartID, 1, userID, uuid.New(), ""),
+				event.NewItemAddedToCartEvent(cartID, 2, itemID, "Test Item", 50.0, uuid.New(), "STANDARD", 0, uuid.Nil, nil, ""),
+				event.NewCartSubmittedEvent(cartID, 3, 55.0, 50.0, 0, "EXCLUSIVE", "FLOOR", 50.0, 5.0, 0, 0, "STANDARD", 0),
 			},
 			wantVersion: 3,
 		},
 		"should handle adding same item multiple times": {
 			events: []event.Event{
-				event.NewCartCreatedEvent(cartID, 1, userID, uuid.New()),
-				event.NewItemAddedToCartEvent(cartID, 2, itemID, "Same Item First", 50.0, uuid.New()),
-				event.NewItemAddedToCartEvent(cartID, 3, itemID, "Same Item Second", 50.0, uuid.New()),
+				event.NewCartCreatedEvent(cartID, 1, userID, uuid.New(), ""),
+				event.NewItemAddedToCartEvent(cartID, 2, itemID, "Same Item First", 50.0, uuid.New(), "STANDARD", 0, uuid.Nil, nil, ""),
+				event.NewItemAddedToCartEvent(cartID, 3, itemID, "Same Item Second", 50.0, uuid.New(), "STANDARD", 0, uuid.Nil, nil, ""),
 			},
 			wantVersion: 3,
 		},
 		"should handle multiple different items": {
 			events: []event.Event{
-				event.NewCartCreatedEvent(cartID, 1, userID, uuid.New()),
-				event.NewItemAddedToCartEvent(cartID, 2, itemID, "First Item", 50.0, uuid.New()),
-				event.NewItemAddedToCartEvent(cartID, 3, uuid.New(), "Second Item", 25.0, uuid.New()),
+				event.NewCartCreatedEvent(cartID, 1, userID, uuid.New(), ""),
+				event.NewItemAddedToCartEvent(cartID, 2, itemID, "First Item", 50.0, uuid.New(), "STANDARD", 0, uuid.Nil, nil, ""),
+				event.NewItemAddedToCartEvent(cartID, 3, uuid.New(), "Second Item", 25.0, uuid.New(), "STANDARD", 0, uuid.Nil, nil, ""),
 			},
 			wantVersion: 3,
 		},
@@ -416,3 +433,2459 @@ func TestCartAggregate_MarkEventsAsCommitted(t *testing.T) {
 		})
 	}
 }
+
+func cartWithItems(t *testing.T, cartID uuid.UUID, prices ...float64) *aggregate.CartAggregate {
+	t.Helper()
+
+	cart := aggregate.NewCartAggregate()
+	tenantID := uuid.New()
+	ownerID := uuid.New()
+	for _, price := range prices {
+		err := cart.ExecuteAddItemToCartCommand(command.AddItemToCartCommand{
+			CartID:   cartID,
+			UserID:   ownerID,
+			ItemID:   uuid.New(),
+			Name:     "Test Item",
+			Price:    price,
+			TenantID: tenantID,
+		})
+		assert.NoError(t, err)
+	}
+	cart.MarkEventsAsCommitted()
+
+	return cart
+}
+
+func TestCartAggregate_ExecuteApplyCouponToCartCommand(t *testing.T) {
+	cartID := uuid.New()
+	percentOff, _ := value.NewPromotion("PERCENT_OFF", 10, 0, 0, 0)
+
+	tests := map[string]struct {
+		prices      []float64
+		applied     bool
+		submitted   bool
+		wantErr     error
+		wantEvents  []string
+		wantTotal   float64
+		wantVersion int
+	}{
+		"should apply coupon and discount total": {
+			prices:      []float64{50, 50},
+			wantEvents:  []string{"CouponAppliedToCartEvent"},
+			wantTotal:   90,
+			wantVersion: 4,
+		},
+		"should return error for new cart": {
+			wantErr:     aggregate.ErrCartNotFound,
+			wantEvents:  []string{},
+			wantVersion: -1,
+		},
+		"should return error when coupon already applied": {
+			prices:      []float64{50, 50},
+			applied:     true,
+			wantErr:     aggregate.ErrCouponAlreadyApplied,
+			wantEvents:  []string{},
+			wantTotal:   90,
+			wantVersion: 4,
+		},
+		"should return error when cart is submitted": {
+			prices:      []float64{50, 50},
+			submitted:   true,
+			wantErr:     aggregate.ErrCartClosed,
+			wantEvents:  []string{},
+			wantTotal:   100,
+			wantVersion: 6,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			cart := cartWithItems(t, cartID, tt.prices...)
+			cmd := command.ApplyCouponToCartCommand{
+				CartID:    cartID,
+				UserID:    cart.GetUserID(),
+				CouponID:  uuid.New(),
+				Code:      value.CouponCode("SUMMER10"),
+				Promotion: percentOff,
+			}
+			if tt.applied {
+				assert.NoError(t, cart.ExecuteApplyCouponToCartCommand(cmd))
+			}
+			if tt.submitted {
+				rates := shipToTokyo(t, cart, cartID, 0)
+				assert.NoError(t, cart.ExecuteSubmitCartCommand(command.SubmitCartCommand{CartID: cartID, UserID: cart.GetUserID(), ShippingRates: rates}))
+			}
+			cart.MarkEventsAsCommitted()
+
+			// Act
+			err := cart.ExecuteApplyCouponToCartCommand(cmd)
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantEvents, eventTypes(cart.GetUncommittedEvents()))
+			assert.Equal(t, tt.wantTotal, cart.GetTotalAmount().Float64())
+			assert.Equal(t, tt.wantVersion, cart.GetVersion())
+		})
+	}
+}
+
+func TestCartAggregate_ExecuteRemoveCouponFromCartCommand(t *testing.T) {
+	cartID := uuid.New()
+	percentOff, _ := value.NewPromotion("PERCENT_OFF", 10, 0, 0, 0)
+
+	tests := map[string]struct {
+		applied    bool
+		wantErr    error
+		wantEvents []string
+		wantTotal  float64
+	}{
+		"should remove applied coupon": {
+			applied:    true,
+			wantEvents: []string{"CouponRemovedFromCartEvent"},
+			wantTotal:  100,
+		},
+		"should return error when coupon not applied": {
+			wantErr:    aggregate.ErrCouponNotApplied,
+			wantEvents: []string{},
+			wantTotal:  100,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			cart := cartWithItems(t, cartID, 50, 50)
+			if tt.applied {
+				err := cart.ExecuteApplyCouponToCartCommand(command.ApplyCouponToCartCommand{
+					CartID:    cartID,
+					UserID:    cart.GetUserID(),
+					CouponID:  uuid.New(),
+					Code:      value.CouponCode("SUMMER10"),
+					Promotion: percentOff,
+				})
+				assert.NoError(t, err)
+				cart.MarkEventsAsCommitted()
+			}
+
+			// Act
+			err := cart.ExecuteRemoveCouponFromCartCommand(command.RemoveCouponFromCartCommand{
+				CartID: cartID,
+				UserID: cart.GetUserID(),
+				Code:   value.CouponCode("SUMMER10"),
+			})
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantEvents, eventTypes(cart.GetUncommittedEvents()))
+			assert.Equal(t, tt.wantTotal, cart.GetTotalAmount().Float64())
+			assert.Empty(t, cart.GetCoupons())
+		})
+	}
+}
+
+func TestCartAggregate_HydrationWithCoupons(t *testing.T) {
+	cartID := uuid.New()
+	couponID := uuid.New()
+
+	// Arrange
+	cart := aggregate.NewCartAggregate()
+	events := []event.Event{
+		event.NewCartCreatedEvent(cartID, 1, uuid.New(), uuid.New(), ""),
+		event.NewItemAddedToCartEvent(cartID, 2, uuid.New(), "First Item", 30.0, uuid.New(), "STANDARD", 0, uuid.Nil, nil, ""),
+		event.NewItemAddedToCartEvent(cartID, 3, uuid.New(), "Second Item", 20.0, uuid.New(), "STANDARD", 0, uuid.Nil, nil, ""),
+		event.NewItemAddedToCartEvent(cartID, 4, uuid.New(), "Third Item", 10.0, uuid.New(), "STANDARD", 0, uuid.Nil, nil, ""),
+		event.NewCouponAppliedToCartEvent(cartID, 5, couponID, "BUY2GET1", "BUY_X_GET_Y", 0, 2, 1, 0, false),
+		event.NewCouponAppliedToCartEvent(cartID, 6, uuid.New(), "TENOFF", "FIXED_AMOUNT_OFF", 10, 0, 0, 0, false),
+		event.NewCouponRemovedFromCartEvent(cartID, 7, couponID, "BUY2GET1"),
+	}
+
+	// Act
+	err := cart.Hydration(events)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 7, cart.GetVersion())
+	assert.Equal(t, 60.0, cart.GetSubtotal().Float64())
+	assert.Equal(t, []float64{10}, cart.GetDiscounts())
+	assert.Equal(t, 50.0, cart.GetTotalAmount().Float64())
+}
+
+func TestCartAggregate_ExecuteSubmitCartCommand_Tax(t *testing.T) {
+	cartID := uuid.New()
+	exclusiveFloor := value.DefaultTaxSettings()
+	inclusiveRound, _ := value.NewTaxSettings("INCLUSIVE", "ROUND")
+	fixedOff, _ := value.NewPromotion("FIXED_AMOUNT_OFF", 400, 0, 0, 0)
+
+	type item struct {
+		price    float64
+		category string
+	}
+
+	tests := map[string]struct {
+		items        []item
+		coupon       bool
+		settings     value.TaxSettings
+		wantSubtotal float64
+		wantDiscount float64
+		wantStandard [2]float64
+		wantReduced  [2]float64
+		wantTotal    float64
+	}{
+		"exclusive adds tax per rate": {
+			items:        []item{{1000, "STANDARD"}, {500, "REDUCED"}},
+			settings:     exclusiveFloor,
+			wantSubtotal: 1500,
+			wantStandard: [2]float64{1000, 100},
+			wantReduced:  [2]float64{500, 40},
+			wantTotal:    1640,
+		},
+		"inclusive keeps the total": {
+			items:        []item{{1100, "STANDARD"}, {1080, "REDUCED"}},
+			settings:     inclusiveRound,
+			wantSubtotal: 2180,
+			wantStandard: [2]float64{1100, 100},
+			wantReduced:  [2]float64{1080, 80},
+			wantTotal:    2180,
+		},
+		"tax is calculated after discounts": {
+			items:        []item{{3000, "STANDARD"}, {1000, "REDUCED"}},
+			coupon:       true,
+			settings:     exclusiveFloor,
+			wantSubtotal: 4000,
+			wantDiscount: 400,
+			wantStandard: [2]float64{2700, 270},
+			wantReduced:  [2]float64{900, 72},
+			wantTotal:    3942,
+		},
+		"unset settings fall back to the default": {
+			items:        []item{{1000, ""}},
+			wantSubtotal: 1000,
+			wantStandard: [2]float64{1000, 100},
+			wantTotal:    1100,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			cart := aggregate.NewCartAggregate()
+			tenantID := uuid.New()
+			userID := uuid.New()
+			for _, it := range tt.items {
+				assert.NoError(t, cart.ExecuteAddItemToCartCommand(command.AddItemToCartCommand{
+					CartID:      cartID,
+					UserID:      userID,
+					ItemID:      uuid.New(),
+					Name:        "Test Item",
+					Price:       it.price,
+					TenantID:    tenantID,
+					TaxCategory: it.category,
+				}))
+			}
+			if tt.coupon {
+				assert.NoError(t, cart.ExecuteApplyCouponToCartCommand(command.ApplyCouponToCartCommand{
+					CartID:    cartID,
+					UserID:    cart.GetUserID(),
+					CouponID:  uuid.New(),
+					Code:      value.CouponCode("SAVE400"),
+					Promotion: fixedOff,
+				}))
+			}
+			rates := shipToTokyo(t, cart, cartID, 0)
+			cart.MarkEventsAsCommitted()
+			_, openErr := cart.GetAmountDue()
+
+			// Act
+			err := cart.ExecuteSubmitCartCommand(command.SubmitCartCommand{CartID: cartID, UserID: cart.GetUserID(), TaxSettings: tt.settings, ShippingRates: rates})
+
+			// Assert
+			assert.NoError(t, err)
+			events := cart.GetUncommittedEvents()
+			assert.Len(t, events, 1)
+			submitted, ok := events[0].(*event.CartSubmittedEvent)
+			assert.True(t, ok)
+			assert.Equal(t, tt.wantSubtotal, submitted.GetSubtotal())
+			assert.Equal(t, tt.wantDiscount, submitted.GetDiscountTotal())
+			assert.Equal(t, tt.wantStandard, [2]float64{submitted.GetStandardTaxableAmount(), submitted.GetStandardTaxAmount()})
+			assert.Equal(t, tt.wantReduced, [2]float64{submitted.GetReducedTaxableAmount(), submitted.GetReducedTaxAmount()})
+			assert.Equal(t, tt.wantTotal, submitted.GetTotalAmount())
+			assert.ErrorIs(t, openErr, aggregate.ErrCartNotSubmitted)
+			amountDue, err := cart.GetAmountDue()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantTotal, amountDue)
+		})
+	}
+}
+
+func TestCartAggregate_ExecuteAddItemToCartCommand_InvalidTaxCategory(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	cart := aggregate.NewCartAggregate()
+
+	// Act
+	err := cart.ExecuteAddItemToCartCommand(command.AddItemToCartCommand{
+		CartID:      uuid.New(),
+		UserID:      uuid.New(),
+		ItemID:      uuid.New(),
+		Name:        "Test Item",
+		Price:       100,
+		TenantID:    uuid.New(),
+		TaxCategory: "EXEMPT",
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, value.ErrTaxCategoryInvalid)
+}
+
+func lineOptions(t *testing.T, options ...value.LineOption) value.LineOptions {
+	t.Helper()
+
+	lineOptions, err := value.NewLineOptions(options)
+	assert.NoError(t, err)
+	return lineOptions
+}
+
+func TestCartAggregate_ExecuteAddItemToCartCommand_Options(t *testing.T) {
+	sizeM, err := value.NewLineOption("size", "M", 0)
+	assert.NoError(t, err)
+	sizeL, err := value.NewLineOption("size", "L", 200)
+	assert.NoError(t, err)
+	noWrap, err := value.NewLineOption("gift_wrap", "none", -2000)
+	assert.NoError(t, err)
+
+	tests := map[string]struct {
+		options   []value.LineOptions
+		wantTotal float64
+		wantLines int
+		wantErr   error
+	}{
+		"modifiers are added to the base price": {
+			options:   []value.LineOptions{lineOptions(t, sizeL)},
+			wantTotal: 1200,
+			wantLines: 1,
+		},
+		"same item with other options is another line": {
+			options:   []value.LineOptions{lineOptions(t, sizeM), lineOptions(t, sizeL)},
+			wantTotal: 2200,
+			wantLines: 2,
+		},
+		"same item with the same options is the same line": {
+			options:   []value.LineOptions{lineOptions(t, sizeM), lineOptions(t, sizeM)},
+			wantTotal: 2000,
+			wantLines: 1,
+		},
+		"line price below zero": {
+			options: []value.LineOptions{lineOptions(t, noWrap)},
+			wantErr: value.ErrPriceInvalid,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			cart := aggregate.NewCartAggregate()
+			cartID := uuid.New()
+			userID := uuid.New()
+			itemID := uuid.New()
+
+			// Act
+			var err error
+			for _, options := range tt.options {
+				err = cart.ExecuteAddItemToCartCommand(command.AddItemToCartCommand{
+					CartID:   cartID,
+					UserID:   userID,
+					ItemID:   itemID,
+					Name:     "T-Shirt",
+					Price:    1000,
+					TenantID: uuid.New(),
+					Options:  options,
+				})
+				if err != nil {
+					break
+				}
+			}
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantTotal, cart.GetTotalAmount().Float64())
+
+			lines := make(map[uuid.UUID]struct{})
+			for _, item := range cart.GetItems() {
+				lines[item.GetLineID()] = struct{}{}
+			}
+			assert.Len(t, lines, tt.wantLines)
+		})
+	}
+}
+
+func TestCartAggregate_HydrationWithOptions(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	cartID := uuid.New()
+	userID := uuid.New()
+	itemID := uuid.New()
+	options := []event.ItemOption{
+		{Name: "size", Value: "L", PriceModifier: 200},
+		{Name: "gift_wrap", Value: "yes", PriceModifier: 300},
+	}
+	events := []event.Event{
+		event.NewCartCreatedEvent(cartID, 1, userID, uuid.New(), ""),
+		event.NewItemAddedToCartEvent(cartID, 2, itemID, "T-Shirt", 1000.0, uuid.New(), "STANDARD", 0, userID, options, ""),
+		event.NewItemAddedToCartEvent(cartID, 3, itemID, "T-Shirt", 1000.0, uuid.New(), "STANDARD", 0, userID, nil, ""),
+	}
+	cart := aggregate.NewCartAggregate()
+
+	// Act
+	err := cart.Hydration(events)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 2500.0, cart.GetTotalAmount().Float64())
+	items := cart.GetItems()
+	assert.Len(t, items, 2)
+	assert.NotEqual(t, itemID, items[0].GetLineID())
+	assert.Equal(t, itemID, items[1].GetLineID())
+	assert.Equal(t, 1000.0, items[0].GetBasePrice().Float64())
+}
+
+func shipToTokyo(t *testing.T, cart *aggregate.CartAggregate, cartID uuid.UUID, fee float64) value.ShippingRateTable {
+	t.Helper()
+
+	address, err := value.NewShippingAddress("Taro Yamada", "1000001", "Tokyo", "Chiyoda-ku", "1-1 Chiyoda", "", "03-1234-5678")
+	assert.NoError(t, err)
+	rate, err := value.NewShippingRate("STANDARD", "Tokyo", 2000, fee)
+	assert.NoError(t, err)
+	rates := value.NewShippingRateTable([]value.ShippingRate{rate})
+
+	assert.NoError(t, cart.ExecuteSetShippingAddressCommand(command.SetShippingAddressCommand{CartID: cartID, UserID: cart.GetUserID(), Address: address}))
+	assert.NoError(t, cart.ExecuteSelectShippingMethodCommand(command.SelectShippingMethodCommand{
+		CartID:        cartID,
+		UserID:        cart.GetUserID(),
+		Method:        value.ShippingMethod("STANDARD"),
+		ShippingRates: rates,
+	}))
+
+	return rates
+}
+
+func TestCartAggregate_ExecuteSetShippingAddressCommand(t *testing.T) {
+	cartID := uuid.New()
+	tokyo, _ := value.NewShippingAddress("Taro Yamada", "100-0001", "Tokyo", "Chiyoda-ku", "1-1 Chiyoda", "", "03-1234-5678")
+	osaka, _ := value.NewShippingAddress("Taro Yamada", "530-0001", "Osaka", "Kita-ku", "1-1 Umeda", "", "06-1234-5678")
+	tokyoOffice, _ := value.NewShippingAddress("Taro Yamada", "100-0005", "Tokyo", "Chiyoda-ku", "1-1 Marunouchi", "8F", "03-1234-5678")
+
+	tests := map[string]struct {
+		prices     []float64
+		address    value.ShippingAddress
+		wantErr    error
+		wantEvents []string
+		wantMethod value.ShippingMethod
+	}{
+		"should return error for new cart": {
+			address:    osaka,
+			wantErr:    aggregate.ErrCartNotFound,
+			wantEvents: []string{},
+		},
+		"should ignore an unchanged address": {
+			prices:     []float64{100},
+			address:    tokyo,
+			wantEvents: []string{},
+			wantMethod: "STANDARD",
+		},
+		"should keep the method within the same prefecture": {
+			prices:     []float64{100},
+			address:    tokyoOffice,
+			wantEvents: []string{"ShippingAddressSetEvent"},
+			wantMethod: "STANDARD",
+		},
+		"should clear the method when the prefecture changes": {
+			prices:     []float64{100},
+			address:    osaka,
+			wantEvents: []string{"ShippingAddressSetEvent"},
+			wantMethod: "",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			cart := cartWithItems(t, cartID, tt.prices...)
+			if len(tt.prices) > 0 {
+				shipToTokyo(t, cart, cartID, 500)
+			}
+			cart.MarkEventsAsCommitted()
+
+			// Act
+			err := cart.ExecuteSetShippingAddressCommand(command.SetShippingAddressCommand{CartID: cartID, UserID: cart.GetUserID(), Address: tt.address})
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantEvents, eventTypes(cart.GetUncommittedEvents()))
+			assert.Equal(t, tt.wantMethod, cart.GetShippingMethod())
+		})
+	}
+}
+
+func TestCartAggregate_ExecuteSelectShippingMethodCommand(t *testing.T) {
+	cartID := uuid.New()
+	address, _ := value.NewShippingAddress("Taro Yamada", "100-0001", "Tokyo", "Chiyoda-ku", "1-1 Chiyoda", "", "03-1234-5678")
+	light, _ := value.NewShippingRate("STANDARD", "Tokyo", 2000, 800)
+	heavy, _ := value.NewShippingRate("STANDARD", "Tokyo", 10000, 1500)
+	express, _ := value.NewShippingRate("EXPRESS", "Osaka", 2000, 1200)
+	rates := value.NewShippingRateTable([]value.ShippingRate{heavy, light, express})
+
+	tests := map[string]struct {
+		weights    []int
+		noAddress  bool
+		method     value.ShippingMethod
+		wantErr    error
+		wantEvents []string
+		wantFee    float64
+		wantTotal  float64
+	}{
+		"should quote the lightest band that fits": {
+			weights:    []int{500, 1000},
+			method:     "STANDARD",
+			wantEvents: []string{"ShippingMethodSelectedEvent"},
+			wantFee:    800,
+			wantTotal:  1000,
+		},
+		"should quote a heavier band by cart weight": {
+			weights:    []int{1500, 1000},
+			method:     "STANDARD",
+			wantEvents: []string{"ShippingMethodSelectedEvent"},
+			wantFee:    1500,
+			wantTotal:  1700,
+		},
+		"should return error without address": {
+			weights:    []int{500},
+			noAddress:  true,
+			method:     "STANDARD",
+			wantErr:    aggregate.ErrShippingAddressNotSet,
+			wantEvents: []string{},
+			wantTotal:  100,
+		},
+		"should return error when method does not ship to the prefecture": {
+			weights:    []int{500},
+			method:     "EXPRESS",
+			wantErr:    value.ErrShippingRateNotFound,
+			wantEvents: []string{},
+			wantTotal:  100,
+		},
+		"should return error when the cart is too heavy": {
+			weights:    []int{6000, 6000},
+			method:     "STANDARD",
+			wantErr:    value.ErrShippingRateNotFound,
+			wantEvents: []string{},
+			wantTotal:  200,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			cart := aggregate.NewCartAggregate()
+			tenantID := uuid.New()
+			userID := uuid.New()
+			for _, weight := range tt.weights {
+				assert.NoError(t, cart.ExecuteAddItemToCartCommand(command.AddItemToCartCommand{
+					CartID:      cartID,
+					UserID:      userID,
+					ItemID:      uuid.New(),
+					Name:        "Test Item",
+					Price:       100,
+					TenantID:    tenantID,
+					WeightGrams: weight,
+				}))
+			}
+			if !tt.noAddress {
+				assert.NoError(t, cart.ExecuteSetShippingAddressCommand(command.SetShippingAddressCommand{CartID: cartID, UserID: cart.GetUserID(), Address: address}))
+			}
+			cart.MarkEventsAsCommitted()
+
+			// Act
+			err := cart.ExecuteSelectShippingMethodCommand(command.SelectShippingMethodCommand{
+				CartID:        cartID,
+				UserID:        cart.GetUserID(),
+				Method:        tt.method,
+				ShippingRates: rates,
+			})
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantEvents, eventTypes(cart.GetUncommittedEvents()))
+			assert.Equal(t, tt.wantFee, cart.GetShippingFee())
+			assert.Equal(t, tt.wantTotal, cart.GetTotalAmount().Float64())
+		})
+	}
+}
+
+func TestCartAggregate_ExecuteSubmitCartCommand_Shipping(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	cartID := uuid.New()
+	fixedOff, _ := value.NewPromotion("FIXED_AMOUNT_OFF", 500, 0, 0, 0)
+	cart := cartWithItems(t, cartID, 1000)
+	assert.NoError(t, cart.ExecuteApplyCouponToCartCommand(command.ApplyCouponToCartCommand{
+		CartID:    cartID,
+		UserID:    cart.GetUserID(),
+		CouponID:  uuid.New(),
+		Code:      value.CouponCode("SAVE500"),
+		Promotion: fixedOff,
+	}))
+	rates := shipToTokyo(t, cart, cartID, 800)
+	cart.MarkEventsAsCommitted()
+
+	// Act
+	err := cart.ExecuteSubmitCartCommand(command.SubmitCartCommand{CartID: cartID, UserID: cart.GetUserID(), ShippingRates: rates})
+
+	// Assert
+	assert.NoError(t, err)
+	submitted, ok := cart.GetUncommittedEvents()[0].(*event.CartSubmittedEvent)
+	assert.True(t, ok)
+	assert.Equal(t, "STANDARD", submitted.GetShippingMethod())
+	assert.Equal(t, 800.0, submitted.GetShippingFee())
+	assert.Equal(t, 500.0, submitted.GetDiscountTotal())
+	assert.Equal(t, 1300.0, submitted.GetStandardTaxableAmount())
+	assert.Equal(t, 1430.0, submitted.GetTotalAmount())
+}
+
+func guestCartWithItems(t *testing.T, cartID, tenantID uuid.UUID, prices ...float64) *aggregate.CartAggregate {
+	t.Helper()
+
+	cart := aggregate.NewCartAggregate()
+	for _, price := range prices {
+		err := cart.ExecuteAddItemToCartCommand(command.AddItemToCartCommand{
+			CartID:    cartID,
+			SessionID: value.SessionID("sess_guest"),
+			ItemID:    uuid.New(),
+			Name:      "Guest Item",
+			Price:     price,
+			TenantID:  tenantID,
+		})
+		assert.NoError(t, err)
+	}
+	cart.MarkEventsAsCommitted()
+
+	return cart
+}
+
+func TestCartAggregate_ExecuteAddItemToCartCommand_Owner(t *testing.T) {
+	tests := map[string]struct {
+		userID    uuid.UUID
+		sessionID value.SessionID
+		wantErr   error
+		wantGuest bool
+	}{
+		"user cart": {
+			userID: uuid.New(),
+		},
+		"guest cart": {
+			sessionID: "sess_guest",
+			wantGuest: true,
+		},
+		"user wins over session": {
+			userID:    uuid.New(),
+			sessionID: "sess_guest",
+		},
+		"no owner": {
+			wantErr: aggregate.ErrCartOwnerRequired,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			cart := aggregate.NewCartAggregate()
+
+			// Act
+			err := cart.ExecuteAddItemToCartCommand(command.AddItemToCartCommand{
+				CartID:    uuid.New(),
+				UserID:    tt.userID,
+				SessionID: tt.sessionID,
+				ItemID:    uuid.New(),
+				Name:      "Test Item",
+				Price:     100,
+				TenantID:  uuid.New(),
+			})
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, -1, cart.GetVersion())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantGuest, cart.IsGuest())
+		})
+	}
+}
+
+func TestCartAggregate_ExecuteAddItemToCartCommand_GuestSession(t *testing.T) {
+	tests := map[string]struct {
+		userID    uuid.UUID
+		sessionID value.SessionID
+		wantErr   error
+	}{
+		"same session": {
+			sessionID: "sess_guest",
+		},
+		"another session": {
+			sessionID: "sess_other",
+			wantErr:   aggregate.ErrCartSessionMismatch,
+		},
+		"user without the session": {
+			userID:  uuid.New(),
+			wantErr: aggregate.ErrCartSessionMismatch,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			cartID := uuid.New()
+			tenantID := uuid.New()
+			cart := guestCartWithItems(t, cartID, tenantID, 100)
+
+			// Act
+			err := cart.ExecuteAddItemToCartCommand(command.AddItemToCartCommand{
+				CartID:    cartID,
+				UserID:    tt.userID,
+				SessionID: tt.sessionID,
+				ItemID:    uuid.New(),
+				Name:      "Test Item",
+				Price:     100,
+				TenantID:  tenantID,
+			})
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, cart.GetUncommittedEvents())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"ItemAddedToCartEvent"}, eventTypes(cart.GetUncommittedEvents()))
+		})
+	}
+}
+
+func TestCartAggregate_ExecuteMergeCartCommand(t *testing.T) {
+	guestID := uuid.New()
+	userCartID := uuid.New()
+	userID := uuid.New()
+	tenantID := uuid.New()
+
+	tests := map[string]struct {
+		guestPrices    []float64
+		userPrices     []float64
+		userTenantID   uuid.UUID
+		userOwnerID    uuid.UUID
+		intoCartID     uuid.UUID
+		sessionID      value.SessionID
+		notGuest       bool
+		wantErr        error
+		wantGuestEvent []string
+		wantIntoEvents []string
+		wantIntoTotal  float64
+	}{
+		"merge into existing cart": {
+			guestPrices:    []float64{30, 20},
+			userPrices:     []float64{100},
+			intoCartID:     userCartID,
+			wantGuestEvent: []string{"CartMergedEvent"},
+			wantIntoEvents: []string{"ItemAddedToCartEvent", "ItemAddedToCartEvent"},
+			wantIntoTotal:  150,
+		},
+		"merge creates the user cart": {
+			guestPrices:    []float64{30},
+			intoCartID:     userCartID,
+			wantGuestEvent: []string{"CartMergedEvent"},
+			wantIntoEvents: []string{"CartCreatedEvent", "ItemAddedToCartEvent"},
+			wantIntoTotal:  30,
+		},
+		"user cart of another tenant": {
+			guestPrices:    []float64{30},
+			userPrices:     []float64{100},
+			userTenantID:   uuid.New(),
+			intoCartID:     userCartID,
+			wantErr:        aggregate.ErrCartTenantMismatch,
+			wantGuestEvent: []string{},
+			wantIntoEvents: []string{},
+			wantIntoTotal:  100,
+		},
+		"cart of another user": {
+			guestPrices:    []float64{30},
+			userPrices:     []float64{100},
+			userOwnerID:    uuid.New(),
+			intoCartID:     userCartID,
+			wantErr:        aggregate.ErrCartOwnerMismatch,
+			wantGuestEvent: []string{},
+			wantIntoEvents: []string{},
+			wantIntoTotal:  100,
+		},
+		"guest cart of another session": {
+			guestPrices:    []float64{30},
+			intoCartID:     userCartID,
+			sessionID:      "sess_other",
+			wantErr:        aggregate.ErrCartSessionMismatch,
+			wantGuestEvent: []string{},
+			wantIntoEvents: []string{},
+		},
+		"merge into itself": {
+			guestPrices:    []float64{30},
+			intoCartID:     guestID,
+			wantErr:        aggregate.ErrCartMergeIntoSelf,
+			wantGuestEvent: []string{},
+			wantIntoEvents: []string{},
+		},
+		"user cart cannot be merged": {
+			guestPrices:    []float64{30},
+			notGuest:       true,
+			intoCartID:     userCartID,
+			wantErr:        aggregate.ErrCartNotGuest,
+			wantGuestEvent: []string{},
+			wantIntoEvents: []string{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			guest := guestCartWithItems(t, guestID, tenantID, tt.guestPrices...)
+			if tt.notGuest {
+				guest = cartWithItems(t, guestID, tt.guestPrices...)
+			}
+			into := aggregate.NewCartAggregate()
+			for _, price := range tt.userPrices {
+				ownerID, cartTenantID := userID, tenantID
+				if tt.userOwnerID != uuid.Nil {
+					ownerID = tt.userOwnerID
+				}
+				if tt.userTenantID != uuid.Nil {
+					cartTenantID = tt.userTenantID
+				}
+				assert.NoError(t, into.ExecuteAddItemToCartCommand(command.AddItemToCartCommand{
+					CartID:   userCartID,
+					UserID:   ownerID,
+					ItemID:   uuid.New(),
+					Name:     "User Item",
+					Price:    price,
+					TenantID: cartTenantID,
+				}))
+			}
+			into.MarkEventsAsCommitted()
+			sessionID := value.SessionID("sess_guest")
+			if tt.sessionID != "" {
+				sessionID = tt.sessionID
+			}
+
+			// Act
+			err := guest.ExecuteMergeCartCommand(command.MergeCartCommand{
+				CartID:     guestID,
+				IntoCartID: tt.intoCartID,
+				UserID:     userID,
+				SessionID:  sessionID,
+			}, into)
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantGuestEvent, eventTypes(guest.GetUncommittedEvents()))
+			assert.Equal(t, tt.wantIntoEvents, eventTypes(into.GetUncommittedEvents()))
+			assert.Equal(t, tt.wantIntoTotal, into.GetTotalAmount().Float64())
+		})
+	}
+}
+
+func TestCartAggregate_ExecuteMergeCartCommand_MatchingLines(t *testing.T) {
+	giftWrap, err := value.NewLineOption("gift_wrap", "yes", 300)
+	assert.NoError(t, err)
+
+	tests := map[string]struct {
+		guestUnits     int
+		guestOptions   value.LineOptions
+		userUnits      int
+		wantErr        bool
+		wantIntoEvents []string
+		wantUnits      int
+	}{
+		"line in both carts adds up the units of both": {
+			guestUnits:     2,
+			userUnits:      1,
+			wantIntoEvents: []string{"ItemAddedToCartEvent", "ItemAddedToCartEvent"},
+			wantUnits:      3,
+		},
+		"single guest unit is added to the user's units": {
+			guestUnits:     1,
+			userUnits:      2,
+			wantIntoEvents: []string{"ItemAddedToCartEvent"},
+			wantUnits:      3,
+		},
+		"same item with other options is another line": {
+			guestUnits:     2,
+			guestOptions:   lineOptions(t, giftWrap),
+			userUnits:      2,
+			wantIntoEvents: []string{"ItemAddedToCartEvent", "ItemAddedToCartEvent"},
+			wantUnits:      4,
+		},
+		"units of both carts break the cart rules": {
+			guestUnits: 2,
+			userUnits:  2,
+			wantErr:    true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			guestID := uuid.New()
+			userCartID := uuid.New()
+			userID := uuid.New()
+			tenantID := uuid.New()
+			itemID := uuid.New()
+			rules := cartRules(t, 2, 3, 0)
+
+			guest := aggregate.NewCartAggregate()
+			for range tt.guestUnits {
+				assert.NoError(t, guest.ExecuteAddItemToCartCommand(command.AddItemToCartCommand{
+					CartID:    guestID,
+					SessionID: value.SessionID("sess_guest"),
+					ItemID:    itemID,
+					Name:      "T-Shirt",
+					Price:     1000,
+					TenantID:  tenantID,
+					Options:   tt.guestOptions,
+				}))
+			}
+			guest.MarkEventsAsCommitted()
+
+			into := aggregate.NewCartAggregate()
+			for range tt.userUnits {
+				assert.NoError(t, into.ExecuteAddItemToCartCommand(command.AddItemToCartCommand{
+					CartID:   userCartID,
+					UserID:   userID,
+					ItemID:   itemID,
+					Name:     "T-Shirt",
+					Price:    1000,
+					TenantID: tenantID,
+				}))
+			}
+			into.MarkEventsAsCommitted()
+
+			// Act
+			err := guest.ExecuteMergeCartCommand(command.MergeCartCommand{
+				CartID:     guestID,
+				IntoCartID: userCartID,
+				UserID:     userID,
+				SessionID:  value.SessionID("sess_guest"),
+				CartRules:  rules,
+			}, into)
+
+			// Assert
+			if tt.wantErr {
+				assert.True(t, errors.IsCode(err, errors.InvalidParameter))
+				assert.Empty(t, guest.GetUncommittedEvents())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantIntoEvents, eventTypes(into.GetUncommittedEvents()))
+			assert.Len(t, into.GetItems(), tt.wantUnits)
+		})
+	}
+}
+
+func TestCartAggregate_MergedGuestCartIsClosed(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	guestID := uuid.New()
+	tenantID := uuid.New()
+	history := []event.Event{
+		event.NewCartCreatedEvent(guestID, 1, uuid.Nil, tenantID, "sess_guest"),
+		event.NewItemAddedToCartEvent(guestID, 2, uuid.New(), "Guest Item", 30.0, tenantID, "STANDARD", 0, uuid.Nil, nil, ""),
+		event.NewCartMergedEvent(guestID, 3, uuid.New(), uuid.New()),
+	}
+
+	hydrated := aggregate.NewCartAggregate()
+	assert.NoError(t, hydrated.Hydration(history))
+
+	// Act
+	err := hydrated.ExecuteAddItemToCartCommand(command.AddItemToCartCommand{
+		CartID:    guestID,
+		SessionID: "sess_guest",
+		ItemID:    uuid.New(),
+		Name:      "Late Item",
+		Price:     10,
+		TenantID:  hydrated.GetTenantID(),
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, aggregate.ErrCartClosed)
+	assert.True(t, hydrated.IsGuest())
+}
+
+func TestCartAggregate_ExecuteCloseCartCommand(t *testing.T) {
+	cartID := uuid.New()
+
+	tests := map[string]struct {
+		cart             func(t *testing.T) *aggregate.CartAggregate
+		idleSinceVersion int
+		wantErr          error
+		wantEvents       []string
+		wantVersion      int
+	}{
+		"closes a cart that stayed idle": {
+			cart:             func(t *testing.T) *aggregate.CartAggregate { return cartWithItems(t, cartID, 100) },
+			idleSinceVersion: 2,
+			wantEvents:       []string{"CartClosedEvent"},
+			wantVersion:      3,
+		},
+		"ignores a check scheduled before later activity": {
+			cart:             func(t *testing.T) *aggregate.CartAggregate { return cartWithItems(t, cartID, 100, 200) },
+			idleSinceVersion: 2,
+			wantEvents:       []string{},
+			wantVersion:      3,
+		},
+		"ignores a submitted cart": {
+			cart: func(t *testing.T) *aggregate.CartAggregate {
+				cart := cartWithItems(t, cartID, 100)
+				rates := shipToTokyo(t, cart, cartID, 800)
+				assert.NoError(t, cart.ExecuteSubmitCartCommand(command.SubmitCartCommand{CartID: cartID, UserID: cart.GetUserID(), ShippingRates: rates}))
+				cart.MarkEventsAsCommitted()
+				return cart
+			},
+			idleSinceVersion: 5,
+			wantEvents:       []string{},
+			wantVersion:      5,
+		},
+		"returns not found for a cart that does not exist": {
+			cart:             func(t *testing.T) *aggregate.CartAggregate { return aggregate.NewCartAggregate() },
+			idleSinceVersion: -1,
+			wantErr:          aggregate.ErrCartNotFound,
+			wantEvents:       []string{},
+			wantVersion:      -1,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			cart := tt.cart(t)
+
+			// Act
+			err := cart.ExecuteCloseCartCommand(command.CloseCartCommand{
+				CartID:           cartID,
+				IdleSinceVersion: tt.idleSinceVersion,
+			})
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantEvents, eventTypes(cart.GetUncommittedEvents()))
+			assert.Equal(t, tt.wantVersion, cart.GetVersion())
+		})
+	}
+}
+
+func TestCartAggregate_ExecuteCloseCartCommand_PendingApproval(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	cartID := uuid.New()
+	ownerID := uuid.New()
+	cart := pendingApprovalCart(t, cartID, ownerID, approvalPolicy(t, 50, uuid.New()))
+	version := cart.GetVersion()
+
+	// Act
+	err := cart.ExecuteCloseCartCommand(command.CloseCartCommand{
+		CartID:           cartID,
+		IdleSinceVersion: version,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, cart.GetUncommittedEvents())
+	assert.Equal(t, aggregate.CartStatusOpen, cart.GetStatus())
+	assert.Equal(t, aggregate.CartApprovalPending, cart.GetApprovalStatus())
+}
+
+func TestCartAggregate_ClosedCartRejectsChanges(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	cartID := uuid.New()
+	userID := uuid.New()
+	tenantID := uuid.New()
+	history := []event.Event{
+		event.NewCartCreatedEvent(cartID, 1, userID, tenantID, ""),
+		event.NewItemAddedToCartEvent(cartID, 2, uuid.New(), "Forgotten Item", 30.0, tenantID, "STANDARD", 0, uuid.Nil, nil, ""),
+		event.NewCartClosedEvent(cartID, 3),
+	}
+
+	cart := aggregate.NewCartAggregate()
+	assert.NoError(t, cart.Hydration(history))
+
+	// Act
+	err := cart.ExecuteAddItemToCartCommand(command.AddItemToCartCommand{
+		CartID:   cartID,
+		UserID:   userID,
+		ItemID:   uuid.New(),
+		Name:     "Late Item",
+		Price:    10,
+		TenantID: tenantID,
+	})
+
+	// Assert
+	assert.ErrorIs(t, err, aggregate.ErrCartExpired)
+	assert.True(t, errors.IsCode(err, errors.UnpermittedOp))
+	assert.Equal(t, 3, cart.GetVersion())
+}
+
+func sharedCart(t *testing.T, cartID, ownerID uuid.UUID, memberIDs ...uuid.UUID) *aggregate.CartAggregate {
+	t.Helper()
+
+	cart := aggregate.NewCartAggregate()
+	assert.NoError(t, cart.ExecuteAddItemToCartCommand(command.AddItemToCartCommand{
+		CartID:   cartID,
+		UserID:   ownerID,
+		ItemID:   uuid.New(),
+		Name:     "Owner Item",
+		Price:    100,
+		TenantID: uuid.New(),
+	}))
+	for _, memberID := range memberIDs {
+		assert.NoError(t, cart.ExecuteInviteCartMemberCommand(command.InviteCartMemberCommand{
+			CartID:    cartID,
+			UserID:    ownerID,
+			InviteeID: memberID,
+		}))
+		assert.NoError(t, cart.ExecuteAcceptCartInvitationCommand(command.AcceptCartInvitationCommand{
+			CartID: cartID,
+			UserID: memberID,
+		}))
+	}
+	cart.MarkEventsAsCommitted()
+
+	return cart
+}
+
+func TestCartAggregate_ExecuteInviteCartMemberCommand(t *testing.T) {
+	cartID := uuid.New()
+	ownerID := uuid.New()
+	memberID := uuid.New()
+	pendingID := uuid.New()
+
+	tests := map[string]struct {
+		guest      bool
+		userID     uuid.UUID
+		inviteeID  uuid.UUID
+		wantErr    error
+		wantEvents []string
+	}{
+		"owner invites a user": {
+			userID:     ownerID,
+			inviteeID:  uuid.New(),
+			wantEvents: []string{"CartMemberInvitedEvent"},
+		},
+		"inviting a pending invitee again is a no-op": {
+			userID:     ownerID,
+			inviteeID:  pendingID,
+			wantEvents: []string{},
+		},
+		"members cannot invite": {
+			userID:     memberID,
+			inviteeID:  uuid.New(),
+			wantErr:    aggregate.ErrCartOwnerOnly,
+			wantEvents: []string{},
+		},
+		"rejects a user who is already a member": {
+			userID:     ownerID,
+			inviteeID:  memberID,
+			wantErr:    aggregate.ErrCartAlreadyMember,
+			wantEvents: []string{},
+		},
+		"rejects a missing invitee": {
+			userID:     ownerID,
+			wantErr:    aggregate.ErrCartMemberRequired,
+			wantEvents: []string{},
+		},
+		"guest carts cannot be shared": {
+			guest:      true,
+			inviteeID:  uuid.New(),
+			wantErr:    aggregate.ErrCartNotShareable,
+			wantEvents: []string{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			cart := sharedCart(t, cartID, ownerID, memberID)
+			assert.NoError(t, cart.ExecuteInviteCartMemberCommand(command.InviteCartMemberCommand{
+				CartID:    cartID,
+				UserID:    ownerID,
+				InviteeID: pendingID,
+			}))
+			if tt.guest {
+				cart = guestCartWithItems(t, cartID, uuid.New(), 100)
+			}
+			cart.MarkEventsAsCommitted()
+
+			// Act
+			err := cart.ExecuteInviteCartMemberCommand(command.InviteCartMemberCommand{
+				CartID:    cartID,
+				UserID:    tt.userID,
+				InviteeID: tt.inviteeID,
+			})
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, cart.IsInvited(tt.inviteeID))
+			}
+			assert.Equal(t, tt.wantEvents, eventTypes(cart.GetUncommittedEvents()))
+		})
+	}
+}
+
+func TestCartAggregate_ExecuteAcceptCartInvitationCommand(t *testing.T) {
+	cartID := uuid.New()
+	ownerID := uuid.New()
+	inviteeID := uuid.New()
+
+	tests := map[string]struct {
+		userID     uuid.UUID
+		wantErr    error
+		wantEvents []string
+	}{
+		"invitee joins the cart": {
+			userID:     inviteeID,
+			wantEvents: []string{"CartInvitationAcceptedEvent"},
+		},
+		"rejects a user without an invitation": {
+			userID:     uuid.New(),
+			wantErr:    aggregate.ErrCartInvitationNotFound,
+			wantEvents: []string{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			cart := sharedCart(t, cartID, ownerID)
+			assert.NoError(t, cart.ExecuteInviteCartMemberCommand(command.InviteCartMemberCommand{
+				CartID:    cartID,
+				UserID:    ownerID,
+				InviteeID: inviteeID,
+			}))
+			cart.MarkEventsAsCommitted()
+
+			// Act
+			err := cart.ExecuteAcceptCartInvitationCommand(command.AcceptCartInvitationCommand{
+				CartID: cartID,
+				UserID: tt.userID,
+			})
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				role, ok := cart.GetMemberRole(tt.userID)
+				assert.True(t, ok)
+				assert.Equal(t, aggregate.CartMemberRoleMember, role)
+				assert.False(t, cart.IsInvited(tt.userID))
+			}
+			assert.Equal(t, tt.wantEvents, eventTypes(cart.GetUncommittedEvents()))
+		})
+	}
+}
+
+func TestCartAggregate_ExecuteRemoveCartMemberCommand(t *testing.T) {
+	cartID := uuid.New()
+	ownerID := uuid.New()
+	memberID := uuid.New()
+	otherMemberID := uuid.New()
+	pendingID := uuid.New()
+
+	tests := map[string]struct {
+		userID     uuid.UUID
+		memberID   uuid.UUID
+		wantErr    error
+		wantEvents []string
+	}{
+		"owner removes a member": {
+			userID:     ownerID,
+			memberID:   memberID,
+			wantEvents: []string{"CartMemberRemovedEvent"},
+		},
+		"owner withdraws an invitation": {
+			userID:     ownerID,
+			memberID:   pendingID,
+			wantEvents: []string{"CartMemberRemovedEvent"},
+		},
+		"member leaves the cart": {
+			userID:     memberID,
+			memberID:   memberID,
+			wantEvents: []string{"CartMemberRemovedEvent"},
+		},
+		"members cannot remove each other": {
+			userID:     memberID,
+			memberID:   otherMemberID,
+			wantErr:    aggregate.ErrCartOwnerOnly,
+			wantEvents: []string{},
+		},
+		"owner cannot be removed": {
+			userID:     ownerID,
+			memberID:   ownerID,
+			wantErr:    aggregate.ErrCartOwnerNotRemovable,
+			wantEvents: []string{},
+		},
+		"returns not found for a user who is not a member": {
+			userID:     ownerID,
+			memberID:   uuid.New(),
+			wantErr:    aggregate.ErrCartMemberNotFound,
+			wantEvents: []string{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			cart := sharedCart(t, cartID, ownerID, memberID, otherMemberID)
+			assert.NoError(t, cart.ExecuteInviteCartMemberCommand(command.InviteCartMemberCommand{
+				CartID:    cartID,
+				UserID:    ownerID,
+				InviteeID: pendingID,
+			}))
+			cart.MarkEventsAsCommitted()
+
+			// Act
+			err := cart.ExecuteRemoveCartMemberCommand(command.RemoveCartMemberCommand{
+				CartID:   cartID,
+				UserID:   tt.userID,
+				MemberID: tt.memberID,
+			})
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				_, ok := cart.GetMemberRole(tt.memberID)
+				assert.False(t, ok)
+				assert.False(t, cart.IsInvited(tt.memberID))
+			}
+			assert.Equal(t, tt.wantEvents, eventTypes(cart.GetUncommittedEvents()))
+		})
+	}
+}
+
+func TestCartAggregate_CommandsRequireMembership(t *testing.T) {
+	cartID := uuid.New()
+	ownerID := uuid.New()
+	memberID := uuid.New()
+	address, _ := value.NewShippingAddress("Taro Yamada", "100-0001", "Tokyo", "Chiyoda-ku", "1-1 Chiyoda", "", "03-1234-5678")
+	percentOff, _ := value.NewPromotion("PERCENT_OFF", 10, 0, 0, 0)
+
+	addItem := func(cart *aggregate.CartAggregate, userID uuid.UUID) error {
+		return cart.ExecuteAddItemToCartCommand(command.AddItemToCartCommand{
+			CartID:   cartID,
+			UserID:   userID,
+			ItemID:   uuid.New(),
+			Name:     "Shared Item",
+			Price:    50,
+			TenantID: cart.GetTenantID(),
+		})
+	}
+
+	tests := map[string]struct {
+		userID  uuid.UUID
+		act     func(cart *aggregate.CartAggregate, userID uuid.UUID) error
+		wantErr error
+	}{
+		"member adds an item": {
+			userID: memberID,
+			act:    addItem,
+		},
+		"non-member cannot add an item": {
+			userID:  uuid.New(),
+			act:     addItem,
+			wantErr: aggregate.ErrCartNotMember,
+		},
+		"non-member cannot set the shipping address": {
+			userID: uuid.New(),
+			act: func(cart *aggregate.CartAggregate, userID uuid.UUID) error {
+				return cart.ExecuteSetShippingAddressCommand(command.SetShippingAddressCommand{CartID: cartID, UserID: userID, Address: address})
+			},
+			wantErr: aggregate.ErrCartNotMember,
+		},
+		"non-member cannot apply a coupon": {
+			userID: uuid.New(),
+			act: func(cart *aggregate.CartAggregate, userID uuid.UUID) error {
+				return cart.ExecuteApplyCouponToCartCommand(command.ApplyCouponToCartCommand{
+					CartID:    cartID,
+					UserID:    userID,
+					CouponID:  uuid.New(),
+					Code:      value.CouponCode("SUMMER10"),
+					Promotion: percentOff,
+				})
+			},
+			wantErr: aggregate.ErrCartNotMember,
+		},
+		"non-member cannot submit": {
+			userID: uuid.New(),
+			act: func(cart *aggregate.CartAggregate, userID uuid.UUID) error {
+				return cart.ExecuteSubmitCartCommand(command.SubmitCartCommand{CartID: cartID, UserID: userID})
+			},
+			wantErr: aggregate.ErrCartNotMember,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			cart := sharedCart(t, cartID, ownerID, memberID)
+
+			// Act
+			err := tt.act(cart, tt.userID)
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, cart.GetUncommittedEvents())
+				return
+			}
+			assert.NoError(t, err)
+			events := cart.GetUncommittedEvents()
+			if assert.Len(t, events, 1) {
+				added, ok := events[0].(*event.ItemAddedToCartEvent)
+				assert.True(t, ok)
+				assert.Equal(t, tt.userID, added.GetAddedBy())
+			}
+		})
+	}
+}
+
+func TestCartAggregate_HydrationWithMembers(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	cartID := uuid.New()
+	ownerID := uuid.New()
+	memberID := uuid.New()
+	removedID := uuid.New()
+	pendingID := uuid.New()
+	tenantID := uuid.New()
+	history := []event.Event{
+		event.NewCartCreatedEvent(cartID, 1, ownerID, tenantID, ""),
+		event.NewItemAddedToCartEvent(cartID, 2, uuid.New(), "Owner Item", 30.0, tenantID, "STANDARD", 0, ownerID, nil, ""),
+		event.NewCartMemberInvitedEvent(cartID, 3, memberID, ownerID),
+		event.NewCartInvitationAcceptedEvent(cartID, 4, memberID),
+		event.NewCartMemberInvitedEvent(cartID, 5, removedID, ownerID),
+		event.NewCartInvitationAcceptedEvent(cartID, 6, removedID),
+		event.NewCartMemberRemovedEvent(cartID, 7, removedID, ownerID),
+		event.NewCartMemberInvitedEvent(cartID, 8, pendingID, ownerID),
+	}
+
+	cart := aggregate.NewCartAggregate()
+
+	// Act
+	err := cart.Hydration(history)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 8, cart.GetVersion())
+	ownerRole, _ := cart.GetMemberRole(ownerID)
+	assert.Equal(t, aggregate.CartMemberRoleOwner, ownerRole)
+	memberRole, _ := cart.GetMemberRole(memberID)
+	assert.Equal(t, aggregate.CartMemberRoleMember, memberRole)
+	_, removed := cart.GetMemberRole(removedID)
+	assert.False(t, removed)
+	assert.True(t, cart.IsInvited(pendingID))
+	assert.ErrorIs(t, cart.ExecuteAddItemToCartCommand(command.AddItemToCartCommand{
+		CartID:   cartID,
+		UserID:   removedID,
+		ItemID:   uuid.New(),
+		Name:     "Late Item",
+		Price:    10,
+		TenantID: tenantID,
+	}), aggregate.ErrCartNotMember)
+}
+
+func TestCartAggregate_HydrationWithErasedMember(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	cartID := uuid.New()
+	ownerID := uuid.New()
+	tenantID := uuid.New()
+	// An erased member's ID replays as uuid.Nil
+	history := []event.Event{
+		event.NewCartCreatedEvent(cartID, 1, ownerID, tenantID, ""),
+		event.NewCartMemberInvitedEvent(cartID, 2, uuid.Nil, ownerID),
+		event.NewCartInvitationAcceptedEvent(cartID, 3, uuid.Nil),
+		event.NewCartMemberInvitedEvent(cartID, 4, uuid.Nil, ownerID),
+	}
+
+	cart := aggregate.NewCartAggregate()
+
+	// Act
+	err := cart.Hydration(history)
+	addErr := cart.ExecuteAddItemToCartCommand(command.AddItemToCartCommand{
+		CartID:   cartID,
+		ItemID:   uuid.New(),
+		Name:     "Anonymous Item",
+		Price:    10,
+		TenantID: tenantID,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 4, cart.GetVersion())
+	_, isMember := cart.GetMemberRole(uuid.Nil)
+	assert.False(t, isMember)
+	assert.False(t, cart.IsInvited(uuid.Nil))
+	assert.ErrorIs(t, addErr, aggregate.ErrCartNotMember)
+	assert.Empty(t, cart.GetUncommittedEvents())
+}
+
+func approvalPolicy(t *testing.T, threshold float64, approverIDs ...uuid.UUID) value.ApprovalPolicy {
+	t.Helper()
+
+	policy, err := value.NewApprovalPolicy(threshold, approverIDs, 48)
+	assert.NoError(t, err)
+	return policy
+}
+
+func pendingApprovalCart(t *testing.T, cartID, ownerID uuid.UUID, policy value.ApprovalPolicy) *aggregate.CartAggregate {
+	t.Helper()
+
+	cart := sharedCart(t, cartID, ownerID)
+	assert.NoError(t, cart.ExecuteRequestCartApprovalCommand(command.RequestCartApprovalCommand{
+		CartID:         cartID,
+		UserID:         ownerID,
+		ApprovalPolicy: policy,
+	}))
+	cart.MarkEventsAsCommitted()
+
+	return cart
+}
+
+func TestCartAggregate_ExecuteRequestCartApprovalCommand(t *testing.T) {
+	cartID := uuid.New()
+	ownerID := uuid.New()
+	approverID := uuid.New()
+	policy := approvalPolicy(t, 50, approverID)
+
+	tests := map[string]struct {
+		cart       func(t *testing.T) *aggregate.CartAggregate
+		userID     uuid.UUID
+		policy     value.ApprovalPolicy
+		wantErr    error
+		wantEvents []string
+		wantStatus aggregate.CartApprovalStatus
+	}{
+		"requests approval for a cart above the threshold": {
+			cart:       func(t *testing.T) *aggregate.CartAggregate { return sharedCart(t, cartID, ownerID) },
+			userID:     ownerID,
+			policy:     policy,
+			wantEvents: []string{"CartApprovalRequestedEvent"},
+			wantStatus: aggregate.CartApprovalPending,
+		},
+		"rejects a cart at or below the threshold": {
+			cart:       func(t *testing.T) *aggregate.CartAggregate { return sharedCart(t, cartID, ownerID) },
+			userID:     ownerID,
+			policy:     approvalPolicy(t, 100, approverID),
+			wantErr:    aggregate.ErrCartApprovalNotRequired,
+			wantEvents: []string{},
+		},
+		"rejects a tenant without an approval policy": {
+			cart:       func(t *testing.T) *aggregate.CartAggregate { return sharedCart(t, cartID, ownerID) },
+			userID:     ownerID,
+			wantErr:    aggregate.ErrCartApprovalNotRequired,
+			wantEvents: []string{},
+		},
+		"rejects a cart that is already pending": {
+			cart:       func(t *testing.T) *aggregate.CartAggregate { return pendingApprovalCart(t, cartID, ownerID, policy) },
+			userID:     ownerID,
+			policy:     policy,
+			wantErr:    aggregate.ErrCartApprovalPending,
+			wantEvents: []string{},
+			wantStatus: aggregate.CartApprovalPending,
+		},
+		"rejects a user who is not a member": {
+			cart:       func(t *testing.T) *aggregate.CartAggregate { return sharedCart(t, cartID, ownerID) },
+			userID:     uuid.New(),
+			policy:     policy,
+			wantErr:    aggregate.ErrCartNotMember,
+			wantEvents: []string{},
+		},
+		"rejects a guest cart": {
+			cart:       func(t *testing.T) *aggregate.CartAggregate { return guestCartWithItems(t, cartID, uuid.New(), 100) },
+			policy:     policy,
+			wantErr:    aggregate.ErrCartGuestApproval,
+			wantEvents: []string{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			cart := tt.cart(t)
+
+			// Act
+			err := cart.ExecuteRequestCartApprovalCommand(command.RequestCartApprovalCommand{
+				CartID:         cartID,
+				UserID:         tt.userID,
+				ApprovalPolicy: tt.policy,
+			})
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantEvents, eventTypes(cart.GetUncommittedEvents()))
+			assert.Equal(t, tt.wantStatus, cart.GetApprovalStatus())
+		})
+	}
+}
+
+func TestCartAggregate_ExecuteDecideCartApprovalCommand(t *testing.T) {
+	cartID := uuid.New()
+	ownerID := uuid.New()
+	approverID := uuid.New()
+	policy := approvalPolicy(t, 50, approverID, ownerID)
+
+	tests := map[string]struct {
+		pending    bool
+		userID     uuid.UUID
+		decision   value.ApprovalDecision
+		wantErr    error
+		wantEvents []string
+		wantStatus aggregate.CartApprovalStatus
+	}{
+		"approver approves": {
+			pending:    true,
+			userID:     approverID,
+			decision:   value.ApprovalDecisionApprove,
+			wantEvents: []string{"CartApprovedEvent"},
+			wantStatus: aggregate.CartApprovalApproved,
+		},
+		"approver rejects": {
+			pending:    true,
+			userID:     approverID,
+			decision:   value.ApprovalDecisionReject,
+			wantEvents: []string{"CartApprovalRejectedEvent"},
+			wantStatus: aggregate.CartApprovalRejected,
+		},
+		"approver requests changes": {
+			pending:    true,
+			userID:     approverID,
+			decision:   value.ApprovalDecisionRequestChanges,
+			wantEvents: []string{"CartChangesRequestedEvent"},
+			wantStatus: aggregate.CartApprovalChangesRequested,
+		},
+		"rejects a user who is not an approver": {
+			pending:    true,
+			userID:     uuid.New(),
+			decision:   value.ApprovalDecisionApprove,
+			wantErr:    aggregate.ErrCartNotApprover,
+			wantEvents: []string{},
+			wantStatus: aggregate.CartApprovalPending,
+		},
+		"approvers cannot approve their own request": {
+			pending:    true,
+			userID:     ownerID,
+			decision:   value.ApprovalDecisionApprove,
+			wantErr:    aggregate.ErrCartSelfApproval,
+			wantEvents: []string{},
+			wantStatus: aggregate.CartApprovalPending,
+		},
+		"rejects a cart without a pending request": {
+			userID:     approverID,
+			decision:   value.ApprovalDecisionApprove,
+			wantErr:    aggregate.ErrCartApprovalNotPending,
+			wantEvents: []string{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			cart := sharedCart(t, cartID, ownerID)
+			if tt.pending {
+				cart = pendingApprovalCart(t, cartID, ownerID, policy)
+			}
+
+			// Act
+			err := cart.ExecuteDecideCartApprovalCommand(command.DecideCartApprovalCommand{
+				CartID:         cartID,
+				UserID:         tt.userID,
+				Decision:       tt.decision,
+				Comment:        "checked against the budget",
+				ApprovalPolicy: policy,
+			})
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantEvents, eventTypes(cart.GetUncommittedEvents()))
+			assert.Equal(t, tt.wantStatus, cart.GetApprovalStatus())
+		})
+	}
+}
+
+func TestCartAggregate_PendingApprovalLocksCart(t *testing.T) {
+	cartID := uuid.New()
+	ownerID := uuid.New()
+	policy := approvalPolicy(t, 50, uuid.New())
+	percentOff, _ := value.NewPromotion("PERCENT_OFF", 10, 0, 0, 0)
+
+	tests := map[string]struct {
+		act     func(cart *aggregate.CartAggregate) error
+		wantErr error
+	}{
+		"adding an item": {
+			act: func(cart *aggregate.CartAggregate) error {
+				return cart.ExecuteAddItemToCartCommand(command.AddItemToCartCommand{
+					CartID: cartID, UserID: ownerID, ItemID: uuid.New(), Name: "Extra", Price: 10, TenantID: cart.GetTenantID(),
+				})
+			},
+			wantErr: aggregate.ErrCartApprovalPending,
+		},
+		"applying a coupon": {
+			act: func(cart *aggregate.CartAggregate) error {
+				return cart.ExecuteApplyCouponToCartCommand(command.ApplyCouponToCartCommand{
+					CartID: cartID, UserID: ownerID, CouponID: uuid.New(), Code: value.CouponCode("SUMMER10"), Promotion: percentOff,
+				})
+			},
+			wantErr: aggregate.ErrCartApprovalPending,
+		},
+		"submitting": {
+			act: func(cart *aggregate.CartAggregate) error {
+				return cart.ExecuteSubmitCartCommand(command.SubmitCartCommand{CartID: cartID, UserID: ownerID, ApprovalPolicy: policy})
+			},
+			wantErr: aggregate.ErrCartApprovalPending,
+		},
+		"inviting a member is still allowed": {
+			act: func(cart *aggregate.CartAggregate) error {
+				return cart.ExecuteInviteCartMemberCommand(command.InviteCartMemberCommand{CartID: cartID, UserID: ownerID, InviteeID: uuid.New()})
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			cart := pendingApprovalCart(t, cartID, ownerID, policy)
+
+			// Act
+			err := tt.act(cart)
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, cart.GetUncommittedEvents())
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCartAggregate_ExecuteSubmitCartCommand_Approval(t *testing.T) {
+	cartID := uuid.New()
+	ownerID := uuid.New()
+	approverID := uuid.New()
+	policy := approvalPolicy(t, 500, approverID)
+
+	approve := func(t *testing.T, cart *aggregate.CartAggregate) {
+		assert.NoError(t, cart.ExecuteRequestCartApprovalCommand(command.RequestCartApprovalCommand{
+			CartID: cartID, UserID: ownerID, ApprovalPolicy: policy,
+		}))
+		assert.NoError(t, cart.ExecuteDecideCartApprovalCommand(command.DecideCartApprovalCommand{
+			CartID: cartID, UserID: approverID, Decision: value.ApprovalDecisionApprove, ApprovalPolicy: policy,
+		}))
+	}
+
+	tests := map[string]struct {
+		fee     float64
+		arrange func(t *testing.T, cart *aggregate.CartAggregate)
+		wantErr error
+	}{
+		"cart below the threshold needs no approval": {
+			fee:     300,
+			arrange: func(t *testing.T, cart *aggregate.CartAggregate) {},
+		},
+		"shipping counts towards the threshold": {
+			fee:     800,
+			arrange: func(t *testing.T, cart *aggregate.CartAggregate) {},
+			wantErr: aggregate.ErrCartApprovalRequired,
+		},
+		"approved cart is submitted": {
+			fee:     800,
+			arrange: approve,
+		},
+		"changing an approved cart voids the approval": {
+			fee: 800,
+			arrange: func(t *testing.T, cart *aggregate.CartAggregate) {
+				approve(t, cart)
+				assert.NoError(t, cart.ExecuteAddItemToCartCommand(command.AddItemToCartCommand{
+					CartID: cartID, UserID: ownerID, ItemID: uuid.New(), Name: "Extra", Price: 10, TenantID: cart.GetTenantID(),
+				}))
+			},
+			wantErr: aggregate.ErrCartApprovalRequired,
+		},
+		"rejected cart cannot be submitted": {
+			fee: 800,
+			arrange: func(t *testing.T, cart *aggregate.CartAggregate) {
+				assert.NoError(t, cart.ExecuteRequestCartApprovalCommand(command.RequestCartApprovalCommand{
+					CartID: cartID, UserID: ownerID, ApprovalPolicy: policy,
+				}))
+				assert.NoError(t, cart.ExecuteDecideCartApprovalCommand(command.DecideCartApprovalCommand{
+					CartID: cartID, UserID: approverID, Decision: value.ApprovalDecisionReject, ApprovalPolicy: policy,
+				}))
+			},
+			wantErr: aggregate.ErrCartApprovalRequired,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			cart := sharedCart(t, cartID, ownerID)
+			rates := shipToTokyo(t, cart, cartID, tt.fee)
+			tt.arrange(t, cart)
+			cart.MarkEventsAsCommitted()
+
+			// Act
+			err := cart.ExecuteSubmitCartCommand(command.SubmitCartCommand{
+				CartID:         cartID,
+				UserID:         ownerID,
+				ShippingRates:  rates,
+				ApprovalPolicy: policy,
+			})
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, cart.GetUncommittedEvents())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"CartSubmittedEvent"}, eventTypes(cart.GetUncommittedEvents()))
+		})
+	}
+}
+
+func TestCartAggregate_ExecuteExpireCartApprovalCommand(t *testing.T) {
+	cartID := uuid.New()
+	ownerID := uuid.New()
+	approverID := uuid.New()
+	policy := approvalPolicy(t, 50, approverID)
+
+	tests := map[string]struct {
+		decide       bool
+		versionDelta int
+		wantEvents   []string
+		wantStatus   aggregate.CartApprovalStatus
+	}{
+		"lapses an undecided request": {
+			wantEvents: []string{"CartApprovalExpiredEvent"},
+			wantStatus: aggregate.CartApprovalExpired,
+		},
+		"ignores a check for an earlier request": {
+			versionDelta: -1,
+			wantEvents:   []string{},
+			wantStatus:   aggregate.CartApprovalPending,
+		},
+		"ignores a decided request": {
+			decide:     true,
+			wantEvents: []string{},
+			wantStatus: aggregate.CartApprovalApproved,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			cart := pendingApprovalCart(t, cartID, ownerID, policy)
+			requestedAt := cart.GetVersion()
+			if tt.decide {
+				assert.NoError(t, cart.ExecuteDecideCartApprovalCommand(command.DecideCartApprovalCommand{
+					CartID: cartID, UserID: approverID, Decision: value.ApprovalDecisionApprove, ApprovalPolicy: policy,
+				}))
+				cart.MarkEventsAsCommitted()
+			}
+
+			// Act
+			err := cart.ExecuteExpireCartApprovalCommand(command.ExpireCartApprovalCommand{
+				CartID:             cartID,
+				RequestedAtVersion: requestedAt + tt.versionDelta,
+			})
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantEvents, eventTypes(cart.GetUncommittedEvents()))
+			assert.Equal(t, tt.wantStatus, cart.GetApprovalStatus())
+		})
+	}
+}
+
+func TestCartAggregate_HydrationWithApproval(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	cartID := uuid.New()
+	ownerID := uuid.New()
+	approverID := uuid.New()
+	tenantID := uuid.New()
+	history := []event.Event{
+		event.NewCartCreatedEvent(cartID, 1, ownerID, tenantID, ""),
+		event.NewItemAddedToCartEvent(cartID, 2, uuid.New(), "Desk", 100.0, tenantID, "STANDARD", 0, ownerID, nil, ""),
+		event.NewCartApprovalRequestedEvent(cartID, 3, tenantID, ownerID, 100.0, time.Now().Add(48*time.Hour)),
+		event.NewCartChangesRequestedEvent(cartID, 4, approverID, "pick the cheaper desk"),
+		event.NewCartApprovalRequestedEvent(cartID, 5, tenantID, ownerID, 100.0, time.Now().Add(48*time.Hour)),
+	}
+
+	cart := aggregate.NewCartAggregate()
+
+	// Act
+	err := cart.Hydration(history)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 5, cart.GetVersion())
+	assert.Equal(t, aggregate.CartApprovalPending, cart.GetApprovalStatus())
+	assert.ErrorIs(t, cart.ExecuteDecideCartApprovalCommand(command.DecideCartApprovalCommand{
+		CartID: cartID, UserID: ownerID, Decision: value.ApprovalDecisionApprove, ApprovalPolicy: approvalPolicy(t, 50, approverID, ownerID),
+	}), aggregate.ErrCartSelfApproval)
+	assert.NoError(t, cart.ExecuteExpireCartApprovalCommand(command.ExpireCartApprovalCommand{CartID: cartID, RequestedAtVersion: 5}))
+	assert.Equal(t, aggregate.CartApprovalExpired, cart.GetApprovalStatus())
+}
+
+func cartRules(t *testing.T, maxLines, maxQuantityPerItem int, minimumOrderValue float64, blocked ...[2]string) value.CartRules {
+	t.Helper()
+
+	combinations := make([]value.CategoryCombination, 0, len(blocked))
+	for _, pair := range blocked {
+		combination, err := value.NewCategoryCombination(pair[0], pair[1])
+		assert.NoError(t, err)
+		combinations = append(combinations, combination)
+	}
+	rules, err := value.NewCartRules(maxLines, maxQuantityPerItem, minimumOrderValue, combinations)
+	assert.NoError(t, err)
+
+	return rules
+}
+
+func TestCartAggregate_ExecuteAddItemToCartCommand_CartRules(t *testing.T) {
+	cartID := uuid.New()
+	ownerID := uuid.New()
+	itemID := uuid.New()
+
+	tests := map[string]struct {
+		rules     value.CartRules
+		itemID    uuid.UUID
+		category  string
+		wantCodes []string
+	}{
+		"item within the rules": {
+			rules:    cartRules(t, 3, 2, 0),
+			itemID:   uuid.New(),
+			category: "books",
+		},
+		"one line too many": {
+			rules:     cartRules(t, 2, 0, 0),
+			itemID:    uuid.New(),
+			wantCodes: []string{"MAX_LINES"},
+		},
+		"one unit too many": {
+			rules:     cartRules(t, 0, 1, 0),
+			itemID:    itemID,
+			wantCodes: []string{"MAX_QUANTITY_PER_ITEM"},
+		},
+		"blocked category combination": {
+			rules:     cartRules(t, 0, 0, 0, [2]string{"alcohol", "toys"}),
+			itemID:    uuid.New(),
+			category:  "toys",
+			wantCodes: []string{"BLOCKED_CATEGORY_COMBINATION"},
+		},
+		"minimum order value does not stop adding items": {
+			rules:  cartRules(t, 0, 0, 100000),
+			itemID: uuid.New(),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			cart := aggregate.NewCartAggregate()
+			tenantID := uuid.New()
+			assert.NoError(t, cart.ExecuteAddItemToCartCommand(command.AddItemToCartCommand{
+				CartID: cartID, UserID: ownerID, ItemID: itemID, Name: "Wine", Price: 3000, TenantID: tenantID, Category: "alcohol",
+			}))
+			assert.NoError(t, cart.ExecuteAddItemToCartCommand(command.AddItemToCartCommand{
+				CartID: cartID, UserID: ownerID, ItemID: uuid.New(), Name: "Pen", Price: 200, TenantID: tenantID,
+			}))
+			cart.MarkEventsAsCommitted()
+
+			// Act
+			err := cart.ExecuteAddItemToCartCommand(command.AddItemToCartCommand{
+				CartID:    cartID,
+				UserID:    ownerID,
+				ItemID:    tt.itemID,
+				Name:      "New Item",
+				Price:     500,
+				TenantID:  tenantID,
+				Category:  tt.category,
+				CartRules: tt.rules,
+			})
+
+			// Assert
+			if tt.wantCodes != nil {
+				assert.True(t, errors.IsCode(err, errors.InvalidParameter))
+				codes := make([]string, 0)
+				for _, detail := range errors.DetailsOf(err) {
+					codes = append(codes, detail.Code)
+				}
+				assert.Equal(t, tt.wantCodes, codes)
+				assert.Empty(t, cart.GetUncommittedEvents())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"ItemAddedToCartEvent"}, eventTypes(cart.GetUncommittedEvents()))
+		})
+	}
+}
+
+func TestCartAggregate_ResolveItemsAdded(t *testing.T) {
+	cartID := uuid.New()
+	ownerID := uuid.New()
+	tenantID := uuid.New()
+	ourItemID := uuid.New()
+
+	addItem := func(itemID uuid.UUID) func(cart *aggregate.CartAggregate) error {
+		return func(cart *aggregate.CartAggregate) error {
+			return cart.ExecuteAddItemToCartCommand(command.AddItemToCartCommand{
+				CartID: cartID, UserID: ownerID, ItemID: itemID, Name: "Item", Price: 100, TenantID: tenantID,
+			})
+		}
+	}
+
+	percentOff, _ := value.NewPromotion("PERCENT_OFF", 10, 0, 0, 0)
+	applyCoupon := func(couponID uuid.UUID, code value.CouponCode, automatic bool) func(cart *aggregate.CartAggregate) error {
+		return func(cart *aggregate.CartAggregate) error {
+			return cart.ExecuteApplyCouponToCartCommand(command.ApplyCouponToCartCommand{
+				CartID: cartID, UserID: ownerID, CouponID: couponID, Code: code, Promotion: percentOff, Automatic: automatic,
+			})
+		}
+	}
+	withPromotion := func(add, apply func(cart *aggregate.CartAggregate) error) func(cart *aggregate.CartAggregate) error {
+		return func(cart *aggregate.CartAggregate) error {
+			if err := add(cart); err != nil {
+				return err
+			}
+			return apply(cart)
+		}
+	}
+	promotionID := uuid.New()
+	autoPromotion := applyCoupon(promotionID, value.CouponCode("AUTO10"), true)
+
+	tests := map[string]struct {
+		newCart     bool
+		ours        func(cart *aggregate.CartAggregate) error
+		theirs      func(cart *aggregate.CartAggregate) error
+		rules       value.CartRules
+		wantOK      bool
+		wantVersion int
+		wantEvents  []string
+		wantCoupons int
+	}{
+		"another item added concurrently": {
+			theirs:      addItem(uuid.New()),
+			wantOK:      true,
+			wantVersion: 4,
+			wantEvents:  []string{"ItemAddedToCartEvent"},
+		},
+		"an automatic promotion applied along with ours": {
+			ours:        withPromotion(addItem(ourItemID), autoPromotion),
+			theirs:      addItem(uuid.New()),
+			wantOK:      true,
+			wantVersion: 5,
+			wantEvents:  []string{"ItemAddedToCartEvent", "CouponAppliedToCartEvent"},
+			wantCoupons: 1,
+		},
+		"the same automatic promotion applied along with theirs": {
+			ours:        withPromotion(addItem(ourItemID), autoPromotion),
+			theirs:      withPromotion(addItem(uuid.New()), autoPromotion),
+			wantOK:      true,
+			wantVersion: 5,
+			wantEvents:  []string{"ItemAddedToCartEvent"},
+			wantCoupons: 1,
+		},
+		"a coupon applied by hand concurrently": {
+			ours:        withPromotion(addItem(ourItemID), autoPromotion),
+			theirs:      applyCoupon(uuid.New(), value.CouponCode("SAVE10"), false),
+			wantVersion: 3,
+		},
+		"the same item added concurrently": {
+			theirs:      addItem(ourItemID),
+			wantVersion: 3,
+		},
+		"another kind of change made concurrently": {
+			theirs: func(cart *aggregate.CartAggregate) error {
+				return cart.ExecuteInviteCartMemberCommand(command.InviteCartMemberCommand{CartID: cartID, UserID: ownerID, InviteeID: uuid.New()})
+			},
+			wantVersion: 3,
+		},
+		"both items together break the rules": {
+			theirs:      addItem(uuid.New()),
+			rules:       cartRules(t, 2, 0, 0),
+			wantVersion: 3,
+		},
+		"both created the cart": {
+			newCart:     true,
+			theirs:      addItem(uuid.New()),
+			wantVersion: 2,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			var base []event.Event
+			if !tt.newCart {
+				cart := aggregate.NewCartAggregate()
+				assert.NoError(t, addItem(uuid.New())(cart))
+				base = cart.GetUncommittedEvents()
+			}
+			hydrate := func(events []event.Event) *aggregate.CartAggregate {
+				cart := aggregate.NewCartAggregate()
+				assert.NoError(t, cart.Hydration(events))
+				return cart
+			}
+
+			ours := tt.ours
+			if ours == nil {
+				ours = addItem(ourItemID)
+			}
+			ourCart := hydrate(base)
+			assert.NoError(t, ours(ourCart))
+			ourEvents := ourCart.GetUncommittedEvents()
+
+			theirCart := hydrate(base)
+			assert.NoError(t, tt.theirs(theirCart))
+			theirs := theirCart.GetUncommittedEvents()
+
+			current := hydrate(append(append([]event.Event{}, base...), theirs...))
+
+			// Act
+			ok := current.ResolveItemsAdded(theirs, ourEvents, tt.rules)
+
+			// Assert
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantVersion, current.GetVersion())
+			if !tt.wantOK {
+				assert.Empty(t, current.GetUncommittedEvents())
+				return
+			}
+			resolved := current.GetUncommittedEvents()
+			assert.Equal(t, tt.wantEvents, eventTypes(resolved))
+			assert.Equal(t, tt.wantVersion, resolved[len(resolved)-1].GetVersion())
+			assert.Len(t, current.GetItems(), 3)
+			assert.Len(t, current.GetCoupons(), tt.wantCoupons)
+		})
+	}
+}
+
+func TestCartAggregate_ExecuteSubmitCartCommand_CartRules(t *testing.T) {
+	cartID := uuid.New()
+	ownerID := uuid.New()
+
+	tests := map[string]struct {
+		rules     value.CartRules
+		wantCodes []string
+	}{
+		"cart meets the minimum order value": {
+			rules: cartRules(t, 0, 0, 100),
+		},
+		"cart below the minimum order value": {
+			rules:     cartRules(t, 0, 0, 1000),
+			wantCodes: []string{"MINIMUM_ORDER_VALUE"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			cart := sharedCart(t, cartID, ownerID)
+			rates := shipToTokyo(t, cart, cartID, 500)
+			cart.MarkEventsAsCommitted()
+
+			// Act
+			err := cart.ExecuteSubmitCartCommand(command.SubmitCartCommand{
+				CartID:        cartID,
+				UserID:        ownerID,
+				ShippingRates: rates,
+				CartRules:     tt.rules,
+			})
+
+			// Assert
+			if tt.wantCodes != nil {
+				assert.True(t, errors.IsCode(err, errors.InvalidParameter))
+				codes := make([]string, 0)
+				for _, detail := range errors.DetailsOf(err) {
+					codes = append(codes, detail.Code)
+				}
+				assert.Equal(t, tt.wantCodes, codes)
+				assert.Empty(t, cart.GetUncommittedEvents())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"CartSubmittedEvent"}, eventTypes(cart.GetUncommittedEvents()))
+		})
+	}
+}
+
+func TestCartAggregate_ExecuteMoveCartItemToSavedListCommand(t *testing.T) {
+	cartID := uuid.New()
+	ownerID := uuid.New()
+	memberID := uuid.New()
+	mugID := uuid.New()
+	blue, err := value.NewLineOption("color", "blue", 200)
+	assert.NoError(t, err)
+	options := lineOptions(t, blue)
+
+	tests := map[string]struct {
+		guest          bool
+		userID         uuid.UUID
+		lineID         uuid.UUID
+		alreadySaved   bool
+		wantErr        error
+		wantCartEvents []string
+		wantListEvents []string
+	}{
+		"member moves every unit of their line": {
+			userID:         memberID,
+			lineID:         options.LineID(mugID),
+			wantCartEvents: []string{"CartItemMovedToSavedListEvent"},
+			wantListEvents: []string{"SavedListCreatedEvent", "ItemSavedEvent"},
+		},
+		"rejects a line that is not in the cart": {
+			userID:         memberID,
+			lineID:         mugID,
+			wantErr:        aggregate.ErrItemNotFound,
+			wantCartEvents: []string{},
+			wantListEvents: []string{},
+		},
+		"rejects a user who is not a member": {
+			userID:         uuid.New(),
+			lineID:         options.LineID(mugID),
+			wantErr:        aggregate.ErrCartNotMember,
+			wantCartEvents: []string{},
+			wantListEvents: []string{},
+		},
+		"leaves the line when the item is already saved": {
+			userID:         memberID,
+			lineID:         options.LineID(mugID),
+			alreadySaved:   true,
+			wantErr:        aggregate.ErrItemAlreadySaved,
+			wantCartEvents: []string{},
+			wantListEvents: []string{},
+		},
+		"guest carts cannot save for later": {
+			guest:          true,
+			userID:         memberID,
+			lineID:         options.LineID(mugID),
+			wantErr:        aggregate.ErrCartGuestSave,
+			wantCartEvents: []string{},
+			wantListEvents: []string{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			cart := sharedCart(t, cartID, ownerID, memberID)
+			if tt.guest {
+				cart = guestCartWithItems(t, cartID, uuid.New(), 100)
+			}
+			for range 2 {
+				assert.NoError(t, cart.ExecuteAddItemToCartCommand(command.AddItemToCartCommand{
+					CartID:      cartID,
+					UserID:      memberID,
+					SessionID:   cart.GetSessionID(),
+					ItemID:      mugID,
+					Name:        "Mug",
+					Price:       500,
+					TenantID:    cart.GetTenantID(),
+					TaxCategory: "STANDARD",
+					Options:     options,
+					Category:    "kitchen",
+				}))
+			}
+			cart.MarkEventsAsCommitted()
+
+			list := aggregate.NewSavedListAggregate()
+			if tt.alreadySaved {
+				assert.NoError(t, list.ExecuteSaveItemCommand(command.SaveItemCommand{
+					TenantID: cart.GetTenantID(),
+					UserID:   memberID,
+					ItemID:   mugID,
+					Name:     "Mug",
+					Price:    500,
+				}))
+				list.MarkEventsAsCommitted()
+			}
+			itemsBefore := len(cart.GetItems())
+
+			// Act
+			err := cart.ExecuteMoveCartItemToSavedListCommand(command.MoveCartItemToSavedListCommand{
+				CartID: cartID,
+				UserID: tt.userID,
+				LineID: tt.lineID,
+			}, list)
+
+			// Assert
+			assert.Equal(t, tt.wantCartEvents, eventTypes(cart.GetUncommittedEvents()))
+			assert.Equal(t, tt.wantListEvents, eventTypes(list.GetUncommittedEvents()))
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Len(t, cart.GetItems(), itemsBefore)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, cart.GetItems(), 1)
+			assert.Equal(t, 100.0, cart.GetSubtotal().Float64())
+
+			saved := list.GetItems()
+			assert.Len(t, saved, 1)
+			assert.Equal(t, mugID, saved[0].GetItemID())
+			assert.Equal(t, 2, saved[0].GetQuantity())
+			assert.Equal(t, 500.0, saved[0].GetPrice().Float64())
+			assert.Equal(t, options, saved[0].GetOptions())
+			assert.Equal(t, "kitchen", saved[0].GetCategory())
+			assert.Equal(t, aggregate.SavedListIDForUser(cart.GetTenantID(), memberID), list.GetAggregateID())
+		})
+	}
+}
+
+func TestCartAggregate_HydrationWithMovedItem(t *testing.T) {
+	// Arrange
+	cartID := uuid.New()
+	ownerID := uuid.New()
+	cart := aggregate.NewCartAggregate()
+	for _, price := range []float64{100, 250} {
+		assert.NoError(t, cart.ExecuteAddItemToCartCommand(command.AddItemToCartCommand{
+			CartID:   cartID,
+			UserID:   ownerID,
+			ItemID:   uuid.New(),
+			Name:     "Test Item",
+			Price:    price,
+			TenantID: uuid.New(),
+		}))
+	}
+	assert.NoError(t, cart.ExecuteMoveCartItemToSavedListCommand(command.MoveCartItemToSavedListCommand{
+		CartID: cartID,
+		UserID: ownerID,
+		LineID: cart.GetItems()[1].GetLineID(),
+	}, aggregate.NewSavedListAggregate()))
+
+	// Act
+	hydrated := aggregate.NewCartAggregate()
+	err := hydrated.Hydration(cart.GetUncommittedEvents())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, hydrated.GetItems(), 1)
+	assert.Equal(t, 100.0, hydrated.GetSubtotal().Float64())
+	assert.Equal(t, cart.GetVersion(), hydrated.GetVersion())
+}
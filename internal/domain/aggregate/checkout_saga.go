@@ -0,0 +1,220 @@
+package aggregate
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+)
+
+var (
+	ErrCheckoutSagaAlreadyStarted = errors.UnpermittedOp.New("checkout saga already started")
+	ErrCheckoutSagaNotFound       = errors.NotFound.New("checkout saga not found")
+)
+
+// checkoutSagaNamespace derives one saga stream per cart so a redelivered
+// CartSubmittedEvent always lands on the same stream.
+var checkoutSagaNamespace = uuid.MustParse("6f1c2d4e-8a0b-4c3d-9e5f-7a8b9c0d1e2f")
+
+type CheckoutSagaStatus string
+
+const (
+	CheckoutSagaStatusStarted      CheckoutSagaStatus = "STARTED"
+	CheckoutSagaStatusCompensating CheckoutSagaStatus = "COMPENSATING"
+	CheckoutSagaStatusCompleted    CheckoutSagaStatus = "COMPLETED"
+	CheckoutSagaStatusAborted      CheckoutSagaStatus = "ABORTED"
+)
+
+func CheckoutSagaIDForCart(cartID uuid.UUID) uuid.UUID {
+	return uuid.NewSHA1(checkoutSagaNamespace, cartID[:])
+}
+
+type CheckoutSagaAggregate struct {
+	sagaID         uuid.UUID
+	cartID         uuid.UUID
+	totalAmount    float64
+	status         CheckoutSagaStatus
+	currentStep    value.CheckoutStep
+	pendingVersion int
+	completedSteps []value.CheckoutStep
+	failureReason  string
+	version        int
+	uncommitted    []event.Event
+}
+
+func NewCheckoutSagaAggregate() *CheckoutSagaAggregate {
+	return &CheckoutSagaAggregate{
+		completedSteps: make([]value.CheckoutStep, 0),
+		version:        -1,
+		uncommitted:    make([]event.Event, 0),
+	}
+}
+
+func (a *CheckoutSagaAggregate) GetAggregateID() uuid.UUID               { return a.sagaID }
+func (a *CheckoutSagaAggregate) GetVersion() int                         { return a.version }
+func (a *CheckoutSagaAggregate) GetCartID() uuid.UUID                    { return a.cartID }
+func (a *CheckoutSagaAggregate) GetStatus() CheckoutSagaStatus           { return a.status }
+func (a *CheckoutSagaAggregate) GetCurrentStep() value.CheckoutStep      { return a.currentStep }
+func (a *CheckoutSagaAggregate) GetCompletedSteps() []value.CheckoutStep { return a.completedSteps }
+func (a *CheckoutSagaAggregate) GetFailureReason() string                { return a.failureReason }
+
+func (a *CheckoutSagaAggregate) GetUncommittedEvents() []event.Event {
+	return a.uncommitted
+}
+
+func (a *CheckoutSagaAggregate) MarkEventsAsCommitted() {
+	a.uncommitted = nil
+}
+
+func (a *CheckoutSagaAggregate) Hydration(events []event.Event) error {
+	for _, ev := range events {
+		a.apply(ev)
+	}
+	return nil
+}
+
+func (a *CheckoutSagaAggregate) apply(ev event.Event) {
+	switch e := ev.(type) {
+	case *event.CheckoutSagaStartedEvent:
+		a.sagaID = e.GetAggregateID()
+		a.cartID = e.GetCartID()
+		a.totalAmount = e.GetTotalAmount()
+		a.status = CheckoutSagaStatusStarted
+	case *event.CheckoutStepStartedEvent:
+		a.currentStep = e.GetStep()
+		a.pendingVersion = e.GetVersion()
+	case *event.CheckoutStepCompletedEvent:
+		a.completedSteps = append(a.completedSteps, e.GetStep())
+	case *event.CheckoutStepFailedEvent:
+		a.failureReason = e.GetReason()
+		a.status = CheckoutSagaStatusCompensating
+	case *event.CheckoutCompensationStartedEvent:
+		a.currentStep = e.GetStep()
+		a.pendingVersion = e.GetVersion()
+		a.status = CheckoutSagaStatusCompensating
+	case *event.CheckoutStepCompensatedEvent:
+		if n := len(a.completedSteps); n > 0 && a.completedSteps[n-1] == e.GetStep() {
+			a.completedSteps = a.completedSteps[:n-1]
+		}
+	case *event.CheckoutSagaCompletedEvent:
+		a.currentStep = ""
+		a.status = CheckoutSagaStatusCompleted
+	case *event.CheckoutSagaAbortedEvent:
+		a.currentStep = ""
+		a.failureReason = e.GetReason()
+		a.status = CheckoutSagaStatusAborted
+	default:
+		return
+	}
+	a.version = ev.GetVersion()
+}
+
+func (a *CheckoutSagaAggregate) raise(ev event.Event) {
+	a.apply(ev)
+	a.uncommitted = append(a.uncommitted, ev)
+}
+
+func (a *CheckoutSagaAggregate) isAwaiting(status CheckoutSagaStatus, step value.CheckoutStep) bool {
+	return a.status == status && a.currentStep == step
+}
+
+func (a *CheckoutSagaAggregate) ExecuteStartCheckoutCommand(cmd command.StartCheckoutCommand) error {
+	if a.version != -1 {
+		return ErrCheckoutSagaAlreadyStarted
+	}
+
+	sagaID := CheckoutSagaIDForCart(cmd.CartID)
+	a.raise(event.NewCheckoutSagaStartedEvent(sagaID, 1, cmd.CartID, cmd.TotalAmount))
+	a.raise(event.NewCheckoutStepStartedEvent(sagaID, a.version+1, a.cartID, value.FirstCheckoutStep()))
+
+	return nil
+}
+
+func (a *CheckoutSagaAggregate) ExecuteCompleteCheckoutStepCommand(cmd command.CompleteCheckoutStepCommand) error {
+	if a.version == -1 {
+		return ErrCheckoutSagaNotFound
+	}
+
+	// Replies for a step that is no longer pending are redeliveries or
+	// arrive after a timeout; either way they must not move the saga.
+	if !a.isAwaiting(CheckoutSagaStatusStarted, cmd.Step) {
+		return nil
+	}
+
+	a.raise(event.NewCheckoutStepCompletedEvent(a.sagaID, a.version+1, cmd.Step, cmd.Reference))
+
+	if next, ok := cmd.Step.Next(); ok {
+		a.raise(event.NewCheckoutStepStartedEvent(a.sagaID, a.version+1, a.cartID, next))
+		return nil
+	}
+
+	a.raise(event.NewCheckoutSagaCompletedEvent(a.sagaID, a.version+1, a.cartID))
+	return nil
+}
+
+func (a *CheckoutSagaAggregate) ExecuteFailCheckoutStepCommand(cmd command.FailCheckoutStepCommand) error {
+	if a.version == -1 {
+		return ErrCheckoutSagaNotFound
+	}
+
+	if !a.isAwaiting(CheckoutSagaStatusStarted, cmd.Step) {
+		return nil
+	}
+
+	a.failCurrentStep(cmd.Reason)
+	return nil
+}
+
+func (a *CheckoutSagaAggregate) ExecuteConfirmCheckoutCompensationCommand(cmd command.ConfirmCheckoutCompensationCommand) error {
+	if a.version == -1 {
+		return ErrCheckoutSagaNotFound
+	}
+
+	if !a.isAwaiting(CheckoutSagaStatusCompensating, cmd.Step) {
+		return nil
+	}
+
+	a.raise(event.NewCheckoutStepCompensatedEvent(a.sagaID, a.version+1, cmd.Step))
+	a.compensateNext()
+	return nil
+}
+
+func (a *CheckoutSagaAggregate) ExecuteTimeoutCheckoutStepCommand(cmd command.TimeoutCheckoutStepCommand) error {
+	if a.version == -1 {
+		return ErrCheckoutSagaNotFound
+	}
+
+	if a.currentStep != cmd.Step || a.pendingVersion != cmd.StartedVersion {
+		return nil
+	}
+
+	switch a.status {
+	case CheckoutSagaStatusStarted:
+		a.failCurrentStep(fmt.Sprintf("%s timed out", cmd.Step))
+	case CheckoutSagaStatusCompensating:
+		// Compensations have to succeed eventually, so a timed out one is
+		// requested again rather than given up on.
+		a.raise(event.NewCheckoutCompensationStartedEvent(a.sagaID, a.version+1, a.cartID, cmd.Step))
+	}
+
+	return nil
+}
+
+func (a *CheckoutSagaAggregate) failCurrentStep(reason string) {
+	a.raise(event.NewCheckoutStepFailedEvent(a.sagaID, a.version+1, a.currentStep, reason))
+	a.compensateNext()
+}
+
+// compensateNext undoes completed steps in reverse order and aborts the saga
+// once nothing is left to compensate.
+func (a *CheckoutSagaAggregate) compensateNext() {
+	if n := len(a.completedSteps); n > 0 {
+		a.raise(event.NewCheckoutCompensationStartedEvent(a.sagaID, a.version+1, a.cartID, a.completedSteps[n-1]))
+		return
+	}
+
+	a.raise(event.NewCheckoutSagaAbortedEvent(a.sagaID, a.version+1, a.cartID, a.failureReason))
+}
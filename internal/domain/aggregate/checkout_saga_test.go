@@ -0,0 +1,309 @@
+package aggregate_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+func startedCheckoutSaga(t *testing.T, cartID uuid.UUID, completed ...value.CheckoutStep) *aggregate.CheckoutSagaAggregate {
+	t.Helper()
+
+	saga := aggregate.NewCheckoutSagaAggregate()
+	err := saga.ExecuteStartCheckoutCommand(command.StartCheckoutCommand{CartID: cartID, TotalAmount: 100.0})
+	assert.NoError(t, err)
+
+	for _, step := range completed {
+		err := saga.ExecuteCompleteCheckoutStepCommand(command.CompleteCheckoutStepCommand{
+			SagaID: saga.GetAggregateID(),
+			Step:   step,
+		})
+		assert.NoError(t, err)
+	}
+	saga.MarkEventsAsCommitted()
+
+	return saga
+}
+
+func eventTypes(events []event.Event) []string {
+	types := make([]string, 0, len(events))
+	for _, ev := range events {
+		types = append(types, ev.GetEventType())
+	}
+	return types
+}
+
+func TestCheckoutSagaAggregate_ExecuteStartCheckoutCommand(t *testing.T) {
+	cartID := uuid.New()
+
+	tests := map[string]struct {
+		alreadyStarted bool
+		wantErr        error
+		wantEvents     []string
+		wantVersion    int
+	}{
+		"should start saga with first step": {
+			wantEvents:  []string{"CheckoutSagaStartedEvent", "CheckoutStepStartedEvent"},
+			wantVersion: 2,
+		},
+		"should return error when saga already started": {
+			alreadyStarted: true,
+			wantErr:        aggregate.ErrCheckoutSagaAlreadyStarted,
+			wantEvents:     []string{},
+			wantVersion:    2,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			saga := aggregate.NewCheckoutSagaAggregate()
+			if tt.alreadyStarted {
+				saga = startedCheckoutSaga(t, cartID)
+			}
+
+			// Act
+			err := saga.ExecuteStartCheckoutCommand(command.StartCheckoutCommand{CartID: cartID, TotalAmount: 100.0})
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, aggregate.CheckoutSagaIDForCart(cartID), saga.GetAggregateID())
+				assert.Equal(t, value.CheckoutStepReserveInventory, saga.GetCurrentStep())
+			}
+			assert.Equal(t, tt.wantEvents, eventTypes(saga.GetUncommittedEvents()))
+			assert.Equal(t, tt.wantVersion, saga.GetVersion())
+		})
+	}
+}
+
+func TestCheckoutSagaAggregate_ExecuteCompleteCheckoutStepCommand(t *testing.T) {
+	cartID := uuid.New()
+
+	tests := map[string]struct {
+		completed  []value.CheckoutStep
+		step       value.CheckoutStep
+		wantEvents []string
+		wantStatus aggregate.CheckoutSagaStatus
+		wantStep   value.CheckoutStep
+	}{
+		"should move to next step": {
+			step:       value.CheckoutStepReserveInventory,
+			wantEvents: []string{"CheckoutStepCompletedEvent", "CheckoutStepStartedEvent"},
+			wantStatus: aggregate.CheckoutSagaStatusStarted,
+			wantStep:   value.CheckoutStepAuthorizePayment,
+		},
+		"should complete saga after last step": {
+			completed:  []value.CheckoutStep{value.CheckoutStepReserveInventory, value.CheckoutStepAuthorizePayment},
+			step:       value.CheckoutStepPlaceOrder,
+			wantEvents: []string{"CheckoutStepCompletedEvent", "CheckoutSagaCompletedEvent"},
+			wantStatus: aggregate.CheckoutSagaStatusCompleted,
+		},
+		"should ignore reply for step that is not pending": {
+			completed:  []value.CheckoutStep{value.CheckoutStepReserveInventory},
+			step:       value.CheckoutStepReserveInventory,
+			wantEvents: []string{},
+			wantStatus: aggregate.CheckoutSagaStatusStarted,
+			wantStep:   value.CheckoutStepAuthorizePayment,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			saga := startedCheckoutSaga(t, cartID, tt.completed...)
+
+			// Act
+			err := saga.ExecuteCompleteCheckoutStepCommand(command.CompleteCheckoutStepCommand{
+				SagaID: saga.GetAggregateID(),
+				Step:   tt.step,
+			})
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantEvents, eventTypes(saga.GetUncommittedEvents()))
+			assert.Equal(t, tt.wantStatus, saga.GetStatus())
+			assert.Equal(t, tt.wantStep, saga.GetCurrentStep())
+		})
+	}
+}
+
+func TestCheckoutSagaAggregate_ExecuteFailCheckoutStepCommand(t *testing.T) {
+	cartID := uuid.New()
+
+	tests := map[string]struct {
+		completed  []value.CheckoutStep
+		step       value.CheckoutStep
+		wantEvents []string
+		wantStatus aggregate.CheckoutSagaStatus
+		wantStep   value.CheckoutStep
+	}{
+		"should abort when first step fails": {
+			step:       value.CheckoutStepReserveInventory,
+			wantEvents: []string{"CheckoutStepFailedEvent", "CheckoutSagaAbortedEvent"},
+			wantStatus: aggregate.CheckoutSagaStatusAborted,
+		},
+		"should compensate last completed step": {
+			completed:  []value.CheckoutStep{value.CheckoutStepReserveInventory, value.CheckoutStepAuthorizePayment},
+			step:       value.CheckoutStepPlaceOrder,
+			wantEvents: []string{"CheckoutStepFailedEvent", "CheckoutCompensationStartedEvent"},
+			wantStatus: aggregate.CheckoutSagaStatusCompensating,
+			wantStep:   value.CheckoutStepAuthorizePayment,
+		},
+		"should ignore failure for step that is not pending": {
+			step:       value.CheckoutStepPlaceOrder,
+			wantEvents: []string{},
+			wantStatus: aggregate.CheckoutSagaStatusStarted,
+			wantStep:   value.CheckoutStepReserveInventory,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			saga := startedCheckoutSaga(t, cartID, tt.completed...)
+
+			// Act
+			err := saga.ExecuteFailCheckoutStepCommand(command.FailCheckoutStepCommand{
+				SagaID: saga.GetAggregateID(),
+				Step:   tt.step,
+				Reason: "out of stock",
+			})
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantEvents, eventTypes(saga.GetUncommittedEvents()))
+			assert.Equal(t, tt.wantStatus, saga.GetStatus())
+			assert.Equal(t, tt.wantStep, saga.GetCurrentStep())
+		})
+	}
+}
+
+func TestCheckoutSagaAggregate_ExecuteConfirmCheckoutCompensationCommand(t *testing.T) {
+	// Arrange
+	saga := startedCheckoutSaga(t, uuid.New(), value.CheckoutStepReserveInventory, value.CheckoutStepAuthorizePayment)
+	err := saga.ExecuteFailCheckoutStepCommand(command.FailCheckoutStepCommand{
+		SagaID: saga.GetAggregateID(),
+		Step:   value.CheckoutStepPlaceOrder,
+		Reason: "order service unavailable",
+	})
+	assert.NoError(t, err)
+	saga.MarkEventsAsCommitted()
+
+	// Act
+	err = saga.ExecuteConfirmCheckoutCompensationCommand(command.ConfirmCheckoutCompensationCommand{
+		SagaID: saga.GetAggregateID(),
+		Step:   value.CheckoutStepAuthorizePayment,
+	})
+	assert.NoError(t, err)
+	err = saga.ExecuteConfirmCheckoutCompensationCommand(command.ConfirmCheckoutCompensationCommand{
+		SagaID: saga.GetAggregateID(),
+		Step:   value.CheckoutStepReserveInventory,
+	})
+	assert.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, []string{
+		"CheckoutStepCompensatedEvent",
+		"CheckoutCompensationStartedEvent",
+		"CheckoutStepCompensatedEvent",
+		"CheckoutSagaAbortedEvent",
+	}, eventTypes(saga.GetUncommittedEvents()))
+	assert.Equal(t, aggregate.CheckoutSagaStatusAborted, saga.GetStatus())
+	assert.Equal(t, "order service unavailable", saga.GetFailureReason())
+	assert.Empty(t, saga.GetCompletedSteps())
+}
+
+func TestCheckoutSagaAggregate_ExecuteTimeoutCheckoutStepCommand(t *testing.T) {
+	cartID := uuid.New()
+
+	tests := map[string]struct {
+		completed      []value.CheckoutStep
+		step           value.CheckoutStep
+		startedVersion int
+		wantEvents     []string
+		wantStatus     aggregate.CheckoutSagaStatus
+	}{
+		"should fail pending step on timeout": {
+			completed:      []value.CheckoutStep{value.CheckoutStepReserveInventory},
+			step:           value.CheckoutStepAuthorizePayment,
+			startedVersion: 4,
+			wantEvents:     []string{"CheckoutStepFailedEvent", "CheckoutCompensationStartedEvent"},
+			wantStatus:     aggregate.CheckoutSagaStatusCompensating,
+		},
+		"should ignore timeout for earlier attempt": {
+			completed:      []value.CheckoutStep{value.CheckoutStepReserveInventory},
+			step:           value.CheckoutStepAuthorizePayment,
+			startedVersion: 2,
+			wantEvents:     []string{},
+			wantStatus:     aggregate.CheckoutSagaStatusStarted,
+		},
+		"should ignore timeout for completed step": {
+			completed:      []value.CheckoutStep{value.CheckoutStepReserveInventory},
+			step:           value.CheckoutStepReserveInventory,
+			startedVersion: 2,
+			wantEvents:     []string{},
+			wantStatus:     aggregate.CheckoutSagaStatusStarted,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			saga := startedCheckoutSaga(t, cartID, tt.completed...)
+
+			// Act
+			err := saga.ExecuteTimeoutCheckoutStepCommand(command.TimeoutCheckoutStepCommand{
+				SagaID:         saga.GetAggregateID(),
+				Step:           tt.step,
+				StartedVersion: tt.startedVersion,
+			})
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantEvents, eventTypes(saga.GetUncommittedEvents()))
+			assert.Equal(t, tt.wantStatus, saga.GetStatus())
+		})
+	}
+}
+
+func TestCheckoutSagaAggregate_Hydration(t *testing.T) {
+	cartID := uuid.New()
+	sagaID := aggregate.CheckoutSagaIDForCart(cartID)
+
+	// Arrange
+	saga := aggregate.NewCheckoutSagaAggregate()
+	events := []event.Event{
+		event.NewCheckoutSagaStartedEvent(sagaID, 1, cartID, 100.0),
+		event.NewCheckoutStepStartedEvent(sagaID, 2, cartID, value.CheckoutStepReserveInventory),
+		event.NewCheckoutStepCompletedEvent(sagaID, 3, value.CheckoutStepReserveInventory, "reservation-1"),
+		event.NewCheckoutStepStartedEvent(sagaID, 4, cartID, value.CheckoutStepAuthorizePayment),
+	}
+
+	// Act
+	err := saga.Hydration(events)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 4, saga.GetVersion())
+	assert.Equal(t, cartID, saga.GetCartID())
+	assert.Equal(t, value.CheckoutStepAuthorizePayment, saga.GetCurrentStep())
+	assert.Equal(t, []value.CheckoutStep{value.CheckoutStepReserveInventory}, saga.GetCompletedSteps())
+	assert.Len(t, saga.GetUncommittedEvents(), 0)
+}
@@ -0,0 +1,169 @@
+package aggregate
+
+import (
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+)
+
+var (
+	ErrCouponAlreadyExists      = errors.UnpermittedOp.New("coupon already exists")
+	ErrCouponNotFound           = errors.NotFound.New("coupon not found")
+	ErrCouponUsageLimitInvalid  = errors.InvalidParameter.New("usage limit must be greater than or equal to 0")
+	ErrCouponAutomaticLimited   = errors.InvalidParameter.New("automatic promotions cannot have a usage limit")
+	ErrCouponUsageLimitReached  = errors.UnpermittedOp.New("coupon usage limit reached")
+	ErrCouponAutomaticNotRedeem = errors.UnpermittedOp.New("automatic promotions cannot be redeemed with a code")
+)
+
+// couponNamespace derives the coupon stream from tenant and code, so each
+// code exists at most once per tenant and can be looked up without a read
+// model.
+var couponNamespace = uuid.MustParse("9b2d4f6a-1c3e-4a5b-8d7f-0e1a2b3c4d5f")
+
+func CouponIDForCode(tenantID uuid.UUID, code value.CouponCode) uuid.UUID {
+	return uuid.NewSHA1(couponNamespace, append(tenantID[:], code.String()...))
+}
+
+type CouponAggregate struct {
+	couponID    uuid.UUID
+	tenantID    uuid.UUID
+	code        value.CouponCode
+	promotion   value.Promotion
+	usageLimit  int
+	automatic   bool
+	redemptions map[uuid.UUID]struct{}
+	version     int
+	uncommitted []event.Event
+}
+
+func NewCouponAggregate() *CouponAggregate {
+	return &CouponAggregate{
+		redemptions: make(map[uuid.UUID]struct{}),
+		version:     -1,
+		uncommitted: make([]event.Event, 0),
+	}
+}
+
+func (a *CouponAggregate) GetAggregateID() uuid.UUID     { return a.couponID }
+func (a *CouponAggregate) GetVersion() int               { return a.version }
+func (a *CouponAggregate) GetTenantID() uuid.UUID        { return a.tenantID }
+func (a *CouponAggregate) GetCode() value.CouponCode     { return a.code }
+func (a *CouponAggregate) GetPromotion() value.Promotion { return a.promotion }
+func (a *CouponAggregate) GetUsageLimit() int            { return a.usageLimit }
+func (a *CouponAggregate) IsAutomatic() bool             { return a.automatic }
+func (a *CouponAggregate) GetRedemptionCount() int       { return len(a.redemptions) }
+
+func (a *CouponAggregate) GetUncommittedEvents() []event.Event {
+	return a.uncommitted
+}
+
+func (a *CouponAggregate) MarkEventsAsCommitted() {
+	a.uncommitted = nil
+}
+
+func (a *CouponAggregate) Hydration(events []event.Event) error {
+	for _, ev := range events {
+		if err := a.apply(ev); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *CouponAggregate) apply(ev event.Event) error {
+	switch e := ev.(type) {
+	case *event.CouponCreatedEvent:
+		promotion, err := value.NewPromotion(e.GetPromotionType(), e.GetValue(), e.GetBuyQuantity(), e.GetGetQuantity(), e.GetMinimumTotal())
+		if err != nil {
+			return err
+		}
+		a.couponID = e.GetAggregateID()
+		a.tenantID = e.GetTenantID()
+		a.code = value.CouponCode(e.GetCode())
+		a.promotion = promotion
+		a.usageLimit = e.GetUsageLimit()
+		a.automatic = e.GetAutomatic()
+	case *event.CouponRedeemedEvent:
+		a.redemptions[e.GetCartID()] = struct{}{}
+	case *event.CouponReleasedEvent:
+		delete(a.redemptions, e.GetCartID())
+	default:
+		return nil
+	}
+	a.version = ev.GetVersion()
+	return nil
+}
+
+func (a *CouponAggregate) raise(ev event.Event) error {
+	if err := a.apply(ev); err != nil {
+		return err
+	}
+	a.uncommitted = append(a.uncommitted, ev)
+	return nil
+}
+
+func (a *CouponAggregate) ExecuteCreateCouponCommand(cmd command.CreateCouponCommand) error {
+	if a.version != -1 {
+		return ErrCouponAlreadyExists
+	}
+
+	if cmd.UsageLimit < 0 {
+		return ErrCouponUsageLimitInvalid
+	}
+
+	if cmd.Automatic && cmd.UsageLimit > 0 {
+		return ErrCouponAutomaticLimited
+	}
+
+	promotion := cmd.Promotion
+	return a.raise(event.NewCouponCreatedEvent(
+		CouponIDForCode(cmd.TenantID, cmd.Code),
+		1,
+		cmd.TenantID,
+		cmd.Code.String(),
+		string(promotion.Type()),
+		promotion.Value(),
+		promotion.BuyQuantity(),
+		promotion.GetQuantity(),
+		promotion.MinimumTotal(),
+		cmd.UsageLimit,
+		cmd.Automatic,
+	))
+}
+
+// ExecuteRedeemCouponCommand reserves one use of the coupon for a cart.
+// Concurrent redemptions append at the same version, so all but one fail
+// with an optimistic lock error and re-check the limit on retry.
+func (a *CouponAggregate) ExecuteRedeemCouponCommand(cmd command.RedeemCouponCommand) error {
+	if a.version == -1 {
+		return ErrCouponNotFound
+	}
+
+	if a.automatic {
+		return ErrCouponAutomaticNotRedeem
+	}
+
+	if _, ok := a.redemptions[cmd.CartID]; ok {
+		return nil
+	}
+
+	if a.usageLimit > 0 && len(a.redemptions) >= a.usageLimit {
+		return ErrCouponUsageLimitReached
+	}
+
+	return a.raise(event.NewCouponRedeemedEvent(a.couponID, a.version+1, cmd.CartID))
+}
+
+func (a *CouponAggregate) ExecuteReleaseCouponCommand(cmd command.ReleaseCouponCommand) error {
+	if a.version == -1 {
+		return ErrCouponNotFound
+	}
+
+	if _, ok := a.redemptions[cmd.CartID]; !ok {
+		return nil
+	}
+
+	return a.raise(event.NewCouponReleasedEvent(a.couponID, a.version+1, cmd.CartID))
+}
@@ -0,0 +1,203 @@
+package aggregate_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+func createdCoupon(t *testing.T, tenantID uuid.UUID, usageLimit int, redeemedCarts ...uuid.UUID) *aggregate.CouponAggregate {
+	t.Helper()
+
+	code := value.CouponCode("SUMMER10")
+	couponID := aggregate.CouponIDForCode(tenantID, code)
+	events := []event.Event{
+		event.NewCouponCreatedEvent(couponID, 1, tenantID, code.String(), "PERCENT_OFF", 10, 0, 0, 0, usageLimit, false),
+	}
+	for i, cartID := range redeemedCarts {
+		events = append(events, event.NewCouponRedeemedEvent(couponID, i+2, cartID))
+	}
+
+	coupon := aggregate.NewCouponAggregate()
+	assert.NoError(t, coupon.Hydration(events))
+	return coupon
+}
+
+func TestCouponAggregate_ExecuteCreateCouponCommand(t *testing.T) {
+	tenantID := uuid.New()
+	promotion, _ := value.NewPromotion("PERCENT_OFF", 10, 0, 0, 0)
+
+	tests := map[string]struct {
+		exists     bool
+		usageLimit int
+		automatic  bool
+		wantErr    error
+		wantEvents []string
+	}{
+		"should create coupon": {
+			usageLimit: 100,
+			wantEvents: []string{"CouponCreatedEvent"},
+		},
+		"should create automatic promotion": {
+			automatic:  true,
+			wantEvents: []string{"CouponCreatedEvent"},
+		},
+		"should return error when coupon exists": {
+			exists:     true,
+			wantErr:    aggregate.ErrCouponAlreadyExists,
+			wantEvents: []string{},
+		},
+		"should return error for negative usage limit": {
+			usageLimit: -1,
+			wantErr:    aggregate.ErrCouponUsageLimitInvalid,
+			wantEvents: []string{},
+		},
+		"should return error for limited automatic promotion": {
+			usageLimit: 10,
+			automatic:  true,
+			wantErr:    aggregate.ErrCouponAutomaticLimited,
+			wantEvents: []string{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			coupon := aggregate.NewCouponAggregate()
+			if tt.exists {
+				coupon = createdCoupon(t, tenantID, 0)
+			}
+
+			// Act
+			err := coupon.ExecuteCreateCouponCommand(command.CreateCouponCommand{
+				TenantID:   tenantID,
+				Code:       value.CouponCode("SUMMER10"),
+				Promotion:  promotion,
+				UsageLimit: tt.usageLimit,
+				Automatic:  tt.automatic,
+			})
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, aggregate.CouponIDForCode(tenantID, "SUMMER10"), coupon.GetAggregateID())
+				assert.Equal(t, promotion, coupon.GetPromotion())
+			}
+			assert.Equal(t, tt.wantEvents, eventTypes(coupon.GetUncommittedEvents()))
+		})
+	}
+}
+
+func TestCouponAggregate_ExecuteRedeemCouponCommand(t *testing.T) {
+	tenantID := uuid.New()
+	cartID := uuid.New()
+
+	tests := map[string]struct {
+		usageLimit      int
+		redeemedCarts   []uuid.UUID
+		wantErr         error
+		wantEvents      []string
+		wantRedemptions int
+	}{
+		"should redeem unlimited coupon": {
+			redeemedCarts:   []uuid.UUID{uuid.New(), uuid.New()},
+			wantEvents:      []string{"CouponRedeemedEvent"},
+			wantRedemptions: 3,
+		},
+		"should redeem coupon below limit": {
+			usageLimit:      2,
+			redeemedCarts:   []uuid.UUID{uuid.New()},
+			wantEvents:      []string{"CouponRedeemedEvent"},
+			wantRedemptions: 2,
+		},
+		"should be idempotent for the same cart": {
+			usageLimit:      1,
+			redeemedCarts:   []uuid.UUID{cartID},
+			wantEvents:      []string{},
+			wantRedemptions: 1,
+		},
+		"should return error when usage limit reached": {
+			usageLimit:      1,
+			redeemedCarts:   []uuid.UUID{uuid.New()},
+			wantErr:         aggregate.ErrCouponUsageLimitReached,
+			wantEvents:      []string{},
+			wantRedemptions: 1,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			coupon := createdCoupon(t, tenantID, tt.usageLimit, tt.redeemedCarts...)
+
+			// Act
+			err := coupon.ExecuteRedeemCouponCommand(command.RedeemCouponCommand{
+				CouponID: coupon.GetAggregateID(),
+				CartID:   cartID,
+			})
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantEvents, eventTypes(coupon.GetUncommittedEvents()))
+			assert.Equal(t, tt.wantRedemptions, coupon.GetRedemptionCount())
+		})
+	}
+}
+
+func TestCouponAggregate_ExecuteReleaseCouponCommand(t *testing.T) {
+	tenantID := uuid.New()
+	cartID := uuid.New()
+
+	tests := map[string]struct {
+		redeemedCarts   []uuid.UUID
+		wantEvents      []string
+		wantRedemptions int
+	}{
+		"should release redeemed coupon": {
+			redeemedCarts:   []uuid.UUID{cartID},
+			wantEvents:      []string{"CouponReleasedEvent"},
+			wantRedemptions: 0,
+		},
+		"should ignore cart that did not redeem": {
+			redeemedCarts:   []uuid.UUID{uuid.New()},
+			wantEvents:      []string{},
+			wantRedemptions: 1,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			coupon := createdCoupon(t, tenantID, 1, tt.redeemedCarts...)
+
+			// Act
+			err := coupon.ExecuteReleaseCouponCommand(command.ReleaseCouponCommand{
+				CouponID: coupon.GetAggregateID(),
+				CartID:   cartID,
+			})
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantEvents, eventTypes(coupon.GetUncommittedEvents()))
+			assert.Equal(t, tt.wantRedemptions, coupon.GetRedemptionCount())
+		})
+	}
+}
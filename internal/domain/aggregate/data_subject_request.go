@@ -0,0 +1,173 @@
+package aggregate
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+)
+
+var (
+	ErrDataSubjectRequestAlreadyOpened = errors.UnpermittedOp.New("data subject request already opened")
+	ErrDataSubjectRequestNotFound      = errors.NotFound.New("data subject request not found")
+	ErrDataSubjectRequestKindInvalid   = errors.InvalidParameter.New("kind must be EXPORT or ERASE")
+	ErrDataSubjectRequired             = errors.InvalidParameter.New("subject_id is required")
+	ErrDataSubjectRequestStepInvalid   = errors.UnpermittedOp.New("step does not follow the data subject request's progress")
+)
+
+type DataSubjectRequestKind string
+
+const (
+	DataSubjectRequestKindExport DataSubjectRequestKind = "EXPORT"
+	DataSubjectRequestKindErase  DataSubjectRequestKind = "ERASE"
+)
+
+type DataSubjectRequestStatus string
+
+const (
+	DataSubjectRequestStatusOpen       DataSubjectRequestStatus = "OPEN"
+	DataSubjectRequestStatusAnonymised DataSubjectRequestStatus = "ANONYMISED"
+	DataSubjectRequestStatusCompleted  DataSubjectRequestStatus = "COMPLETED"
+)
+
+// DataSubjectRequestAggregate tracks one export or erasure of a data
+// subject. An export completes once its bundle is recorded. An erasure
+// first anonymises the read models and then erases the subject's key, and
+// each step is recorded as an event of its own.
+type DataSubjectRequestAggregate struct {
+	requestID   uuid.UUID
+	subjectID   uuid.UUID
+	kind        DataSubjectRequestKind
+	status      DataSubjectRequestStatus
+	bundle      string
+	cartCount   int
+	eventCount  int
+	openedAt    time.Time
+	completedAt time.Time
+	version     int
+	uncommitted []event.Event
+}
+
+func NewDataSubjectRequestAggregate() *DataSubjectRequestAggregate {
+	return &DataSubjectRequestAggregate{
+		version:     -1,
+		uncommitted: make([]event.Event, 0),
+	}
+}
+
+func (a *DataSubjectRequestAggregate) GetAggregateID() uuid.UUID           { return a.requestID }
+func (a *DataSubjectRequestAggregate) GetVersion() int                     { return a.version }
+func (a *DataSubjectRequestAggregate) GetSubjectID() uuid.UUID             { return a.subjectID }
+func (a *DataSubjectRequestAggregate) GetKind() DataSubjectRequestKind     { return a.kind }
+func (a *DataSubjectRequestAggregate) GetStatus() DataSubjectRequestStatus { return a.status }
+func (a *DataSubjectRequestAggregate) GetBundle() string                   { return a.bundle }
+func (a *DataSubjectRequestAggregate) GetCartCount() int                   { return a.cartCount }
+func (a *DataSubjectRequestAggregate) GetEventCount() int                  { return a.eventCount }
+func (a *DataSubjectRequestAggregate) GetOpenedAt() time.Time              { return a.openedAt }
+func (a *DataSubjectRequestAggregate) GetCompletedAt() time.Time           { return a.completedAt }
+
+func (a *DataSubjectRequestAggregate) GetUncommittedEvents() []event.Event {
+	return a.uncommitted
+}
+
+func (a *DataSubjectRequestAggregate) MarkEventsAsCommitted() {
+	a.uncommitted = nil
+}
+
+func (a *DataSubjectRequestAggregate) Hydration(events []event.Event) error {
+	for _, ev := range events {
+		a.apply(ev)
+	}
+	return nil
+}
+
+func (a *DataSubjectRequestAggregate) apply(ev event.Event) {
+	switch e := ev.(type) {
+	case *event.DataSubjectRequestOpenedEvent:
+		a.requestID = e.GetAggregateID()
+		a.subjectID = e.GetSubjectID()
+		a.kind = DataSubjectRequestKind(e.GetKind())
+		a.status = DataSubjectRequestStatusOpen
+		a.openedAt = e.GetTimestamp()
+	case *event.DataSubjectDataExportedEvent:
+		a.bundle = e.GetBundle()
+		a.cartCount = e.GetCartCount()
+		a.eventCount = e.GetEventCount()
+		a.status = DataSubjectRequestStatusCompleted
+		a.completedAt = e.GetTimestamp()
+	case *event.DataSubjectAnonymisedEvent:
+		a.cartCount = e.GetCartCount()
+		a.status = DataSubjectRequestStatusAnonymised
+	case *event.DataSubjectErasedEvent:
+		a.status = DataSubjectRequestStatusCompleted
+		a.completedAt = e.GetTimestamp()
+	default:
+		return
+	}
+	a.version = ev.GetVersion()
+}
+
+func (a *DataSubjectRequestAggregate) raise(ev event.Event) {
+	a.apply(ev)
+	a.uncommitted = append(a.uncommitted, ev)
+}
+
+func (a *DataSubjectRequestAggregate) isAt(kind DataSubjectRequestKind, status DataSubjectRequestStatus) bool {
+	return a.kind == kind && a.status == status
+}
+
+func (a *DataSubjectRequestAggregate) ExecuteOpenDataSubjectRequestCommand(cmd command.OpenDataSubjectRequestCommand) error {
+	if a.version != -1 {
+		return ErrDataSubjectRequestAlreadyOpened
+	}
+	if cmd.SubjectID == uuid.Nil {
+		return ErrDataSubjectRequired
+	}
+
+	switch DataSubjectRequestKind(cmd.Kind) {
+	case DataSubjectRequestKindExport, DataSubjectRequestKindErase:
+	default:
+		return ErrDataSubjectRequestKindInvalid
+	}
+
+	a.raise(event.NewDataSubjectRequestOpenedEvent(cmd.RequestID, 1, cmd.SubjectID, cmd.Kind))
+	return nil
+}
+
+func (a *DataSubjectRequestAggregate) ExecuteCompleteDataSubjectExportCommand(cmd command.CompleteDataSubjectExportCommand) error {
+	if a.version == -1 {
+		return ErrDataSubjectRequestNotFound
+	}
+	if !a.isAt(DataSubjectRequestKindExport, DataSubjectRequestStatusOpen) {
+		return ErrDataSubjectRequestStepInvalid
+	}
+
+	a.raise(event.NewDataSubjectDataExportedEvent(a.requestID, a.version+1, a.subjectID, cmd.Bundle, cmd.CartCount, cmd.EventCount))
+	return nil
+}
+
+func (a *DataSubjectRequestAggregate) ExecuteRecordDataSubjectAnonymisedCommand(cmd command.RecordDataSubjectAnonymisedCommand) error {
+	if a.version == -1 {
+		return ErrDataSubjectRequestNotFound
+	}
+	if !a.isAt(DataSubjectRequestKindErase, DataSubjectRequestStatusOpen) {
+		return ErrDataSubjectRequestStepInvalid
+	}
+
+	a.raise(event.NewDataSubjectAnonymisedEvent(a.requestID, a.version+1, a.subjectID, cmd.CartCount))
+	return nil
+}
+
+func (a *DataSubjectRequestAggregate) ExecuteRecordDataSubjectErasedCommand(cmd command.RecordDataSubjectErasedCommand) error {
+	if a.version == -1 {
+		return ErrDataSubjectRequestNotFound
+	}
+	if !a.isAt(DataSubjectRequestKindErase, DataSubjectRequestStatusAnonymised) {
+		return ErrDataSubjectRequestStepInvalid
+	}
+
+	a.raise(event.NewDataSubjectErasedEvent(a.requestID, a.version+1, a.subjectID))
+	return nil
+}
@@ -0,0 +1,198 @@
+package aggregate_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+)
+
+func openedDataSubjectRequest(t *testing.T, kind aggregate.DataSubjectRequestKind) *aggregate.DataSubjectRequestAggregate {
+	t.Helper()
+
+	request := aggregate.NewDataSubjectRequestAggregate()
+	err := request.ExecuteOpenDataSubjectRequestCommand(command.OpenDataSubjectRequestCommand{
+		RequestID: uuid.New(),
+		SubjectID: uuid.New(),
+		Kind:      string(kind),
+	})
+	assert.NoError(t, err)
+	request.MarkEventsAsCommitted()
+
+	return request
+}
+
+func TestDataSubjectRequestAggregate_ExecuteOpenDataSubjectRequestCommand(t *testing.T) {
+	tests := map[string]struct {
+		alreadyOpened bool
+		subjectID     uuid.UUID
+		kind          string
+		wantErr       error
+		wantEvents    []string
+	}{
+		"should open export request": {
+			subjectID:  uuid.New(),
+			kind:       "EXPORT",
+			wantEvents: []string{"DataSubjectRequestOpenedEvent"},
+		},
+		"should open erase request": {
+			subjectID:  uuid.New(),
+			kind:       "ERASE",
+			wantEvents: []string{"DataSubjectRequestOpenedEvent"},
+		},
+		"should return error for unknown kind": {
+			subjectID:  uuid.New(),
+			kind:       "RECTIFY",
+			wantErr:    aggregate.ErrDataSubjectRequestKindInvalid,
+			wantEvents: []string{},
+		},
+		"should return error without subject": {
+			subjectID:  uuid.Nil,
+			kind:       "EXPORT",
+			wantErr:    aggregate.ErrDataSubjectRequired,
+			wantEvents: []string{},
+		},
+		"should return error when already opened": {
+			alreadyOpened: true,
+			subjectID:     uuid.New(),
+			kind:          "EXPORT",
+			wantErr:       aggregate.ErrDataSubjectRequestAlreadyOpened,
+			wantEvents:    []string{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			request := aggregate.NewDataSubjectRequestAggregate()
+			if tt.alreadyOpened {
+				request = openedDataSubjectRequest(t, aggregate.DataSubjectRequestKindExport)
+			}
+
+			// Act
+			err := request.ExecuteOpenDataSubjectRequestCommand(command.OpenDataSubjectRequestCommand{
+				RequestID: uuid.New(),
+				SubjectID: tt.subjectID,
+				Kind:      tt.kind,
+			})
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, aggregate.DataSubjectRequestStatusOpen, request.GetStatus())
+			}
+			assert.Equal(t, tt.wantEvents, eventTypes(request.GetUncommittedEvents()))
+		})
+	}
+}
+
+func TestDataSubjectRequestAggregate_ExecuteCompleteDataSubjectExportCommand(t *testing.T) {
+	tests := map[string]struct {
+		kind       aggregate.DataSubjectRequestKind
+		wantErr    error
+		wantStatus aggregate.DataSubjectRequestStatus
+		wantEvents []string
+	}{
+		"should complete export with its bundle": {
+			kind:       aggregate.DataSubjectRequestKindExport,
+			wantStatus: aggregate.DataSubjectRequestStatusCompleted,
+			wantEvents: []string{"DataSubjectDataExportedEvent"},
+		},
+		"should return error for erase request": {
+			kind:       aggregate.DataSubjectRequestKindErase,
+			wantErr:    aggregate.ErrDataSubjectRequestStepInvalid,
+			wantStatus: aggregate.DataSubjectRequestStatusOpen,
+			wantEvents: []string{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			request := openedDataSubjectRequest(t, tt.kind)
+
+			// Act
+			err := request.ExecuteCompleteDataSubjectExportCommand(command.CompleteDataSubjectExportCommand{
+				Bundle:     `{"carts":[]}`,
+				CartCount:  1,
+				EventCount: 3,
+			})
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, `{"carts":[]}`, request.GetBundle())
+				assert.Equal(t, 3, request.GetEventCount())
+			}
+			assert.Equal(t, tt.wantStatus, request.GetStatus())
+			assert.Equal(t, tt.wantEvents, eventTypes(request.GetUncommittedEvents()))
+		})
+	}
+}
+
+func TestDataSubjectRequestAggregate_Erase(t *testing.T) {
+	tests := map[string]struct {
+		anonymised bool
+		erase      bool
+		wantErr    error
+		wantStatus aggregate.DataSubjectRequestStatus
+		wantEvents []string
+	}{
+		"should record anonymised read models": {
+			anonymised: true,
+			wantStatus: aggregate.DataSubjectRequestStatusAnonymised,
+			wantEvents: []string{"DataSubjectAnonymisedEvent"},
+		},
+		"should complete once the key is erased": {
+			anonymised: true,
+			erase:      true,
+			wantStatus: aggregate.DataSubjectRequestStatusCompleted,
+			wantEvents: []string{"DataSubjectAnonymisedEvent", "DataSubjectErasedEvent"},
+		},
+		"should return error when erasing before anonymising": {
+			erase:      true,
+			wantErr:    aggregate.ErrDataSubjectRequestStepInvalid,
+			wantStatus: aggregate.DataSubjectRequestStatusOpen,
+			wantEvents: []string{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			request := openedDataSubjectRequest(t, aggregate.DataSubjectRequestKindErase)
+
+			// Act
+			var err error
+			if tt.anonymised {
+				err = request.ExecuteRecordDataSubjectAnonymisedCommand(command.RecordDataSubjectAnonymisedCommand{CartCount: 2})
+			}
+			if err == nil && tt.erase {
+				err = request.ExecuteRecordDataSubjectErasedCommand(command.RecordDataSubjectErasedCommand{})
+			}
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 2, request.GetCartCount())
+			}
+			assert.Equal(t, tt.wantStatus, request.GetStatus())
+			assert.Equal(t, tt.wantEvents, eventTypes(request.GetUncommittedEvents()))
+		})
+	}
+}
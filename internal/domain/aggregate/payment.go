@@ -0,0 +1,256 @@
+package aggregate
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+)
+
+var (
+	ErrPaymentAmountInvalid   = errors.InvalidParameter.New("payment amount must be greater than 0")
+	ErrPaymentAmountMismatch  = errors.UnpermittedOp.New("payment amount does not match the existing authorization")
+	ErrPaymentAlreadyRefunded = errors.UnpermittedOp.New("payment already refunded")
+	ErrPaymentNotPending      = errors.UnpermittedOp.New("payment authorization is not pending")
+	ErrPaymentNotAuthorized   = errors.UnpermittedOp.New("payment is not authorized")
+	ErrPaymentNotCaptured     = errors.UnpermittedOp.New("payment is not captured")
+	ErrPaymentNotFound        = errors.NotFound.New("payment not found")
+)
+
+// paymentNamespace derives one payment stream per cart so a retried checkout
+// always resumes the same payment instead of charging again.
+var paymentNamespace = uuid.MustParse("0d3e5b7a-2c4f-4a1e-8b6d-9f0a1c2e3d4b")
+
+type PaymentStatus string
+
+const (
+	PaymentStatusRequested  PaymentStatus = "REQUESTED"
+	PaymentStatusAuthorized PaymentStatus = "AUTHORIZED"
+	PaymentStatusDeclined   PaymentStatus = "DECLINED"
+	// PaymentStatusCaptureRequested and PaymentStatusRefundRequested are
+	// held while the gateway is asked to move the money.
+	PaymentStatusCaptureRequested PaymentStatus = "CAPTURE_REQUESTED"
+	PaymentStatusCaptured         PaymentStatus = "CAPTURED"
+	PaymentStatusRefundRequested  PaymentStatus = "REFUND_REQUESTED"
+	PaymentStatusRefunded         PaymentStatus = "REFUNDED"
+)
+
+func PaymentIDForCart(cartID uuid.UUID) uuid.UUID {
+	return uuid.NewSHA1(paymentNamespace, cartID[:])
+}
+
+type PaymentAggregate struct {
+	paymentID       uuid.UUID
+	cartID          uuid.UUID
+	amount          float64
+	cardLast4       string
+	status          PaymentStatus
+	attempts        int
+	authorizationID string
+	declineReason   string
+	version         int
+	uncommitted     []event.Event
+}
+
+func NewPaymentAggregate() *PaymentAggregate {
+	return &PaymentAggregate{
+		version:     -1,
+		uncommitted: make([]event.Event, 0),
+	}
+}
+
+func (a *PaymentAggregate) GetAggregateID() uuid.UUID  { return a.paymentID }
+func (a *PaymentAggregate) GetVersion() int            { return a.version }
+func (a *PaymentAggregate) GetCartID() uuid.UUID       { return a.cartID }
+func (a *PaymentAggregate) GetAmount() float64         { return a.amount }
+func (a *PaymentAggregate) GetStatus() PaymentStatus   { return a.status }
+func (a *PaymentAggregate) GetAuthorizationID() string { return a.authorizationID }
+func (a *PaymentAggregate) GetDeclineReason() string   { return a.declineReason }
+
+// GetIdempotencyKey identifies the current authorization attempt at the
+// gateway. It is stable across retries of the same attempt and changes once a
+// declined payment is requested again.
+func (a *PaymentAggregate) GetIdempotencyKey() string {
+	return fmt.Sprintf("%s-%d", a.paymentID, a.attempts)
+}
+
+func (a *PaymentAggregate) GetUncommittedEvents() []event.Event {
+	return a.uncommitted
+}
+
+func (a *PaymentAggregate) MarkEventsAsCommitted() {
+	a.uncommitted = nil
+}
+
+func (a *PaymentAggregate) Hydration(events []event.Event) error {
+	for _, ev := range events {
+		a.apply(ev)
+	}
+	return nil
+}
+
+func (a *PaymentAggregate) apply(ev event.Event) {
+	switch e := ev.(type) {
+	case *event.PaymentAuthorizationRequestedEvent:
+		a.paymentID = e.GetAggregateID()
+		a.cartID = e.GetCartID()
+		a.amount = e.GetAmount()
+		a.cardLast4 = e.GetCardLast4()
+		a.status = PaymentStatusRequested
+		a.attempts++
+		a.declineReason = ""
+	case *event.PaymentAuthorizedEvent:
+		a.authorizationID = e.GetAuthorizationID()
+		a.status = PaymentStatusAuthorized
+	case *event.PaymentDeclinedEvent:
+		a.declineReason = e.GetReason()
+		a.status = PaymentStatusDeclined
+	case *event.PaymentCaptureRequestedEvent:
+		a.status = PaymentStatusCaptureRequested
+	case *event.PaymentCapturedEvent:
+		a.status = PaymentStatusCaptured
+	case *event.PaymentRefundRequestedEvent:
+		a.status = PaymentStatusRefundRequested
+	case *event.PaymentRefundedEvent:
+		a.status = PaymentStatusRefunded
+	default:
+		return
+	}
+	a.version = ev.GetVersion()
+}
+
+func (a *PaymentAggregate) raise(ev event.Event) {
+	a.apply(ev)
+	a.uncommitted = append(a.uncommitted, ev)
+}
+
+func (a *PaymentAggregate) ExecuteRequestPaymentAuthorizationCommand(cmd command.RequestPaymentAuthorizationCommand) error {
+	if cmd.Amount <= 0 {
+		return ErrPaymentAmountInvalid
+	}
+
+	switch a.status {
+	case PaymentStatusRequested, PaymentStatusAuthorized, PaymentStatusCaptureRequested, PaymentStatusCaptured:
+		// A retried checkout resumes the existing attempt instead of
+		// starting a second charge.
+		if a.amount != cmd.Amount {
+			return ErrPaymentAmountMismatch
+		}
+		return nil
+	case PaymentStatusRefundRequested, PaymentStatusRefunded:
+		return ErrPaymentAlreadyRefunded
+	}
+
+	version := a.version + 1
+	if a.version == -1 {
+		version = 1
+	}
+
+	paymentID := PaymentIDForCart(cmd.CartID)
+	a.raise(event.NewPaymentAuthorizationRequestedEvent(paymentID, version, cmd.CartID, cmd.Amount, cmd.CardNumber.Last4()))
+
+	return nil
+}
+
+func (a *PaymentAggregate) ExecuteAuthorizePaymentCommand(cmd command.AuthorizePaymentCommand) error {
+	if a.version == -1 {
+		return ErrPaymentNotFound
+	}
+
+	if a.status != PaymentStatusRequested {
+		if a.authorizationID == cmd.AuthorizationID {
+			return nil
+		}
+		return ErrPaymentNotPending
+	}
+
+	a.raise(event.NewPaymentAuthorizedEvent(a.paymentID, a.version+1, cmd.AuthorizationID, a.amount))
+	return nil
+}
+
+func (a *PaymentAggregate) ExecuteDeclinePaymentCommand(cmd command.DeclinePaymentCommand) error {
+	if a.version == -1 {
+		return ErrPaymentNotFound
+	}
+
+	if a.status == PaymentStatusDeclined {
+		return nil
+	}
+
+	if a.status != PaymentStatusRequested {
+		return ErrPaymentNotPending
+	}
+
+	a.raise(event.NewPaymentDeclinedEvent(a.paymentID, a.version+1, cmd.Reason))
+	return nil
+}
+
+// ExecuteRequestPaymentCaptureCommand records that an authorized payment is
+// about to be captured. Asking again while it is, or once it was, is a no-op.
+func (a *PaymentAggregate) ExecuteRequestPaymentCaptureCommand(cmd command.RequestPaymentCaptureCommand) error {
+	if a.version == -1 {
+		return ErrPaymentNotFound
+	}
+
+	switch a.status {
+	case PaymentStatusCaptureRequested, PaymentStatusCaptured, PaymentStatusRefundRequested, PaymentStatusRefunded:
+		return nil
+	case PaymentStatusAuthorized:
+		a.raise(event.NewPaymentCaptureRequestedEvent(a.paymentID, a.version+1, a.amount))
+		return nil
+	default:
+		return ErrPaymentNotAuthorized
+	}
+}
+
+func (a *PaymentAggregate) ExecuteCapturePaymentCommand(cmd command.CapturePaymentCommand) error {
+	if a.version == -1 {
+		return ErrPaymentNotFound
+	}
+
+	switch a.status {
+	case PaymentStatusCaptured, PaymentStatusRefundRequested, PaymentStatusRefunded:
+		return nil
+	case PaymentStatusAuthorized, PaymentStatusCaptureRequested:
+		a.raise(event.NewPaymentCapturedEvent(a.paymentID, a.version+1, a.amount))
+		return nil
+	default:
+		return ErrPaymentNotAuthorized
+	}
+}
+
+// ExecuteRequestPaymentRefundCommand records that a captured payment is
+// about to be refunded. Asking again while it is, or once it was, is a no-op.
+func (a *PaymentAggregate) ExecuteRequestPaymentRefundCommand(cmd command.RequestPaymentRefundCommand) error {
+	if a.version == -1 {
+		return ErrPaymentNotFound
+	}
+
+	switch a.status {
+	case PaymentStatusRefundRequested, PaymentStatusRefunded:
+		return nil
+	case PaymentStatusCaptured:
+		a.raise(event.NewPaymentRefundRequestedEvent(a.paymentID, a.version+1, a.amount))
+		return nil
+	default:
+		return ErrPaymentNotCaptured
+	}
+}
+
+func (a *PaymentAggregate) ExecuteRefundPaymentCommand(cmd command.RefundPaymentCommand) error {
+	if a.version == -1 {
+		return ErrPaymentNotFound
+	}
+
+	switch a.status {
+	case PaymentStatusRefunded:
+		return nil
+	case PaymentStatusCaptured, PaymentStatusRefundRequested:
+		a.raise(event.NewPaymentRefundedEvent(a.paymentID, a.version+1, a.amount))
+		return nil
+	default:
+		return ErrPaymentNotCaptured
+	}
+}
@@ -0,0 +1,416 @@
+package aggregate_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+func paymentWithStatus(t *testing.T, cartID uuid.UUID, status aggregate.PaymentStatus) *aggregate.PaymentAggregate {
+	t.Helper()
+
+	paymentID := aggregate.PaymentIDForCart(cartID)
+	events := []event.Event{
+		event.NewPaymentAuthorizationRequestedEvent(paymentID, 1, cartID, 100.0, "4242"),
+	}
+
+	switch status {
+	case aggregate.PaymentStatusRequested:
+	case aggregate.PaymentStatusDeclined:
+		events = append(events, event.NewPaymentDeclinedEvent(paymentID, 2, "card declined"))
+	default:
+		events = append(events, event.NewPaymentAuthorizedEvent(paymentID, 2, "auth-1", 100.0))
+	}
+
+	switch status {
+	case aggregate.PaymentStatusCaptureRequested:
+		events = append(events, event.NewPaymentCaptureRequestedEvent(paymentID, 3, 100.0))
+	case aggregate.PaymentStatusCaptured, aggregate.PaymentStatusRefundRequested, aggregate.PaymentStatusRefunded:
+		events = append(events, event.NewPaymentCapturedEvent(paymentID, 3, 100.0))
+	}
+
+	switch status {
+	case aggregate.PaymentStatusRefundRequested:
+		events = append(events, event.NewPaymentRefundRequestedEvent(paymentID, 4, 100.0))
+	case aggregate.PaymentStatusRefunded:
+		events = append(events, event.NewPaymentRefundedEvent(paymentID, 4, 100.0))
+	}
+
+	payment := aggregate.NewPaymentAggregate()
+	assert.NoError(t, payment.Hydration(events))
+	return payment
+}
+
+func TestPaymentAggregate_ExecuteRequestPaymentAuthorizationCommand(t *testing.T) {
+	cartID := uuid.New()
+
+	tests := map[string]struct {
+		status      aggregate.PaymentStatus
+		amount      float64
+		wantErr     error
+		wantEvents  []string
+		wantVersion int
+		wantKey     string
+	}{
+		"should request authorization for new payment": {
+			amount:      100.0,
+			wantEvents:  []string{"PaymentAuthorizationRequestedEvent"},
+			wantVersion: 1,
+			wantKey:     aggregate.PaymentIDForCart(cartID).String() + "-1",
+		},
+		"should request new attempt after decline": {
+			status:      aggregate.PaymentStatusDeclined,
+			amount:      100.0,
+			wantEvents:  []string{"PaymentAuthorizationRequestedEvent"},
+			wantVersion: 3,
+			wantKey:     aggregate.PaymentIDForCart(cartID).String() + "-2",
+		},
+		"should be idempotent when already authorized": {
+			status:      aggregate.PaymentStatusAuthorized,
+			amount:      100.0,
+			wantEvents:  []string{},
+			wantVersion: 2,
+			wantKey:     aggregate.PaymentIDForCart(cartID).String() + "-1",
+		},
+		"should be idempotent when already captured": {
+			status:      aggregate.PaymentStatusCaptured,
+			amount:      100.0,
+			wantEvents:  []string{},
+			wantVersion: 3,
+			wantKey:     aggregate.PaymentIDForCart(cartID).String() + "-1",
+		},
+		"should return error when amount differs from authorization": {
+			status:      aggregate.PaymentStatusAuthorized,
+			amount:      120.0,
+			wantErr:     aggregate.ErrPaymentAmountMismatch,
+			wantEvents:  []string{},
+			wantVersion: 2,
+		},
+		"should return error when refunded": {
+			status:      aggregate.PaymentStatusRefunded,
+			amount:      100.0,
+			wantErr:     aggregate.ErrPaymentAlreadyRefunded,
+			wantEvents:  []string{},
+			wantVersion: 4,
+		},
+		"should return error when amount is not positive": {
+			amount:      0,
+			wantErr:     aggregate.ErrPaymentAmountInvalid,
+			wantEvents:  []string{},
+			wantVersion: -1,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			payment := aggregate.NewPaymentAggregate()
+			if tt.status != "" {
+				payment = paymentWithStatus(t, cartID, tt.status)
+			}
+
+			// Act
+			err := payment.ExecuteRequestPaymentAuthorizationCommand(command.RequestPaymentAuthorizationCommand{
+				CartID:     cartID,
+				Amount:     tt.amount,
+				CardNumber: value.CardNumber("4242424242424242"),
+			})
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, aggregate.PaymentIDForCart(cartID), payment.GetAggregateID())
+				assert.Equal(t, tt.wantKey, payment.GetIdempotencyKey())
+			}
+			assert.Equal(t, tt.wantEvents, eventTypes(payment.GetUncommittedEvents()))
+			assert.Equal(t, tt.wantVersion, payment.GetVersion())
+		})
+	}
+}
+
+func TestPaymentAggregate_ExecuteAuthorizePaymentCommand(t *testing.T) {
+	cartID := uuid.New()
+
+	tests := map[string]struct {
+		status          aggregate.PaymentStatus
+		authorizationID string
+		wantErr         error
+		wantEvents      []string
+		wantStatus      aggregate.PaymentStatus
+	}{
+		"should authorize pending payment": {
+			status:          aggregate.PaymentStatusRequested,
+			authorizationID: "auth-1",
+			wantEvents:      []string{"PaymentAuthorizedEvent"},
+			wantStatus:      aggregate.PaymentStatusAuthorized,
+		},
+		"should ignore redelivered authorization": {
+			status:          aggregate.PaymentStatusAuthorized,
+			authorizationID: "auth-1",
+			wantEvents:      []string{},
+			wantStatus:      aggregate.PaymentStatusAuthorized,
+		},
+		"should return error when payment is not pending": {
+			status:          aggregate.PaymentStatusDeclined,
+			authorizationID: "auth-2",
+			wantErr:         aggregate.ErrPaymentNotPending,
+			wantEvents:      []string{},
+			wantStatus:      aggregate.PaymentStatusDeclined,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			payment := paymentWithStatus(t, cartID, tt.status)
+
+			// Act
+			err := payment.ExecuteAuthorizePaymentCommand(command.AuthorizePaymentCommand{
+				PaymentID:       payment.GetAggregateID(),
+				AuthorizationID: tt.authorizationID,
+			})
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantEvents, eventTypes(payment.GetUncommittedEvents()))
+			assert.Equal(t, tt.wantStatus, payment.GetStatus())
+		})
+	}
+}
+
+func TestPaymentAggregate_ExecuteDeclinePaymentCommand(t *testing.T) {
+	// Arrange
+	payment := paymentWithStatus(t, uuid.New(), aggregate.PaymentStatusRequested)
+
+	// Act
+	err := payment.ExecuteDeclinePaymentCommand(command.DeclinePaymentCommand{
+		PaymentID: payment.GetAggregateID(),
+		Reason:    "insufficient funds",
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"PaymentDeclinedEvent"}, eventTypes(payment.GetUncommittedEvents()))
+	assert.Equal(t, aggregate.PaymentStatusDeclined, payment.GetStatus())
+	assert.Equal(t, "insufficient funds", payment.GetDeclineReason())
+}
+
+func TestPaymentAggregate_ExecuteCapturePaymentCommand(t *testing.T) {
+	cartID := uuid.New()
+
+	tests := map[string]struct {
+		status     aggregate.PaymentStatus
+		wantErr    error
+		wantEvents []string
+	}{
+		"should capture authorized payment": {
+			status:     aggregate.PaymentStatusAuthorized,
+			wantEvents: []string{"PaymentCapturedEvent"},
+		},
+		"should capture payment whose capture was requested": {
+			status:     aggregate.PaymentStatusCaptureRequested,
+			wantEvents: []string{"PaymentCapturedEvent"},
+		},
+		"should be idempotent when already captured": {
+			status:     aggregate.PaymentStatusCaptured,
+			wantEvents: []string{},
+		},
+		"should return error when payment was declined": {
+			status:     aggregate.PaymentStatusDeclined,
+			wantErr:    aggregate.ErrPaymentNotAuthorized,
+			wantEvents: []string{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			payment := paymentWithStatus(t, cartID, tt.status)
+
+			// Act
+			err := payment.ExecuteCapturePaymentCommand(command.CapturePaymentCommand{PaymentID: payment.GetAggregateID()})
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantEvents, eventTypes(payment.GetUncommittedEvents()))
+		})
+	}
+}
+
+func TestPaymentAggregate_ExecuteRefundPaymentCommand(t *testing.T) {
+	cartID := uuid.New()
+
+	tests := map[string]struct {
+		status     aggregate.PaymentStatus
+		wantErr    error
+		wantEvents []string
+	}{
+		"should refund captured payment": {
+			status:     aggregate.PaymentStatusCaptured,
+			wantEvents: []string{"PaymentRefundedEvent"},
+		},
+		"should refund payment whose refund was requested": {
+			status:     aggregate.PaymentStatusRefundRequested,
+			wantEvents: []string{"PaymentRefundedEvent"},
+		},
+		"should be idempotent when already refunded": {
+			status:     aggregate.PaymentStatusRefunded,
+			wantEvents: []string{},
+		},
+		"should return error when payment is not captured": {
+			status:     aggregate.PaymentStatusAuthorized,
+			wantErr:    aggregate.ErrPaymentNotCaptured,
+			wantEvents: []string{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			payment := paymentWithStatus(t, cartID, tt.status)
+
+			// Act
+			err := payment.ExecuteRefundPaymentCommand(command.RefundPaymentCommand{PaymentID: payment.GetAggregateID()})
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantEvents, eventTypes(payment.GetUncommittedEvents()))
+		})
+	}
+}
+
+func TestPaymentAggregate_ExecuteRequestPaymentCaptureCommand(t *testing.T) {
+	cartID := uuid.New()
+
+	tests := map[string]struct {
+		status     aggregate.PaymentStatus
+		wantErr    error
+		wantEvents []string
+		wantStatus aggregate.PaymentStatus
+	}{
+		"should request capture of authorized payment": {
+			status:     aggregate.PaymentStatusAuthorized,
+			wantEvents: []string{"PaymentCaptureRequestedEvent"},
+			wantStatus: aggregate.PaymentStatusCaptureRequested,
+		},
+		"should resume a pending capture": {
+			status:     aggregate.PaymentStatusCaptureRequested,
+			wantEvents: []string{},
+			wantStatus: aggregate.PaymentStatusCaptureRequested,
+		},
+		"should be idempotent when already captured": {
+			status:     aggregate.PaymentStatusCaptured,
+			wantEvents: []string{},
+			wantStatus: aggregate.PaymentStatusCaptured,
+		},
+		"should return error when payment was declined": {
+			status:     aggregate.PaymentStatusDeclined,
+			wantErr:    aggregate.ErrPaymentNotAuthorized,
+			wantEvents: []string{},
+			wantStatus: aggregate.PaymentStatusDeclined,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			payment := paymentWithStatus(t, cartID, tt.status)
+
+			// Act
+			err := payment.ExecuteRequestPaymentCaptureCommand(command.RequestPaymentCaptureCommand{PaymentID: payment.GetAggregateID()})
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantEvents, eventTypes(payment.GetUncommittedEvents()))
+			assert.Equal(t, tt.wantStatus, payment.GetStatus())
+		})
+	}
+}
+
+func TestPaymentAggregate_ExecuteRequestPaymentRefundCommand(t *testing.T) {
+	cartID := uuid.New()
+
+	tests := map[string]struct {
+		status     aggregate.PaymentStatus
+		wantErr    error
+		wantEvents []string
+		wantStatus aggregate.PaymentStatus
+	}{
+		"should request refund of captured payment": {
+			status:     aggregate.PaymentStatusCaptured,
+			wantEvents: []string{"PaymentRefundRequestedEvent"},
+			wantStatus: aggregate.PaymentStatusRefundRequested,
+		},
+		"should resume a pending refund": {
+			status:     aggregate.PaymentStatusRefundRequested,
+			wantEvents: []string{},
+			wantStatus: aggregate.PaymentStatusRefundRequested,
+		},
+		"should be idempotent when already refunded": {
+			status:     aggregate.PaymentStatusRefunded,
+			wantEvents: []string{},
+			wantStatus: aggregate.PaymentStatusRefunded,
+		},
+		"should return error when payment is not captured": {
+			status:     aggregate.PaymentStatusAuthorized,
+			wantErr:    aggregate.ErrPaymentNotCaptured,
+			wantEvents: []string{},
+			wantStatus: aggregate.PaymentStatusAuthorized,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			payment := paymentWithStatus(t, cartID, tt.status)
+
+			// Act
+			err := payment.ExecuteRequestPaymentRefundCommand(command.RequestPaymentRefundCommand{PaymentID: payment.GetAggregateID()})
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantEvents, eventTypes(payment.GetUncommittedEvents()))
+			assert.Equal(t, tt.wantStatus, payment.GetStatus())
+		})
+	}
+}
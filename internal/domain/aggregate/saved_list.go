@@ -0,0 +1,218 @@
+package aggregate
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/entity"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+)
+
+var (
+	ErrSavedListUserRequired = errors.InvalidParameter.New("user_id is required")
+	ErrItemAlreadySaved      = errors.UnpermittedOp.New("item is already saved")
+	ErrSavedItemNotFound     = errors.NotFound.New("item not found in saved list")
+	ErrSavedItemQuantity     = errors.InvalidParameter.New("quantity must be at least 1")
+)
+
+// savedListNamespace derives the list stream from tenant and user, so every
+// shopper has exactly one list per tenant.
+var savedListNamespace = uuid.MustParse("4e8a1c2d-7b3f-4d6e-9a5c-2f1b0d3e8c7a")
+
+func SavedListIDForUser(tenantID, userID uuid.UUID) uuid.UUID {
+	return uuid.NewSHA1(savedListNamespace, append(tenantID[:], userID[:]...))
+}
+
+type SavedListAggregate struct {
+	listID      uuid.UUID
+	tenantID    uuid.UUID
+	userID      uuid.UUID
+	items       []*entity.SavedItem
+	version     int
+	uncommitted []event.Event
+}
+
+func NewSavedListAggregate() *SavedListAggregate {
+	return &SavedListAggregate{
+		items:       make([]*entity.SavedItem, 0),
+		version:     -1,
+		uncommitted: make([]event.Event, 0),
+	}
+}
+
+func (a *SavedListAggregate) GetAggregateID() uuid.UUID     { return a.listID }
+func (a *SavedListAggregate) GetVersion() int               { return a.version }
+func (a *SavedListAggregate) GetTenantID() uuid.UUID        { return a.tenantID }
+func (a *SavedListAggregate) GetUserID() uuid.UUID          { return a.userID }
+func (a *SavedListAggregate) GetItems() []*entity.SavedItem { return a.items }
+
+func (a *SavedListAggregate) GetUncommittedEvents() []event.Event {
+	return a.uncommitted
+}
+
+func (a *SavedListAggregate) MarkEventsAsCommitted() {
+	a.uncommitted = nil
+}
+
+func (a *SavedListAggregate) Hydration(events []event.Event) error {
+	for _, ev := range events {
+		if err := a.apply(ev); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *SavedListAggregate) apply(ev event.Event) error {
+	switch e := ev.(type) {
+	case *event.SavedListCreatedEvent:
+		a.listID = e.GetAggregateID()
+		a.tenantID = e.GetTenantID()
+		a.userID = e.GetUserID()
+	case *event.ItemSavedEvent:
+		price, err := value.NewPrice(e.GetPrice())
+		if err != nil {
+			return err
+		}
+		taxCategory, err := value.NewTaxCategory(e.GetTaxCategory())
+		if err != nil {
+			return err
+		}
+		a.items = append(a.items, entity.NewSavedItem(e.GetItemID(), e.GetName(), price, taxCategory, e.GetWeightGrams(), e.GetLineOptions(), e.GetCategory(), e.GetQuantity()))
+	case *event.SavedItemRemovedEvent:
+		a.removeItem(e.GetItemID())
+	case *event.SavedItemMovedToCartEvent:
+		a.removeItem(e.GetItemID())
+	default:
+		return nil
+	}
+	a.version = ev.GetVersion()
+	return nil
+}
+
+func (a *SavedListAggregate) raise(ev event.Event) error {
+	if err := a.apply(ev); err != nil {
+		return err
+	}
+	a.uncommitted = append(a.uncommitted, ev)
+	return nil
+}
+
+func (a *SavedListAggregate) findItem(itemID uuid.UUID) *entity.SavedItem {
+	for _, item := range a.items {
+		if item.GetItemID() == itemID {
+			return item
+		}
+	}
+	return nil
+}
+
+func (a *SavedListAggregate) removeItem(itemID uuid.UUID) {
+	for i, item := range a.items {
+		if item.GetItemID() == itemID {
+			a.items = append(a.items[:i], a.items[i+1:]...)
+			return
+		}
+	}
+}
+
+// ExecuteSaveItemCommand keeps an item for later, creating the list on the
+// first save.
+func (a *SavedListAggregate) ExecuteSaveItemCommand(cmd command.SaveItemCommand) error {
+	if cmd.UserID == uuid.Nil {
+		return ErrSavedListUserRequired
+	}
+
+	price, err := value.NewPrice(cmd.Price)
+	if err != nil {
+		return err
+	}
+
+	taxCategory, err := value.NewTaxCategory(cmd.TaxCategory)
+	if err != nil {
+		return err
+	}
+
+	if cmd.WeightGrams < 0 {
+		return ErrItemWeightInvalid
+	}
+
+	// The unit price, options included, is checked as the cart would
+	if _, err := value.NewPrice(price.Float64() + cmd.Options.PriceModifier()); err != nil {
+		return err
+	}
+
+	quantity := cmd.Quantity
+	if quantity == 0 {
+		quantity = 1
+	}
+	if quantity < 0 {
+		return ErrSavedItemQuantity
+	}
+
+	if a.findItem(cmd.ItemID) != nil {
+		return ErrItemAlreadySaved
+	}
+
+	if a.version == -1 {
+		listID := SavedListIDForUser(cmd.TenantID, cmd.UserID)
+		if err := a.raise(event.NewSavedListCreatedEvent(listID, 1, cmd.TenantID, cmd.UserID)); err != nil {
+			return err
+		}
+	}
+
+	category := strings.TrimSpace(cmd.Category)
+	return a.raise(event.NewItemSavedEvent(a.listID, a.version+1, cmd.ItemID, cmd.Name, price.Float64(), taxCategory.String(), cmd.WeightGrams, event.NewItemOptions(cmd.Options), category, quantity))
+}
+
+func (a *SavedListAggregate) ExecuteRemoveSavedItemCommand(cmd command.RemoveSavedItemCommand) error {
+	if a.findItem(cmd.ItemID) == nil {
+		return ErrSavedItemNotFound
+	}
+
+	return a.raise(event.NewSavedItemRemovedEvent(a.listID, a.version+1, cmd.ItemID))
+}
+
+// ExecuteMoveSavedItemToCartCommand adds the saved item to the shopper's cart,
+// creating it if needed, and takes it off the list. It goes in with its
+// options, category and units, under the cart rules like any other add. Both
+// aggregates get new events, so the caller has to save both streams together.
+func (a *SavedListAggregate) ExecuteMoveSavedItemToCartCommand(cmd command.MoveSavedItemToCartCommand, cart *CartAggregate) error {
+	item := a.findItem(cmd.ItemID)
+	if item == nil {
+		return ErrSavedItemNotFound
+	}
+
+	if !cart.isNew() {
+		if !cart.isMember(a.userID) {
+			return ErrCartOwnerMismatch
+		}
+		if cart.tenantID != a.tenantID {
+			return ErrCartTenantMismatch
+		}
+	}
+
+	for range item.GetQuantity() {
+		err := cart.ExecuteAddItemToCartCommand(command.AddItemToCartCommand{
+			CartID:      cmd.CartID,
+			UserID:      a.userID,
+			ItemID:      item.GetItemID(),
+			Name:        item.GetName(),
+			Price:       item.GetPrice().Float64(),
+			TenantID:    a.tenantID,
+			TaxCategory: item.GetTaxCategory().String(),
+			WeightGrams: item.GetWeightGrams(),
+			Options:     item.GetOptions(),
+			Category:    item.GetCategory(),
+			CartRules:   cmd.CartRules,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return a.raise(event.NewSavedItemMovedToCartEvent(a.listID, a.version+1, cmd.ItemID, cmd.CartID))
+}
@@ -0,0 +1,379 @@
+package aggregate_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+)
+
+func savedListWithItems(t *testing.T, tenantID, userID uuid.UUID, itemIDs ...uuid.UUID) *aggregate.SavedListAggregate {
+	t.Helper()
+
+	list := aggregate.NewSavedListAggregate()
+	for _, itemID := range itemIDs {
+		err := list.ExecuteSaveItemCommand(command.SaveItemCommand{
+			TenantID:    tenantID,
+			UserID:      userID,
+			ItemID:      itemID,
+			Name:        "Saved Item",
+			Price:       1000,
+			TaxCategory: "STANDARD",
+			WeightGrams: 500,
+		})
+		assert.NoError(t, err)
+	}
+	list.MarkEventsAsCommitted()
+
+	return list
+}
+
+func TestSavedListAggregate_ExecuteSaveItemCommand(t *testing.T) {
+	tenantID := uuid.New()
+	userID := uuid.New()
+	savedItemID := uuid.New()
+
+	tests := map[string]struct {
+		existingItems []uuid.UUID
+		cmd           command.SaveItemCommand
+		wantErr       error
+		wantEvents    []string
+		wantVersion   int
+	}{
+		"creates the list on the first save": {
+			cmd: command.SaveItemCommand{
+				TenantID: tenantID, UserID: userID, ItemID: uuid.New(), Name: "Tea", Price: 500, TaxCategory: "REDUCED",
+			},
+			wantEvents:  []string{"SavedListCreatedEvent", "ItemSavedEvent"},
+			wantVersion: 2,
+		},
+		"appends to an existing list": {
+			existingItems: []uuid.UUID{savedItemID},
+			cmd: command.SaveItemCommand{
+				TenantID: tenantID, UserID: userID, ItemID: uuid.New(), Name: "Mug", Price: 1200,
+			},
+			wantEvents:  []string{"ItemSavedEvent"},
+			wantVersion: 3,
+		},
+		"rejects an item that is already saved": {
+			existingItems: []uuid.UUID{savedItemID},
+			cmd: command.SaveItemCommand{
+				TenantID: tenantID, UserID: userID, ItemID: savedItemID, Name: "Saved Item", Price: 1000,
+			},
+			wantErr:     aggregate.ErrItemAlreadySaved,
+			wantEvents:  []string{},
+			wantVersion: 2,
+		},
+		"requires a user": {
+			cmd: command.SaveItemCommand{
+				TenantID: tenantID, ItemID: uuid.New(), Name: "Tea", Price: 500,
+			},
+			wantErr:     aggregate.ErrSavedListUserRequired,
+			wantEvents:  []string{},
+			wantVersion: -1,
+		},
+		"rejects a negative quantity": {
+			cmd: command.SaveItemCommand{
+				TenantID: tenantID, UserID: userID, ItemID: uuid.New(), Name: "Tea", Price: 500, Quantity: -1,
+			},
+			wantErr:     aggregate.ErrSavedItemQuantity,
+			wantEvents:  []string{},
+			wantVersion: -1,
+		},
+		"rejects an invalid price": {
+			cmd: command.SaveItemCommand{
+				TenantID: tenantID, UserID: userID, ItemID: uuid.New(), Name: "Tea", Price: -1,
+			},
+			wantErr:     value.ErrPriceInvalid,
+			wantEvents:  []string{},
+			wantVersion: -1,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			list := savedListWithItems(t, tenantID, userID, tt.existingItems...)
+
+			// Act
+			err := list.ExecuteSaveItemCommand(tt.cmd)
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, aggregate.SavedListIDForUser(tenantID, userID), list.GetAggregateID())
+			}
+			assert.Equal(t, tt.wantEvents, eventTypes(list.GetUncommittedEvents()))
+			assert.Equal(t, tt.wantVersion, list.GetVersion())
+		})
+	}
+}
+
+func TestSavedListAggregate_ExecuteRemoveSavedItemCommand(t *testing.T) {
+	tenantID := uuid.New()
+	userID := uuid.New()
+	savedItemID := uuid.New()
+
+	tests := map[string]struct {
+		itemID     uuid.UUID
+		wantErr    error
+		wantEvents []string
+		wantItems  int
+	}{
+		"removes a saved item": {
+			itemID:     savedItemID,
+			wantEvents: []string{"SavedItemRemovedEvent"},
+			wantItems:  0,
+		},
+		"returns not found for an item that is not saved": {
+			itemID:     uuid.New(),
+			wantErr:    aggregate.ErrSavedItemNotFound,
+			wantEvents: []string{},
+			wantItems:  1,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			list := savedListWithItems(t, tenantID, userID, savedItemID)
+
+			// Act
+			err := list.ExecuteRemoveSavedItemCommand(command.RemoveSavedItemCommand{
+				TenantID: tenantID,
+				UserID:   userID,
+				ItemID:   tt.itemID,
+			})
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantEvents, eventTypes(list.GetUncommittedEvents()))
+			assert.Len(t, list.GetItems(), tt.wantItems)
+		})
+	}
+}
+
+func TestSavedListAggregate_ExecuteMoveSavedItemToCartCommand(t *testing.T) {
+	tenantID := uuid.New()
+	userID := uuid.New()
+	savedItemID := uuid.New()
+	cartID := uuid.New()
+
+	userCart := func(t *testing.T, owner, tenant uuid.UUID) *aggregate.CartAggregate {
+		cart := aggregate.NewCartAggregate()
+		err := cart.ExecuteAddItemToCartCommand(command.AddItemToCartCommand{
+			CartID: cartID, UserID: owner, ItemID: uuid.New(), Name: "In Cart", Price: 300, TenantID: tenant,
+		})
+		assert.NoError(t, err)
+		cart.MarkEventsAsCommitted()
+		return cart
+	}
+
+	tests := map[string]struct {
+		cart           func(t *testing.T) *aggregate.CartAggregate
+		itemID         uuid.UUID
+		wantErr        error
+		wantListEvents []string
+		wantCartEvents []string
+		wantCartTotal  float64
+	}{
+		"moves the item into a new cart": {
+			cart:           func(t *testing.T) *aggregate.CartAggregate { return aggregate.NewCartAggregate() },
+			itemID:         savedItemID,
+			wantListEvents: []string{"SavedItemMovedToCartEvent"},
+			wantCartEvents: []string{"CartCreatedEvent", "ItemAddedToCartEvent"},
+			wantCartTotal:  1000,
+		},
+		"moves the item into the shopper's open cart": {
+			cart:           func(t *testing.T) *aggregate.CartAggregate { return userCart(t, userID, tenantID) },
+			itemID:         savedItemID,
+			wantListEvents: []string{"SavedItemMovedToCartEvent"},
+			wantCartEvents: []string{"ItemAddedToCartEvent"},
+			wantCartTotal:  1300,
+		},
+		"rejects another user's cart": {
+			cart:           func(t *testing.T) *aggregate.CartAggregate { return userCart(t, uuid.New(), tenantID) },
+			itemID:         savedItemID,
+			wantErr:        aggregate.ErrCartOwnerMismatch,
+			wantListEvents: []string{},
+			wantCartEvents: []string{},
+			wantCartTotal:  300,
+		},
+		"rejects a cart of another tenant": {
+			cart:           func(t *testing.T) *aggregate.CartAggregate { return userCart(t, userID, uuid.New()) },
+			itemID:         savedItemID,
+			wantErr:        aggregate.ErrCartTenantMismatch,
+			wantListEvents: []string{},
+			wantCartEvents: []string{},
+			wantCartTotal:  300,
+		},
+		"returns not found for an item that is not saved": {
+			cart:           func(t *testing.T) *aggregate.CartAggregate { return aggregate.NewCartAggregate() },
+			itemID:         uuid.New(),
+			wantErr:        aggregate.ErrSavedItemNotFound,
+			wantListEvents: []string{},
+			wantCartEvents: []string{},
+			wantCartTotal:  0,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			list := savedListWithItems(t, tenantID, userID, savedItemID)
+			cart := tt.cart(t)
+
+			// Act
+			err := list.ExecuteMoveSavedItemToCartCommand(command.MoveSavedItemToCartCommand{
+				TenantID: tenantID,
+				UserID:   userID,
+				ItemID:   tt.itemID,
+				CartID:   cartID,
+			}, cart)
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Empty(t, list.GetItems())
+			}
+			assert.Equal(t, tt.wantListEvents, eventTypes(list.GetUncommittedEvents()))
+			assert.Equal(t, tt.wantCartEvents, eventTypes(cart.GetUncommittedEvents()))
+			assert.Equal(t, tt.wantCartTotal, cart.GetTotalAmount().Float64())
+		})
+	}
+}
+
+func TestSavedListAggregate_ExecuteMoveSavedItemToCartCommand_SavedLine(t *testing.T) {
+	giftWrap, err := value.NewLineOption("gift_wrap", "yes", 300)
+	assert.NoError(t, err)
+
+	tests := map[string]struct {
+		rules          value.CartRules
+		wantErr        bool
+		wantListEvents []string
+		wantCartEvents []string
+		wantCartTotal  float64
+	}{
+		"goes in with its options, category and units": {
+			wantListEvents: []string{"SavedItemMovedToCartEvent"},
+			wantCartEvents: []string{"ItemAddedToCartEvent", "ItemAddedToCartEvent"},
+			wantCartTotal:  3600,
+		},
+		"item of a blocked category is refused": {
+			rules:          cartRules(t, 0, 0, 0, [2]string{"alcohol", "toys"}),
+			wantErr:        true,
+			wantListEvents: []string{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			tenantID := uuid.New()
+			userID := uuid.New()
+			itemID := uuid.New()
+			cartID := uuid.New()
+
+			list := aggregate.NewSavedListAggregate()
+			assert.NoError(t, list.ExecuteSaveItemCommand(command.SaveItemCommand{
+				TenantID: tenantID,
+				UserID:   userID,
+				ItemID:   itemID,
+				Name:     "Teddy Bear",
+				Price:    1000,
+				Options:  lineOptions(t, giftWrap),
+				Category: "toys",
+				Quantity: 2,
+			}))
+			list.MarkEventsAsCommitted()
+
+			cart := aggregate.NewCartAggregate()
+			assert.NoError(t, cart.ExecuteAddItemToCartCommand(command.AddItemToCartCommand{
+				CartID: cartID, UserID: userID, ItemID: uuid.New(), Name: "Wine", Price: 1000, TenantID: tenantID, Category: "alcohol",
+			}))
+			cart.MarkEventsAsCommitted()
+
+			// Act
+			err := list.ExecuteMoveSavedItemToCartCommand(command.MoveSavedItemToCartCommand{
+				TenantID:  tenantID,
+				UserID:    userID,
+				ItemID:    itemID,
+				CartID:    cartID,
+				CartRules: tt.rules,
+			}, cart)
+
+			// Assert
+			assert.Equal(t, tt.wantListEvents, eventTypes(list.GetUncommittedEvents()))
+			if tt.wantErr {
+				assert.True(t, errors.IsCode(err, errors.InvalidParameter))
+				assert.Len(t, list.GetItems(), 1)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantCartEvents, eventTypes(cart.GetUncommittedEvents()))
+			assert.Equal(t, tt.wantCartTotal, cart.GetTotalAmount().Float64())
+			for _, item := range cart.GetItems()[1:] {
+				assert.Equal(t, "toys", item.GetCategory())
+				assert.Equal(t, lineOptions(t, giftWrap), item.GetOptions())
+			}
+		})
+	}
+}
+
+func TestSavedListAggregate_Hydration(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	tenantID := uuid.New()
+	userID := uuid.New()
+	listID := aggregate.SavedListIDForUser(tenantID, userID)
+	keptID := uuid.New()
+	removedID := uuid.New()
+	movedID := uuid.New()
+	history := []event.Event{
+		event.NewSavedListCreatedEvent(listID, 1, tenantID, userID),
+		event.NewItemSavedEvent(listID, 2, keptID, "Kept", 100, "STANDARD", 0, nil, "", 0),
+		event.NewItemSavedEvent(listID, 3, removedID, "Removed", 200, "STANDARD", 0, nil, "", 1),
+		event.NewItemSavedEvent(listID, 4, movedID, "Moved", 300, "REDUCED", 0, nil, "", 1),
+		event.NewSavedItemRemovedEvent(listID, 5, removedID),
+		event.NewSavedItemMovedToCartEvent(listID, 6, movedID, uuid.New()),
+	}
+
+	list := aggregate.NewSavedListAggregate()
+
+	// Act
+	err := list.Hydration(history)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 6, list.GetVersion())
+	assert.Equal(t, userID, list.GetUserID())
+	assert.Equal(t, tenantID, list.GetTenantID())
+	if assert.Len(t, list.GetItems(), 1) {
+		assert.Equal(t, keptID, list.GetItems()[0].GetItemID())
+		assert.Equal(t, 1, list.GetItems()[0].GetQuantity())
+	}
+	assert.Empty(t, list.GetUncommittedEvents())
+}
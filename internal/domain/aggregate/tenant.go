@@ -0,0 +1,236 @@
+package aggregate
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+)
+
+var (
+	ErrTenantAlreadyOnboarded     = errors.UnpermittedOp.New("tenant already onboarded")
+	ErrTenantNotOnboarded         = errors.NotFound.New("tenant not onboarded")
+	ErrTenantDisplayNameRequired  = errors.InvalidParameter.New("tenant display name must not be empty")
+	ErrTenantSuspended            = errors.UnpermittedOp.New("tenant is suspended")
+	ErrTenantClosed               = errors.UnpermittedOp.New("tenant is closed")
+	ErrTenantSuspendReasonMissing = errors.InvalidParameter.New("a reason is required to suspend a tenant")
+)
+
+// tenantNamespace keeps the tenant stream apart from the abandonment policy
+// stream, which already uses the tenant ID.
+var tenantNamespace = uuid.MustParse("8c2e4a6f-1b3d-4f5a-9e7c-0d2b4f6a8c1e")
+
+func TenantIDForStream(tenantID uuid.UUID) uuid.UUID {
+	return uuid.NewSHA1(tenantNamespace, tenantID[:])
+}
+
+type TenantAggregate struct {
+	streamID         uuid.UUID
+	tenantID         uuid.UUID
+	displayName      string
+	plan             value.TenantPlan
+	status           value.TenantStatus
+	defaultLocale    value.Locale
+	suspensionReason string
+	version          int
+	uncommitted      []event.Event
+}
+
+func NewTenantAggregate() *TenantAggregate {
+	return &TenantAggregate{
+		version:     -1,
+		uncommitted: make([]event.Event, 0),
+	}
+}
+
+func (a *TenantAggregate) GetAggregateID() uuid.UUID           { return a.streamID }
+func (a *TenantAggregate) GetVersion() int                     { return a.version }
+func (a *TenantAggregate) GetTenantID() uuid.UUID              { return a.tenantID }
+func (a *TenantAggregate) GetDisplayName() string              { return a.displayName }
+func (a *TenantAggregate) GetPlan() value.TenantPlan           { return a.plan }
+func (a *TenantAggregate) GetStatus() value.TenantStatus       { return a.status }
+func (a *TenantAggregate) GetDefaultLocale() value.Locale      { return a.defaultLocale }
+func (a *TenantAggregate) GetSuspensionReason() string         { return a.suspensionReason }
+func (a *TenantAggregate) GetUncommittedEvents() []event.Event { return a.uncommitted }
+
+func (a *TenantAggregate) MarkEventsAsCommitted() {
+	a.uncommitted = nil
+}
+
+func (a *TenantAggregate) Hydration(events []event.Event) error {
+	for _, ev := range events {
+		a.apply(ev)
+	}
+	return nil
+}
+
+func (a *TenantAggregate) apply(ev event.Event) {
+	switch e := ev.(type) {
+	case *event.TenantOnboardedEvent:
+		a.streamID = e.GetAggregateID()
+		a.tenantID = e.GetTenantID()
+		a.displayName = e.GetDisplayName()
+		a.plan = value.TenantPlan(e.GetPlan())
+		a.defaultLocale = value.Locale(e.GetDefaultLocale())
+		a.status = value.TenantStatusActive
+	case *event.TenantProfileUpdatedEvent:
+		a.displayName = e.GetDisplayName()
+		a.defaultLocale = value.Locale(e.GetDefaultLocale())
+	case *event.TenantPlanChangedEvent:
+		a.plan = value.TenantPlan(e.GetPlan())
+	case *event.TenantSuspendedEvent:
+		a.status = value.TenantStatusSuspended
+		a.suspensionReason = e.GetReason()
+	case *event.TenantReactivatedEvent:
+		a.status = value.TenantStatusActive
+		a.suspensionReason = ""
+	case *event.TenantClosedEvent:
+		a.status = value.TenantStatusClosed
+	default:
+		return
+	}
+	a.version = ev.GetVersion()
+}
+
+func (a *TenantAggregate) isOnboarded() bool {
+	return a.version != -1
+}
+
+// CheckOpenForCarts rejects cart commands of suspended and closed tenants.
+// Tenants that were never onboarded predate the tenant stream and stay open.
+func (a *TenantAggregate) CheckOpenForCarts() error {
+	switch a.status {
+	case value.TenantStatusSuspended:
+		return ErrTenantSuspended
+	case value.TenantStatusClosed:
+		return ErrTenantClosed
+	default:
+		return nil
+	}
+}
+
+func (a *TenantAggregate) checkChangeable() error {
+	if !a.isOnboarded() {
+		return ErrTenantNotOnboarded
+	}
+	if a.status == value.TenantStatusClosed {
+		return ErrTenantClosed
+	}
+	return nil
+}
+
+func (a *TenantAggregate) raise(ev event.Event) {
+	a.apply(ev)
+	a.uncommitted = append(a.uncommitted, ev)
+}
+
+func (a *TenantAggregate) ExecuteOnboardTenantCommand(cmd command.OnboardTenantCommand) error {
+	if a.isOnboarded() {
+		return ErrTenantAlreadyOnboarded
+	}
+
+	displayName := strings.TrimSpace(cmd.DisplayName)
+	if displayName == "" {
+		return ErrTenantDisplayNameRequired
+	}
+
+	a.raise(event.NewTenantOnboardedEvent(
+		TenantIDForStream(cmd.TenantID),
+		1,
+		cmd.TenantID,
+		displayName,
+		cmd.Plan.String(),
+		cmd.DefaultLocale.String(),
+	))
+
+	return nil
+}
+
+func (a *TenantAggregate) ExecuteUpdateTenantProfileCommand(cmd command.UpdateTenantProfileCommand) error {
+	if err := a.checkChangeable(); err != nil {
+		return err
+	}
+
+	displayName := strings.TrimSpace(cmd.DisplayName)
+	if displayName == "" {
+		return ErrTenantDisplayNameRequired
+	}
+
+	if a.displayName == displayName && a.defaultLocale == cmd.DefaultLocale {
+		return nil
+	}
+
+	a.raise(event.NewTenantProfileUpdatedEvent(
+		a.streamID,
+		a.version+1,
+		a.tenantID,
+		displayName,
+		cmd.DefaultLocale.String(),
+	))
+
+	return nil
+}
+
+func (a *TenantAggregate) ExecuteChangeTenantPlanCommand(cmd command.ChangeTenantPlanCommand) error {
+	if err := a.checkChangeable(); err != nil {
+		return err
+	}
+
+	if a.plan == cmd.Plan {
+		return nil
+	}
+
+	a.raise(event.NewTenantPlanChangedEvent(a.streamID, a.version+1, a.tenantID, cmd.Plan.String()))
+
+	return nil
+}
+
+func (a *TenantAggregate) ExecuteSuspendTenantCommand(cmd command.SuspendTenantCommand) error {
+	if err := a.checkChangeable(); err != nil {
+		return err
+	}
+
+	reason := strings.TrimSpace(cmd.Reason)
+	if reason == "" {
+		return ErrTenantSuspendReasonMissing
+	}
+
+	if a.status == value.TenantStatusSuspended {
+		return nil
+	}
+
+	a.raise(event.NewTenantSuspendedEvent(a.streamID, a.version+1, a.tenantID, reason))
+
+	return nil
+}
+
+func (a *TenantAggregate) ExecuteReactivateTenantCommand(cmd command.ReactivateTenantCommand) error {
+	if err := a.checkChangeable(); err != nil {
+		return err
+	}
+
+	if a.status == value.TenantStatusActive {
+		return nil
+	}
+
+	a.raise(event.NewTenantReactivatedEvent(a.streamID, a.version+1, a.tenantID))
+
+	return nil
+}
+
+func (a *TenantAggregate) ExecuteCloseTenantCommand(cmd command.CloseTenantCommand) error {
+	if !a.isOnboarded() {
+		return ErrTenantNotOnboarded
+	}
+
+	if a.status == value.TenantStatusClosed {
+		return nil
+	}
+
+	a.raise(event.NewTenantClosedEvent(a.streamID, a.version+1, a.tenantID))
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package aggregate
+
+import (
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+var approvalPolicyNamespace = uuid.MustParse("9c3e7a15-2f4b-4d8e-b6a1-0e5d7c9f3b28")
+
+func ApprovalPolicyIDForTenant(tenantID uuid.UUID) uuid.UUID {
+	return uuid.NewSHA1(approvalPolicyNamespace, tenantID[:])
+}
+
+type TenantApprovalPolicyAggregate struct {
+	policyID    uuid.UUID
+	tenantID    uuid.UUID
+	policy      value.ApprovalPolicy
+	version     int
+	uncommitted []event.Event
+}
+
+func NewTenantApprovalPolicyAggregate() *TenantApprovalPolicyAggregate {
+	return &TenantApprovalPolicyAggregate{
+		version:     -1,
+		uncommitted: make([]event.Event, 0),
+	}
+}
+
+func (a *TenantApprovalPolicyAggregate) GetAggregateID() uuid.UUID { return a.policyID }
+func (a *TenantApprovalPolicyAggregate) GetVersion() int           { return a.version }
+func (a *TenantApprovalPolicyAggregate) GetTenantID() uuid.UUID    { return a.tenantID }
+
+// GetApprovalPolicy returns the zero policy, which never requires approval,
+// until the tenant configures one.
+func (a *TenantApprovalPolicyAggregate) GetApprovalPolicy() value.ApprovalPolicy { return a.policy }
+
+func (a *TenantApprovalPolicyAggregate) GetUncommittedEvents() []event.Event {
+	return a.uncommitted
+}
+
+func (a *TenantApprovalPolicyAggregate) MarkEventsAsCommitted() {
+	a.uncommitted = nil
+}
+
+func (a *TenantApprovalPolicyAggregate) Hydration(events []event.Event) error {
+	for _, ev := range events {
+		if err := a.apply(ev); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *TenantApprovalPolicyAggregate) apply(ev event.Event) error {
+	switch e := ev.(type) {
+	case *event.TenantApprovalPolicyConfiguredEvent:
+		policy, err := value.NewApprovalPolicy(e.GetThreshold(), e.GetApproverIDs(), e.GetDeadlineHours())
+		if err != nil {
+			return err
+		}
+		a.policyID = e.GetAggregateID()
+		a.tenantID = e.GetTenantID()
+		a.policy = policy
+	default:
+		return nil
+	}
+	a.version = ev.GetVersion()
+	return nil
+}
+
+func (a *TenantApprovalPolicyAggregate) ExecuteConfigureTenantApprovalPolicyCommand(cmd command.ConfigureTenantApprovalPolicyCommand) error {
+	if a.version != -1 && a.policy.Equal(cmd.ApprovalPolicy) {
+		return nil
+	}
+
+	version := a.version + 1
+	if a.version == -1 {
+		version = 1
+	}
+
+	policy := cmd.ApprovalPolicy
+	ev := event.NewTenantApprovalPolicyConfiguredEvent(
+		ApprovalPolicyIDForTenant(cmd.TenantID),
+		version,
+		cmd.TenantID,
+		policy.Threshold(),
+		policy.ApproverIDs(),
+		policy.DeadlineHours(),
+	)
+	if err := a.apply(ev); err != nil {
+		return err
+	}
+	a.uncommitted = append(a.uncommitted, ev)
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package aggregate_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+func TestTenantApprovalPolicyAggregate_ExecuteConfigureTenantApprovalPolicyCommand(t *testing.T) {
+	tenantID := uuid.New()
+	approverID := uuid.New()
+	policy, err := value.NewApprovalPolicy(100000, []uuid.UUID{approverID}, 48)
+	require.NoError(t, err)
+	stricter, err := value.NewApprovalPolicy(50000, []uuid.UUID{approverID}, 24)
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		existing    *value.ApprovalPolicy
+		policy      value.ApprovalPolicy
+		wantEvents  []string
+		wantVersion int
+	}{
+		"first configuration": {
+			policy:      policy,
+			wantEvents:  []string{"TenantApprovalPolicyConfiguredEvent"},
+			wantVersion: 1,
+		},
+		"changed policy": {
+			existing:    &policy,
+			policy:      stricter,
+			wantEvents:  []string{"TenantApprovalPolicyConfiguredEvent"},
+			wantVersion: 2,
+		},
+		"unchanged policy is a no-op": {
+			existing:    &policy,
+			policy:      policy,
+			wantEvents:  []string{},
+			wantVersion: 1,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			approvalPolicy := aggregate.NewTenantApprovalPolicyAggregate()
+			if tt.existing != nil {
+				require.NoError(t, approvalPolicy.ExecuteConfigureTenantApprovalPolicyCommand(command.ConfigureTenantApprovalPolicyCommand{
+					TenantID:       tenantID,
+					ApprovalPolicy: *tt.existing,
+				}))
+				approvalPolicy.MarkEventsAsCommitted()
+			}
+
+			// Act
+			err := approvalPolicy.ExecuteConfigureTenantApprovalPolicyCommand(command.ConfigureTenantApprovalPolicyCommand{
+				TenantID:       tenantID,
+				ApprovalPolicy: tt.policy,
+			})
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantEvents, eventTypes(approvalPolicy.GetUncommittedEvents()))
+			assert.Equal(t, tt.wantVersion, approvalPolicy.GetVersion())
+			assert.True(t, tt.policy.Equal(approvalPolicy.GetApprovalPolicy()))
+			assert.Equal(t, aggregate.ApprovalPolicyIDForTenant(tenantID), approvalPolicy.GetAggregateID())
+		})
+	}
+}
+
+func TestTenantApprovalPolicyAggregate_NotConfigured(t *testing.T) {
+	t.Parallel()
+
+	// Act
+	approvalPolicy := aggregate.NewTenantApprovalPolicyAggregate()
+
+	// Assert
+	assert.False(t, approvalPolicy.GetApprovalPolicy().IsConfigured())
+	assert.Equal(t, -1, approvalPolicy.GetVersion())
+}
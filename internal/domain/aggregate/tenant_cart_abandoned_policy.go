@@ -1,20 +1,68 @@
 package aggregate
 
 import (
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/service"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
 )
 
+var (
+	ErrCartExpiryDaysInvalid        = errors.InvalidParameter.New("cart expiry days must be greater than or equal to 0")
+	ErrAbandonmentSegmentNotFound   = errors.NotFound.New("abandonment segment not found")
+	ErrAbandonmentPolicyNotCreated  = errors.UnpermittedOp.New("tenant policy not created")
+	ErrPolicyEffectivePeriodInvalid = errors.InvalidParameter.New("a scheduled policy change needs an effective-from date before its effective-to date")
+)
+
+// TenantCartAbandonedPolicyVersion is one version of the tenant's default
+// policy. Updates apply from when they were made until the next update;
+// scheduled changes apply for their own period and win over updates.
+type TenantCartAbandonedPolicyVersion struct {
+	Version          int
+	Title            string
+	AbandonedMinutes int
+	QuietTimeFrom    time.Time
+	QuietTimeTo      time.Time
+	CartExpiryDays   int
+	EffectiveFrom    time.Time
+	EffectiveTo      time.Time
+	Scheduled        bool
+	RecordedAt       time.Time
+}
+
+// Covers reports whether the version is effective at t. A zero EffectiveTo
+// is open-ended.
+func (v TenantCartAbandonedPolicyVersion) Covers(t time.Time) bool {
+	if t.Before(v.EffectiveFrom) {
+		return false
+	}
+	return v.EffectiveTo.IsZero() || t.Before(v.EffectiveTo)
+}
+
+func (v TenantCartAbandonedPolicyVersion) CartAbandonedDelay() time.Duration {
+	return time.Duration(v.AbandonedMinutes) * time.Minute
+}
+
+// CartExpiryDelay is how long a cart may stay idle before it is closed. Zero
+// means carts of the tenant never expire.
+func (v TenantCartAbandonedPolicyVersion) CartExpiryDelay() time.Duration {
+	return time.Duration(v.CartExpiryDays) * 24 * time.Hour
+}
+
 type TenantCartAbandonedPolicyAggregate struct {
 	tenantID             uuid.UUID
 	title                string
 	cartAbandonedMinutes int
 	quietTimeFrom        time.Time
 	quietTimeTo          time.Time
+	cartExpiryDays       int
+	segments             []value.AbandonmentSegment
+	versions             []TenantCartAbandonedPolicyVersion
 	version              int
 	uncommitted          []event.Event
 }
@@ -30,6 +78,16 @@ func (a *TenantCartAbandonedPolicyAggregate) GetAggregateID() uuid.UUID { return
 func (a *TenantCartAbandonedPolicyAggregate) GetVersion() int           { return a.version }
 func (a *TenantCartAbandonedPolicyAggregate) GetTitle() string          { return a.title }
 
+func (a *TenantCartAbandonedPolicyAggregate) GetSegments() []value.AbandonmentSegment {
+	return slices.Clone(a.segments)
+}
+
+// GetPolicyVersions returns every version of the default policy in the
+// order they were recorded, including scheduled changes still to come.
+func (a *TenantCartAbandonedPolicyAggregate) GetPolicyVersions() []TenantCartAbandonedPolicyVersion {
+	return slices.Clone(a.versions)
+}
+
 func (a *TenantCartAbandonedPolicyAggregate) GetUncommittedEvents() []event.Event {
 	return a.uncommitted
 }
@@ -40,12 +98,14 @@ func (a *TenantCartAbandonedPolicyAggregate) MarkEventsAsCommitted() {
 
 func (a *TenantCartAbandonedPolicyAggregate) Hydration(events []event.Event) error {
 	for _, ev := range events {
-		a.apply(ev)
+		if err := a.apply(ev); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
-func (a *TenantCartAbandonedPolicyAggregate) apply(ev event.Event) {
+func (a *TenantCartAbandonedPolicyAggregate) apply(ev event.Event) error {
 	switch e := ev.(type) {
 	case *event.TenantCartAbandonedPolicyCreatedEvent:
 		a.tenantID = e.GetAggregateID()
@@ -53,19 +113,129 @@ func (a *TenantCartAbandonedPolicyAggregate) apply(ev event.Event) {
 		a.cartAbandonedMinutes = e.GetAbandonedMinutes()
 		a.quietTimeFrom = e.GetQuietTimeFrom()
 		a.quietTimeTo = e.GetQuietTimeTo()
+		a.cartExpiryDays = e.GetCartExpiryDays()
 		a.version = e.GetVersion()
+		a.recordUpdate(e.GetTimestamp())
 	case *event.TenantCartAbandonedPolicyUpdatedEvent:
 		a.title = e.GetTitle()
 		a.cartAbandonedMinutes = e.GetAbandonedMinutes()
 		a.quietTimeFrom = e.GetQuietTimeFrom()
 		a.quietTimeTo = e.GetQuietTimeTo()
+		a.cartExpiryDays = e.GetCartExpiryDays()
+		a.version = e.GetVersion()
+		a.recordUpdate(e.GetTimestamp())
+	case *event.TenantCartAbandonedPolicyChangeScheduledEvent:
+		a.versions = append(a.versions, TenantCartAbandonedPolicyVersion{
+			Version:          e.GetVersion(),
+			Title:            e.GetTitle(),
+			AbandonedMinutes: e.GetAbandonedMinutes(),
+			QuietTimeFrom:    e.GetQuietTimeFrom(),
+			QuietTimeTo:      e.GetQuietTimeTo(),
+			CartExpiryDays:   e.GetCartExpiryDays(),
+			EffectiveFrom:    e.GetEffectiveFrom(),
+			EffectiveTo:      e.GetEffectiveTo(),
+			Scheduled:        true,
+			RecordedAt:       e.GetTimestamp(),
+		})
+		a.version = e.GetVersion()
+	case *event.TenantCartAbandonedPolicySegmentConfiguredEvent:
+		segment, err := segmentOf(e)
+		if err != nil {
+			return err
+		}
+		a.removeSegment(segment.ID())
+		a.segments = append(a.segments, segment)
+		a.version = e.GetVersion()
+	case *event.TenantCartAbandonedPolicySegmentRemovedEvent:
+		a.removeSegment(e.GetSegmentID())
 		a.version = e.GetVersion()
 	default:
 	}
+	return nil
 }
 
-func (a *TenantCartAbandonedPolicyAggregate) CartAbandonedDelay() time.Duration {
-	return time.Duration(a.cartAbandonedMinutes) * time.Minute
+// recordUpdate ends the previous update and starts a version with the
+// current settings.
+func (a *TenantCartAbandonedPolicyAggregate) recordUpdate(at time.Time) {
+	for i := len(a.versions) - 1; i >= 0; i-- {
+		if !a.versions[i].Scheduled {
+			a.versions[i].EffectiveTo = at
+			break
+		}
+	}
+	a.versions = append(a.versions, a.currentVersion(at))
+}
+
+func (a *TenantCartAbandonedPolicyAggregate) currentVersion(at time.Time) TenantCartAbandonedPolicyVersion {
+	return TenantCartAbandonedPolicyVersion{
+		Version:          a.version,
+		Title:            a.title,
+		AbandonedMinutes: a.cartAbandonedMinutes,
+		QuietTimeFrom:    a.quietTimeFrom,
+		QuietTimeTo:      a.quietTimeTo,
+		CartExpiryDays:   a.cartExpiryDays,
+		EffectiveFrom:    at,
+		RecordedAt:       at,
+	}
+}
+
+// PolicyAt resolves the default policy that applies at t. A scheduled change
+// covering t wins over the updates, the latest one if they overlap. Times
+// before the policy was created get its first version.
+func (a *TenantCartAbandonedPolicyAggregate) PolicyAt(t time.Time) TenantCartAbandonedPolicyVersion {
+	for i := len(a.versions) - 1; i >= 0; i-- {
+		if a.versions[i].Scheduled && a.versions[i].Covers(t) {
+			return a.versions[i]
+		}
+	}
+
+	for i := len(a.versions) - 1; i >= 0; i-- {
+		if !a.versions[i].Scheduled && a.versions[i].Covers(t) {
+			return a.versions[i]
+		}
+	}
+
+	for _, v := range a.versions {
+		if !v.Scheduled {
+			return v
+		}
+	}
+	return a.currentVersion(t)
+}
+
+func segmentOf(e *event.TenantCartAbandonedPolicySegmentConfiguredEvent) (value.AbandonmentSegment, error) {
+	customerSegment, err := value.NewCustomerSegment(e.GetCustomerSegment())
+	if err != nil {
+		return value.AbandonmentSegment{}, err
+	}
+
+	conditions, err := value.NewAbandonmentConditions(e.GetMinCartTotal(), e.GetMaxCartTotal(), e.GetCategories(), customerSegment)
+	if err != nil {
+		return value.AbandonmentSegment{}, err
+	}
+
+	return value.NewAbandonmentSegment(e.GetSegmentID(), e.GetTitle(), e.GetPriority(), e.GetAbandonedMinutes(), conditions)
+}
+
+func (a *TenantCartAbandonedPolicyAggregate) removeSegment(segmentID uuid.UUID) {
+	a.segments = slices.DeleteFunc(a.segments, func(s value.AbandonmentSegment) bool {
+		return s.ID() == segmentID
+	})
+}
+
+func (a *TenantCartAbandonedPolicyAggregate) findSegment(segmentID uuid.UUID) (value.AbandonmentSegment, bool) {
+	for _, segment := range a.segments {
+		if segment.ID() == segmentID {
+			return segment, true
+		}
+	}
+	return value.AbandonmentSegment{}, false
+}
+
+// SelectSegment picks the segment policy for the cart. It reports false when
+// no segment matches, in which case the default policy applies.
+func (a *TenantCartAbandonedPolicyAggregate) SelectSegment(cart service.AbandonmentCart) (value.AbandonmentSegment, bool) {
+	return service.NewAbandonmentPolicySelector().Select(a.segments, cart)
 }
 
 func (a *TenantCartAbandonedPolicyAggregate) IsWithinQuietTime(now time.Time) (bool, error) {
@@ -95,6 +265,10 @@ func (a *TenantCartAbandonedPolicyAggregate) ExecuteCreateTenantCartAbandonedPol
 		return errors.UnpermittedOp.New("tenant policy already exists")
 	}
 
+	if cmd.CartExpiryDays < 0 {
+		return ErrCartExpiryDaysInvalid
+	}
+
 	ev := event.NewTenantCartAbandonedPolicyCreatedEvent(
 		cmd.TenantID,
 		1,
@@ -102,6 +276,7 @@ func (a *TenantCartAbandonedPolicyAggregate) ExecuteCreateTenantCartAbandonedPol
 		cmd.AbandonedMinutes,
 		cmd.QuietTimeFrom,
 		cmd.QuietTimeTo,
+		cmd.CartExpiryDays,
 	)
 	a.apply(ev)
 	a.uncommitted = append(a.uncommitted, ev)
@@ -111,13 +286,22 @@ func (a *TenantCartAbandonedPolicyAggregate) ExecuteCreateTenantCartAbandonedPol
 
 func (a *TenantCartAbandonedPolicyAggregate) ExecuteUpdateTenantCartAbandonedPolicyCommand(cmd command.UpdateTenantCartAbandonedPolicyCommand) error {
 	if a.version == -1 {
-		return errors.UnpermittedOp.New("tenant policy not created")
+		return ErrAbandonmentPolicyNotCreated
+	}
+
+	if cmd.CartExpiryDays < 0 {
+		return ErrCartExpiryDaysInvalid
+	}
+
+	if !cmd.EffectiveFrom.IsZero() || !cmd.EffectiveTo.IsZero() {
+		return a.scheduleChange(cmd)
 	}
 
 	if a.title == cmd.Title &&
 		a.cartAbandonedMinutes == cmd.AbandonedMinutes &&
 		a.quietTimeFrom.Equal(cmd.QuietTimeFrom) &&
-		a.quietTimeTo.Equal(cmd.QuietTimeTo) {
+		a.quietTimeTo.Equal(cmd.QuietTimeTo) &&
+		a.cartExpiryDays == cmd.CartExpiryDays {
 		return nil
 	}
 
@@ -128,9 +312,85 @@ func (a *TenantCartAbandonedPolicyAggregate) ExecuteUpdateTenantCartAbandonedPol
 		cmd.AbandonedMinutes,
 		cmd.QuietTimeFrom,
 		cmd.QuietTimeTo,
+		cmd.CartExpiryDays,
 	)
 	a.apply(ev)
 	a.uncommitted = append(a.uncommitted, ev)
 
 	return nil
 }
+
+func (a *TenantCartAbandonedPolicyAggregate) scheduleChange(cmd command.UpdateTenantCartAbandonedPolicyCommand) error {
+	if cmd.EffectiveFrom.IsZero() || cmd.EffectiveTo.IsZero() || !cmd.EffectiveFrom.Before(cmd.EffectiveTo) {
+		return ErrPolicyEffectivePeriodInvalid
+	}
+
+	ev := event.NewTenantCartAbandonedPolicyChangeScheduledEvent(
+		a.tenantID,
+		a.version+1,
+		cmd.Title,
+		cmd.AbandonedMinutes,
+		cmd.QuietTimeFrom,
+		cmd.QuietTimeTo,
+		cmd.CartExpiryDays,
+		cmd.EffectiveFrom,
+		cmd.EffectiveTo,
+	)
+	if err := a.apply(ev); err != nil {
+		return err
+	}
+	a.uncommitted = append(a.uncommitted, ev)
+
+	return nil
+}
+
+// ExecuteConfigureCartAbandonedPolicySegmentCommand adds the segment, or
+// replaces the one with the same ID.
+func (a *TenantCartAbandonedPolicyAggregate) ExecuteConfigureCartAbandonedPolicySegmentCommand(cmd command.ConfigureCartAbandonedPolicySegmentCommand) error {
+	if a.version == -1 {
+		return ErrAbandonmentPolicyNotCreated
+	}
+
+	segment := cmd.Segment
+	if existing, ok := a.findSegment(segment.ID()); ok && existing.Equal(segment) {
+		return nil
+	}
+
+	conditions := segment.Conditions()
+	ev := event.NewTenantCartAbandonedPolicySegmentConfiguredEvent(
+		a.tenantID,
+		a.version+1,
+		segment.ID(),
+		segment.Title(),
+		segment.Priority(),
+		segment.AbandonedMinutes(),
+		conditions.MinCartTotal(),
+		conditions.MaxCartTotal(),
+		conditions.Categories(),
+		conditions.CustomerSegment().String(),
+	)
+	if err := a.apply(ev); err != nil {
+		return err
+	}
+	a.uncommitted = append(a.uncommitted, ev)
+
+	return nil
+}
+
+func (a *TenantCartAbandonedPolicyAggregate) ExecuteRemoveCartAbandonedPolicySegmentCommand(cmd command.RemoveCartAbandonedPolicySegmentCommand) error {
+	if a.version == -1 {
+		return ErrAbandonmentPolicyNotCreated
+	}
+
+	if _, ok := a.findSegment(cmd.SegmentID); !ok {
+		return ErrAbandonmentSegmentNotFound
+	}
+
+	ev := event.NewTenantCartAbandonedPolicySegmentRemovedEvent(a.tenantID, a.version+1, cmd.SegmentID)
+	if err := a.apply(ev); err != nil {
+		return err
+	}
+	a.uncommitted = append(a.uncommitted, ev)
+
+	return nil
+}
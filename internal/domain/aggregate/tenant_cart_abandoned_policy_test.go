@@ -10,6 +10,8 @@ import (
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/service"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
 )
 
@@ -172,24 +174,26 @@ func TestTenantCartAbandonedPolicyAggregate_ExecuteUpdateTenantCartAbandonedPoli
 	}
 }
 
-func TestTenantCartAbandonedPolicyAggregate_CartAbandonedDelay(t *testing.T) {
+func TestTenantCartAbandonedPolicyVersion_CartExpiryDelay(t *testing.T) {
 	tenantID := uuid.New()
 
 	tests := map[string]struct {
-		abandonedMinutes int
-		want             time.Duration
+		cartExpiryDays int
+		want           time.Duration
+		wantErr        error
 	}{
-		"should return 30 minutes": {
-			abandonedMinutes: 30,
-			want:             30 * time.Minute,
+		"should never expire when days are zero": {
+			cartExpiryDays: 0,
+			want:           0,
 		},
-		"should return 60 minutes": {
-			abandonedMinutes: 60,
-			want:             60 * time.Minute,
+		"should return 7 days": {
+			cartExpiryDays: 7,
+			want:           7 * 24 * time.Hour,
 		},
-		"should return 120 minutes": {
-			abandonedMinutes: 120,
-			want:             120 * time.Minute,
+		"should reject negative days": {
+			cartExpiryDays: -1,
+			want:           0,
+			wantErr:        aggregate.ErrCartExpiryDaysInvalid,
 		},
 	}
 
@@ -202,15 +206,20 @@ func TestTenantCartAbandonedPolicyAggregate_CartAbandonedDelay(t *testing.T) {
 			cmd := command.CreateTenantCartAbandonedPolicyCommand{
 				TenantID:         tenantID,
 				Title:            "Test Policy",
-				AbandonedMinutes: tt.abandonedMinutes,
-				QuietTimeFrom:    time.Date(2023, 1, 1, 22, 0, 0, 0, time.UTC),
-				QuietTimeTo:      time.Date(2023, 1, 1, 8, 0, 0, 0, time.UTC),
+				AbandonedMinutes: 30,
+				CartExpiryDays:   tt.cartExpiryDays,
 			}
-			policy.ExecuteCreateTenantCartAbandonedPolicyCommand(cmd)
 
-			// Act & Assert
-			got := policy.CartAbandonedDelay()
-			assert.Equal(t, tt.want, got)
+			// Act
+			err := policy.ExecuteCreateTenantCartAbandonedPolicyCommand(cmd)
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.want, policy.PolicyAt(time.Now()).CartExpiryDelay())
 		})
 	}
 }
@@ -303,6 +312,7 @@ func TestTenantCartAbandonedPolicyAggregate_Hydration(t *testing.T) {
 					30,
 					time.Date(2023, 1, 1, 22, 0, 0, 0, time.UTC),
 					time.Date(2023, 1, 1, 8, 0, 0, 0, time.UTC),
+					0,
 				),
 			},
 			wantVersion: 1,
@@ -317,6 +327,7 @@ func TestTenantCartAbandonedPolicyAggregate_Hydration(t *testing.T) {
 					30,
 					time.Date(2023, 1, 1, 22, 0, 0, 0, time.UTC),
 					time.Date(2023, 1, 1, 8, 0, 0, 0, time.UTC),
+					0,
 				),
 				event.NewTenantCartAbandonedPolicyUpdatedEvent(
 					tenantID,
@@ -325,6 +336,7 @@ func TestTenantCartAbandonedPolicyAggregate_Hydration(t *testing.T) {
 					60,
 					time.Date(2023, 1, 1, 23, 0, 0, 0, time.UTC),
 					time.Date(2023, 1, 1, 7, 0, 0, 0, time.UTC),
+					0,
 				),
 			},
 			wantVersion: 2,
@@ -393,3 +405,281 @@ func TestTenantCartAbandonedPolicyAggregate_MarkEventsAsCommitted(t *testing.T)
 		})
 	}
 }
+
+func policyWithSegment(t *testing.T, tenantID, segmentID uuid.UUID) *aggregate.TenantCartAbandonedPolicyAggregate {
+	t.Helper()
+
+	policy := aggregate.NewTenantCartAbandonedPolicyAggregate()
+	err := policy.Hydration([]event.Event{
+		event.NewTenantCartAbandonedPolicyCreatedEvent(tenantID, 1, "Default", 60, time.Time{}, time.Time{}, 0),
+		event.NewTenantCartAbandonedPolicySegmentConfiguredEvent(tenantID, 2, segmentID, "High value carts", 0, 10, 50000, 0, []string{}, ""),
+	})
+	assert.NoError(t, err)
+	return policy
+}
+
+func highValueSegment(t *testing.T, segmentID uuid.UUID, minutes int) value.AbandonmentSegment {
+	t.Helper()
+
+	conditions, err := value.NewAbandonmentConditions(50000, 0, nil, value.CustomerSegmentAny)
+	assert.NoError(t, err)
+	segment, err := value.NewAbandonmentSegment(segmentID, "High value carts", 0, minutes, conditions)
+	assert.NoError(t, err)
+	return segment
+}
+
+func TestTenantCartAbandonedPolicyAggregate_ExecuteConfigureCartAbandonedPolicySegmentCommand(t *testing.T) {
+	tenantID := uuid.New()
+	segmentID := uuid.New()
+
+	tests := map[string]struct {
+		policy       func(t *testing.T) *aggregate.TenantCartAbandonedPolicyAggregate
+		segment      func(t *testing.T) value.AbandonmentSegment
+		wantErr      error
+		wantEvents   []string
+		wantSegments int
+	}{
+		"adds a segment": {
+			policy: func(t *testing.T) *aggregate.TenantCartAbandonedPolicyAggregate {
+				return policyWithSegment(t, tenantID, segmentID)
+			},
+			segment:      func(t *testing.T) value.AbandonmentSegment { return highValueSegment(t, uuid.New(), 5) },
+			wantEvents:   []string{"TenantCartAbandonedPolicySegmentConfiguredEvent"},
+			wantSegments: 2,
+		},
+		"replaces a segment with the same id": {
+			policy: func(t *testing.T) *aggregate.TenantCartAbandonedPolicyAggregate {
+				return policyWithSegment(t, tenantID, segmentID)
+			},
+			segment:      func(t *testing.T) value.AbandonmentSegment { return highValueSegment(t, segmentID, 5) },
+			wantEvents:   []string{"TenantCartAbandonedPolicySegmentConfiguredEvent"},
+			wantSegments: 1,
+		},
+		"unchanged segment is a no-op": {
+			policy: func(t *testing.T) *aggregate.TenantCartAbandonedPolicyAggregate {
+				return policyWithSegment(t, tenantID, segmentID)
+			},
+			segment:      func(t *testing.T) value.AbandonmentSegment { return highValueSegment(t, segmentID, 10) },
+			wantEvents:   []string{},
+			wantSegments: 1,
+		},
+		"policy must exist": {
+			policy: func(t *testing.T) *aggregate.TenantCartAbandonedPolicyAggregate {
+				return aggregate.NewTenantCartAbandonedPolicyAggregate()
+			},
+			segment:    func(t *testing.T) value.AbandonmentSegment { return highValueSegment(t, segmentID, 10) },
+			wantErr:    aggregate.ErrAbandonmentPolicyNotCreated,
+			wantEvents: []string{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			policy := tt.policy(t)
+
+			// Act
+			err := policy.ExecuteConfigureCartAbandonedPolicySegmentCommand(command.ConfigureCartAbandonedPolicySegmentCommand{
+				TenantID: tenantID,
+				Segment:  tt.segment(t),
+			})
+
+			// Assert
+			assert.Equal(t, tt.wantEvents, eventTypes(policy.GetUncommittedEvents()))
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, policy.GetSegments(), tt.wantSegments)
+		})
+	}
+}
+
+func TestTenantCartAbandonedPolicyAggregate_ExecuteRemoveCartAbandonedPolicySegmentCommand(t *testing.T) {
+	tenantID := uuid.New()
+	segmentID := uuid.New()
+
+	tests := map[string]struct {
+		segmentID  uuid.UUID
+		wantErr    error
+		wantEvents []string
+	}{
+		"removes the segment": {
+			segmentID:  segmentID,
+			wantEvents: []string{"TenantCartAbandonedPolicySegmentRemovedEvent"},
+		},
+		"unknown segment": {
+			segmentID:  uuid.New(),
+			wantErr:    aggregate.ErrAbandonmentSegmentNotFound,
+			wantEvents: []string{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			policy := policyWithSegment(t, tenantID, segmentID)
+
+			// Act
+			err := policy.ExecuteRemoveCartAbandonedPolicySegmentCommand(command.RemoveCartAbandonedPolicySegmentCommand{
+				TenantID:  tenantID,
+				SegmentID: tt.segmentID,
+			})
+
+			// Assert
+			assert.Equal(t, tt.wantEvents, eventTypes(policy.GetUncommittedEvents()))
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Empty(t, policy.GetSegments())
+		})
+	}
+}
+
+func TestTenantCartAbandonedPolicyAggregate_SelectSegment(t *testing.T) {
+	tenantID := uuid.New()
+	segmentID := uuid.New()
+
+	tests := map[string]struct {
+		cart      service.AbandonmentCart
+		wantFound bool
+	}{
+		"high value cart gets the segment": {
+			cart:      service.AbandonmentCart{Total: 500000},
+			wantFound: true,
+		},
+		"small cart falls back to the default policy": {
+			cart: service.AbandonmentCart{Total: 500},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			policy := policyWithSegment(t, tenantID, segmentID)
+
+			// Act
+			segment, found := policy.SelectSegment(tt.cart)
+
+			// Assert
+			assert.Equal(t, tt.wantFound, found)
+			if tt.wantFound {
+				assert.Equal(t, segmentID, segment.ID())
+				assert.Equal(t, 10, segment.AbandonedMinutes())
+			}
+		})
+	}
+}
+
+func TestTenantCartAbandonedPolicyAggregate_ScheduleChange(t *testing.T) {
+	tenantID := uuid.New()
+	holidayFrom := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+	holidayTo := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		effectiveFrom time.Time
+		effectiveTo   time.Time
+		wantErr       error
+		wantEvents    []string
+	}{
+		"schedules a holiday-season change": {
+			effectiveFrom: holidayFrom,
+			effectiveTo:   holidayTo,
+			wantEvents:    []string{"TenantCartAbandonedPolicyChangeScheduledEvent"},
+		},
+		"effective-to is required": {
+			effectiveFrom: holidayFrom,
+			wantErr:       aggregate.ErrPolicyEffectivePeriodInvalid,
+			wantEvents:    []string{},
+		},
+		"period must not be reversed": {
+			effectiveFrom: holidayTo,
+			effectiveTo:   holidayFrom,
+			wantErr:       aggregate.ErrPolicyEffectivePeriodInvalid,
+			wantEvents:    []string{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			policy := aggregate.NewTenantCartAbandonedPolicyAggregate()
+			assert.NoError(t, policy.Hydration([]event.Event{
+				event.NewTenantCartAbandonedPolicyCreatedEvent(tenantID, 1, "Default", 30, time.Time{}, time.Time{}, 0),
+			}))
+
+			// Act
+			err := policy.ExecuteUpdateTenantCartAbandonedPolicyCommand(command.UpdateTenantCartAbandonedPolicyCommand{
+				TenantID:         tenantID,
+				Title:            "Holiday season",
+				AbandonedMinutes: 120,
+				EffectiveFrom:    tt.effectiveFrom,
+				EffectiveTo:      tt.effectiveTo,
+			})
+
+			// Assert
+			assert.Equal(t, tt.wantEvents, eventTypes(policy.GetUncommittedEvents()))
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			// The default policy itself is left alone until the period starts
+			assert.Equal(t, "Default", policy.GetTitle())
+			assert.Len(t, policy.GetPolicyVersions(), 2)
+		})
+	}
+}
+
+func TestTenantCartAbandonedPolicyAggregate_PolicyAt(t *testing.T) {
+	tenantID := uuid.New()
+	createdAt := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC)
+	holidayFrom := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+	holidayTo := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+
+	created := event.NewTenantCartAbandonedPolicyCreatedEvent(tenantID, 1, "Default", 30, time.Time{}, time.Time{}, 0)
+	created.Timestamp = createdAt
+	scheduled := event.NewTenantCartAbandonedPolicyChangeScheduledEvent(tenantID, 2, "Holiday season", 120, time.Time{}, time.Time{}, 0, holidayFrom, holidayTo)
+	scheduled.Timestamp = createdAt.Add(time.Hour)
+	updated := event.NewTenantCartAbandonedPolicyUpdatedEvent(tenantID, 3, "Default", 45, time.Time{}, time.Time{}, 0)
+	updated.Timestamp = updatedAt
+
+	policy := aggregate.NewTenantCartAbandonedPolicyAggregate()
+	assert.NoError(t, policy.Hydration([]event.Event{created, scheduled, updated}))
+
+	tests := map[string]struct {
+		at          time.Time
+		wantMinutes int
+	}{
+		"before the policy was created":     {at: createdAt.Add(-time.Hour), wantMinutes: 30},
+		"before the update":                 {at: updatedAt.Add(-time.Hour), wantMinutes: 30},
+		"after the update":                  {at: updatedAt.Add(time.Hour), wantMinutes: 45},
+		"scheduled change wins over update": {at: holidayFrom, wantMinutes: 120},
+		"effective-to is exclusive":         {at: holidayTo, wantMinutes: 45},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			version := policy.PolicyAt(tt.at)
+
+			// Assert
+			assert.Equal(t, tt.wantMinutes, version.AbandonedMinutes)
+			assert.Equal(t, time.Duration(tt.wantMinutes)*time.Minute, version.CartAbandonedDelay())
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package aggregate
+
+import (
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+var cartRulesNamespace = uuid.MustParse("4f81d2c6-93a7-4b5e-8c0d-6a2e1f7b9d43")
+
+func CartRulesIDForTenant(tenantID uuid.UUID) uuid.UUID {
+	return uuid.NewSHA1(cartRulesNamespace, tenantID[:])
+}
+
+type TenantCartRulesAggregate struct {
+	rulesID     uuid.UUID
+	tenantID    uuid.UUID
+	rules       value.CartRules
+	version     int
+	uncommitted []event.Event
+}
+
+func NewTenantCartRulesAggregate() *TenantCartRulesAggregate {
+	return &TenantCartRulesAggregate{
+		version:     -1,
+		uncommitted: make([]event.Event, 0),
+	}
+}
+
+func (a *TenantCartRulesAggregate) GetAggregateID() uuid.UUID { return a.rulesID }
+func (a *TenantCartRulesAggregate) GetVersion() int           { return a.version }
+func (a *TenantCartRulesAggregate) GetTenantID() uuid.UUID    { return a.tenantID }
+
+// GetCartRules returns the zero rules, which allow everything, until the
+// tenant configures some.
+func (a *TenantCartRulesAggregate) GetCartRules() value.CartRules { return a.rules }
+
+func (a *TenantCartRulesAggregate) GetUncommittedEvents() []event.Event {
+	return a.uncommitted
+}
+
+func (a *TenantCartRulesAggregate) MarkEventsAsCommitted() {
+	a.uncommitted = nil
+}
+
+func (a *TenantCartRulesAggregate) Hydration(events []event.Event) error {
+	for _, ev := range events {
+		if err := a.apply(ev); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *TenantCartRulesAggregate) apply(ev event.Event) error {
+	switch e := ev.(type) {
+	case *event.TenantCartRulesConfiguredEvent:
+		combinations := make([]value.CategoryCombination, 0, len(e.GetBlockedCategoryCombinations()))
+		for _, pair := range e.GetBlockedCategoryCombinations() {
+			if len(pair) != 2 {
+				return value.ErrCategoryCombinationInvalid
+			}
+			combination, err := value.NewCategoryCombination(pair[0], pair[1])
+			if err != nil {
+				return err
+			}
+			combinations = append(combinations, combination)
+		}
+
+		rules, err := value.NewCartRules(e.GetMaxLines(), e.GetMaxQuantityPerItem(), e.GetMinimumOrderValue(), combinations)
+		if err != nil {
+			return err
+		}
+		a.rulesID = e.GetAggregateID()
+		a.tenantID = e.GetTenantID()
+		a.rules = rules
+	default:
+		return nil
+	}
+	a.version = ev.GetVersion()
+	return nil
+}
+
+func (a *TenantCartRulesAggregate) ExecuteConfigureTenantCartRulesCommand(cmd command.ConfigureTenantCartRulesCommand) error {
+	if a.version != -1 && a.rules.Equal(cmd.CartRules) {
+		return nil
+	}
+
+	version := a.version + 1
+	if a.version == -1 {
+		version = 1
+	}
+
+	rules := cmd.CartRules
+	combinations := make([][]string, 0, len(rules.BlockedCombinations()))
+	for _, combination := range rules.BlockedCombinations() {
+		combinations = append(combinations, combination.Categories())
+	}
+
+	ev := event.NewTenantCartRulesConfiguredEvent(
+		CartRulesIDForTenant(cmd.TenantID),
+		version,
+		cmd.TenantID,
+		rules.MaxLines(),
+		rules.MaxQuantityPerItem(),
+		rules.MinimumOrderValue(),
+		combinations,
+	)
+	if err := a.apply(ev); err != nil {
+		return err
+	}
+	a.uncommitted = append(a.uncommitted, ev)
+
+	return nil
+}
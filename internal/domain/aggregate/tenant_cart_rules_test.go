@@ -0,0 +1,104 @@
+package aggregate_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+func TestTenantCartRulesAggregate_ExecuteConfigureTenantCartRulesCommand(t *testing.T) {
+	tenantID := uuid.New()
+	combination, err := value.NewCategoryCombination("alcohol", "kids")
+	require.NoError(t, err)
+	rules, err := value.NewCartRules(10, 5, 3000, []value.CategoryCombination{combination})
+	require.NoError(t, err)
+	stricter, err := value.NewCartRules(5, 5, 3000, []value.CategoryCombination{combination})
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		existing    *value.CartRules
+		rules       value.CartRules
+		wantEvents  []string
+		wantVersion int
+	}{
+		"first configuration": {
+			rules:       rules,
+			wantEvents:  []string{"TenantCartRulesConfiguredEvent"},
+			wantVersion: 1,
+		},
+		"changed rules": {
+			existing:    &rules,
+			rules:       stricter,
+			wantEvents:  []string{"TenantCartRulesConfiguredEvent"},
+			wantVersion: 2,
+		},
+		"unchanged rules are a no-op": {
+			existing:    &rules,
+			rules:       rules,
+			wantEvents:  []string{},
+			wantVersion: 1,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			cartRules := aggregate.NewTenantCartRulesAggregate()
+			if tt.existing != nil {
+				require.NoError(t, cartRules.ExecuteConfigureTenantCartRulesCommand(command.ConfigureTenantCartRulesCommand{
+					TenantID:  tenantID,
+					CartRules: *tt.existing,
+				}))
+				cartRules.MarkEventsAsCommitted()
+			}
+
+			// Act
+			err := cartRules.ExecuteConfigureTenantCartRulesCommand(command.ConfigureTenantCartRulesCommand{
+				TenantID:  tenantID,
+				CartRules: tt.rules,
+			})
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantEvents, eventTypes(cartRules.GetUncommittedEvents()))
+			assert.Equal(t, tt.wantVersion, cartRules.GetVersion())
+			assert.True(t, tt.rules.Equal(cartRules.GetCartRules()))
+			assert.Equal(t, aggregate.CartRulesIDForTenant(tenantID), cartRules.GetAggregateID())
+		})
+	}
+}
+
+func TestTenantCartRulesAggregate_Hydration(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	tenantID := uuid.New()
+	combination, err := value.NewCategoryCombination("kids", "alcohol")
+	require.NoError(t, err)
+	rules, err := value.NewCartRules(10, 0, 0, []value.CategoryCombination{combination})
+	require.NoError(t, err)
+
+	configured := aggregate.NewTenantCartRulesAggregate()
+	require.NoError(t, configured.ExecuteConfigureTenantCartRulesCommand(command.ConfigureTenantCartRulesCommand{
+		TenantID:  tenantID,
+		CartRules: rules,
+	}))
+	cartRules := aggregate.NewTenantCartRulesAggregate()
+
+	// Act
+	err = cartRules.Hydration(configured.GetUncommittedEvents())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, rules.Equal(cartRules.GetCartRules()))
+	assert.True(t, cartRules.GetCartRules().Blocks("alcohol", "kids"))
+	assert.Equal(t, tenantID, cartRules.GetTenantID())
+}
@@ -0,0 +1,122 @@
+package aggregate
+
+import (
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+var shippingRatesNamespace = uuid.MustParse("9c4d2e7a-3f1b-4a8c-b6d5-0e2f4a6c8b1d")
+
+func ShippingRatesIDForTenant(tenantID uuid.UUID) uuid.UUID {
+	return uuid.NewSHA1(shippingRatesNamespace, tenantID[:])
+}
+
+type TenantShippingRatesAggregate struct {
+	ratesID     uuid.UUID
+	tenantID    uuid.UUID
+	rates       []value.ShippingRate
+	version     int
+	uncommitted []event.Event
+}
+
+func NewTenantShippingRatesAggregate() *TenantShippingRatesAggregate {
+	return &TenantShippingRatesAggregate{
+		rates:       make([]value.ShippingRate, 0),
+		version:     -1,
+		uncommitted: make([]event.Event, 0),
+	}
+}
+
+func (a *TenantShippingRatesAggregate) GetAggregateID() uuid.UUID { return a.ratesID }
+func (a *TenantShippingRatesAggregate) GetVersion() int           { return a.version }
+func (a *TenantShippingRatesAggregate) GetTenantID() uuid.UUID    { return a.tenantID }
+
+func (a *TenantShippingRatesAggregate) GetRateTable() value.ShippingRateTable {
+	return value.NewShippingRateTable(a.rates)
+}
+
+func (a *TenantShippingRatesAggregate) GetUncommittedEvents() []event.Event {
+	return a.uncommitted
+}
+
+func (a *TenantShippingRatesAggregate) MarkEventsAsCommitted() {
+	a.uncommitted = nil
+}
+
+func (a *TenantShippingRatesAggregate) Hydration(events []event.Event) error {
+	for _, ev := range events {
+		if err := a.apply(ev); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *TenantShippingRatesAggregate) apply(ev event.Event) error {
+	switch e := ev.(type) {
+	case *event.ShippingRateSetEvent:
+		rate, err := value.NewShippingRate(e.GetMethod(), e.GetPrefecture(), e.GetMaxWeightGrams(), e.GetFee())
+		if err != nil {
+			return err
+		}
+		a.ratesID = e.GetAggregateID()
+		a.tenantID = e.GetTenantID()
+		a.setRate(rate)
+	default:
+		return nil
+	}
+	a.version = ev.GetVersion()
+	return nil
+}
+
+func (a *TenantShippingRatesAggregate) setRate(rate value.ShippingRate) {
+	for i, existing := range a.rates {
+		if existing.SameBand(rate) {
+			a.rates[i] = rate
+			return
+		}
+	}
+	a.rates = append(a.rates, rate)
+}
+
+func (a *TenantShippingRatesAggregate) findRate(rate value.ShippingRate) (value.ShippingRate, bool) {
+	for _, existing := range a.rates {
+		if existing.SameBand(rate) {
+			return existing, true
+		}
+	}
+	return value.ShippingRate{}, false
+}
+
+// ExecuteSetShippingRatesCommand upserts the given bands. Bands that are not
+// listed keep their current fee, and unchanged bands raise no event.
+func (a *TenantShippingRatesAggregate) ExecuteSetShippingRatesCommand(cmd command.SetShippingRatesCommand) error {
+	for _, rate := range cmd.Rates {
+		if existing, ok := a.findRate(rate); ok && existing == rate {
+			continue
+		}
+
+		version := a.version + 1
+		if a.version == -1 {
+			version = 1
+		}
+
+		ev := event.NewShippingRateSetEvent(
+			ShippingRatesIDForTenant(cmd.TenantID),
+			version,
+			cmd.TenantID,
+			rate.Method().String(),
+			rate.Prefecture().String(),
+			rate.MaxWeightGrams(),
+			rate.Fee(),
+		)
+		if err := a.apply(ev); err != nil {
+			return err
+		}
+		a.uncommitted = append(a.uncommitted, ev)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package aggregate_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+func TestTenantShippingRatesAggregate_ExecuteSetShippingRatesCommand(t *testing.T) {
+	tenantID := uuid.New()
+	tokyo, _ := value.NewShippingRate("STANDARD", "Tokyo", 2000, 800)
+	tokyoRaised, _ := value.NewShippingRate("STANDARD", "Tokyo", 2000, 900)
+	osaka, _ := value.NewShippingRate("STANDARD", "Osaka", 2000, 1000)
+
+	tests := map[string]struct {
+		existing    []value.ShippingRate
+		rates       []value.ShippingRate
+		wantEvents  []string
+		wantVersion int
+		wantTokyo   float64
+	}{
+		"first rates": {
+			rates:       []value.ShippingRate{tokyo, osaka},
+			wantEvents:  []string{"ShippingRateSetEvent", "ShippingRateSetEvent"},
+			wantVersion: 2,
+			wantTokyo:   800,
+		},
+		"changed fee replaces the band": {
+			existing:    []value.ShippingRate{tokyo, osaka},
+			rates:       []value.ShippingRate{tokyoRaised, osaka},
+			wantEvents:  []string{"ShippingRateSetEvent"},
+			wantVersion: 3,
+			wantTokyo:   900,
+		},
+		"unchanged rates are a no-op": {
+			existing:    []value.ShippingRate{tokyo},
+			rates:       []value.ShippingRate{tokyo},
+			wantEvents:  []string{},
+			wantVersion: 1,
+			wantTokyo:   800,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			rates := aggregate.NewTenantShippingRatesAggregate()
+			if tt.existing != nil {
+				require.NoError(t, rates.ExecuteSetShippingRatesCommand(command.SetShippingRatesCommand{
+					TenantID: tenantID,
+					Rates:    tt.existing,
+				}))
+				rates.MarkEventsAsCommitted()
+			}
+
+			// Act
+			err := rates.ExecuteSetShippingRatesCommand(command.SetShippingRatesCommand{
+				TenantID: tenantID,
+				Rates:    tt.rates,
+			})
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantEvents, eventTypes(rates.GetUncommittedEvents()))
+			assert.Equal(t, tt.wantVersion, rates.GetVersion())
+			assert.Equal(t, aggregate.ShippingRatesIDForTenant(tenantID), rates.GetAggregateID())
+			fee, err := rates.GetRateTable().FeeFor("STANDARD", "Tokyo", 1000)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantTokyo, fee)
+		})
+	}
+}
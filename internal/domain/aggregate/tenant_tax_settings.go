@@ -0,0 +1,99 @@
+package aggregate
+
+import (
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+// taxSettingsNamespace keeps the tax settings stream apart from the
+// abandonment policy stream, which already uses the tenant ID.
+var taxSettingsNamespace = uuid.MustParse("5e8a1f3c-7b2d-4c6e-9a0f-1d3b5c7e9f2a")
+
+func TaxSettingsIDForTenant(tenantID uuid.UUID) uuid.UUID {
+	return uuid.NewSHA1(taxSettingsNamespace, tenantID[:])
+}
+
+type TenantTaxSettingsAggregate struct {
+	settingsID  uuid.UUID
+	tenantID    uuid.UUID
+	settings    value.TaxSettings
+	version     int
+	uncommitted []event.Event
+}
+
+func NewTenantTaxSettingsAggregate() *TenantTaxSettingsAggregate {
+	return &TenantTaxSettingsAggregate{
+		settings:    value.DefaultTaxSettings(),
+		version:     -1,
+		uncommitted: make([]event.Event, 0),
+	}
+}
+
+func (a *TenantTaxSettingsAggregate) GetAggregateID() uuid.UUID { return a.settingsID }
+func (a *TenantTaxSettingsAggregate) GetVersion() int           { return a.version }
+func (a *TenantTaxSettingsAggregate) GetTenantID() uuid.UUID    { return a.tenantID }
+
+// GetTaxSettings falls back to the default settings until the tenant
+// configures its own.
+func (a *TenantTaxSettingsAggregate) GetTaxSettings() value.TaxSettings { return a.settings }
+
+func (a *TenantTaxSettingsAggregate) GetUncommittedEvents() []event.Event {
+	return a.uncommitted
+}
+
+func (a *TenantTaxSettingsAggregate) MarkEventsAsCommitted() {
+	a.uncommitted = nil
+}
+
+func (a *TenantTaxSettingsAggregate) Hydration(events []event.Event) error {
+	for _, ev := range events {
+		if err := a.apply(ev); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *TenantTaxSettingsAggregate) apply(ev event.Event) error {
+	switch e := ev.(type) {
+	case *event.TenantTaxSettingsConfiguredEvent:
+		settings, err := value.NewTaxSettings(e.GetTaxDisplay(), e.GetRoundingMode())
+		if err != nil {
+			return err
+		}
+		a.settingsID = e.GetAggregateID()
+		a.tenantID = e.GetTenantID()
+		a.settings = settings
+	default:
+		return nil
+	}
+	a.version = ev.GetVersion()
+	return nil
+}
+
+func (a *TenantTaxSettingsAggregate) ExecuteConfigureTenantTaxSettingsCommand(cmd command.ConfigureTenantTaxSettingsCommand) error {
+	if a.version != -1 && a.settings == cmd.TaxSettings {
+		return nil
+	}
+
+	version := a.version + 1
+	if a.version == -1 {
+		version = 1
+	}
+
+	ev := event.NewTenantTaxSettingsConfiguredEvent(
+		TaxSettingsIDForTenant(cmd.TenantID),
+		version,
+		cmd.TenantID,
+		string(cmd.TaxSettings.Display()),
+		string(cmd.TaxSettings.RoundingMode()),
+	)
+	if err := a.apply(ev); err != nil {
+		return err
+	}
+	a.uncommitted = append(a.uncommitted, ev)
+
+	return nil
+}
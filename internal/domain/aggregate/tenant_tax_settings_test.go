@@ -0,0 +1,94 @@
+package aggregate_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+func TestTenantTaxSettingsAggregate_ExecuteConfigureTenantTaxSettingsCommand(t *testing.T) {
+	tenantID := uuid.New()
+	inclusiveRound, err := value.NewTaxSettings("INCLUSIVE", "ROUND")
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		existing     *value.TaxSettings
+		settings     value.TaxSettings
+		wantEvents   []string
+		wantVersion  int
+		wantSettings value.TaxSettings
+	}{
+		"first configuration": {
+			settings:     inclusiveRound,
+			wantEvents:   []string{"TenantTaxSettingsConfiguredEvent"},
+			wantVersion:  1,
+			wantSettings: inclusiveRound,
+		},
+		"changed settings": {
+			existing:     func() *value.TaxSettings { s := value.DefaultTaxSettings(); return &s }(),
+			settings:     inclusiveRound,
+			wantEvents:   []string{"TenantTaxSettingsConfiguredEvent"},
+			wantVersion:  2,
+			wantSettings: inclusiveRound,
+		},
+		"unchanged settings are a no-op": {
+			existing:     &inclusiveRound,
+			settings:     inclusiveRound,
+			wantEvents:   []string{},
+			wantVersion:  1,
+			wantSettings: inclusiveRound,
+		},
+		"default settings can be configured explicitly": {
+			settings:     value.DefaultTaxSettings(),
+			wantEvents:   []string{"TenantTaxSettingsConfiguredEvent"},
+			wantVersion:  1,
+			wantSettings: value.DefaultTaxSettings(),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			settings := aggregate.NewTenantTaxSettingsAggregate()
+			if tt.existing != nil {
+				require.NoError(t, settings.ExecuteConfigureTenantTaxSettingsCommand(command.ConfigureTenantTaxSettingsCommand{
+					TenantID:    tenantID,
+					TaxSettings: *tt.existing,
+				}))
+				settings.MarkEventsAsCommitted()
+			}
+
+			// Act
+			err := settings.ExecuteConfigureTenantTaxSettingsCommand(command.ConfigureTenantTaxSettingsCommand{
+				TenantID:    tenantID,
+				TaxSettings: tt.settings,
+			})
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantEvents, eventTypes(settings.GetUncommittedEvents()))
+			assert.Equal(t, tt.wantVersion, settings.GetVersion())
+			assert.Equal(t, tt.wantSettings, settings.GetTaxSettings())
+			assert.Equal(t, aggregate.TaxSettingsIDForTenant(tenantID), settings.GetAggregateID())
+		})
+	}
+}
+
+func TestTenantTaxSettingsAggregate_DefaultsUntilConfigured(t *testing.T) {
+	t.Parallel()
+
+	// Act
+	settings := aggregate.NewTenantTaxSettingsAggregate()
+
+	// Assert
+	assert.Equal(t, value.DefaultTaxSettings(), settings.GetTaxSettings())
+	assert.Equal(t, -1, settings.GetVersion())
+}
@@ -0,0 +1,247 @@
+package aggregate_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+func tenantWithStatus(t *testing.T, tenantID uuid.UUID, status value.TenantStatus) *aggregate.TenantAggregate {
+	t.Helper()
+
+	streamID := aggregate.TenantIDForStream(tenantID)
+	events := []event.Event{
+		event.NewTenantOnboardedEvent(streamID, 1, tenantID, "Shop", "FREE", "ja-JP"),
+	}
+
+	switch status {
+	case value.TenantStatusSuspended:
+		events = append(events, event.NewTenantSuspendedEvent(streamID, 2, tenantID, "unpaid invoice"))
+	case value.TenantStatusClosed:
+		events = append(events, event.NewTenantClosedEvent(streamID, 2, tenantID))
+	}
+
+	tenant := aggregate.NewTenantAggregate()
+	assert.NoError(t, tenant.Hydration(events))
+	return tenant
+}
+
+func TestTenantAggregate_ExecuteOnboardTenantCommand(t *testing.T) {
+	tenantID := uuid.New()
+
+	tests := map[string]struct {
+		onboarded   bool
+		displayName string
+		wantErr     error
+		wantEvents  []string
+	}{
+		"onboards a new tenant": {
+			displayName: " Shop ",
+			wantEvents:  []string{"TenantOnboardedEvent"},
+		},
+		"display name is required": {
+			displayName: " ",
+			wantErr:     aggregate.ErrTenantDisplayNameRequired,
+			wantEvents:  []string{},
+		},
+		"tenant is onboarded once": {
+			onboarded:   true,
+			displayName: "Shop",
+			wantErr:     aggregate.ErrTenantAlreadyOnboarded,
+			wantEvents:  []string{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			tenant := aggregate.NewTenantAggregate()
+			if tt.onboarded {
+				tenant = tenantWithStatus(t, tenantID, value.TenantStatusActive)
+			}
+
+			// Act
+			err := tenant.ExecuteOnboardTenantCommand(command.OnboardTenantCommand{
+				TenantID:      tenantID,
+				DisplayName:   tt.displayName,
+				Plan:          value.TenantPlanStandard,
+				DefaultLocale: value.DefaultLocale,
+			})
+
+			// Assert
+			assert.Equal(t, tt.wantEvents, eventTypes(tenant.GetUncommittedEvents()))
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, aggregate.TenantIDForStream(tenantID), tenant.GetAggregateID())
+			assert.Equal(t, "Shop", tenant.GetDisplayName())
+			assert.Equal(t, value.TenantPlanStandard, tenant.GetPlan())
+			assert.Equal(t, value.TenantStatusActive, tenant.GetStatus())
+		})
+	}
+}
+
+func TestTenantAggregate_Lifecycle(t *testing.T) {
+	tenantID := uuid.New()
+
+	tests := map[string]struct {
+		status     value.TenantStatus
+		act        func(tenant *aggregate.TenantAggregate) error
+		wantErr    error
+		wantEvents []string
+		wantStatus value.TenantStatus
+	}{
+		"suspend an active tenant": {
+			status: value.TenantStatusActive,
+			act: func(tenant *aggregate.TenantAggregate) error {
+				return tenant.ExecuteSuspendTenantCommand(command.SuspendTenantCommand{TenantID: tenantID, Reason: "fraud review"})
+			},
+			wantEvents: []string{"TenantSuspendedEvent"},
+			wantStatus: value.TenantStatusSuspended,
+		},
+		"suspension needs a reason": {
+			status: value.TenantStatusActive,
+			act: func(tenant *aggregate.TenantAggregate) error {
+				return tenant.ExecuteSuspendTenantCommand(command.SuspendTenantCommand{TenantID: tenantID})
+			},
+			wantErr:    aggregate.ErrTenantSuspendReasonMissing,
+			wantEvents: []string{},
+			wantStatus: value.TenantStatusActive,
+		},
+		"suspending a suspended tenant is a no-op": {
+			status: value.TenantStatusSuspended,
+			act: func(tenant *aggregate.TenantAggregate) error {
+				return tenant.ExecuteSuspendTenantCommand(command.SuspendTenantCommand{TenantID: tenantID, Reason: "again"})
+			},
+			wantEvents: []string{},
+			wantStatus: value.TenantStatusSuspended,
+		},
+		"reactivate a suspended tenant": {
+			status: value.TenantStatusSuspended,
+			act: func(tenant *aggregate.TenantAggregate) error {
+				return tenant.ExecuteReactivateTenantCommand(command.ReactivateTenantCommand{TenantID: tenantID})
+			},
+			wantEvents: []string{"TenantReactivatedEvent"},
+			wantStatus: value.TenantStatusActive,
+		},
+		"close a suspended tenant": {
+			status: value.TenantStatusSuspended,
+			act: func(tenant *aggregate.TenantAggregate) error {
+				return tenant.ExecuteCloseTenantCommand(command.CloseTenantCommand{TenantID: tenantID})
+			},
+			wantEvents: []string{"TenantClosedEvent"},
+			wantStatus: value.TenantStatusClosed,
+		},
+		"closed tenant cannot be reactivated": {
+			status: value.TenantStatusClosed,
+			act: func(tenant *aggregate.TenantAggregate) error {
+				return tenant.ExecuteReactivateTenantCommand(command.ReactivateTenantCommand{TenantID: tenantID})
+			},
+			wantErr:    aggregate.ErrTenantClosed,
+			wantEvents: []string{},
+			wantStatus: value.TenantStatusClosed,
+		},
+		"closed tenant cannot change plan": {
+			status: value.TenantStatusClosed,
+			act: func(tenant *aggregate.TenantAggregate) error {
+				return tenant.ExecuteChangeTenantPlanCommand(command.ChangeTenantPlanCommand{TenantID: tenantID, Plan: value.TenantPlanEnterprise})
+			},
+			wantErr:    aggregate.ErrTenantClosed,
+			wantEvents: []string{},
+			wantStatus: value.TenantStatusClosed,
+		},
+		"suspended tenant can change plan": {
+			status: value.TenantStatusSuspended,
+			act: func(tenant *aggregate.TenantAggregate) error {
+				return tenant.ExecuteChangeTenantPlanCommand(command.ChangeTenantPlanCommand{TenantID: tenantID, Plan: value.TenantPlanEnterprise})
+			},
+			wantEvents: []string{"TenantPlanChangedEvent"},
+			wantStatus: value.TenantStatusSuspended,
+		},
+		"unchanged profile is a no-op": {
+			status: value.TenantStatusActive,
+			act: func(tenant *aggregate.TenantAggregate) error {
+				return tenant.ExecuteUpdateTenantProfileCommand(command.UpdateTenantProfileCommand{TenantID: tenantID, DisplayName: "Shop", DefaultLocale: "ja-JP"})
+			},
+			wantEvents: []string{},
+			wantStatus: value.TenantStatusActive,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			tenant := tenantWithStatus(t, tenantID, tt.status)
+
+			// Act
+			err := tt.act(tenant)
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantEvents, eventTypes(tenant.GetUncommittedEvents()))
+			assert.Equal(t, tt.wantStatus, tenant.GetStatus())
+		})
+	}
+}
+
+func TestTenantAggregate_CheckOpenForCarts(t *testing.T) {
+	tenantID := uuid.New()
+
+	tests := map[string]struct {
+		tenant  func(t *testing.T) *aggregate.TenantAggregate
+		wantErr error
+	}{
+		"tenant that was never onboarded": {
+			tenant: func(t *testing.T) *aggregate.TenantAggregate { return aggregate.NewTenantAggregate() },
+		},
+		"active tenant": {
+			tenant: func(t *testing.T) *aggregate.TenantAggregate {
+				return tenantWithStatus(t, tenantID, value.TenantStatusActive)
+			},
+		},
+		"suspended tenant": {
+			tenant: func(t *testing.T) *aggregate.TenantAggregate {
+				return tenantWithStatus(t, tenantID, value.TenantStatusSuspended)
+			},
+			wantErr: aggregate.ErrTenantSuspended,
+		},
+		"closed tenant": {
+			tenant: func(t *testing.T) *aggregate.TenantAggregate {
+				return tenantWithStatus(t, tenantID, value.TenantStatusClosed)
+			},
+			wantErr: aggregate.ErrTenantClosed,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			err := tt.tenant(t).CheckOpenForCarts()
+
+			// Assert
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
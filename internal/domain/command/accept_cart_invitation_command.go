@@ -0,0 +1,8 @@
+package command
+
+import "github.com/google/uuid"
+
+type AcceptCartInvitationCommand struct {
+	CartID uuid.UUID
+	UserID uuid.UUID
+}
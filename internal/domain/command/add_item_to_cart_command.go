@@ -1,12 +1,23 @@
 package command
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
 
+// AddItemToCartCommand creates the cart on its first item. Guest carts are
+// created with a SessionID instead of a UserID.
 type AddItemToCartCommand struct {
-	CartID   uuid.UUID
-	UserID   uuid.UUID
-	ItemID   uuid.UUID
-	Name     string
-	Price    float64
-	TenantID uuid.UUID
+	CartID      uuid.UUID
+	UserID      uuid.UUID
+	SessionID   value.SessionID
+	ItemID      uuid.UUID
+	Name        string
+	Price       float64
+	TenantID    uuid.UUID
+	TaxCategory string
+	WeightGrams int
+	Options     value.LineOptions
+	Category    string
+	CartRules   value.CartRules
 }
@@ -0,0 +1,15 @@
+package command
+
+import (
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+type ApplyCouponToCartCommand struct {
+	CartID    uuid.UUID
+	UserID    uuid.UUID
+	CouponID  uuid.UUID
+	Code      value.CouponCode
+	Promotion value.Promotion
+	Automatic bool
+}
@@ -0,0 +1,8 @@
+package command
+
+import "github.com/google/uuid"
+
+type AuthorizePaymentCommand struct {
+	PaymentID       uuid.UUID
+	AuthorizationID string
+}
@@ -0,0 +1,9 @@
+package command
+
+import "github.com/google/uuid"
+
+// CloseCartCommand closes a cart that has not changed since IdleSinceVersion.
+type CloseCartCommand struct {
+	CartID           uuid.UUID
+	IdleSinceVersion int
+}
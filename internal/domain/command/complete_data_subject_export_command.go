@@ -0,0 +1,7 @@
+package command
+
+type CompleteDataSubjectExportCommand struct {
+	Bundle     string
+	CartCount  int
+	EventCount int
+}
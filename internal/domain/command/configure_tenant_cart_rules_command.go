@@ -0,0 +1,11 @@
+package command
+
+import (
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+type ConfigureTenantCartRulesCommand struct {
+	TenantID  uuid.UUID
+	CartRules value.CartRules
+}
@@ -0,0 +1,14 @@
+package command
+
+import (
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+type CreateCouponCommand struct {
+	TenantID   uuid.UUID
+	Code       value.CouponCode
+	Promotion  value.Promotion
+	UsageLimit int
+	Automatic  bool
+}
@@ -12,4 +12,5 @@ type CreateTenantCartAbandonedPolicyCommand struct {
 	AbandonedMinutes int
 	QuietTimeFrom    time.Time
 	QuietTimeTo      time.Time
+	CartExpiryDays   int
 }
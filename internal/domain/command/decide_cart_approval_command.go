@@ -0,0 +1,14 @@
+package command
+
+import (
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+type DecideCartApprovalCommand struct {
+	CartID         uuid.UUID
+	UserID         uuid.UUID
+	Decision       value.ApprovalDecision
+	Comment        string
+	ApprovalPolicy value.ApprovalPolicy
+}
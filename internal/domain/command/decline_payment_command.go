@@ -0,0 +1,8 @@
+package command
+
+import "github.com/google/uuid"
+
+type DeclinePaymentCommand struct {
+	PaymentID uuid.UUID
+	Reason    string
+}
@@ -0,0 +1,12 @@
+package command
+
+import (
+	"github.com/google/uuid"
+)
+
+// ExpireCartApprovalCommand lapses the approval request raised at
+// RequestedAtVersion if nobody has decided on it yet.
+type ExpireCartApprovalCommand struct {
+	CartID             uuid.UUID
+	RequestedAtVersion int
+}
@@ -0,0 +1,11 @@
+package command
+
+import "github.com/google/uuid"
+
+// InviteCartMemberCommand lets the owner of a cart invite another user to
+// edit it.
+type InviteCartMemberCommand struct {
+	CartID    uuid.UUID
+	UserID    uuid.UUID
+	InviteeID uuid.UUID
+}
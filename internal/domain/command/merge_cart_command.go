@@ -0,0 +1,14 @@
+package command
+
+import (
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+type MergeCartCommand struct {
+	CartID     uuid.UUID
+	IntoCartID uuid.UUID
+	UserID     uuid.UUID
+	SessionID  value.SessionID
+	CartRules  value.CartRules
+}
@@ -0,0 +1,11 @@
+package command
+
+import "github.com/google/uuid"
+
+// MoveCartItemToSavedListCommand keeps a cart line for later on the saved list
+// of the user moving it.
+type MoveCartItemToSavedListCommand struct {
+	CartID uuid.UUID
+	UserID uuid.UUID
+	LineID uuid.UUID
+}
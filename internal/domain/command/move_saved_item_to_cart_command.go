@@ -0,0 +1,14 @@
+package command
+
+import (
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+type MoveSavedItemToCartCommand struct {
+	TenantID  uuid.UUID
+	UserID    uuid.UUID
+	ItemID    uuid.UUID
+	CartID    uuid.UUID
+	CartRules value.CartRules
+}
@@ -0,0 +1,13 @@
+package command
+
+import (
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+type OnboardTenantCommand struct {
+	TenantID      uuid.UUID
+	DisplayName   string
+	Plan          value.TenantPlan
+	DefaultLocale value.Locale
+}
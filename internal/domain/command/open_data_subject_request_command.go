@@ -0,0 +1,9 @@
+package command
+
+import "github.com/google/uuid"
+
+type OpenDataSubjectRequestCommand struct {
+	RequestID uuid.UUID
+	SubjectID uuid.UUID
+	Kind      string
+}
@@ -0,0 +1,5 @@
+package command
+
+type RecordDataSubjectAnonymisedCommand struct {
+	CartCount int
+}
@@ -0,0 +1,3 @@
+package command
+
+type RecordDataSubjectErasedCommand struct{}
@@ -0,0 +1,8 @@
+package command
+
+import "github.com/google/uuid"
+
+type RedeemCouponCommand struct {
+	CouponID uuid.UUID
+	CartID   uuid.UUID
+}
@@ -0,0 +1,7 @@
+package command
+
+import "github.com/google/uuid"
+
+type RefundPaymentCommand struct {
+	PaymentID uuid.UUID
+}
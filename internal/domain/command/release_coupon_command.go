@@ -0,0 +1,8 @@
+package command
+
+import "github.com/google/uuid"
+
+type ReleaseCouponCommand struct {
+	CouponID uuid.UUID
+	CartID   uuid.UUID
+}
@@ -0,0 +1,10 @@
+package command
+
+import (
+	"github.com/google/uuid"
+)
+
+type RemoveCartAbandonedPolicySegmentCommand struct {
+	TenantID  uuid.UUID
+	SegmentID uuid.UUID
+}
@@ -0,0 +1,11 @@
+package command
+
+import "github.com/google/uuid"
+
+// RemoveCartMemberCommand removes a member or withdraws a pending invitation.
+// Members may remove themselves; anyone else needs the owner.
+type RemoveCartMemberCommand struct {
+	CartID   uuid.UUID
+	UserID   uuid.UUID
+	MemberID uuid.UUID
+}
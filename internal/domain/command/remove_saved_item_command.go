@@ -0,0 +1,9 @@
+package command
+
+import "github.com/google/uuid"
+
+type RemoveSavedItemCommand struct {
+	TenantID uuid.UUID
+	UserID   uuid.UUID
+	ItemID   uuid.UUID
+}
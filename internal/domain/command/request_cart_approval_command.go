@@ -0,0 +1,12 @@
+package command
+
+import (
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+type RequestCartApprovalCommand struct {
+	CartID         uuid.UUID
+	UserID         uuid.UUID
+	ApprovalPolicy value.ApprovalPolicy
+}
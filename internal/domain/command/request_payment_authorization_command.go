@@ -0,0 +1,12 @@
+package command
+
+import (
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+type RequestPaymentAuthorizationCommand struct {
+	CartID     uuid.UUID
+	Amount     float64
+	CardNumber value.CardNumber
+}
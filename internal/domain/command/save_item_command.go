@@ -0,0 +1,21 @@
+package command
+
+import (
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+// SaveItemCommand keeps an item for later. Options and Category are what it
+// is to go into the cart with, and Quantity its units, 1 if left out.
+type SaveItemCommand struct {
+	TenantID    uuid.UUID
+	UserID      uuid.UUID
+	ItemID      uuid.UUID
+	Name        string
+	Price       float64
+	TaxCategory string
+	WeightGrams int
+	Options     value.LineOptions
+	Category    string
+	Quantity    int
+}
@@ -0,0 +1,13 @@
+package command
+
+import (
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+type SelectShippingMethodCommand struct {
+	CartID        uuid.UUID
+	UserID        uuid.UUID
+	Method        value.ShippingMethod
+	ShippingRates value.ShippingRateTable
+}
@@ -0,0 +1,8 @@
+package command
+
+import "github.com/google/uuid"
+
+type StartCheckoutCommand struct {
+	CartID      uuid.UUID
+	TotalAmount float64
+}
@@ -1,7 +1,15 @@
 package command
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
 
 type SubmitCartCommand struct {
-	CartID uuid.UUID
+	CartID         uuid.UUID
+	UserID         uuid.UUID
+	TaxSettings    value.TaxSettings
+	ShippingRates  value.ShippingRateTable
+	ApprovalPolicy value.ApprovalPolicy
+	CartRules      value.CartRules
 }
@@ -0,0 +1,8 @@
+package command
+
+import "github.com/google/uuid"
+
+type SuspendTenantCommand struct {
+	TenantID uuid.UUID
+	Reason   string
+}
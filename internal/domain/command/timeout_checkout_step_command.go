@@ -0,0 +1,12 @@
+package command
+
+import (
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+type TimeoutCheckoutStepCommand struct {
+	SagaID         uuid.UUID
+	Step           value.CheckoutStep
+	StartedVersion int
+}
@@ -12,4 +12,9 @@ type UpdateTenantCartAbandonedPolicyCommand struct {
 	AbandonedMinutes int
 	QuietTimeFrom    time.Time
 	QuietTimeTo      time.Time
+	CartExpiryDays   int
+	// EffectiveFrom and EffectiveTo schedule the change for a period. Both
+	// zero applies it right away.
+	EffectiveFrom time.Time
+	EffectiveTo   time.Time
 }
@@ -0,0 +1,38 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+type AppliedCoupon struct {
+	CouponID  uuid.UUID
+	Code      value.CouponCode
+	Promotion value.Promotion
+	Automatic bool
+}
+
+func NewAppliedCoupon(couponID uuid.UUID, code value.CouponCode, promotion value.Promotion, automatic bool) *AppliedCoupon {
+	return &AppliedCoupon{
+		CouponID:  couponID,
+		Code:      code,
+		Promotion: promotion,
+		Automatic: automatic,
+	}
+}
+
+func (ac *AppliedCoupon) GetCouponID() uuid.UUID {
+	return ac.CouponID
+}
+
+func (ac *AppliedCoupon) GetCode() value.CouponCode {
+	return ac.Code
+}
+
+func (ac *AppliedCoupon) GetPromotion() value.Promotion {
+	return ac.Promotion
+}
+
+func (ac *AppliedCoupon) IsAutomatic() bool {
+	return ac.Automatic
+}
@@ -6,16 +6,24 @@ import (
 )
 
 type CartItem struct {
-	ItemID uuid.UUID
-	Name   string
-	Price  value.Price
+	ItemID      uuid.UUID
+	Name        string
+	Price       value.Price
+	TaxCategory value.TaxCategory
+	WeightGrams int
+	Options     value.LineOptions
+	Category    string
 }
 
-func NewCartItem(itemID uuid.UUID, name string, price value.Price) *CartItem {
+func NewCartItem(itemID uuid.UUID, name string, price value.Price, taxCategory value.TaxCategory, weightGrams int, options value.LineOptions, category string) *CartItem {
 	return &CartItem{
-		ItemID: itemID,
-		Name:   name,
-		Price:  price,
+		ItemID:      itemID,
+		Name:        name,
+		Price:       price,
+		TaxCategory: taxCategory,
+		WeightGrams: weightGrams,
+		Options:     options,
+		Category:    category,
 	}
 }
 
@@ -23,10 +31,36 @@ func (ci *CartItem) GetItemID() uuid.UUID {
 	return ci.ItemID
 }
 
+// GetLineID tells apart lines of the same item with different options.
+func (ci *CartItem) GetLineID() uuid.UUID {
+	return ci.Options.LineID(ci.ItemID)
+}
+
 func (ci *CartItem) GetName() string {
 	return ci.Name
 }
 
+// GetPrice returns the price charged for the line, options included.
 func (ci *CartItem) GetPrice() value.Price {
+	return ci.Price + value.Price(ci.Options.PriceModifier())
+}
+
+func (ci *CartItem) GetBasePrice() value.Price {
 	return ci.Price
 }
+
+func (ci *CartItem) GetTaxCategory() value.TaxCategory {
+	return ci.TaxCategory
+}
+
+func (ci *CartItem) GetWeightGrams() int {
+	return ci.WeightGrams
+}
+
+func (ci *CartItem) GetOptions() value.LineOptions {
+	return ci.Options
+}
+
+func (ci *CartItem) GetCategory() string {
+	return ci.Category
+}
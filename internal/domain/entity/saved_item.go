@@ -0,0 +1,65 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+// SavedItem is an item kept for later with the options, category and number
+// of units it is to go into the cart with. Price is the base price; the
+// modifiers of the options are added when it is moved to the cart.
+type SavedItem struct {
+	ItemID      uuid.UUID
+	Name        string
+	Price       value.Price
+	TaxCategory value.TaxCategory
+	WeightGrams int
+	Options     value.LineOptions
+	Category    string
+	Quantity    int
+}
+
+func NewSavedItem(itemID uuid.UUID, name string, price value.Price, taxCategory value.TaxCategory, weightGrams int, options value.LineOptions, category string, quantity int) *SavedItem {
+	return &SavedItem{
+		ItemID:      itemID,
+		Name:        name,
+		Price:       price,
+		TaxCategory: taxCategory,
+		WeightGrams: weightGrams,
+		Options:     options,
+		Category:    category,
+		Quantity:    quantity,
+	}
+}
+
+func (si *SavedItem) GetItemID() uuid.UUID {
+	return si.ItemID
+}
+
+func (si *SavedItem) GetName() string {
+	return si.Name
+}
+
+func (si *SavedItem) GetPrice() value.Price {
+	return si.Price
+}
+
+func (si *SavedItem) GetTaxCategory() value.TaxCategory {
+	return si.TaxCategory
+}
+
+func (si *SavedItem) GetWeightGrams() int {
+	return si.WeightGrams
+}
+
+func (si *SavedItem) GetOptions() value.LineOptions {
+	return si.Options
+}
+
+func (si *SavedItem) GetCategory() string {
+	return si.Category
+}
+
+func (si *SavedItem) GetQuantity() int {
+	return si.Quantity
+}
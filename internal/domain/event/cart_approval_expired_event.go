@@ -0,0 +1,47 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CartApprovalExpiredEvent struct {
+	AggregateID uuid.UUID
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewCartApprovalExpiredEvent(aggregateID uuid.UUID, version int) *CartApprovalExpiredEvent {
+	return &CartApprovalExpiredEvent{
+		AggregateID: aggregateID,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e CartApprovalExpiredEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e CartApprovalExpiredEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e CartApprovalExpiredEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e CartApprovalExpiredEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e CartApprovalExpiredEvent) GetEventType() string {
+	return "CartApprovalExpiredEvent"
+}
+
+func (e CartApprovalExpiredEvent) GetAggregateType() string {
+	return "Cart"
+}
@@ -0,0 +1,59 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CartApprovalRejectedEvent struct {
+	AggregateID uuid.UUID
+	RejectedBy  uuid.UUID `personal:"subject"`
+	Comment     string
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewCartApprovalRejectedEvent(aggregateID uuid.UUID, version int, rejectedBy uuid.UUID, comment string) *CartApprovalRejectedEvent {
+	return &CartApprovalRejectedEvent{
+		AggregateID: aggregateID,
+		RejectedBy:  rejectedBy,
+		Comment:     comment,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e CartApprovalRejectedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e CartApprovalRejectedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e CartApprovalRejectedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e CartApprovalRejectedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e CartApprovalRejectedEvent) GetEventType() string {
+	return "CartApprovalRejectedEvent"
+}
+
+func (e CartApprovalRejectedEvent) GetAggregateType() string {
+	return "Cart"
+}
+
+func (e *CartApprovalRejectedEvent) GetRejectedBy() uuid.UUID {
+	return e.RejectedBy
+}
+
+func (e *CartApprovalRejectedEvent) GetComment() string {
+	return e.Comment
+}
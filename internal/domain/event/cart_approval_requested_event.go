@@ -0,0 +1,71 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CartApprovalRequestedEvent struct {
+	AggregateID uuid.UUID
+	TenantID    uuid.UUID
+	RequestedBy uuid.UUID `personal:"subject"`
+	Amount      float64
+	DeadlineAt  time.Time
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewCartApprovalRequestedEvent(aggregateID uuid.UUID, version int, tenantID uuid.UUID, requestedBy uuid.UUID, amount float64, deadlineAt time.Time) *CartApprovalRequestedEvent {
+	return &CartApprovalRequestedEvent{
+		AggregateID: aggregateID,
+		TenantID:    tenantID,
+		RequestedBy: requestedBy,
+		Amount:      amount,
+		DeadlineAt:  deadlineAt,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e CartApprovalRequestedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e CartApprovalRequestedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e CartApprovalRequestedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e CartApprovalRequestedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e CartApprovalRequestedEvent) GetEventType() string {
+	return "CartApprovalRequestedEvent"
+}
+
+func (e CartApprovalRequestedEvent) GetAggregateType() string {
+	return "Cart"
+}
+
+func (e *CartApprovalRequestedEvent) GetTenantID() uuid.UUID {
+	return e.TenantID
+}
+
+func (e *CartApprovalRequestedEvent) GetRequestedBy() uuid.UUID {
+	return e.RequestedBy
+}
+
+func (e *CartApprovalRequestedEvent) GetAmount() float64 {
+	return e.Amount
+}
+
+func (e *CartApprovalRequestedEvent) GetDeadlineAt() time.Time {
+	return e.DeadlineAt
+}
@@ -0,0 +1,59 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CartApprovedEvent struct {
+	AggregateID uuid.UUID
+	ApprovedBy  uuid.UUID `personal:"subject"`
+	Comment     string
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewCartApprovedEvent(aggregateID uuid.UUID, version int, approvedBy uuid.UUID, comment string) *CartApprovedEvent {
+	return &CartApprovedEvent{
+		AggregateID: aggregateID,
+		ApprovedBy:  approvedBy,
+		Comment:     comment,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e CartApprovedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e CartApprovedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e CartApprovedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e CartApprovedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e CartApprovedEvent) GetEventType() string {
+	return "CartApprovedEvent"
+}
+
+func (e CartApprovedEvent) GetAggregateType() string {
+	return "Cart"
+}
+
+func (e *CartApprovedEvent) GetApprovedBy() uuid.UUID {
+	return e.ApprovedBy
+}
+
+func (e *CartApprovedEvent) GetComment() string {
+	return e.Comment
+}
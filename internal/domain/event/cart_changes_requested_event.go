@@ -0,0 +1,59 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CartChangesRequestedEvent struct {
+	AggregateID uuid.UUID
+	RequestedBy uuid.UUID `personal:"subject"`
+	Comment     string
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewCartChangesRequestedEvent(aggregateID uuid.UUID, version int, requestedBy uuid.UUID, comment string) *CartChangesRequestedEvent {
+	return &CartChangesRequestedEvent{
+		AggregateID: aggregateID,
+		RequestedBy: requestedBy,
+		Comment:     comment,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e CartChangesRequestedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e CartChangesRequestedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e CartChangesRequestedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e CartChangesRequestedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e CartChangesRequestedEvent) GetEventType() string {
+	return "CartChangesRequestedEvent"
+}
+
+func (e CartChangesRequestedEvent) GetAggregateType() string {
+	return "Cart"
+}
+
+func (e *CartChangesRequestedEvent) GetRequestedBy() uuid.UUID {
+	return e.RequestedBy
+}
+
+func (e *CartChangesRequestedEvent) GetComment() string {
+	return e.Comment
+}
@@ -0,0 +1,47 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CartClosedEvent struct {
+	AggregateID uuid.UUID
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewCartClosedEvent(aggregateID uuid.UUID, version int) *CartClosedEvent {
+	return &CartClosedEvent{
+		AggregateID: aggregateID,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e CartClosedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e CartClosedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e CartClosedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e CartClosedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e CartClosedEvent) GetEventType() string {
+	return "CartClosedEvent"
+}
+
+func (e CartClosedEvent) GetAggregateType() string {
+	return "Cart"
+}
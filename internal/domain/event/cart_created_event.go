@@ -6,20 +6,24 @@ import (
 	"github.com/google/uuid"
 )
 
+// CartCreatedEvent is personal data of the cart's owner, or of the cart
+// itself for a guest cart, whose session is sealed with its key.
 type CartCreatedEvent struct {
 	AggregateID uuid.UUID
-	UserID      uuid.UUID
+	UserID      uuid.UUID `personal:"subject"`
 	TenantID    uuid.UUID
+	SessionID   string `personal:"true"`
 	EventID     uuid.UUID
 	Timestamp   time.Time
 	Version     int
 }
 
-func NewCartCreatedEvent(aggregateID uuid.UUID, version int, userID uuid.UUID, tenantID uuid.UUID) *CartCreatedEvent {
+func NewCartCreatedEvent(aggregateID uuid.UUID, version int, userID uuid.UUID, tenantID uuid.UUID, sessionID string) *CartCreatedEvent {
 	return &CartCreatedEvent{
 		AggregateID: aggregateID,
 		UserID:      userID,
 		TenantID:    tenantID,
+		SessionID:   sessionID,
 		EventID:     uuid.New(),
 		Timestamp:   time.Now(),
 		Version:     version,
@@ -50,6 +54,13 @@ func (e CartCreatedEvent) GetAggregateType() string {
 	return "Cart"
 }
 
+func (e CartCreatedEvent) GetDataSubjectID() uuid.UUID {
+	if e.UserID != uuid.Nil {
+		return e.UserID
+	}
+	return e.AggregateID
+}
+
 func (e *CartCreatedEvent) GetUserID() uuid.UUID {
 	return e.UserID
 }
@@ -57,3 +68,8 @@ func (e *CartCreatedEvent) GetUserID() uuid.UUID {
 func (e *CartCreatedEvent) GetTenantID() uuid.UUID {
 	return e.TenantID
 }
+
+// GetSessionID is set for guest carts, which have no user yet.
+func (e *CartCreatedEvent) GetSessionID() string {
+	return e.SessionID
+}
@@ -0,0 +1,53 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CartInvitationAcceptedEvent struct {
+	AggregateID uuid.UUID
+	UserID      uuid.UUID `personal:"subject"`
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewCartInvitationAcceptedEvent(aggregateID uuid.UUID, version int, userID uuid.UUID) *CartInvitationAcceptedEvent {
+	return &CartInvitationAcceptedEvent{
+		AggregateID: aggregateID,
+		UserID:      userID,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e CartInvitationAcceptedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e CartInvitationAcceptedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e CartInvitationAcceptedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e CartInvitationAcceptedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e CartInvitationAcceptedEvent) GetEventType() string {
+	return "CartInvitationAcceptedEvent"
+}
+
+func (e CartInvitationAcceptedEvent) GetAggregateType() string {
+	return "Cart"
+}
+
+func (e *CartInvitationAcceptedEvent) GetUserID() uuid.UUID {
+	return e.UserID
+}
@@ -0,0 +1,73 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CartItemMovedToSavedListEvent takes every unit of a line off the cart once
+// it is kept on the mover's saved list.
+type CartItemMovedToSavedListEvent struct {
+	AggregateID uuid.UUID
+	ItemID      uuid.UUID
+	LineID      uuid.UUID
+	ListID      uuid.UUID
+	MovedBy     uuid.UUID `personal:"subject"`
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewCartItemMovedToSavedListEvent(aggregateID uuid.UUID, version int, itemID uuid.UUID, lineID uuid.UUID, listID uuid.UUID, movedBy uuid.UUID) *CartItemMovedToSavedListEvent {
+	return &CartItemMovedToSavedListEvent{
+		AggregateID: aggregateID,
+		ItemID:      itemID,
+		LineID:      lineID,
+		ListID:      listID,
+		MovedBy:     movedBy,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e CartItemMovedToSavedListEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e CartItemMovedToSavedListEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e CartItemMovedToSavedListEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e CartItemMovedToSavedListEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e CartItemMovedToSavedListEvent) GetEventType() string {
+	return "CartItemMovedToSavedListEvent"
+}
+
+func (e CartItemMovedToSavedListEvent) GetAggregateType() string {
+	return "Cart"
+}
+
+func (e *CartItemMovedToSavedListEvent) GetItemID() uuid.UUID {
+	return e.ItemID
+}
+
+func (e *CartItemMovedToSavedListEvent) GetLineID() uuid.UUID {
+	return e.LineID
+}
+
+func (e *CartItemMovedToSavedListEvent) GetListID() uuid.UUID {
+	return e.ListID
+}
+
+func (e *CartItemMovedToSavedListEvent) GetMovedBy() uuid.UUID {
+	return e.MovedBy
+}
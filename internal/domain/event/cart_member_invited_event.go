@@ -0,0 +1,59 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CartMemberInvitedEvent struct {
+	AggregateID uuid.UUID
+	UserID      uuid.UUID `personal:"subject"`
+	InvitedBy   uuid.UUID `personal:"subject"`
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewCartMemberInvitedEvent(aggregateID uuid.UUID, version int, userID uuid.UUID, invitedBy uuid.UUID) *CartMemberInvitedEvent {
+	return &CartMemberInvitedEvent{
+		AggregateID: aggregateID,
+		UserID:      userID,
+		InvitedBy:   invitedBy,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e CartMemberInvitedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e CartMemberInvitedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e CartMemberInvitedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e CartMemberInvitedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e CartMemberInvitedEvent) GetEventType() string {
+	return "CartMemberInvitedEvent"
+}
+
+func (e CartMemberInvitedEvent) GetAggregateType() string {
+	return "Cart"
+}
+
+func (e *CartMemberInvitedEvent) GetUserID() uuid.UUID {
+	return e.UserID
+}
+
+func (e *CartMemberInvitedEvent) GetInvitedBy() uuid.UUID {
+	return e.InvitedBy
+}
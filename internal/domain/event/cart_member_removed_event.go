@@ -0,0 +1,59 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CartMemberRemovedEvent struct {
+	AggregateID uuid.UUID
+	UserID      uuid.UUID `personal:"subject"`
+	RemovedBy   uuid.UUID `personal:"subject"`
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewCartMemberRemovedEvent(aggregateID uuid.UUID, version int, userID uuid.UUID, removedBy uuid.UUID) *CartMemberRemovedEvent {
+	return &CartMemberRemovedEvent{
+		AggregateID: aggregateID,
+		UserID:      userID,
+		RemovedBy:   removedBy,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e CartMemberRemovedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e CartMemberRemovedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e CartMemberRemovedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e CartMemberRemovedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e CartMemberRemovedEvent) GetEventType() string {
+	return "CartMemberRemovedEvent"
+}
+
+func (e CartMemberRemovedEvent) GetAggregateType() string {
+	return "Cart"
+}
+
+func (e *CartMemberRemovedEvent) GetUserID() uuid.UUID {
+	return e.UserID
+}
+
+func (e *CartMemberRemovedEvent) GetRemovedBy() uuid.UUID {
+	return e.RemovedBy
+}
@@ -0,0 +1,59 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CartMergedEvent struct {
+	AggregateID uuid.UUID
+	IntoCartID  uuid.UUID
+	UserID      uuid.UUID `personal:"subject"`
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewCartMergedEvent(aggregateID uuid.UUID, version int, intoCartID uuid.UUID, userID uuid.UUID) *CartMergedEvent {
+	return &CartMergedEvent{
+		AggregateID: aggregateID,
+		IntoCartID:  intoCartID,
+		UserID:      userID,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e CartMergedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e CartMergedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e CartMergedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e CartMergedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e CartMergedEvent) GetEventType() string {
+	return "CartMergedEvent"
+}
+
+func (e CartMergedEvent) GetAggregateType() string {
+	return "Cart"
+}
+
+func (e *CartMergedEvent) GetIntoCartID() uuid.UUID {
+	return e.IntoCartID
+}
+
+func (e *CartMergedEvent) GetUserID() uuid.UUID {
+	return e.UserID
+}
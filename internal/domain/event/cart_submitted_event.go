@@ -7,22 +7,56 @@ import (
 )
 
 type CartSubmittedEvent struct {
-	AggregateID uuid.UUID
-	TotalAmount float64
-	SubmittedAt time.Time
-	EventID     uuid.UUID
-	Timestamp   time.Time
-	Version     int
+	AggregateID           uuid.UUID
+	TotalAmount           float64
+	Subtotal              float64
+	DiscountTotal         float64
+	TaxDisplay            string
+	TaxRoundingMode       string
+	StandardTaxableAmount float64
+	StandardTaxAmount     float64
+	ReducedTaxableAmount  float64
+	ReducedTaxAmount      float64
+	ShippingMethod        string
+	ShippingFee           float64
+	SubmittedAt           time.Time
+	EventID               uuid.UUID
+	Timestamp             time.Time
+	Version               int
 }
 
-func NewCartSubmittedEvent(aggregateID uuid.UUID, version int, totalAmount float64) *CartSubmittedEvent {
+func NewCartSubmittedEvent(
+	aggregateID uuid.UUID,
+	version int,
+	totalAmount float64,
+	subtotal float64,
+	discountTotal float64,
+	taxDisplay string,
+	taxRoundingMode string,
+	standardTaxableAmount float64,
+	standardTaxAmount float64,
+	reducedTaxableAmount float64,
+	reducedTaxAmount float64,
+	shippingMethod string,
+	shippingFee float64,
+) *CartSubmittedEvent {
 	return &CartSubmittedEvent{
-		AggregateID: aggregateID,
-		TotalAmount: totalAmount,
-		SubmittedAt: time.Now(),
-		EventID:     uuid.New(),
-		Timestamp:   time.Now(),
-		Version:     version,
+		AggregateID:           aggregateID,
+		TotalAmount:           totalAmount,
+		Subtotal:              subtotal,
+		DiscountTotal:         discountTotal,
+		TaxDisplay:            taxDisplay,
+		TaxRoundingMode:       taxRoundingMode,
+		StandardTaxableAmount: standardTaxableAmount,
+		StandardTaxAmount:     standardTaxAmount,
+		ReducedTaxableAmount:  reducedTaxableAmount,
+		ReducedTaxAmount:      reducedTaxAmount,
+		ShippingMethod:        shippingMethod,
+		ShippingFee:           shippingFee,
+		SubmittedAt:           time.Now(),
+		EventID:               uuid.New(),
+		Timestamp:             time.Now(),
+		Version:               version,
 	}
 }
 
@@ -50,10 +84,55 @@ func (e CartSubmittedEvent) GetAggregateType() string {
 	return "Cart"
 }
 
+// GetTotalAmount is the amount charged, including consumption tax.
 func (e *CartSubmittedEvent) GetTotalAmount() float64 {
 	return e.TotalAmount
 }
 
+func (e *CartSubmittedEvent) GetSubtotal() float64 {
+	return e.Subtotal
+}
+
+func (e *CartSubmittedEvent) GetDiscountTotal() float64 {
+	return e.DiscountTotal
+}
+
+func (e *CartSubmittedEvent) GetTaxDisplay() string {
+	return e.TaxDisplay
+}
+
+func (e *CartSubmittedEvent) GetTaxRoundingMode() string {
+	return e.TaxRoundingMode
+}
+
+func (e *CartSubmittedEvent) GetStandardTaxableAmount() float64 {
+	return e.StandardTaxableAmount
+}
+
+func (e *CartSubmittedEvent) GetStandardTaxAmount() float64 {
+	return e.StandardTaxAmount
+}
+
+func (e *CartSubmittedEvent) GetReducedTaxableAmount() float64 {
+	return e.ReducedTaxableAmount
+}
+
+func (e *CartSubmittedEvent) GetReducedTaxAmount() float64 {
+	return e.ReducedTaxAmount
+}
+
+func (e *CartSubmittedEvent) GetTaxTotal() float64 {
+	return e.StandardTaxAmount + e.ReducedTaxAmount
+}
+
+func (e *CartSubmittedEvent) GetShippingMethod() string {
+	return e.ShippingMethod
+}
+
+func (e *CartSubmittedEvent) GetShippingFee() float64 {
+	return e.ShippingFee
+}
+
 func (e *CartSubmittedEvent) GetSubmittedAt() time.Time {
 	return e.SubmittedAt
 }
@@ -0,0 +1,60 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+type CheckoutCompensationStartedEvent struct {
+	AggregateID uuid.UUID
+	CartID      uuid.UUID
+	Step        value.CheckoutStep
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewCheckoutCompensationStartedEvent(aggregateID uuid.UUID, version int, cartID uuid.UUID, step value.CheckoutStep) *CheckoutCompensationStartedEvent {
+	return &CheckoutCompensationStartedEvent{
+		AggregateID: aggregateID,
+		CartID:      cartID,
+		Step:        step,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e CheckoutCompensationStartedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e CheckoutCompensationStartedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e CheckoutCompensationStartedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e CheckoutCompensationStartedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e CheckoutCompensationStartedEvent) GetEventType() string {
+	return "CheckoutCompensationStartedEvent"
+}
+
+func (e CheckoutCompensationStartedEvent) GetAggregateType() string {
+	return "CheckoutSaga"
+}
+
+func (e *CheckoutCompensationStartedEvent) GetCartID() uuid.UUID {
+	return e.CartID
+}
+
+func (e *CheckoutCompensationStartedEvent) GetStep() value.CheckoutStep {
+	return e.Step
+}
@@ -0,0 +1,59 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CheckoutSagaAbortedEvent struct {
+	AggregateID uuid.UUID
+	CartID      uuid.UUID
+	Reason      string
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewCheckoutSagaAbortedEvent(aggregateID uuid.UUID, version int, cartID uuid.UUID, reason string) *CheckoutSagaAbortedEvent {
+	return &CheckoutSagaAbortedEvent{
+		AggregateID: aggregateID,
+		CartID:      cartID,
+		Reason:      reason,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e CheckoutSagaAbortedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e CheckoutSagaAbortedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e CheckoutSagaAbortedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e CheckoutSagaAbortedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e CheckoutSagaAbortedEvent) GetEventType() string {
+	return "CheckoutSagaAbortedEvent"
+}
+
+func (e CheckoutSagaAbortedEvent) GetAggregateType() string {
+	return "CheckoutSaga"
+}
+
+func (e *CheckoutSagaAbortedEvent) GetCartID() uuid.UUID {
+	return e.CartID
+}
+
+func (e *CheckoutSagaAbortedEvent) GetReason() string {
+	return e.Reason
+}
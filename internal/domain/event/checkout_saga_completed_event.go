@@ -0,0 +1,53 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CheckoutSagaCompletedEvent struct {
+	AggregateID uuid.UUID
+	CartID      uuid.UUID
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewCheckoutSagaCompletedEvent(aggregateID uuid.UUID, version int, cartID uuid.UUID) *CheckoutSagaCompletedEvent {
+	return &CheckoutSagaCompletedEvent{
+		AggregateID: aggregateID,
+		CartID:      cartID,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e CheckoutSagaCompletedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e CheckoutSagaCompletedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e CheckoutSagaCompletedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e CheckoutSagaCompletedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e CheckoutSagaCompletedEvent) GetEventType() string {
+	return "CheckoutSagaCompletedEvent"
+}
+
+func (e CheckoutSagaCompletedEvent) GetAggregateType() string {
+	return "CheckoutSaga"
+}
+
+func (e *CheckoutSagaCompletedEvent) GetCartID() uuid.UUID {
+	return e.CartID
+}
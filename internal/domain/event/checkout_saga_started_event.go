@@ -0,0 +1,59 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CheckoutSagaStartedEvent struct {
+	AggregateID uuid.UUID
+	CartID      uuid.UUID
+	TotalAmount float64
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewCheckoutSagaStartedEvent(aggregateID uuid.UUID, version int, cartID uuid.UUID, totalAmount float64) *CheckoutSagaStartedEvent {
+	return &CheckoutSagaStartedEvent{
+		AggregateID: aggregateID,
+		CartID:      cartID,
+		TotalAmount: totalAmount,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e CheckoutSagaStartedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e CheckoutSagaStartedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e CheckoutSagaStartedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e CheckoutSagaStartedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e CheckoutSagaStartedEvent) GetEventType() string {
+	return "CheckoutSagaStartedEvent"
+}
+
+func (e CheckoutSagaStartedEvent) GetAggregateType() string {
+	return "CheckoutSaga"
+}
+
+func (e *CheckoutSagaStartedEvent) GetCartID() uuid.UUID {
+	return e.CartID
+}
+
+func (e *CheckoutSagaStartedEvent) GetTotalAmount() float64 {
+	return e.TotalAmount
+}
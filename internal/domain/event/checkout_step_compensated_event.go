@@ -0,0 +1,54 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+type CheckoutStepCompensatedEvent struct {
+	AggregateID uuid.UUID
+	Step        value.CheckoutStep
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewCheckoutStepCompensatedEvent(aggregateID uuid.UUID, version int, step value.CheckoutStep) *CheckoutStepCompensatedEvent {
+	return &CheckoutStepCompensatedEvent{
+		AggregateID: aggregateID,
+		Step:        step,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e CheckoutStepCompensatedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e CheckoutStepCompensatedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e CheckoutStepCompensatedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e CheckoutStepCompensatedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e CheckoutStepCompensatedEvent) GetEventType() string {
+	return "CheckoutStepCompensatedEvent"
+}
+
+func (e CheckoutStepCompensatedEvent) GetAggregateType() string {
+	return "CheckoutSaga"
+}
+
+func (e *CheckoutStepCompensatedEvent) GetStep() value.CheckoutStep {
+	return e.Step
+}
@@ -0,0 +1,60 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+type CheckoutStepCompletedEvent struct {
+	AggregateID uuid.UUID
+	Step        value.CheckoutStep
+	Reference   string
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewCheckoutStepCompletedEvent(aggregateID uuid.UUID, version int, step value.CheckoutStep, reference string) *CheckoutStepCompletedEvent {
+	return &CheckoutStepCompletedEvent{
+		AggregateID: aggregateID,
+		Step:        step,
+		Reference:   reference,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e CheckoutStepCompletedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e CheckoutStepCompletedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e CheckoutStepCompletedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e CheckoutStepCompletedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e CheckoutStepCompletedEvent) GetEventType() string {
+	return "CheckoutStepCompletedEvent"
+}
+
+func (e CheckoutStepCompletedEvent) GetAggregateType() string {
+	return "CheckoutSaga"
+}
+
+func (e *CheckoutStepCompletedEvent) GetStep() value.CheckoutStep {
+	return e.Step
+}
+
+func (e *CheckoutStepCompletedEvent) GetReference() string {
+	return e.Reference
+}
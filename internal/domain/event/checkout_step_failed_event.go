@@ -0,0 +1,60 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+type CheckoutStepFailedEvent struct {
+	AggregateID uuid.UUID
+	Step        value.CheckoutStep
+	Reason      string
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewCheckoutStepFailedEvent(aggregateID uuid.UUID, version int, step value.CheckoutStep, reason string) *CheckoutStepFailedEvent {
+	return &CheckoutStepFailedEvent{
+		AggregateID: aggregateID,
+		Step:        step,
+		Reason:      reason,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e CheckoutStepFailedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e CheckoutStepFailedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e CheckoutStepFailedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e CheckoutStepFailedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e CheckoutStepFailedEvent) GetEventType() string {
+	return "CheckoutStepFailedEvent"
+}
+
+func (e CheckoutStepFailedEvent) GetAggregateType() string {
+	return "CheckoutSaga"
+}
+
+func (e *CheckoutStepFailedEvent) GetStep() value.CheckoutStep {
+	return e.Step
+}
+
+func (e *CheckoutStepFailedEvent) GetReason() string {
+	return e.Reason
+}
@@ -0,0 +1,60 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+type CheckoutStepStartedEvent struct {
+	AggregateID uuid.UUID
+	CartID      uuid.UUID
+	Step        value.CheckoutStep
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewCheckoutStepStartedEvent(aggregateID uuid.UUID, version int, cartID uuid.UUID, step value.CheckoutStep) *CheckoutStepStartedEvent {
+	return &CheckoutStepStartedEvent{
+		AggregateID: aggregateID,
+		CartID:      cartID,
+		Step:        step,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e CheckoutStepStartedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e CheckoutStepStartedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e CheckoutStepStartedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e CheckoutStepStartedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e CheckoutStepStartedEvent) GetEventType() string {
+	return "CheckoutStepStartedEvent"
+}
+
+func (e CheckoutStepStartedEvent) GetAggregateType() string {
+	return "CheckoutSaga"
+}
+
+func (e *CheckoutStepStartedEvent) GetCartID() uuid.UUID {
+	return e.CartID
+}
+
+func (e *CheckoutStepStartedEvent) GetStep() value.CheckoutStep {
+	return e.Step
+}
@@ -0,0 +1,95 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CouponAppliedToCartEvent struct {
+	AggregateID   uuid.UUID
+	CouponID      uuid.UUID
+	Code          string
+	PromotionType string
+	Value         float64
+	BuyQuantity   int
+	GetQuantity   int
+	MinimumTotal  float64
+	Automatic     bool
+	EventID       uuid.UUID
+	Timestamp     time.Time
+	Version       int
+}
+
+func NewCouponAppliedToCartEvent(aggregateID uuid.UUID, version int, couponID uuid.UUID, code string, promotionType string, value float64, buyQuantity int, getQuantity int, minimumTotal float64, automatic bool) *CouponAppliedToCartEvent {
+	return &CouponAppliedToCartEvent{
+		AggregateID:   aggregateID,
+		CouponID:      couponID,
+		Code:          code,
+		PromotionType: promotionType,
+		Value:         value,
+		BuyQuantity:   buyQuantity,
+		GetQuantity:   getQuantity,
+		MinimumTotal:  minimumTotal,
+		Automatic:     automatic,
+		EventID:       uuid.New(),
+		Timestamp:     time.Now(),
+		Version:       version,
+	}
+}
+
+func (e CouponAppliedToCartEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e CouponAppliedToCartEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e CouponAppliedToCartEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e CouponAppliedToCartEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e CouponAppliedToCartEvent) GetEventType() string {
+	return "CouponAppliedToCartEvent"
+}
+
+func (e CouponAppliedToCartEvent) GetAggregateType() string {
+	return "Cart"
+}
+
+func (e *CouponAppliedToCartEvent) GetCouponID() uuid.UUID {
+	return e.CouponID
+}
+
+func (e *CouponAppliedToCartEvent) GetCode() string {
+	return e.Code
+}
+
+func (e *CouponAppliedToCartEvent) GetPromotionType() string {
+	return e.PromotionType
+}
+
+func (e *CouponAppliedToCartEvent) GetValue() float64 {
+	return e.Value
+}
+
+func (e *CouponAppliedToCartEvent) GetBuyQuantity() int {
+	return e.BuyQuantity
+}
+
+func (e *CouponAppliedToCartEvent) GetGetQuantity() int {
+	return e.GetQuantity
+}
+
+func (e *CouponAppliedToCartEvent) GetMinimumTotal() float64 {
+	return e.MinimumTotal
+}
+
+func (e *CouponAppliedToCartEvent) GetAutomatic() bool {
+	return e.Automatic
+}
@@ -0,0 +1,101 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CouponCreatedEvent struct {
+	AggregateID   uuid.UUID
+	TenantID      uuid.UUID
+	Code          string
+	PromotionType string
+	Value         float64
+	BuyQuantity   int
+	GetQuantity   int
+	MinimumTotal  float64
+	UsageLimit    int
+	Automatic     bool
+	EventID       uuid.UUID
+	Timestamp     time.Time
+	Version       int
+}
+
+func NewCouponCreatedEvent(aggregateID uuid.UUID, version int, tenantID uuid.UUID, code string, promotionType string, value float64, buyQuantity int, getQuantity int, minimumTotal float64, usageLimit int, automatic bool) *CouponCreatedEvent {
+	return &CouponCreatedEvent{
+		AggregateID:   aggregateID,
+		TenantID:      tenantID,
+		Code:          code,
+		PromotionType: promotionType,
+		Value:         value,
+		BuyQuantity:   buyQuantity,
+		GetQuantity:   getQuantity,
+		MinimumTotal:  minimumTotal,
+		UsageLimit:    usageLimit,
+		Automatic:     automatic,
+		EventID:       uuid.New(),
+		Timestamp:     time.Now(),
+		Version:       version,
+	}
+}
+
+func (e CouponCreatedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e CouponCreatedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e CouponCreatedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e CouponCreatedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e CouponCreatedEvent) GetEventType() string {
+	return "CouponCreatedEvent"
+}
+
+func (e CouponCreatedEvent) GetAggregateType() string {
+	return "Coupon"
+}
+
+func (e *CouponCreatedEvent) GetTenantID() uuid.UUID {
+	return e.TenantID
+}
+
+func (e *CouponCreatedEvent) GetCode() string {
+	return e.Code
+}
+
+func (e *CouponCreatedEvent) GetPromotionType() string {
+	return e.PromotionType
+}
+
+func (e *CouponCreatedEvent) GetValue() float64 {
+	return e.Value
+}
+
+func (e *CouponCreatedEvent) GetBuyQuantity() int {
+	return e.BuyQuantity
+}
+
+func (e *CouponCreatedEvent) GetGetQuantity() int {
+	return e.GetQuantity
+}
+
+func (e *CouponCreatedEvent) GetMinimumTotal() float64 {
+	return e.MinimumTotal
+}
+
+func (e *CouponCreatedEvent) GetUsageLimit() int {
+	return e.UsageLimit
+}
+
+func (e *CouponCreatedEvent) GetAutomatic() bool {
+	return e.Automatic
+}
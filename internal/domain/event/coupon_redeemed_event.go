@@ -0,0 +1,53 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CouponRedeemedEvent struct {
+	AggregateID uuid.UUID
+	CartID      uuid.UUID
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewCouponRedeemedEvent(aggregateID uuid.UUID, version int, cartID uuid.UUID) *CouponRedeemedEvent {
+	return &CouponRedeemedEvent{
+		AggregateID: aggregateID,
+		CartID:      cartID,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e CouponRedeemedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e CouponRedeemedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e CouponRedeemedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e CouponRedeemedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e CouponRedeemedEvent) GetEventType() string {
+	return "CouponRedeemedEvent"
+}
+
+func (e CouponRedeemedEvent) GetAggregateType() string {
+	return "Coupon"
+}
+
+func (e *CouponRedeemedEvent) GetCartID() uuid.UUID {
+	return e.CartID
+}
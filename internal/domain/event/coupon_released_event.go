@@ -0,0 +1,53 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CouponReleasedEvent struct {
+	AggregateID uuid.UUID
+	CartID      uuid.UUID
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewCouponReleasedEvent(aggregateID uuid.UUID, version int, cartID uuid.UUID) *CouponReleasedEvent {
+	return &CouponReleasedEvent{
+		AggregateID: aggregateID,
+		CartID:      cartID,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e CouponReleasedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e CouponReleasedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e CouponReleasedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e CouponReleasedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e CouponReleasedEvent) GetEventType() string {
+	return "CouponReleasedEvent"
+}
+
+func (e CouponReleasedEvent) GetAggregateType() string {
+	return "Coupon"
+}
+
+func (e *CouponReleasedEvent) GetCartID() uuid.UUID {
+	return e.CartID
+}
@@ -0,0 +1,59 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CouponRemovedFromCartEvent struct {
+	AggregateID uuid.UUID
+	CouponID    uuid.UUID
+	Code        string
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewCouponRemovedFromCartEvent(aggregateID uuid.UUID, version int, couponID uuid.UUID, code string) *CouponRemovedFromCartEvent {
+	return &CouponRemovedFromCartEvent{
+		AggregateID: aggregateID,
+		CouponID:    couponID,
+		Code:        code,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e CouponRemovedFromCartEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e CouponRemovedFromCartEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e CouponRemovedFromCartEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e CouponRemovedFromCartEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e CouponRemovedFromCartEvent) GetEventType() string {
+	return "CouponRemovedFromCartEvent"
+}
+
+func (e CouponRemovedFromCartEvent) GetAggregateType() string {
+	return "Cart"
+}
+
+func (e *CouponRemovedFromCartEvent) GetCouponID() uuid.UUID {
+	return e.CouponID
+}
+
+func (e *CouponRemovedFromCartEvent) GetCode() string {
+	return e.Code
+}
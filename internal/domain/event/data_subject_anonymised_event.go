@@ -0,0 +1,61 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DataSubjectAnonymisedEvent records that the subject was removed from the
+// cart read models.
+type DataSubjectAnonymisedEvent struct {
+	AggregateID uuid.UUID
+	SubjectID   uuid.UUID
+	CartCount   int
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewDataSubjectAnonymisedEvent(aggregateID uuid.UUID, version int, subjectID uuid.UUID, cartCount int) *DataSubjectAnonymisedEvent {
+	return &DataSubjectAnonymisedEvent{
+		AggregateID: aggregateID,
+		SubjectID:   subjectID,
+		CartCount:   cartCount,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e DataSubjectAnonymisedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e DataSubjectAnonymisedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e DataSubjectAnonymisedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e DataSubjectAnonymisedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e DataSubjectAnonymisedEvent) GetEventType() string {
+	return "DataSubjectAnonymisedEvent"
+}
+
+func (e DataSubjectAnonymisedEvent) GetAggregateType() string {
+	return "DataSubjectRequest"
+}
+
+func (e *DataSubjectAnonymisedEvent) GetSubjectID() uuid.UUID {
+	return e.SubjectID
+}
+
+func (e *DataSubjectAnonymisedEvent) GetCartCount() int {
+	return e.CartCount
+}
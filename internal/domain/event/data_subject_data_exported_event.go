@@ -0,0 +1,74 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DataSubjectDataExportedEvent holds the JSON bundle of an export. The
+// bundle is personal data of the subject, so it reads as Redacted once the
+// subject is erased.
+type DataSubjectDataExportedEvent struct {
+	AggregateID   uuid.UUID
+	DataSubjectID uuid.UUID
+	Bundle        string `personal:"true"`
+	CartCount     int
+	EventCount    int
+	EventID       uuid.UUID
+	Timestamp     time.Time
+	Version       int
+}
+
+func NewDataSubjectDataExportedEvent(aggregateID uuid.UUID, version int, dataSubjectID uuid.UUID, bundle string, cartCount int, eventCount int) *DataSubjectDataExportedEvent {
+	return &DataSubjectDataExportedEvent{
+		AggregateID:   aggregateID,
+		DataSubjectID: dataSubjectID,
+		Bundle:        bundle,
+		CartCount:     cartCount,
+		EventCount:    eventCount,
+		EventID:       uuid.New(),
+		Timestamp:     time.Now(),
+		Version:       version,
+	}
+}
+
+func (e DataSubjectDataExportedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e DataSubjectDataExportedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e DataSubjectDataExportedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e DataSubjectDataExportedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e DataSubjectDataExportedEvent) GetEventType() string {
+	return "DataSubjectDataExportedEvent"
+}
+
+func (e DataSubjectDataExportedEvent) GetAggregateType() string {
+	return "DataSubjectRequest"
+}
+
+func (e DataSubjectDataExportedEvent) GetDataSubjectID() uuid.UUID {
+	return e.DataSubjectID
+}
+
+func (e *DataSubjectDataExportedEvent) GetBundle() string {
+	return e.Bundle
+}
+
+func (e *DataSubjectDataExportedEvent) GetCartCount() int {
+	return e.CartCount
+}
+
+func (e *DataSubjectDataExportedEvent) GetEventCount() int {
+	return e.EventCount
+}
@@ -0,0 +1,55 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DataSubjectErasedEvent records that the key of the subject was erased, so
+// their personal data in every event reads as Redacted.
+type DataSubjectErasedEvent struct {
+	AggregateID uuid.UUID
+	SubjectID   uuid.UUID
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewDataSubjectErasedEvent(aggregateID uuid.UUID, version int, subjectID uuid.UUID) *DataSubjectErasedEvent {
+	return &DataSubjectErasedEvent{
+		AggregateID: aggregateID,
+		SubjectID:   subjectID,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e DataSubjectErasedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e DataSubjectErasedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e DataSubjectErasedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e DataSubjectErasedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e DataSubjectErasedEvent) GetEventType() string {
+	return "DataSubjectErasedEvent"
+}
+
+func (e DataSubjectErasedEvent) GetAggregateType() string {
+	return "DataSubjectRequest"
+}
+
+func (e *DataSubjectErasedEvent) GetSubjectID() uuid.UUID {
+	return e.SubjectID
+}
@@ -0,0 +1,61 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DataSubjectRequestOpenedEvent starts an export or an erasure of
+// everything held about a data subject.
+type DataSubjectRequestOpenedEvent struct {
+	AggregateID uuid.UUID
+	SubjectID   uuid.UUID
+	Kind        string
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewDataSubjectRequestOpenedEvent(aggregateID uuid.UUID, version int, subjectID uuid.UUID, kind string) *DataSubjectRequestOpenedEvent {
+	return &DataSubjectRequestOpenedEvent{
+		AggregateID: aggregateID,
+		SubjectID:   subjectID,
+		Kind:        kind,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e DataSubjectRequestOpenedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e DataSubjectRequestOpenedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e DataSubjectRequestOpenedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e DataSubjectRequestOpenedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e DataSubjectRequestOpenedEvent) GetEventType() string {
+	return "DataSubjectRequestOpenedEvent"
+}
+
+func (e DataSubjectRequestOpenedEvent) GetAggregateType() string {
+	return "DataSubjectRequest"
+}
+
+func (e *DataSubjectRequestOpenedEvent) GetSubjectID() uuid.UUID {
+	return e.SubjectID
+}
+
+func (e *DataSubjectRequestOpenedEvent) GetKind() string {
+	return e.Kind
+}
@@ -4,26 +4,61 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
 )
 
+// ItemOption is a variant attribute of the added line. Price is the base
+// price of the item; the modifiers of its options are added on top.
+type ItemOption struct {
+	Name          string
+	Value         string
+	PriceModifier float64
+}
+
+func NewItemOptions(options value.LineOptions) []ItemOption {
+	if options.IsEmpty() {
+		return nil
+	}
+
+	itemOptions := make([]ItemOption, 0, len(options.Options()))
+	for _, option := range options.Options() {
+		itemOptions = append(itemOptions, ItemOption{
+			Name:          option.Name(),
+			Value:         option.Value(),
+			PriceModifier: option.PriceModifier(),
+		})
+	}
+	return itemOptions
+}
+
 type ItemAddedToCartEvent struct {
 	AggregateID uuid.UUID
 	ItemID      uuid.UUID
 	Name        string
 	Price       float64
 	TenantID    uuid.UUID
+	TaxCategory string
+	WeightGrams int
+	AddedBy     uuid.UUID `personal:"subject"`
+	Options     []ItemOption
+	Category    string
 	EventID     uuid.UUID
 	Timestamp   time.Time
 	Version     int
 }
 
-func NewItemAddedToCartEvent(aggregateID uuid.UUID, version int, itemID uuid.UUID, name string, price float64, tenantID uuid.UUID) *ItemAddedToCartEvent {
+func NewItemAddedToCartEvent(aggregateID uuid.UUID, version int, itemID uuid.UUID, name string, price float64, tenantID uuid.UUID, taxCategory string, weightGrams int, addedBy uuid.UUID, options []ItemOption, category string) *ItemAddedToCartEvent {
 	return &ItemAddedToCartEvent{
 		AggregateID: aggregateID,
 		ItemID:      itemID,
 		Name:        name,
 		Price:       price,
 		TenantID:    tenantID,
+		TaxCategory: taxCategory,
+		WeightGrams: weightGrams,
+		AddedBy:     addedBy,
+		Options:     options,
+		Category:    category,
 		EventID:     uuid.New(),
 		Timestamp:   time.Now(),
 		Version:     version,
@@ -69,3 +104,45 @@ func (e *ItemAddedToCartEvent) GetPrice() float64 {
 func (e *ItemAddedToCartEvent) GetTenantID() uuid.UUID {
 	return e.TenantID
 }
+
+func (e *ItemAddedToCartEvent) GetTaxCategory() string {
+	return e.TaxCategory
+}
+
+func (e *ItemAddedToCartEvent) GetWeightGrams() int {
+	return e.WeightGrams
+}
+
+// GetAddedBy returns the member who added the line. Lines of guest carts, and
+// lines added before carts could be shared, have no member.
+func (e *ItemAddedToCartEvent) GetAddedBy() uuid.UUID {
+	return e.AddedBy
+}
+
+// GetOptions returns nil for lines without options, including every line
+// added before options existed.
+func (e *ItemAddedToCartEvent) GetOptions() []ItemOption {
+	return e.Options
+}
+
+// GetLineOptions rebuilds the options, which were validated when the item
+// was added.
+func (e *ItemAddedToCartEvent) GetLineOptions() value.LineOptions {
+	return lineOptions(e.Options)
+}
+
+func lineOptions(itemOptions []ItemOption) value.LineOptions {
+	options := make([]value.LineOption, 0, len(itemOptions))
+	for _, option := range itemOptions {
+		lineOption, _ := value.NewLineOption(option.Name, option.Value, option.PriceModifier)
+		options = append(options, lineOption)
+	}
+	built, _ := value.NewLineOptions(options)
+	return built
+}
+
+// GetCategory returns the product category the tenant's cart rules refer to.
+// Lines without one are not part of any blocked combination.
+func (e *ItemAddedToCartEvent) GetCategory() string {
+	return e.Category
+}
@@ -0,0 +1,99 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+type ItemSavedEvent struct {
+	AggregateID uuid.UUID
+	ItemID      uuid.UUID
+	Name        string
+	Price       float64
+	TaxCategory string
+	WeightGrams int
+	Options     []ItemOption
+	Category    string
+	Quantity    int
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewItemSavedEvent(aggregateID uuid.UUID, version int, itemID uuid.UUID, name string, price float64, taxCategory string, weightGrams int, options []ItemOption, category string, quantity int) *ItemSavedEvent {
+	return &ItemSavedEvent{
+		AggregateID: aggregateID,
+		ItemID:      itemID,
+		Name:        name,
+		Price:       price,
+		TaxCategory: taxCategory,
+		WeightGrams: weightGrams,
+		Options:     options,
+		Category:    category,
+		Quantity:    quantity,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e ItemSavedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e ItemSavedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e ItemSavedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e ItemSavedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e ItemSavedEvent) GetEventType() string {
+	return "ItemSavedEvent"
+}
+
+func (e ItemSavedEvent) GetAggregateType() string {
+	return "SavedList"
+}
+
+func (e *ItemSavedEvent) GetItemID() uuid.UUID {
+	return e.ItemID
+}
+
+func (e *ItemSavedEvent) GetName() string {
+	return e.Name
+}
+
+func (e *ItemSavedEvent) GetPrice() float64 {
+	return e.Price
+}
+
+func (e *ItemSavedEvent) GetTaxCategory() string {
+	return e.TaxCategory
+}
+
+func (e *ItemSavedEvent) GetWeightGrams() int {
+	return e.WeightGrams
+}
+
+// GetLineOptions rebuilds the options, which were validated when the item
+// was saved. Items saved before options were kept have none.
+func (e *ItemSavedEvent) GetLineOptions() value.LineOptions {
+	return lineOptions(e.Options)
+}
+
+func (e *ItemSavedEvent) GetCategory() string {
+	return e.Category
+}
+
+// GetQuantity returns 1 for items saved before quantities were kept.
+func (e *ItemSavedEvent) GetQuantity() int {
+	return max(e.Quantity, 1)
+}
@@ -0,0 +1,65 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PaymentAuthorizationRequestedEvent struct {
+	AggregateID uuid.UUID
+	CartID      uuid.UUID
+	Amount      float64
+	CardLast4   string
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewPaymentAuthorizationRequestedEvent(aggregateID uuid.UUID, version int, cartID uuid.UUID, amount float64, cardLast4 string) *PaymentAuthorizationRequestedEvent {
+	return &PaymentAuthorizationRequestedEvent{
+		AggregateID: aggregateID,
+		CartID:      cartID,
+		Amount:      amount,
+		CardLast4:   cardLast4,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e PaymentAuthorizationRequestedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e PaymentAuthorizationRequestedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e PaymentAuthorizationRequestedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e PaymentAuthorizationRequestedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e PaymentAuthorizationRequestedEvent) GetEventType() string {
+	return "PaymentAuthorizationRequestedEvent"
+}
+
+func (e PaymentAuthorizationRequestedEvent) GetAggregateType() string {
+	return "Payment"
+}
+
+func (e *PaymentAuthorizationRequestedEvent) GetCartID() uuid.UUID {
+	return e.CartID
+}
+
+func (e *PaymentAuthorizationRequestedEvent) GetAmount() float64 {
+	return e.Amount
+}
+
+func (e *PaymentAuthorizationRequestedEvent) GetCardLast4() string {
+	return e.CardLast4
+}
@@ -0,0 +1,59 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PaymentAuthorizedEvent struct {
+	AggregateID     uuid.UUID
+	AuthorizationID string
+	Amount          float64
+	EventID         uuid.UUID
+	Timestamp       time.Time
+	Version         int
+}
+
+func NewPaymentAuthorizedEvent(aggregateID uuid.UUID, version int, authorizationID string, amount float64) *PaymentAuthorizedEvent {
+	return &PaymentAuthorizedEvent{
+		AggregateID:     aggregateID,
+		AuthorizationID: authorizationID,
+		Amount:          amount,
+		EventID:         uuid.New(),
+		Timestamp:       time.Now(),
+		Version:         version,
+	}
+}
+
+func (e PaymentAuthorizedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e PaymentAuthorizedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e PaymentAuthorizedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e PaymentAuthorizedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e PaymentAuthorizedEvent) GetEventType() string {
+	return "PaymentAuthorizedEvent"
+}
+
+func (e PaymentAuthorizedEvent) GetAggregateType() string {
+	return "Payment"
+}
+
+func (e *PaymentAuthorizedEvent) GetAuthorizationID() string {
+	return e.AuthorizationID
+}
+
+func (e *PaymentAuthorizedEvent) GetAmount() float64 {
+	return e.Amount
+}
@@ -0,0 +1,55 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PaymentCaptureRequestedEvent records that the payment is about to be
+// captured, before the gateway is asked to.
+type PaymentCaptureRequestedEvent struct {
+	AggregateID uuid.UUID
+	Amount      float64
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewPaymentCaptureRequestedEvent(aggregateID uuid.UUID, version int, amount float64) *PaymentCaptureRequestedEvent {
+	return &PaymentCaptureRequestedEvent{
+		AggregateID: aggregateID,
+		Amount:      amount,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e PaymentCaptureRequestedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e PaymentCaptureRequestedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e PaymentCaptureRequestedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e PaymentCaptureRequestedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e PaymentCaptureRequestedEvent) GetEventType() string {
+	return "PaymentCaptureRequestedEvent"
+}
+
+func (e PaymentCaptureRequestedEvent) GetAggregateType() string {
+	return "Payment"
+}
+
+func (e *PaymentCaptureRequestedEvent) GetAmount() float64 {
+	return e.Amount
+}
@@ -0,0 +1,53 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PaymentCapturedEvent struct {
+	AggregateID uuid.UUID
+	Amount      float64
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewPaymentCapturedEvent(aggregateID uuid.UUID, version int, amount float64) *PaymentCapturedEvent {
+	return &PaymentCapturedEvent{
+		AggregateID: aggregateID,
+		Amount:      amount,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e PaymentCapturedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e PaymentCapturedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e PaymentCapturedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e PaymentCapturedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e PaymentCapturedEvent) GetEventType() string {
+	return "PaymentCapturedEvent"
+}
+
+func (e PaymentCapturedEvent) GetAggregateType() string {
+	return "Payment"
+}
+
+func (e *PaymentCapturedEvent) GetAmount() float64 {
+	return e.Amount
+}
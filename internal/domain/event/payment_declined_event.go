@@ -0,0 +1,53 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PaymentDeclinedEvent struct {
+	AggregateID uuid.UUID
+	Reason      string
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewPaymentDeclinedEvent(aggregateID uuid.UUID, version int, reason string) *PaymentDeclinedEvent {
+	return &PaymentDeclinedEvent{
+		AggregateID: aggregateID,
+		Reason:      reason,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e PaymentDeclinedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e PaymentDeclinedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e PaymentDeclinedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e PaymentDeclinedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e PaymentDeclinedEvent) GetEventType() string {
+	return "PaymentDeclinedEvent"
+}
+
+func (e PaymentDeclinedEvent) GetAggregateType() string {
+	return "Payment"
+}
+
+func (e *PaymentDeclinedEvent) GetReason() string {
+	return e.Reason
+}
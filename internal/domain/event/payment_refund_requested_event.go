@@ -0,0 +1,55 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PaymentRefundRequestedEvent records that the payment is about to be
+// refunded, before the gateway is asked to.
+type PaymentRefundRequestedEvent struct {
+	AggregateID uuid.UUID
+	Amount      float64
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewPaymentRefundRequestedEvent(aggregateID uuid.UUID, version int, amount float64) *PaymentRefundRequestedEvent {
+	return &PaymentRefundRequestedEvent{
+		AggregateID: aggregateID,
+		Amount:      amount,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e PaymentRefundRequestedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e PaymentRefundRequestedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e PaymentRefundRequestedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e PaymentRefundRequestedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e PaymentRefundRequestedEvent) GetEventType() string {
+	return "PaymentRefundRequestedEvent"
+}
+
+func (e PaymentRefundRequestedEvent) GetAggregateType() string {
+	return "Payment"
+}
+
+func (e *PaymentRefundRequestedEvent) GetAmount() float64 {
+	return e.Amount
+}
@@ -0,0 +1,53 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PaymentRefundedEvent struct {
+	AggregateID uuid.UUID
+	Amount      float64
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewPaymentRefundedEvent(aggregateID uuid.UUID, version int, amount float64) *PaymentRefundedEvent {
+	return &PaymentRefundedEvent{
+		AggregateID: aggregateID,
+		Amount:      amount,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e PaymentRefundedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e PaymentRefundedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e PaymentRefundedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e PaymentRefundedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e PaymentRefundedEvent) GetEventType() string {
+	return "PaymentRefundedEvent"
+}
+
+func (e PaymentRefundedEvent) GetAggregateType() string {
+	return "Payment"
+}
+
+func (e *PaymentRefundedEvent) GetAmount() float64 {
+	return e.Amount
+}
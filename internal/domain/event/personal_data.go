@@ -0,0 +1,54 @@
+package event
+
+import (
+	"reflect"
+
+	"github.com/google/uuid"
+)
+
+// Redacted is what personal data reads as once the key of its data subject
+// has been erased.
+const Redacted = "[REDACTED]"
+
+// PersonalData is implemented by events holding data about a person, the
+// data subject. Their string fields tagged `personal:"true"` are stored
+// encrypted with the subject's key, so erasing the key erases the data from
+// every stored copy of the event.
+//
+// A user ID field tagged `personal:"subject"` is encrypted with the key of
+// the user it names, whether or not the event implements PersonalData, and
+// reads as uuid.Nil once that key is erased.
+type PersonalData interface {
+	Event
+	GetDataSubjectID() uuid.UUID
+}
+
+var uuidType = reflect.TypeOf(uuid.UUID{})
+
+// RedactOthers redacts, in place, the personal data in the event that is
+// about someone other than the subject: fields tagged `personal:"true"` read
+// as Redacted unless the subject is the event's data subject, and IDs tagged
+// `personal:"subject"` naming another user read as uuid.Nil. Events that are
+// not pointers are left as they are.
+func RedactOthers(evt Event, subjectID uuid.UUID) {
+	value := reflect.ValueOf(evt)
+	if value.Kind() != reflect.Pointer || value.Elem().Kind() != reflect.Struct {
+		return
+	}
+
+	personal, ok := evt.(PersonalData)
+	ownData := ok && personal.GetDataSubjectID() == subjectID
+	for _, f := range reflect.VisibleFields(value.Elem().Type()) {
+		field := value.Elem().FieldByIndex(f.Index)
+		switch {
+		case f.Tag.Get("personal") == "true" && f.Type.Kind() == reflect.String:
+			if !ownData && field.String() != "" {
+				field.SetString(Redacted)
+			}
+		case f.Tag.Get("personal") == "subject" && f.Type == uuidType:
+			if field.Interface().(uuid.UUID) != subjectID {
+				field.Set(reflect.ValueOf(uuid.Nil))
+			}
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SavedItemMovedToCartEvent struct {
+	AggregateID uuid.UUID
+	ItemID      uuid.UUID
+	CartID      uuid.UUID
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewSavedItemMovedToCartEvent(aggregateID uuid.UUID, version int, itemID uuid.UUID, cartID uuid.UUID) *SavedItemMovedToCartEvent {
+	return &SavedItemMovedToCartEvent{
+		AggregateID: aggregateID,
+		ItemID:      itemID,
+		CartID:      cartID,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e SavedItemMovedToCartEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e SavedItemMovedToCartEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e SavedItemMovedToCartEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e SavedItemMovedToCartEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e SavedItemMovedToCartEvent) GetEventType() string {
+	return "SavedItemMovedToCartEvent"
+}
+
+func (e SavedItemMovedToCartEvent) GetAggregateType() string {
+	return "SavedList"
+}
+
+func (e *SavedItemMovedToCartEvent) GetItemID() uuid.UUID {
+	return e.ItemID
+}
+
+func (e *SavedItemMovedToCartEvent) GetCartID() uuid.UUID {
+	return e.CartID
+}
@@ -0,0 +1,53 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SavedItemRemovedEvent struct {
+	AggregateID uuid.UUID
+	ItemID      uuid.UUID
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewSavedItemRemovedEvent(aggregateID uuid.UUID, version int, itemID uuid.UUID) *SavedItemRemovedEvent {
+	return &SavedItemRemovedEvent{
+		AggregateID: aggregateID,
+		ItemID:      itemID,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e SavedItemRemovedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e SavedItemRemovedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e SavedItemRemovedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e SavedItemRemovedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e SavedItemRemovedEvent) GetEventType() string {
+	return "SavedItemRemovedEvent"
+}
+
+func (e SavedItemRemovedEvent) GetAggregateType() string {
+	return "SavedList"
+}
+
+func (e *SavedItemRemovedEvent) GetItemID() uuid.UUID {
+	return e.ItemID
+}
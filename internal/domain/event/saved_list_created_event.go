@@ -0,0 +1,59 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SavedListCreatedEvent struct {
+	AggregateID uuid.UUID
+	TenantID    uuid.UUID
+	UserID      uuid.UUID `personal:"subject"`
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewSavedListCreatedEvent(aggregateID uuid.UUID, version int, tenantID uuid.UUID, userID uuid.UUID) *SavedListCreatedEvent {
+	return &SavedListCreatedEvent{
+		AggregateID: aggregateID,
+		TenantID:    tenantID,
+		UserID:      userID,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e SavedListCreatedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e SavedListCreatedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e SavedListCreatedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e SavedListCreatedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e SavedListCreatedEvent) GetEventType() string {
+	return "SavedListCreatedEvent"
+}
+
+func (e SavedListCreatedEvent) GetAggregateType() string {
+	return "SavedList"
+}
+
+func (e *SavedListCreatedEvent) GetTenantID() uuid.UUID {
+	return e.TenantID
+}
+
+func (e *SavedListCreatedEvent) GetUserID() uuid.UUID {
+	return e.UserID
+}
@@ -0,0 +1,103 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ShippingAddressSetEvent is personal data of the cart's owner, or of the
+// cart itself for a guest cart. The prefecture is kept in the clear since
+// shipping fees are quoted by it.
+type ShippingAddressSetEvent struct {
+	AggregateID   uuid.UUID
+	DataSubjectID uuid.UUID `personal:"subject"`
+	RecipientName string    `personal:"true"`
+	PostalCode    string    `personal:"true"`
+	Prefecture    string
+	City          string `personal:"true"`
+	AddressLine1  string `personal:"true"`
+	AddressLine2  string `personal:"true"`
+	Phone         string `personal:"true"`
+	EventID       uuid.UUID
+	Timestamp     time.Time
+	Version       int
+}
+
+func NewShippingAddressSetEvent(aggregateID uuid.UUID, version int, dataSubjectID uuid.UUID, recipientName string, postalCode string, prefecture string, city string, addressLine1 string, addressLine2 string, phone string) *ShippingAddressSetEvent {
+	return &ShippingAddressSetEvent{
+		AggregateID:   aggregateID,
+		DataSubjectID: dataSubjectID,
+		RecipientName: recipientName,
+		PostalCode:    postalCode,
+		Prefecture:    prefecture,
+		City:          city,
+		AddressLine1:  addressLine1,
+		AddressLine2:  addressLine2,
+		Phone:         phone,
+		EventID:       uuid.New(),
+		Timestamp:     time.Now(),
+		Version:       version,
+	}
+}
+
+func (e ShippingAddressSetEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e ShippingAddressSetEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e ShippingAddressSetEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e ShippingAddressSetEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e ShippingAddressSetEvent) GetEventType() string {
+	return "ShippingAddressSetEvent"
+}
+
+func (e ShippingAddressSetEvent) GetAggregateType() string {
+	return "Cart"
+}
+
+func (e ShippingAddressSetEvent) GetDataSubjectID() uuid.UUID {
+	return e.DataSubjectID
+}
+
+// IsRedacted tells whether the address was erased with its subject's key.
+func (e *ShippingAddressSetEvent) IsRedacted() bool {
+	return e.RecipientName == Redacted
+}
+
+func (e *ShippingAddressSetEvent) GetRecipientName() string {
+	return e.RecipientName
+}
+
+func (e *ShippingAddressSetEvent) GetPostalCode() string {
+	return e.PostalCode
+}
+
+func (e *ShippingAddressSetEvent) GetPrefecture() string {
+	return e.Prefecture
+}
+
+func (e *ShippingAddressSetEvent) GetCity() string {
+	return e.City
+}
+
+func (e *ShippingAddressSetEvent) GetAddressLine1() string {
+	return e.AddressLine1
+}
+
+func (e *ShippingAddressSetEvent) GetAddressLine2() string {
+	return e.AddressLine2
+}
+
+func (e *ShippingAddressSetEvent) GetPhone() string {
+	return e.Phone
+}
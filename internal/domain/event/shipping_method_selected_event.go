@@ -0,0 +1,59 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ShippingMethodSelectedEvent struct {
+	AggregateID uuid.UUID
+	Method      string
+	Fee         float64
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewShippingMethodSelectedEvent(aggregateID uuid.UUID, version int, method string, fee float64) *ShippingMethodSelectedEvent {
+	return &ShippingMethodSelectedEvent{
+		AggregateID: aggregateID,
+		Method:      method,
+		Fee:         fee,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e ShippingMethodSelectedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e ShippingMethodSelectedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e ShippingMethodSelectedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e ShippingMethodSelectedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e ShippingMethodSelectedEvent) GetEventType() string {
+	return "ShippingMethodSelectedEvent"
+}
+
+func (e ShippingMethodSelectedEvent) GetAggregateType() string {
+	return "Cart"
+}
+
+func (e *ShippingMethodSelectedEvent) GetMethod() string {
+	return e.Method
+}
+
+func (e *ShippingMethodSelectedEvent) GetFee() float64 {
+	return e.Fee
+}
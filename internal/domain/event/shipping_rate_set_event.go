@@ -0,0 +1,77 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ShippingRateSetEvent struct {
+	AggregateID    uuid.UUID
+	TenantID       uuid.UUID
+	Method         string
+	Prefecture     string
+	MaxWeightGrams int
+	Fee            float64
+	EventID        uuid.UUID
+	Timestamp      time.Time
+	Version        int
+}
+
+func NewShippingRateSetEvent(aggregateID uuid.UUID, version int, tenantID uuid.UUID, method string, prefecture string, maxWeightGrams int, fee float64) *ShippingRateSetEvent {
+	return &ShippingRateSetEvent{
+		AggregateID:    aggregateID,
+		TenantID:       tenantID,
+		Method:         method,
+		Prefecture:     prefecture,
+		MaxWeightGrams: maxWeightGrams,
+		Fee:            fee,
+		EventID:        uuid.New(),
+		Timestamp:      time.Now(),
+		Version:        version,
+	}
+}
+
+func (e ShippingRateSetEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e ShippingRateSetEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e ShippingRateSetEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e ShippingRateSetEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e ShippingRateSetEvent) GetEventType() string {
+	return "ShippingRateSetEvent"
+}
+
+func (e ShippingRateSetEvent) GetAggregateType() string {
+	return "TenantShippingRates"
+}
+
+func (e *ShippingRateSetEvent) GetTenantID() uuid.UUID {
+	return e.TenantID
+}
+
+func (e *ShippingRateSetEvent) GetMethod() string {
+	return e.Method
+}
+
+func (e *ShippingRateSetEvent) GetPrefecture() string {
+	return e.Prefecture
+}
+
+func (e *ShippingRateSetEvent) GetMaxWeightGrams() int {
+	return e.MaxWeightGrams
+}
+
+func (e *ShippingRateSetEvent) GetFee() float64 {
+	return e.Fee
+}
@@ -0,0 +1,71 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TenantApprovalPolicyConfiguredEvent struct {
+	AggregateID   uuid.UUID
+	TenantID      uuid.UUID
+	Threshold     float64
+	ApproverIDs   []uuid.UUID
+	DeadlineHours int
+	EventID       uuid.UUID
+	Timestamp     time.Time
+	Version       int
+}
+
+func NewTenantApprovalPolicyConfiguredEvent(aggregateID uuid.UUID, version int, tenantID uuid.UUID, threshold float64, approverIDs []uuid.UUID, deadlineHours int) *TenantApprovalPolicyConfiguredEvent {
+	return &TenantApprovalPolicyConfiguredEvent{
+		AggregateID:   aggregateID,
+		TenantID:      tenantID,
+		Threshold:     threshold,
+		ApproverIDs:   approverIDs,
+		DeadlineHours: deadlineHours,
+		EventID:       uuid.New(),
+		Timestamp:     time.Now(),
+		Version:       version,
+	}
+}
+
+func (e TenantApprovalPolicyConfiguredEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e TenantApprovalPolicyConfiguredEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e TenantApprovalPolicyConfiguredEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e TenantApprovalPolicyConfiguredEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e TenantApprovalPolicyConfiguredEvent) GetEventType() string {
+	return "TenantApprovalPolicyConfiguredEvent"
+}
+
+func (e TenantApprovalPolicyConfiguredEvent) GetAggregateType() string {
+	return "TenantApprovalPolicy"
+}
+
+func (e *TenantApprovalPolicyConfiguredEvent) GetTenantID() uuid.UUID {
+	return e.TenantID
+}
+
+func (e *TenantApprovalPolicyConfiguredEvent) GetThreshold() float64 {
+	return e.Threshold
+}
+
+func (e *TenantApprovalPolicyConfiguredEvent) GetApproverIDs() []uuid.UUID {
+	return e.ApproverIDs
+}
+
+func (e *TenantApprovalPolicyConfiguredEvent) GetDeadlineHours() int {
+	return e.DeadlineHours
+}
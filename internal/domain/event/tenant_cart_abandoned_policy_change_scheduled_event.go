@@ -0,0 +1,89 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TenantCartAbandonedPolicyChangeScheduledEvent struct {
+	AggregateID      uuid.UUID
+	Title            string
+	AbandonedMinutes int
+	QuietTimeFrom    time.Time
+	QuietTimeTo      time.Time
+	CartExpiryDays   int
+	EffectiveFrom    time.Time
+	EffectiveTo      time.Time
+	EventID          uuid.UUID
+	Timestamp        time.Time
+	Version          int
+}
+
+func NewTenantCartAbandonedPolicyChangeScheduledEvent(aggregateID uuid.UUID, version int, title string, abandonedMinutes int, quietTimeFrom time.Time, quietTimeTo time.Time, cartExpiryDays int, effectiveFrom time.Time, effectiveTo time.Time) *TenantCartAbandonedPolicyChangeScheduledEvent {
+	return &TenantCartAbandonedPolicyChangeScheduledEvent{
+		AggregateID:      aggregateID,
+		Title:            title,
+		AbandonedMinutes: abandonedMinutes,
+		QuietTimeFrom:    quietTimeFrom,
+		QuietTimeTo:      quietTimeTo,
+		CartExpiryDays:   cartExpiryDays,
+		EffectiveFrom:    effectiveFrom,
+		EffectiveTo:      effectiveTo,
+		EventID:          uuid.New(),
+		Timestamp:        time.Now(),
+		Version:          version,
+	}
+}
+
+func (e TenantCartAbandonedPolicyChangeScheduledEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e TenantCartAbandonedPolicyChangeScheduledEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e TenantCartAbandonedPolicyChangeScheduledEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e TenantCartAbandonedPolicyChangeScheduledEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e TenantCartAbandonedPolicyChangeScheduledEvent) GetEventType() string {
+	return "TenantCartAbandonedPolicyChangeScheduledEvent"
+}
+
+func (e TenantCartAbandonedPolicyChangeScheduledEvent) GetAggregateType() string {
+	return "TenantCartAbandonedPolicy"
+}
+
+func (e *TenantCartAbandonedPolicyChangeScheduledEvent) GetTitle() string {
+	return e.Title
+}
+
+func (e *TenantCartAbandonedPolicyChangeScheduledEvent) GetAbandonedMinutes() int {
+	return e.AbandonedMinutes
+}
+
+func (e *TenantCartAbandonedPolicyChangeScheduledEvent) GetQuietTimeFrom() time.Time {
+	return e.QuietTimeFrom
+}
+
+func (e *TenantCartAbandonedPolicyChangeScheduledEvent) GetQuietTimeTo() time.Time {
+	return e.QuietTimeTo
+}
+
+func (e *TenantCartAbandonedPolicyChangeScheduledEvent) GetCartExpiryDays() int {
+	return e.CartExpiryDays
+}
+
+func (e *TenantCartAbandonedPolicyChangeScheduledEvent) GetEffectiveFrom() time.Time {
+	return e.EffectiveFrom
+}
+
+func (e *TenantCartAbandonedPolicyChangeScheduledEvent) GetEffectiveTo() time.Time {
+	return e.EffectiveTo
+}
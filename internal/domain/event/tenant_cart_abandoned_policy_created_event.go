@@ -12,18 +12,20 @@ type TenantCartAbandonedPolicyCreatedEvent struct {
 	AbandonedMinutes int
 	QuietTimeFrom    time.Time
 	QuietTimeTo      time.Time
+	CartExpiryDays   int
 	EventID          uuid.UUID
 	Timestamp        time.Time
 	Version          int
 }
 
-func NewTenantCartAbandonedPolicyCreatedEvent(aggregateID uuid.UUID, version int, title string, abandonedMinutes int, quietTimeFrom time.Time, quietTimeTo time.Time) *TenantCartAbandonedPolicyCreatedEvent {
+func NewTenantCartAbandonedPolicyCreatedEvent(aggregateID uuid.UUID, version int, title string, abandonedMinutes int, quietTimeFrom time.Time, quietTimeTo time.Time, cartExpiryDays int) *TenantCartAbandonedPolicyCreatedEvent {
 	return &TenantCartAbandonedPolicyCreatedEvent{
 		AggregateID:      aggregateID,
 		Title:            title,
 		AbandonedMinutes: abandonedMinutes,
 		QuietTimeFrom:    quietTimeFrom,
 		QuietTimeTo:      quietTimeTo,
+		CartExpiryDays:   cartExpiryDays,
 		EventID:          uuid.New(),
 		Timestamp:        time.Now(),
 		Version:          version,
@@ -69,3 +71,7 @@ func (e *TenantCartAbandonedPolicyCreatedEvent) GetQuietTimeFrom() time.Time {
 func (e *TenantCartAbandonedPolicyCreatedEvent) GetQuietTimeTo() time.Time {
 	return e.QuietTimeTo
 }
+
+func (e *TenantCartAbandonedPolicyCreatedEvent) GetCartExpiryDays() int {
+	return e.CartExpiryDays
+}
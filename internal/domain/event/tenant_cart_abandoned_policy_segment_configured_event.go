@@ -0,0 +1,95 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TenantCartAbandonedPolicySegmentConfiguredEvent struct {
+	AggregateID      uuid.UUID
+	SegmentID        uuid.UUID
+	Title            string
+	Priority         int
+	AbandonedMinutes int
+	MinCartTotal     float64
+	MaxCartTotal     float64
+	Categories       []string
+	CustomerSegment  string
+	EventID          uuid.UUID
+	Timestamp        time.Time
+	Version          int
+}
+
+func NewTenantCartAbandonedPolicySegmentConfiguredEvent(aggregateID uuid.UUID, version int, segmentID uuid.UUID, title string, priority int, abandonedMinutes int, minCartTotal float64, maxCartTotal float64, categories []string, customerSegment string) *TenantCartAbandonedPolicySegmentConfiguredEvent {
+	return &TenantCartAbandonedPolicySegmentConfiguredEvent{
+		AggregateID:      aggregateID,
+		SegmentID:        segmentID,
+		Title:            title,
+		Priority:         priority,
+		AbandonedMinutes: abandonedMinutes,
+		MinCartTotal:     minCartTotal,
+		MaxCartTotal:     maxCartTotal,
+		Categories:       categories,
+		CustomerSegment:  customerSegment,
+		EventID:          uuid.New(),
+		Timestamp:        time.Now(),
+		Version:          version,
+	}
+}
+
+func (e TenantCartAbandonedPolicySegmentConfiguredEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e TenantCartAbandonedPolicySegmentConfiguredEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e TenantCartAbandonedPolicySegmentConfiguredEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e TenantCartAbandonedPolicySegmentConfiguredEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e TenantCartAbandonedPolicySegmentConfiguredEvent) GetEventType() string {
+	return "TenantCartAbandonedPolicySegmentConfiguredEvent"
+}
+
+func (e TenantCartAbandonedPolicySegmentConfiguredEvent) GetAggregateType() string {
+	return "TenantCartAbandonedPolicy"
+}
+
+func (e *TenantCartAbandonedPolicySegmentConfiguredEvent) GetSegmentID() uuid.UUID {
+	return e.SegmentID
+}
+
+func (e *TenantCartAbandonedPolicySegmentConfiguredEvent) GetTitle() string {
+	return e.Title
+}
+
+func (e *TenantCartAbandonedPolicySegmentConfiguredEvent) GetPriority() int {
+	return e.Priority
+}
+
+func (e *TenantCartAbandonedPolicySegmentConfiguredEvent) GetAbandonedMinutes() int {
+	return e.AbandonedMinutes
+}
+
+func (e *TenantCartAbandonedPolicySegmentConfiguredEvent) GetMinCartTotal() float64 {
+	return e.MinCartTotal
+}
+
+func (e *TenantCartAbandonedPolicySegmentConfiguredEvent) GetMaxCartTotal() float64 {
+	return e.MaxCartTotal
+}
+
+func (e *TenantCartAbandonedPolicySegmentConfiguredEvent) GetCategories() []string {
+	return e.Categories
+}
+
+func (e *TenantCartAbandonedPolicySegmentConfiguredEvent) GetCustomerSegment() string {
+	return e.CustomerSegment
+}
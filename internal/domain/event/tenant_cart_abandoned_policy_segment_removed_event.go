@@ -0,0 +1,53 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TenantCartAbandonedPolicySegmentRemovedEvent struct {
+	AggregateID uuid.UUID
+	SegmentID   uuid.UUID
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewTenantCartAbandonedPolicySegmentRemovedEvent(aggregateID uuid.UUID, version int, segmentID uuid.UUID) *TenantCartAbandonedPolicySegmentRemovedEvent {
+	return &TenantCartAbandonedPolicySegmentRemovedEvent{
+		AggregateID: aggregateID,
+		SegmentID:   segmentID,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e TenantCartAbandonedPolicySegmentRemovedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e TenantCartAbandonedPolicySegmentRemovedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e TenantCartAbandonedPolicySegmentRemovedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e TenantCartAbandonedPolicySegmentRemovedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e TenantCartAbandonedPolicySegmentRemovedEvent) GetEventType() string {
+	return "TenantCartAbandonedPolicySegmentRemovedEvent"
+}
+
+func (e TenantCartAbandonedPolicySegmentRemovedEvent) GetAggregateType() string {
+	return "TenantCartAbandonedPolicy"
+}
+
+func (e *TenantCartAbandonedPolicySegmentRemovedEvent) GetSegmentID() uuid.UUID {
+	return e.SegmentID
+}
@@ -12,18 +12,20 @@ type TenantCartAbandonedPolicyUpdatedEvent struct {
 	AbandonedMinutes int
 	QuietTimeFrom    time.Time
 	QuietTimeTo      time.Time
+	CartExpiryDays   int
 	EventID          uuid.UUID
 	Timestamp        time.Time
 	Version          int
 }
 
-func NewTenantCartAbandonedPolicyUpdatedEvent(aggregateID uuid.UUID, version int, title string, abandonedMinutes int, quietTimeFrom time.Time, quietTimeTo time.Time) *TenantCartAbandonedPolicyUpdatedEvent {
+func NewTenantCartAbandonedPolicyUpdatedEvent(aggregateID uuid.UUID, version int, title string, abandonedMinutes int, quietTimeFrom time.Time, quietTimeTo time.Time, cartExpiryDays int) *TenantCartAbandonedPolicyUpdatedEvent {
 	return &TenantCartAbandonedPolicyUpdatedEvent{
 		AggregateID:      aggregateID,
 		Title:            title,
 		AbandonedMinutes: abandonedMinutes,
 		QuietTimeFrom:    quietTimeFrom,
 		QuietTimeTo:      quietTimeTo,
+		CartExpiryDays:   cartExpiryDays,
 		EventID:          uuid.New(),
 		Timestamp:        time.Now(),
 		Version:          version,
@@ -69,3 +71,7 @@ func (e *TenantCartAbandonedPolicyUpdatedEvent) GetQuietTimeFrom() time.Time {
 func (e *TenantCartAbandonedPolicyUpdatedEvent) GetQuietTimeTo() time.Time {
 	return e.QuietTimeTo
 }
+
+func (e *TenantCartAbandonedPolicyUpdatedEvent) GetCartExpiryDays() int {
+	return e.CartExpiryDays
+}
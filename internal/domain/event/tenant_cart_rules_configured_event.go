@@ -0,0 +1,77 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TenantCartRulesConfiguredEvent struct {
+	AggregateID                 uuid.UUID
+	TenantID                    uuid.UUID
+	MaxLines                    int
+	MaxQuantityPerItem          int
+	MinimumOrderValue           float64
+	BlockedCategoryCombinations [][]string
+	EventID                     uuid.UUID
+	Timestamp                   time.Time
+	Version                     int
+}
+
+func NewTenantCartRulesConfiguredEvent(aggregateID uuid.UUID, version int, tenantID uuid.UUID, maxLines int, maxQuantityPerItem int, minimumOrderValue float64, blockedCategoryCombinations [][]string) *TenantCartRulesConfiguredEvent {
+	return &TenantCartRulesConfiguredEvent{
+		AggregateID:                 aggregateID,
+		TenantID:                    tenantID,
+		MaxLines:                    maxLines,
+		MaxQuantityPerItem:          maxQuantityPerItem,
+		MinimumOrderValue:           minimumOrderValue,
+		BlockedCategoryCombinations: blockedCategoryCombinations,
+		EventID:                     uuid.New(),
+		Timestamp:                   time.Now(),
+		Version:                     version,
+	}
+}
+
+func (e TenantCartRulesConfiguredEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e TenantCartRulesConfiguredEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e TenantCartRulesConfiguredEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e TenantCartRulesConfiguredEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e TenantCartRulesConfiguredEvent) GetEventType() string {
+	return "TenantCartRulesConfiguredEvent"
+}
+
+func (e TenantCartRulesConfiguredEvent) GetAggregateType() string {
+	return "TenantCartRules"
+}
+
+func (e *TenantCartRulesConfiguredEvent) GetTenantID() uuid.UUID {
+	return e.TenantID
+}
+
+func (e *TenantCartRulesConfiguredEvent) GetMaxLines() int {
+	return e.MaxLines
+}
+
+func (e *TenantCartRulesConfiguredEvent) GetMaxQuantityPerItem() int {
+	return e.MaxQuantityPerItem
+}
+
+func (e *TenantCartRulesConfiguredEvent) GetMinimumOrderValue() float64 {
+	return e.MinimumOrderValue
+}
+
+func (e *TenantCartRulesConfiguredEvent) GetBlockedCategoryCombinations() [][]string {
+	return e.BlockedCategoryCombinations
+}
@@ -0,0 +1,53 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TenantClosedEvent struct {
+	AggregateID uuid.UUID
+	TenantID    uuid.UUID
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewTenantClosedEvent(aggregateID uuid.UUID, version int, tenantID uuid.UUID) *TenantClosedEvent {
+	return &TenantClosedEvent{
+		AggregateID: aggregateID,
+		TenantID:    tenantID,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e TenantClosedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e TenantClosedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e TenantClosedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e TenantClosedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e TenantClosedEvent) GetEventType() string {
+	return "TenantClosedEvent"
+}
+
+func (e TenantClosedEvent) GetAggregateType() string {
+	return "Tenant"
+}
+
+func (e *TenantClosedEvent) GetTenantID() uuid.UUID {
+	return e.TenantID
+}
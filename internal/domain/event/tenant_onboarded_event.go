@@ -0,0 +1,71 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TenantOnboardedEvent struct {
+	AggregateID   uuid.UUID
+	TenantID      uuid.UUID
+	DisplayName   string
+	Plan          string
+	DefaultLocale string
+	EventID       uuid.UUID
+	Timestamp     time.Time
+	Version       int
+}
+
+func NewTenantOnboardedEvent(aggregateID uuid.UUID, version int, tenantID uuid.UUID, displayName string, plan string, defaultLocale string) *TenantOnboardedEvent {
+	return &TenantOnboardedEvent{
+		AggregateID:   aggregateID,
+		TenantID:      tenantID,
+		DisplayName:   displayName,
+		Plan:          plan,
+		DefaultLocale: defaultLocale,
+		EventID:       uuid.New(),
+		Timestamp:     time.Now(),
+		Version:       version,
+	}
+}
+
+func (e TenantOnboardedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e TenantOnboardedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e TenantOnboardedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e TenantOnboardedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e TenantOnboardedEvent) GetEventType() string {
+	return "TenantOnboardedEvent"
+}
+
+func (e TenantOnboardedEvent) GetAggregateType() string {
+	return "Tenant"
+}
+
+func (e *TenantOnboardedEvent) GetTenantID() uuid.UUID {
+	return e.TenantID
+}
+
+func (e *TenantOnboardedEvent) GetDisplayName() string {
+	return e.DisplayName
+}
+
+func (e *TenantOnboardedEvent) GetPlan() string {
+	return e.Plan
+}
+
+func (e *TenantOnboardedEvent) GetDefaultLocale() string {
+	return e.DefaultLocale
+}
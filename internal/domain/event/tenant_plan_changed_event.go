@@ -0,0 +1,59 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TenantPlanChangedEvent struct {
+	AggregateID uuid.UUID
+	TenantID    uuid.UUID
+	Plan        string
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewTenantPlanChangedEvent(aggregateID uuid.UUID, version int, tenantID uuid.UUID, plan string) *TenantPlanChangedEvent {
+	return &TenantPlanChangedEvent{
+		AggregateID: aggregateID,
+		TenantID:    tenantID,
+		Plan:        plan,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e TenantPlanChangedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e TenantPlanChangedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e TenantPlanChangedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e TenantPlanChangedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e TenantPlanChangedEvent) GetEventType() string {
+	return "TenantPlanChangedEvent"
+}
+
+func (e TenantPlanChangedEvent) GetAggregateType() string {
+	return "Tenant"
+}
+
+func (e *TenantPlanChangedEvent) GetTenantID() uuid.UUID {
+	return e.TenantID
+}
+
+func (e *TenantPlanChangedEvent) GetPlan() string {
+	return e.Plan
+}
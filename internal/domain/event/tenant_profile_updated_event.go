@@ -0,0 +1,65 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TenantProfileUpdatedEvent struct {
+	AggregateID   uuid.UUID
+	TenantID      uuid.UUID
+	DisplayName   string
+	DefaultLocale string
+	EventID       uuid.UUID
+	Timestamp     time.Time
+	Version       int
+}
+
+func NewTenantProfileUpdatedEvent(aggregateID uuid.UUID, version int, tenantID uuid.UUID, displayName string, defaultLocale string) *TenantProfileUpdatedEvent {
+	return &TenantProfileUpdatedEvent{
+		AggregateID:   aggregateID,
+		TenantID:      tenantID,
+		DisplayName:   displayName,
+		DefaultLocale: defaultLocale,
+		EventID:       uuid.New(),
+		Timestamp:     time.Now(),
+		Version:       version,
+	}
+}
+
+func (e TenantProfileUpdatedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e TenantProfileUpdatedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e TenantProfileUpdatedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e TenantProfileUpdatedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e TenantProfileUpdatedEvent) GetEventType() string {
+	return "TenantProfileUpdatedEvent"
+}
+
+func (e TenantProfileUpdatedEvent) GetAggregateType() string {
+	return "Tenant"
+}
+
+func (e *TenantProfileUpdatedEvent) GetTenantID() uuid.UUID {
+	return e.TenantID
+}
+
+func (e *TenantProfileUpdatedEvent) GetDisplayName() string {
+	return e.DisplayName
+}
+
+func (e *TenantProfileUpdatedEvent) GetDefaultLocale() string {
+	return e.DefaultLocale
+}
@@ -0,0 +1,53 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TenantReactivatedEvent struct {
+	AggregateID uuid.UUID
+	TenantID    uuid.UUID
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewTenantReactivatedEvent(aggregateID uuid.UUID, version int, tenantID uuid.UUID) *TenantReactivatedEvent {
+	return &TenantReactivatedEvent{
+		AggregateID: aggregateID,
+		TenantID:    tenantID,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e TenantReactivatedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e TenantReactivatedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e TenantReactivatedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e TenantReactivatedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e TenantReactivatedEvent) GetEventType() string {
+	return "TenantReactivatedEvent"
+}
+
+func (e TenantReactivatedEvent) GetAggregateType() string {
+	return "Tenant"
+}
+
+func (e *TenantReactivatedEvent) GetTenantID() uuid.UUID {
+	return e.TenantID
+}
@@ -0,0 +1,59 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TenantSuspendedEvent struct {
+	AggregateID uuid.UUID
+	TenantID    uuid.UUID
+	Reason      string
+	EventID     uuid.UUID
+	Timestamp   time.Time
+	Version     int
+}
+
+func NewTenantSuspendedEvent(aggregateID uuid.UUID, version int, tenantID uuid.UUID, reason string) *TenantSuspendedEvent {
+	return &TenantSuspendedEvent{
+		AggregateID: aggregateID,
+		TenantID:    tenantID,
+		Reason:      reason,
+		EventID:     uuid.New(),
+		Timestamp:   time.Now(),
+		Version:     version,
+	}
+}
+
+func (e TenantSuspendedEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e TenantSuspendedEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e TenantSuspendedEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e TenantSuspendedEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e TenantSuspendedEvent) GetEventType() string {
+	return "TenantSuspendedEvent"
+}
+
+func (e TenantSuspendedEvent) GetAggregateType() string {
+	return "Tenant"
+}
+
+func (e *TenantSuspendedEvent) GetTenantID() uuid.UUID {
+	return e.TenantID
+}
+
+func (e *TenantSuspendedEvent) GetReason() string {
+	return e.Reason
+}
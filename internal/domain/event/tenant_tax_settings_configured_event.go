@@ -0,0 +1,65 @@
+package event
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TenantTaxSettingsConfiguredEvent struct {
+	AggregateID  uuid.UUID
+	TenantID     uuid.UUID
+	TaxDisplay   string
+	RoundingMode string
+	EventID      uuid.UUID
+	Timestamp    time.Time
+	Version      int
+}
+
+func NewTenantTaxSettingsConfiguredEvent(aggregateID uuid.UUID, version int, tenantID uuid.UUID, taxDisplay string, roundingMode string) *TenantTaxSettingsConfiguredEvent {
+	return &TenantTaxSettingsConfiguredEvent{
+		AggregateID:  aggregateID,
+		TenantID:     tenantID,
+		TaxDisplay:   taxDisplay,
+		RoundingMode: roundingMode,
+		EventID:      uuid.New(),
+		Timestamp:    time.Now(),
+		Version:      version,
+	}
+}
+
+func (e TenantTaxSettingsConfiguredEvent) GetAggregateID() uuid.UUID {
+	return e.AggregateID
+}
+
+func (e TenantTaxSettingsConfiguredEvent) GetEventID() uuid.UUID {
+	return e.EventID
+}
+
+func (e TenantTaxSettingsConfiguredEvent) GetTimestamp() time.Time {
+	return e.Timestamp
+}
+
+func (e TenantTaxSettingsConfiguredEvent) GetVersion() int {
+	return e.Version
+}
+
+func (e TenantTaxSettingsConfiguredEvent) GetEventType() string {
+	return "TenantTaxSettingsConfiguredEvent"
+}
+
+func (e TenantTaxSettingsConfiguredEvent) GetAggregateType() string {
+	return "TenantTaxSettings"
+}
+
+func (e *TenantTaxSettingsConfiguredEvent) GetTenantID() uuid.UUID {
+	return e.TenantID
+}
+
+func (e *TenantTaxSettingsConfiguredEvent) GetTaxDisplay() string {
+	return e.TaxDisplay
+}
+
+func (e *TenantTaxSettingsConfiguredEvent) GetRoundingMode() string {
+	return e.RoundingMode
+}
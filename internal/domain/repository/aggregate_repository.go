@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+// AggregateRoot is what every event-sourced aggregate offers to be loaded from
+// and saved to its stream.
+type AggregateRoot interface {
+	GetAggregateID() uuid.UUID
+	GetVersion() int
+	GetUncommittedEvents() []event.Event
+	MarkEventsAsCommitted()
+	Hydration(events []event.Event) error
+}
+
+// ConflictResolver decides whether a command's events still apply after a
+// concurrent write. Ours are the events the command decided on; theirs were
+// committed concurrently and are already applied to current. If ours still
+// apply, it records them again as uncommitted events of current and returns
+// true. Otherwise it leaves current as it is and returns false.
+type ConflictResolver[T AggregateRoot] func(current T, theirs, ours []event.Event) bool
+
+type AggregateRepository[T AggregateRoot] interface {
+	// Load returns the aggregate rebuilt from its stream, or a new aggregate
+	// when the stream has no events yet. It must run inside a transaction.
+	Load(ctx context.Context, aggregateID uuid.UUID) (T, error)
+	// Save appends the uncommitted events to the event store and the outbox,
+	// marks them committed and returns them. It must run inside a transaction.
+	Save(ctx context.Context, aggregate T) ([]event.Event, error)
+	// Update loads the aggregate, runs fn on it and saves it in one
+	// transaction. The whole transaction is retried when another writer
+	// appended to the stream first.
+	Update(ctx context.Context, aggregateID uuid.UUID, fn func(ctx context.Context, aggregate T) error) (T, []event.Event, error)
+	// UpdateResolving is Update that, when another writer appended first,
+	// lets resolve carry the events of fn over to the current aggregate
+	// instead of running fn again. fn is run again only when resolve refuses.
+	// Attempts that only carried events over do not count towards the retry
+	// limit, but there are at most as many of them as the limit allows
+	// attempts, so a stream that never stops changing still fails with
+	// OptimisticLock.
+	UpdateResolving(ctx context.Context, aggregateID uuid.UUID, fn func(ctx context.Context, aggregate T) error, resolve ConflictResolver[T]) (T, []event.Event, error)
+}
@@ -1,9 +1,11 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
 )
 
 type EventDeserializer interface {
-	Deserialize(eventType string, eventData []byte) (event.Event, error)
+	Deserialize(ctx context.Context, eventType string, eventData []byte) (event.Event, error)
 }
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+// EventSerializer is the counterpart of EventDeserializer, used wherever
+// events are stored.
+type EventSerializer interface {
+	Serialize(ctx context.Context, evt event.Event) ([]byte, error)
+}
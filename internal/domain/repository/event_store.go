@@ -2,12 +2,48 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
 )
 
+// StreamAppend is the events to append to one stream, which has to be at
+// ExpectedVersion before them; -1 for a stream without events.
+type StreamAppend struct {
+	AggregateID     uuid.UUID
+	ExpectedVersion int
+	Events          []event.Event
+}
+
+// EventRange picks the events of a stream to read, oldest first. A nil
+// version or a zero time leaves that end open, and a zero Limit reads all.
+type EventRange struct {
+	FromVersion *int
+	ToVersion   *int
+	From        time.Time
+	To          time.Time
+	Limit       int
+}
+
+// StoredEvent is an event with the time the event store recorded it.
+type StoredEvent struct {
+	Event      event.Event
+	RecordedAt time.Time
+}
+
 type EventStore interface {
 	SaveEvents(ctx context.Context, aggregateID uuid.UUID, events []event.Event) error
+	// AppendStreams appends to several streams in the caller's transaction.
+	// When any stream is not at its expected version nothing is appended and
+	// it fails with OptimisticLock, with a detail for each such stream.
+	AppendStreams(ctx context.Context, appends []StreamAppend) error
 	LoadEvents(ctx context.Context, aggregateID uuid.UUID) ([]event.Event, error)
+	// LoadEventsUntilVersion loads the stream up to and including the version.
+	LoadEventsUntilVersion(ctx context.Context, aggregateID uuid.UUID, version int) ([]event.Event, error)
+	// LoadEventsUntil loads the events of the stream stored at or before the time.
+	LoadEventsUntil(ctx context.Context, aggregateID uuid.UUID, until time.Time) ([]event.Event, error)
+	// ReadEvents reads the events of the stream in the range, which may be
+	// none. It fails with NotFound only when the stream has no events at all.
+	ReadEvents(ctx context.Context, aggregateID uuid.UUID, r EventRange) ([]StoredEvent, error)
 }
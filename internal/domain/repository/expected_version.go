@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type expectedVersionKey struct {
+	aggregateID uuid.UUID
+}
+
+// WithExpectedVersion makes the EventStore refuse to append to the aggregate
+// unless it is at the version, as with an If-Match request.
+func WithExpectedVersion(ctx context.Context, aggregateID uuid.UUID, version int) context.Context {
+	return context.WithValue(ctx, expectedVersionKey{aggregateID: aggregateID}, version)
+}
+
+func ExpectedVersion(ctx context.Context, aggregateID uuid.UUID) (int, bool) {
+	version, ok := ctx.Value(expectedVersionKey{aggregateID: aggregateID}).(int)
+	return version, ok
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// SubjectKey is the encryption key of a data subject. Sealed values refer to
+// it by its ID rather than by the subject, so they do not give the subject
+// away.
+type SubjectKey struct {
+	ID  uuid.UUID
+	Key []byte
+}
+
+// KeyStore keeps an encryption key per data subject for the personal data
+// in their events. Erasing the key crypto-shreds that data.
+type KeyStore interface {
+	// GetOrCreateKey returns the subject's key, creating one if there is
+	// none. It returns false once the subject has been erased, since an
+	// erasure leaves a tombstone and a key is never created for them again.
+	GetOrCreateKey(ctx context.Context, subjectID uuid.UUID) (SubjectKey, bool, error)
+	// FindKey returns the key with the ID, or false if it has been erased.
+	FindKey(ctx context.Context, keyID uuid.UUID) ([]byte, bool, error)
+	// EraseKey destroys the subject's key and leaves the tombstone in its
+	// place, whether or not a key was ever created.
+	EraseKey(ctx context.Context, subjectID uuid.UUID) error
+}
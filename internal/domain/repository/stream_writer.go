@@ -0,0 +1,14 @@
+package repository
+
+import "context"
+
+// StreamWriter appends to several streams and to the outbox at once, in the
+// caller's transaction, for commands that change more than one aggregate.
+// Either every stream and its outbox entries are written or none are.
+type StreamWriter interface {
+	Append(ctx context.Context, appends ...StreamAppend) error
+	// Update runs fn in one transaction and appends the streams it returns.
+	// The whole transaction is retried when another writer appended to one
+	// of them first, so fn loads every stream it changes itself.
+	Update(ctx context.Context, fn func(ctx context.Context) ([]StreamAppend, error)) error
+}
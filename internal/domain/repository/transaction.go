@@ -4,5 +4,12 @@ import "context"
 
 type Transaction interface {
 	RWTx(ctx context.Context, fn func(ctx context.Context) error) error
+	// SharedRWTx is RWTx whose transaction is also used by every RWTx called
+	// within fn, so that all their writes commit or roll back together.
+	SharedRWTx(ctx context.Context, fn func(ctx context.Context) error) error
+	// Shared reports whether ctx is within a SharedRWTx. A write that lost a
+	// race there cannot be retried on its own, because the shared transaction
+	// keeps reading the snapshot it lost on.
+	Shared(ctx context.Context) bool
 	AfterCommit(fn func() error)
 }
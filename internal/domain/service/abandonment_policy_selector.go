@@ -0,0 +1,46 @@
+package service
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+// AbandonmentCart is what the selector needs to know about a cart.
+type AbandonmentCart struct {
+	Total             float64
+	Categories        []string
+	FirstTimeCustomer bool
+}
+
+type AbandonmentPolicySelector struct{}
+
+func NewAbandonmentPolicySelector() *AbandonmentPolicySelector {
+	return &AbandonmentPolicySelector{}
+}
+
+// Select returns the first segment whose conditions the cart meets, trying
+// segments by priority and then by ID so that the same cart always gets the
+// same policy. It reports false when no segment matches and the tenant's
+// default policy applies.
+func (s *AbandonmentPolicySelector) Select(segments []value.AbandonmentSegment, cart AbandonmentCart) (value.AbandonmentSegment, bool) {
+	ordered := slices.Clone(segments)
+	slices.SortFunc(ordered, func(a, b value.AbandonmentSegment) int {
+		if c := cmp.Compare(a.Priority(), b.Priority()); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ID().String(), b.ID().String())
+	})
+
+	for _, segment := range ordered {
+		conditions := segment.Conditions()
+		if conditions.CoversTotal(cart.Total) &&
+			conditions.CoversCategories(cart.Categories) &&
+			conditions.CoversCustomer(cart.FirstTimeCustomer) {
+			return segment, true
+		}
+	}
+
+	return value.AbandonmentSegment{}, false
+}
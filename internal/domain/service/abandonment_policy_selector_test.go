@@ -0,0 +1,82 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/service"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+func abandonmentSegment(t *testing.T, id uuid.UUID, priority int, minTotal, maxTotal float64, categories []string, customer value.CustomerSegment) value.AbandonmentSegment {
+	t.Helper()
+
+	conditions, err := value.NewAbandonmentConditions(minTotal, maxTotal, categories, customer)
+	require.NoError(t, err)
+	segment, err := value.NewAbandonmentSegment(id, "segment", priority, 10, conditions)
+	require.NoError(t, err)
+	return segment
+}
+
+func TestAbandonmentPolicySelector_Select(t *testing.T) {
+	lowID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	highID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+
+	highValue := abandonmentSegment(t, uuid.New(), 10, 50000, 0, nil, value.CustomerSegmentAny)
+	firstTimeToys := abandonmentSegment(t, uuid.New(), 0, 0, 0, []string{"toys"}, value.CustomerSegmentFirstTime)
+	returning := abandonmentSegment(t, uuid.New(), 20, 0, 0, nil, value.CustomerSegmentReturning)
+	tieLow := abandonmentSegment(t, lowID, 5, 0, 0, nil, value.CustomerSegmentAny)
+	tieHigh := abandonmentSegment(t, highID, 5, 0, 0, nil, value.CustomerSegmentAny)
+
+	tests := map[string]struct {
+		segments  []value.AbandonmentSegment
+		cart      service.AbandonmentCart
+		wantID    uuid.UUID
+		wantFound bool
+	}{
+		"cart total in range": {
+			segments:  []value.AbandonmentSegment{highValue, returning},
+			cart:      service.AbandonmentCart{Total: 500000},
+			wantID:    highValue.ID(),
+			wantFound: true,
+		},
+		"lower priority is tried first": {
+			segments:  []value.AbandonmentSegment{highValue, firstTimeToys},
+			cart:      service.AbandonmentCart{Total: 500000, Categories: []string{"toys"}, FirstTimeCustomer: true},
+			wantID:    firstTimeToys.ID(),
+			wantFound: true,
+		},
+		"returning customer skips first-time segment": {
+			segments:  []value.AbandonmentSegment{firstTimeToys, returning},
+			cart:      service.AbandonmentCart{Total: 500, Categories: []string{"toys"}},
+			wantID:    returning.ID(),
+			wantFound: true,
+		},
+		"same priority is ordered by id": {
+			segments:  []value.AbandonmentSegment{tieHigh, tieLow},
+			cart:      service.AbandonmentCart{Total: 500},
+			wantID:    lowID,
+			wantFound: true,
+		},
+		"no segment matches": {
+			segments: []value.AbandonmentSegment{highValue, firstTimeToys},
+			cart:     service.AbandonmentCart{Total: 500, Categories: []string{"books"}},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			segment, found := service.NewAbandonmentPolicySelector().Select(tt.segments, tt.cart)
+
+			// Assert
+			require.Equal(t, tt.wantFound, found)
+			if tt.wantFound {
+				require.Equal(t, tt.wantID, segment.ID())
+			}
+		})
+	}
+}
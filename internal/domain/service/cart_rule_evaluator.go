@@ -0,0 +1,88 @@
+package service
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+// RuleLine is one unit in a cart. Units of the same item with the same
+// options share a LineID.
+type RuleLine struct {
+	LineID   uuid.UUID
+	Name     string
+	Category string
+}
+
+// CartRuleViolation is a rule the cart breaks, with a message for the shopper.
+type CartRuleViolation struct {
+	Rule    value.CartRuleCode
+	Message string
+}
+
+type CartRuleEvaluator struct{}
+
+func NewCartRuleEvaluator() *CartRuleEvaluator {
+	return &CartRuleEvaluator{}
+}
+
+// Evaluate returns every rule the cart breaks, in a stable order. The minimum
+// order value only applies when the cart is submitted, since a cart is
+// expected to be below it while it is being filled.
+func (e *CartRuleEvaluator) Evaluate(rules value.CartRules, lines []RuleLine, orderValue float64, submitting bool) []CartRuleViolation {
+	violations := make([]CartRuleViolation, 0)
+
+	quantities := make(map[uuid.UUID]int)
+	lineOrder := make([]uuid.UUID, 0)
+	names := make(map[uuid.UUID]string)
+	categories := make([]string, 0)
+	for _, line := range lines {
+		if _, ok := quantities[line.LineID]; !ok {
+			lineOrder = append(lineOrder, line.LineID)
+			names[line.LineID] = line.Name
+		}
+		quantities[line.LineID]++
+		if line.Category != "" && !slices.Contains(categories, line.Category) {
+			categories = append(categories, line.Category)
+		}
+	}
+
+	if rules.MaxLines() > 0 && len(lineOrder) > rules.MaxLines() {
+		violations = append(violations, CartRuleViolation{
+			Rule:    value.CartRuleMaxLines,
+			Message: fmt.Sprintf("cart can have at most %d lines", rules.MaxLines()),
+		})
+	}
+
+	if rules.MaxQuantityPerItem() > 0 {
+		for _, lineID := range lineOrder {
+			if quantities[lineID] > rules.MaxQuantityPerItem() {
+				violations = append(violations, CartRuleViolation{
+					Rule:    value.CartRuleMaxQuantityPerItem,
+					Message: fmt.Sprintf("%s can be ordered at most %d times", names[lineID], rules.MaxQuantityPerItem()),
+				})
+			}
+		}
+	}
+
+	if submitting && rules.MinimumOrderValue() > 0 && orderValue < rules.MinimumOrderValue() {
+		violations = append(violations, CartRuleViolation{
+			Rule:    value.CartRuleMinimumOrderValue,
+			Message: fmt.Sprintf("order value must be at least %.0f", rules.MinimumOrderValue()),
+		})
+	}
+
+	for _, combination := range rules.BlockedCombinations() {
+		pair := combination.Categories()
+		if slices.Contains(categories, pair[0]) && slices.Contains(categories, pair[1]) {
+			violations = append(violations, CartRuleViolation{
+				Rule:    value.CartRuleBlockedCategoryCombination,
+				Message: fmt.Sprintf("%s and %s cannot be ordered together", pair[0], pair[1]),
+			})
+		}
+	}
+
+	return violations
+}
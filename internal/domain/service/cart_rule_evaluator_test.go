@@ -0,0 +1,95 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/service"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+func TestCartRuleEvaluator_Evaluate(t *testing.T) {
+	alcoholToys, err := value.NewCategoryCombination("alcohol", "toys")
+	require.NoError(t, err)
+	rules, err := value.NewCartRules(2, 2, 3000, []value.CategoryCombination{alcoholToys})
+	require.NoError(t, err)
+
+	wine := service.RuleLine{LineID: uuid.New(), Name: "Wine", Category: "alcohol"}
+	robot := service.RuleLine{LineID: uuid.New(), Name: "Robot", Category: "toys"}
+	book := service.RuleLine{LineID: uuid.New(), Name: "Book", Category: "books"}
+
+	tests := map[string]struct {
+		rules      value.CartRules
+		lines      []service.RuleLine
+		orderValue float64
+		submitting bool
+		want       []value.CartRuleCode
+	}{
+		"cart within the rules": {
+			rules:      rules,
+			lines:      []service.RuleLine{book, book},
+			orderValue: 3000,
+			submitting: true,
+			want:       []value.CartRuleCode{},
+		},
+		"too many lines": {
+			rules:      rules,
+			lines:      []service.RuleLine{book, wine, {LineID: uuid.New(), Name: "Pen"}},
+			orderValue: 3000,
+			want:       []value.CartRuleCode{value.CartRuleMaxLines},
+		},
+		"too many of one line": {
+			rules:      rules,
+			lines:      []service.RuleLine{book, book, book},
+			orderValue: 3000,
+			want:       []value.CartRuleCode{value.CartRuleMaxQuantityPerItem},
+		},
+		"minimum order value is ignored while filling the cart": {
+			rules:      rules,
+			lines:      []service.RuleLine{book},
+			orderValue: 1000,
+			want:       []value.CartRuleCode{},
+		},
+		"minimum order value on submit": {
+			rules:      rules,
+			lines:      []service.RuleLine{book},
+			orderValue: 1000,
+			submitting: true,
+			want:       []value.CartRuleCode{value.CartRuleMinimumOrderValue},
+		},
+		"every broken rule is listed": {
+			rules:      rules,
+			lines:      []service.RuleLine{wine, robot, book, robot, robot},
+			orderValue: 1000,
+			submitting: true,
+			want: []value.CartRuleCode{
+				value.CartRuleMaxLines,
+				value.CartRuleMaxQuantityPerItem,
+				value.CartRuleMinimumOrderValue,
+				value.CartRuleBlockedCategoryCombination,
+			},
+		},
+		"zero rules allow everything": {
+			lines:      []service.RuleLine{wine, robot, book, robot, robot},
+			submitting: true,
+			want:       []value.CartRuleCode{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			violations := service.NewCartRuleEvaluator().Evaluate(tt.rules, tt.lines, tt.orderValue, tt.submitting)
+
+			// Assert
+			got := make([]value.CartRuleCode, 0, len(violations))
+			for _, violation := range violations {
+				got = append(got, violation.Rule)
+			}
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"math"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+// TaxableLine is one priced line of an invoice. Lines with NoDiscount, such
+// as shipping fees, take no share of the discount.
+type TaxableLine struct {
+	Category   value.TaxCategory
+	Amount     float64
+	NoDiscount bool
+}
+
+// TaxBreakdown is the consumption tax of an invoice per rate, as required on a
+// qualified invoice. Taxable amounts are after discounts and, for inclusive
+// display, include the tax.
+type TaxBreakdown struct {
+	Display               value.TaxDisplay
+	RoundingMode          value.TaxRoundingMode
+	StandardTaxableAmount float64
+	StandardTaxAmount     float64
+	ReducedTaxableAmount  float64
+	ReducedTaxAmount      float64
+}
+
+func (b TaxBreakdown) TaxTotal() float64 {
+	return b.StandardTaxAmount + b.ReducedTaxAmount
+}
+
+// Total is the amount the customer pays.
+func (b TaxBreakdown) Total() float64 {
+	total := b.StandardTaxableAmount + b.ReducedTaxableAmount
+	if b.Display == value.TaxDisplayExclusive {
+		total += b.TaxTotal()
+	}
+	return total
+}
+
+type TaxCalculator struct{}
+
+func NewTaxCalculator() *TaxCalculator {
+	return &TaxCalculator{}
+}
+
+// Calculate sums the lines per rate, spreads the discount over the rates in
+// proportion to their amounts and rounds the tax once per rate. Qualified
+// invoice rules allow only one rounding per rate and invoice, so tax is never
+// rounded per line.
+func (c *TaxCalculator) Calculate(lines []TaxableLine, discount float64, settings value.TaxSettings) TaxBreakdown {
+	var standard, reduced, fixedStandard, fixedReduced float64
+	for _, line := range lines {
+		switch {
+		case line.Category == value.TaxCategoryReduced && line.NoDiscount:
+			fixedReduced += line.Amount
+		case line.Category == value.TaxCategoryReduced:
+			reduced += line.Amount
+		case line.NoDiscount:
+			fixedStandard += line.Amount
+		default:
+			standard += line.Amount
+		}
+	}
+
+	if subtotal := standard + reduced; subtotal > 0 && discount > 0 {
+		discount = math.Min(discount, subtotal)
+		reducedDiscount := roundCents(discount * reduced / subtotal)
+		reduced -= reducedDiscount
+		standard -= discount - reducedDiscount
+	}
+
+	standard += fixedStandard
+	reduced += fixedReduced
+
+	standard = roundCents(standard)
+	reduced = roundCents(reduced)
+
+	return TaxBreakdown{
+		Display:               settings.Display(),
+		RoundingMode:          settings.RoundingMode(),
+		StandardTaxableAmount: standard,
+		StandardTaxAmount:     taxFor(standard, value.TaxCategoryStandard, settings),
+		ReducedTaxableAmount:  reduced,
+		ReducedTaxAmount:      taxFor(reduced, value.TaxCategoryReduced, settings),
+	}
+}
+
+func taxFor(amount float64, category value.TaxCategory, settings value.TaxSettings) float64 {
+	rate := float64(category.Rate())
+	if settings.Display() == value.TaxDisplayInclusive {
+		return settings.Round(amount * rate / (100 + rate))
+	}
+	return settings.Round(amount * rate / 100)
+}
+
+func roundCents(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
@@ -0,0 +1,150 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/service"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+func TestTaxCalculator_Calculate(t *testing.T) {
+	tests := map[string]struct {
+		lines        []service.TaxableLine
+		discount     float64
+		display      string
+		roundingMode string
+		want         service.TaxBreakdown
+		wantTotal    float64
+	}{
+		"exclusive with both rates": {
+			lines: []service.TaxableLine{
+				{Category: value.TaxCategoryStandard, Amount: 1000},
+				{Category: value.TaxCategoryReduced, Amount: 500},
+			},
+			display:      "EXCLUSIVE",
+			roundingMode: "FLOOR",
+			want: service.TaxBreakdown{
+				Display:               value.TaxDisplayExclusive,
+				RoundingMode:          value.TaxRoundingFloor,
+				StandardTaxableAmount: 1000,
+				StandardTaxAmount:     100,
+				ReducedTaxableAmount:  500,
+				ReducedTaxAmount:      40,
+			},
+			wantTotal: 1640,
+		},
+		"undiscounted lines keep their full amount": {
+			lines: []service.TaxableLine{
+				{Category: value.TaxCategoryStandard, Amount: 1000},
+				{Category: value.TaxCategoryStandard, Amount: 500, NoDiscount: true},
+			},
+			discount:     200,
+			display:      "EXCLUSIVE",
+			roundingMode: "FLOOR",
+			want: service.TaxBreakdown{
+				Display:               value.TaxDisplayExclusive,
+				RoundingMode:          value.TaxRoundingFloor,
+				StandardTaxableAmount: 1300,
+				StandardTaxAmount:     130,
+			},
+			wantTotal: 1430,
+		},
+		"inclusive extracts tax from prices": {
+			lines: []service.TaxableLine{
+				{Category: value.TaxCategoryStandard, Amount: 1100},
+				{Category: value.TaxCategoryReduced, Amount: 1080},
+			},
+			display:      "INCLUSIVE",
+			roundingMode: "FLOOR",
+			want: service.TaxBreakdown{
+				Display:               value.TaxDisplayInclusive,
+				RoundingMode:          value.TaxRoundingFloor,
+				StandardTaxableAmount: 1100,
+				StandardTaxAmount:     100,
+				ReducedTaxableAmount:  1080,
+				ReducedTaxAmount:      80,
+			},
+			wantTotal: 2180,
+		},
+		"rounds once per rate instead of per line": {
+			lines: []service.TaxableLine{
+				{Category: value.TaxCategoryReduced, Amount: 105},
+				{Category: value.TaxCategoryReduced, Amount: 105},
+				{Category: value.TaxCategoryReduced, Amount: 105},
+			},
+			display:      "EXCLUSIVE",
+			roundingMode: "FLOOR",
+			want: service.TaxBreakdown{
+				Display:              value.TaxDisplayExclusive,
+				RoundingMode:         value.TaxRoundingFloor,
+				ReducedTaxableAmount: 315,
+				ReducedTaxAmount:     25,
+			},
+			wantTotal: 340,
+		},
+		"ceil rounding": {
+			lines: []service.TaxableLine{
+				{Category: value.TaxCategoryStandard, Amount: 999},
+			},
+			display:      "EXCLUSIVE",
+			roundingMode: "CEIL",
+			want: service.TaxBreakdown{
+				Display:               value.TaxDisplayExclusive,
+				RoundingMode:          value.TaxRoundingCeil,
+				StandardTaxableAmount: 999,
+				StandardTaxAmount:     100,
+			},
+			wantTotal: 1099,
+		},
+		"discount is spread over rates by amount": {
+			lines: []service.TaxableLine{
+				{Category: value.TaxCategoryStandard, Amount: 3000},
+				{Category: value.TaxCategoryReduced, Amount: 1000},
+			},
+			discount:     400,
+			display:      "EXCLUSIVE",
+			roundingMode: "ROUND",
+			want: service.TaxBreakdown{
+				Display:               value.TaxDisplayExclusive,
+				RoundingMode:          value.TaxRoundingRound,
+				StandardTaxableAmount: 2700,
+				StandardTaxAmount:     270,
+				ReducedTaxableAmount:  900,
+				ReducedTaxAmount:      72,
+			},
+			wantTotal: 3942,
+		},
+		"discount never makes taxable amount negative": {
+			lines: []service.TaxableLine{
+				{Category: value.TaxCategoryStandard, Amount: 100},
+			},
+			discount:     500,
+			display:      "EXCLUSIVE",
+			roundingMode: "FLOOR",
+			want: service.TaxBreakdown{
+				Display:      value.TaxDisplayExclusive,
+				RoundingMode: value.TaxRoundingFloor,
+			},
+			wantTotal: 0,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			settings, err := value.NewTaxSettings(tt.display, tt.roundingMode)
+			require.NoError(t, err)
+			calculator := service.NewTaxCalculator()
+
+			// Act
+			got := calculator.Calculate(tt.lines, tt.discount, settings)
+
+			// Assert
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.wantTotal, got.Total())
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package value
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+)
+
+var (
+	ErrCustomerSegmentInvalid            = errors.InvalidParameter.New("customer segment must be FIRST_TIME or RETURNING")
+	ErrCartTotalRangeInvalid             = errors.InvalidParameter.New("cart total range must be non-negative and the maximum above the minimum")
+	ErrSegmentCategoryInvalid            = errors.InvalidParameter.New("segment categories must not be empty")
+	ErrAbandonmentSegmentIDRequired      = errors.InvalidParameter.New("abandonment segment id is required")
+	ErrAbandonmentSegmentTitleRequired   = errors.InvalidParameter.New("abandonment segment title must not be empty")
+	ErrAbandonmentSegmentPriorityInvalid = errors.InvalidParameter.New("abandonment segment priority must be greater than or equal to 0")
+	ErrAbandonmentSegmentMinutesInvalid  = errors.InvalidParameter.New("abandonment segment minutes must be greater than 0")
+)
+
+// CustomerSegment tells first-time customers from returning ones. The empty
+// segment matches both.
+type CustomerSegment string
+
+const (
+	CustomerSegmentAny       CustomerSegment = ""
+	CustomerSegmentFirstTime CustomerSegment = "FIRST_TIME"
+	CustomerSegmentReturning CustomerSegment = "RETURNING"
+)
+
+func NewCustomerSegment(segment string) (CustomerSegment, error) {
+	switch s := CustomerSegment(strings.ToUpper(strings.TrimSpace(segment))); s {
+	case CustomerSegmentAny, CustomerSegmentFirstTime, CustomerSegmentReturning:
+		return s, nil
+	default:
+		return "", ErrCustomerSegmentInvalid
+	}
+}
+
+func (s CustomerSegment) String() string { return string(s) }
+
+// AbandonmentConditions are what a cart has to look like for a segment policy
+// to apply. Every condition left unset matches all carts: a maximum of 0 has
+// no upper bound and no categories match carts of any category.
+type AbandonmentConditions struct {
+	minCartTotal    float64
+	maxCartTotal    float64
+	categories      []string
+	customerSegment CustomerSegment
+}
+
+func NewAbandonmentConditions(minCartTotal, maxCartTotal float64, categories []string, customerSegment CustomerSegment) (AbandonmentConditions, error) {
+	if minCartTotal < 0 || maxCartTotal < 0 || (maxCartTotal > 0 && maxCartTotal <= minCartTotal) {
+		return AbandonmentConditions{}, ErrCartTotalRangeInvalid
+	}
+
+	normalized := make([]string, 0, len(categories))
+	for _, category := range categories {
+		category = strings.TrimSpace(category)
+		if category == "" {
+			return AbandonmentConditions{}, ErrSegmentCategoryInvalid
+		}
+		if !slices.Contains(normalized, category) {
+			normalized = append(normalized, category)
+		}
+	}
+	slices.Sort(normalized)
+
+	return AbandonmentConditions{
+		minCartTotal:    minCartTotal,
+		maxCartTotal:    maxCartTotal,
+		categories:      normalized,
+		customerSegment: customerSegment,
+	}, nil
+}
+
+func (c AbandonmentConditions) MinCartTotal() float64            { return c.minCartTotal }
+func (c AbandonmentConditions) MaxCartTotal() float64            { return c.maxCartTotal }
+func (c AbandonmentConditions) Categories() []string             { return slices.Clone(c.categories) }
+func (c AbandonmentConditions) CustomerSegment() CustomerSegment { return c.customerSegment }
+
+// CoversTotal reports whether the total is in the range. The maximum is
+// exclusive so that adjacent ranges such as 0-5000 and 5000-50000 do not
+// overlap.
+func (c AbandonmentConditions) CoversTotal(total float64) bool {
+	if total < c.minCartTotal {
+		return false
+	}
+	return c.maxCartTotal == 0 || total < c.maxCartTotal
+}
+
+// CoversCategories reports whether the cart holds any of the categories.
+func (c AbandonmentConditions) CoversCategories(categories []string) bool {
+	if len(c.categories) == 0 {
+		return true
+	}
+	for _, category := range categories {
+		if slices.Contains(c.categories, category) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c AbandonmentConditions) CoversCustomer(firstTime bool) bool {
+	switch c.customerSegment {
+	case CustomerSegmentFirstTime:
+		return firstTime
+	case CustomerSegmentReturning:
+		return !firstTime
+	default:
+		return true
+	}
+}
+
+func (c AbandonmentConditions) Equal(other AbandonmentConditions) bool {
+	return c.minCartTotal == other.minCartTotal &&
+		c.maxCartTotal == other.maxCartTotal &&
+		slices.Equal(c.categories, other.categories) &&
+		c.customerSegment == other.customerSegment
+}
+
+// AbandonmentSegment is an abandonment policy that only applies to the carts
+// matching its conditions. Segments with a lower priority are tried first.
+type AbandonmentSegment struct {
+	id               uuid.UUID
+	title            string
+	priority         int
+	abandonedMinutes int
+	conditions       AbandonmentConditions
+}
+
+func NewAbandonmentSegment(id uuid.UUID, title string, priority, abandonedMinutes int, conditions AbandonmentConditions) (AbandonmentSegment, error) {
+	if id == uuid.Nil {
+		return AbandonmentSegment{}, ErrAbandonmentSegmentIDRequired
+	}
+
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return AbandonmentSegment{}, ErrAbandonmentSegmentTitleRequired
+	}
+
+	if priority < 0 {
+		return AbandonmentSegment{}, ErrAbandonmentSegmentPriorityInvalid
+	}
+
+	if abandonedMinutes <= 0 {
+		return AbandonmentSegment{}, ErrAbandonmentSegmentMinutesInvalid
+	}
+
+	return AbandonmentSegment{
+		id:               id,
+		title:            title,
+		priority:         priority,
+		abandonedMinutes: abandonedMinutes,
+		conditions:       conditions,
+	}, nil
+}
+
+func (s AbandonmentSegment) ID() uuid.UUID                     { return s.id }
+func (s AbandonmentSegment) Title() string                     { return s.title }
+func (s AbandonmentSegment) Priority() int                     { return s.priority }
+func (s AbandonmentSegment) AbandonedMinutes() int             { return s.abandonedMinutes }
+func (s AbandonmentSegment) Conditions() AbandonmentConditions { return s.conditions }
+
+func (s AbandonmentSegment) Equal(other AbandonmentSegment) bool {
+	return s.id == other.id &&
+		s.title == other.title &&
+		s.priority == other.priority &&
+		s.abandonedMinutes == other.abandonedMinutes &&
+		s.conditions.Equal(other.conditions)
+}
@@ -0,0 +1,175 @@
+package value_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+func TestNewAbandonmentConditions(t *testing.T) {
+	tests := map[string]struct {
+		minCartTotal   float64
+		maxCartTotal   float64
+		categories     []string
+		wantCategories []string
+		wantError      error
+	}{
+		"categories are trimmed, deduplicated and sorted": {
+			minCartTotal:   5000,
+			maxCartTotal:   50000,
+			categories:     []string{" toys", "books", "toys"},
+			wantCategories: []string{"books", "toys"},
+		},
+		"no upper bound": {
+			minCartTotal:   50000,
+			wantCategories: []string{},
+		},
+		"maximum below minimum": {
+			minCartTotal: 5000,
+			maxCartTotal: 1000,
+			wantError:    value.ErrCartTotalRangeInvalid,
+		},
+		"negative minimum": {
+			minCartTotal: -1,
+			wantError:    value.ErrCartTotalRangeInvalid,
+		},
+		"empty category": {
+			categories: []string{"toys", " "},
+			wantError:  value.ErrSegmentCategoryInvalid,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			conditions, err := value.NewAbandonmentConditions(tt.minCartTotal, tt.maxCartTotal, tt.categories, value.CustomerSegmentAny)
+
+			// Assert
+			if tt.wantError != nil {
+				require.ErrorIs(t, err, tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantCategories, conditions.Categories())
+		})
+	}
+}
+
+func TestAbandonmentConditions_CoversTotal(t *testing.T) {
+	conditions, err := value.NewAbandonmentConditions(5000, 50000, nil, value.CustomerSegmentAny)
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		total float64
+		want  bool
+	}{
+		"below the range":      {total: 4999, want: false},
+		"minimum is inclusive": {total: 5000, want: true},
+		"maximum is exclusive": {total: 50000, want: false},
+		"inside the range":     {total: 20000, want: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			got := conditions.CoversTotal(tt.total)
+
+			// Assert
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewCustomerSegment(t *testing.T) {
+	tests := map[string]struct {
+		segment   string
+		want      value.CustomerSegment
+		wantError error
+	}{
+		"first time":      {segment: "first_time", want: value.CustomerSegmentFirstTime},
+		"returning":       {segment: "RETURNING", want: value.CustomerSegmentReturning},
+		"any customer":    {segment: "", want: value.CustomerSegmentAny},
+		"unknown segment": {segment: "VIP", wantError: value.ErrCustomerSegmentInvalid},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			segment, err := value.NewCustomerSegment(tt.segment)
+
+			// Assert
+			if tt.wantError != nil {
+				require.ErrorIs(t, err, tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, segment)
+		})
+	}
+}
+
+func TestNewAbandonmentSegment(t *testing.T) {
+	conditions, err := value.NewAbandonmentConditions(0, 0, nil, value.CustomerSegmentAny)
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		id               uuid.UUID
+		title            string
+		priority         int
+		abandonedMinutes int
+		wantError        error
+	}{
+		"valid segment": {
+			id:               uuid.New(),
+			title:            "High value carts",
+			abandonedMinutes: 10,
+		},
+		"id is required": {
+			title:            "High value carts",
+			abandonedMinutes: 10,
+			wantError:        value.ErrAbandonmentSegmentIDRequired,
+		},
+		"title is required": {
+			id:               uuid.New(),
+			title:            " ",
+			abandonedMinutes: 10,
+			wantError:        value.ErrAbandonmentSegmentTitleRequired,
+		},
+		"negative priority": {
+			id:               uuid.New(),
+			title:            "High value carts",
+			priority:         -1,
+			abandonedMinutes: 10,
+			wantError:        value.ErrAbandonmentSegmentPriorityInvalid,
+		},
+		"minutes must be positive": {
+			id:        uuid.New(),
+			title:     "High value carts",
+			wantError: value.ErrAbandonmentSegmentMinutesInvalid,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			_, err := value.NewAbandonmentSegment(tt.id, tt.title, tt.priority, tt.abandonedMinutes, conditions)
+
+			// Assert
+			if tt.wantError != nil {
+				require.ErrorIs(t, err, tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
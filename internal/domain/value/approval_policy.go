@@ -0,0 +1,95 @@
+package value
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+)
+
+var (
+	ErrApprovalThresholdInvalid = errors.InvalidParameter.New("approval threshold must be greater than 0")
+	ErrApproversRequired        = errors.InvalidParameter.New("at least one approver is required")
+	ErrApproverInvalid          = errors.InvalidParameter.New("approver id must not be empty")
+	ErrApprovalDeadlineInvalid  = errors.InvalidParameter.New("approval deadline must be at least 1 hour")
+	ErrApprovalDecisionInvalid  = errors.InvalidParameter.New("decision must be APPROVE, REJECT or REQUEST_CHANGES")
+)
+
+// ApprovalPolicy is how a B2B tenant reviews large carts: a cart whose total
+// exceeds the threshold can only be submitted once one of the approvers has
+// approved it, and a request nobody decides on lapses after the deadline.
+type ApprovalPolicy struct {
+	threshold     float64
+	approverIDs   []uuid.UUID
+	deadlineHours int
+}
+
+func NewApprovalPolicy(threshold float64, approverIDs []uuid.UUID, deadlineHours int) (ApprovalPolicy, error) {
+	if threshold <= 0 {
+		return ApprovalPolicy{}, ErrApprovalThresholdInvalid
+	}
+
+	if len(approverIDs) == 0 {
+		return ApprovalPolicy{}, ErrApproversRequired
+	}
+
+	approvers := make([]uuid.UUID, 0, len(approverIDs))
+	for _, approverID := range approverIDs {
+		if approverID == uuid.Nil {
+			return ApprovalPolicy{}, ErrApproverInvalid
+		}
+		if !slices.Contains(approvers, approverID) {
+			approvers = append(approvers, approverID)
+		}
+	}
+
+	if deadlineHours < 1 {
+		return ApprovalPolicy{}, ErrApprovalDeadlineInvalid
+	}
+
+	return ApprovalPolicy{threshold: threshold, approverIDs: approvers, deadlineHours: deadlineHours}, nil
+}
+
+func (p ApprovalPolicy) Threshold() float64           { return p.threshold }
+func (p ApprovalPolicy) DeadlineHours() int           { return p.deadlineHours }
+func (p ApprovalPolicy) Deadline() time.Duration      { return time.Duration(p.deadlineHours) * time.Hour }
+func (p ApprovalPolicy) ApproverIDs() []uuid.UUID     { return slices.Clone(p.approverIDs) }
+func (p ApprovalPolicy) IsApprover(id uuid.UUID) bool { return slices.Contains(p.approverIDs, id) }
+
+// IsConfigured is false for the zero policy of tenants that never set one up;
+// their carts never need approval.
+func (p ApprovalPolicy) IsConfigured() bool {
+	return p.threshold > 0
+}
+
+// Requires reports whether a cart with the given total needs approval.
+func (p ApprovalPolicy) Requires(total float64) bool {
+	return p.IsConfigured() && total > p.threshold
+}
+
+func (p ApprovalPolicy) Equal(other ApprovalPolicy) bool {
+	return p.threshold == other.threshold &&
+		p.deadlineHours == other.deadlineHours &&
+		slices.Equal(p.approverIDs, other.approverIDs)
+}
+
+// ApprovalDecision is what an approver decides on a pending cart.
+type ApprovalDecision string
+
+const (
+	ApprovalDecisionApprove        ApprovalDecision = "APPROVE"
+	ApprovalDecisionReject         ApprovalDecision = "REJECT"
+	ApprovalDecisionRequestChanges ApprovalDecision = "REQUEST_CHANGES"
+)
+
+func NewApprovalDecision(decision string) (ApprovalDecision, error) {
+	d := ApprovalDecision(strings.ToUpper(strings.TrimSpace(decision)))
+	switch d {
+	case ApprovalDecisionApprove, ApprovalDecisionReject, ApprovalDecisionRequestChanges:
+		return d, nil
+	default:
+		return "", ErrApprovalDecisionInvalid
+	}
+}
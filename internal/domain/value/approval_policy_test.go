@@ -0,0 +1,151 @@
+package value_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+func TestNewApprovalPolicy(t *testing.T) {
+	approverID := uuid.New()
+
+	tests := map[string]struct {
+		threshold     float64
+		approverIDs   []uuid.UUID
+		deadlineHours int
+		wantApprovers int
+		wantError     error
+	}{
+		"valid policy": {
+			threshold:     100000,
+			approverIDs:   []uuid.UUID{approverID, uuid.New()},
+			deadlineHours: 48,
+			wantApprovers: 2,
+		},
+		"duplicate approvers are listed once": {
+			threshold:     100000,
+			approverIDs:   []uuid.UUID{approverID, approverID},
+			deadlineHours: 48,
+			wantApprovers: 1,
+		},
+		"zero threshold": {
+			threshold:     0,
+			approverIDs:   []uuid.UUID{approverID},
+			deadlineHours: 48,
+			wantError:     value.ErrApprovalThresholdInvalid,
+		},
+		"no approvers": {
+			threshold:     100000,
+			deadlineHours: 48,
+			wantError:     value.ErrApproversRequired,
+		},
+		"empty approver id": {
+			threshold:     100000,
+			approverIDs:   []uuid.UUID{uuid.Nil},
+			deadlineHours: 48,
+			wantError:     value.ErrApproverInvalid,
+		},
+		"deadline shorter than an hour": {
+			threshold:     100000,
+			approverIDs:   []uuid.UUID{approverID},
+			deadlineHours: 0,
+			wantError:     value.ErrApprovalDeadlineInvalid,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			policy, err := value.NewApprovalPolicy(tt.threshold, tt.approverIDs, tt.deadlineHours)
+
+			// Assert
+			if tt.wantError != nil {
+				require.ErrorIs(t, err, tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, policy.ApproverIDs(), tt.wantApprovers)
+		})
+	}
+}
+
+func TestApprovalPolicy_Requires(t *testing.T) {
+	policy, err := value.NewApprovalPolicy(100000, []uuid.UUID{uuid.New()}, 48)
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		policy value.ApprovalPolicy
+		total  float64
+		want   bool
+	}{
+		"total above the threshold": {
+			policy: policy,
+			total:  100001,
+			want:   true,
+		},
+		"total at the threshold": {
+			policy: policy,
+			total:  100000,
+			want:   false,
+		},
+		"tenant without a policy": {
+			policy: value.ApprovalPolicy{},
+			total:  1000000,
+			want:   false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			got := tt.policy.Requires(tt.total)
+
+			// Assert
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewApprovalDecision(t *testing.T) {
+	tests := map[string]struct {
+		decision  string
+		want      value.ApprovalDecision
+		wantError error
+	}{
+		"approve": {
+			decision: "APPROVE",
+			want:     value.ApprovalDecisionApprove,
+		},
+		"request changes in lower case": {
+			decision: "request_changes",
+			want:     value.ApprovalDecisionRequestChanges,
+		},
+		"unknown decision": {
+			decision:  "ESCALATE",
+			wantError: value.ErrApprovalDecisionInvalid,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			got, err := value.NewApprovalDecision(tt.decision)
+
+			// Assert
+			if tt.wantError != nil {
+				require.ErrorIs(t, err, tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package value
+
+import (
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+)
+
+var ErrCardNumberInvalid = errors.InvalidParameter.New("card number must be 12 to 19 digits")
+
+type CardNumber string
+
+func NewCardNumber(number string) (CardNumber, error) {
+	if len(number) < 12 || len(number) > 19 {
+		return "", ErrCardNumberInvalid
+	}
+
+	for _, r := range number {
+		if r < '0' || r > '9' {
+			return "", ErrCardNumberInvalid
+		}
+	}
+
+	return CardNumber(number), nil
+}
+
+func (c CardNumber) String() string {
+	return string(c)
+}
+
+// Last4 is the only part of the card number that may be stored in events.
+func (c CardNumber) Last4() string {
+	return string(c[len(c)-4:])
+}
@@ -0,0 +1,62 @@
+package value_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+func TestNewCardNumber(t *testing.T) {
+	tests := map[string]struct {
+		input     string
+		want      value.CardNumber
+		wantLast4 string
+		wantError error
+	}{
+		"valid 16 digit card number": {
+			input:     "4242424242424242",
+			want:      value.CardNumber("4242424242424242"),
+			wantLast4: "4242",
+		},
+		"valid 12 digit card number": {
+			input:     "400000000002",
+			want:      value.CardNumber("400000000002"),
+			wantLast4: "0002",
+		},
+		"too short": {
+			input:     "42424242424",
+			wantError: value.ErrCardNumberInvalid,
+		},
+		"too long": {
+			input:     "42424242424242424242",
+			wantError: value.ErrCardNumberInvalid,
+		},
+		"contains non digit": {
+			input:     "4242-4242-4242-4242",
+			wantError: value.ErrCardNumberInvalid,
+		},
+		"empty": {
+			input:     "",
+			wantError: value.ErrCardNumberInvalid,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			got, err := value.NewCardNumber(tt.input)
+
+			// Assert
+			if tt.wantError != nil {
+				require.ErrorIs(t, err, tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.wantLast4, got.Last4())
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package value
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+)
+
+var (
+	ErrCartRuleLimitInvalid         = errors.InvalidParameter.New("cart rule limits must be greater than or equal to 0")
+	ErrCategoryCombinationInvalid   = errors.InvalidParameter.New("a blocked combination needs two different categories")
+	ErrCategoryCombinationDuplicate = errors.InvalidParameter.New("each category combination can only be blocked once")
+)
+
+// CartRuleCode names a rule in the violations returned to clients.
+type CartRuleCode string
+
+const (
+	CartRuleMaxLines                   CartRuleCode = "MAX_LINES"
+	CartRuleMaxQuantityPerItem         CartRuleCode = "MAX_QUANTITY_PER_ITEM"
+	CartRuleMinimumOrderValue          CartRuleCode = "MINIMUM_ORDER_VALUE"
+	CartRuleBlockedCategoryCombination CartRuleCode = "BLOCKED_CATEGORY_COMBINATION"
+)
+
+// CategoryCombination is a pair of product categories that may not be
+// ordered together, such as alcohol and children's goods.
+type CategoryCombination struct {
+	first  string
+	second string
+}
+
+func NewCategoryCombination(a, b string) (CategoryCombination, error) {
+	a = strings.TrimSpace(a)
+	b = strings.TrimSpace(b)
+	if a == "" || b == "" || a == b {
+		return CategoryCombination{}, ErrCategoryCombinationInvalid
+	}
+
+	// The order the tenant lists them in does not matter
+	if b < a {
+		a, b = b, a
+	}
+	return CategoryCombination{first: a, second: b}, nil
+}
+
+func (c CategoryCombination) Categories() []string { return []string{c.first, c.second} }
+
+// CartRules are the guardrails a tenant puts on its carts. A limit of 0 is
+// not enforced, so the zero rules of tenants that never set any allow
+// everything.
+type CartRules struct {
+	maxLines            int
+	maxQuantityPerItem  int
+	minimumOrderValue   float64
+	blockedCombinations []CategoryCombination
+}
+
+func NewCartRules(maxLines, maxQuantityPerItem int, minimumOrderValue float64, blockedCombinations []CategoryCombination) (CartRules, error) {
+	if maxLines < 0 || maxQuantityPerItem < 0 || minimumOrderValue < 0 {
+		return CartRules{}, ErrCartRuleLimitInvalid
+	}
+
+	for i, combination := range blockedCombinations {
+		if slices.Contains(blockedCombinations[:i], combination) {
+			return CartRules{}, ErrCategoryCombinationDuplicate
+		}
+	}
+
+	return CartRules{
+		maxLines:            maxLines,
+		maxQuantityPerItem:  maxQuantityPerItem,
+		minimumOrderValue:   minimumOrderValue,
+		blockedCombinations: slices.Clone(blockedCombinations),
+	}, nil
+}
+
+func (r CartRules) MaxLines() int              { return r.maxLines }
+func (r CartRules) MaxQuantityPerItem() int    { return r.maxQuantityPerItem }
+func (r CartRules) MinimumOrderValue() float64 { return r.minimumOrderValue }
+
+func (r CartRules) BlockedCombinations() []CategoryCombination {
+	return slices.Clone(r.blockedCombinations)
+}
+
+// Blocks reports whether the two categories may not be in one cart.
+func (r CartRules) Blocks(a, b string) bool {
+	combination, err := NewCategoryCombination(a, b)
+	if err != nil {
+		return false
+	}
+	return slices.Contains(r.blockedCombinations, combination)
+}
+
+func (r CartRules) Equal(other CartRules) bool {
+	return r.maxLines == other.maxLines &&
+		r.maxQuantityPerItem == other.maxQuantityPerItem &&
+		r.minimumOrderValue == other.minimumOrderValue &&
+		slices.Equal(r.blockedCombinations, other.blockedCombinations)
+}
@@ -0,0 +1,103 @@
+package value_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+func TestNewCategoryCombination(t *testing.T) {
+	tests := map[string]struct {
+		a         string
+		b         string
+		want      []string
+		wantError error
+	}{
+		"categories are sorted": {
+			a:    "toys",
+			b:    "alcohol",
+			want: []string{"alcohol", "toys"},
+		},
+		"same category twice": {
+			a:         "toys",
+			b:         " toys ",
+			wantError: value.ErrCategoryCombinationInvalid,
+		},
+		"empty category": {
+			a:         "alcohol",
+			b:         "",
+			wantError: value.ErrCategoryCombinationInvalid,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			combination, err := value.NewCategoryCombination(tt.a, tt.b)
+
+			// Assert
+			if tt.wantError != nil {
+				require.ErrorIs(t, err, tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, combination.Categories())
+		})
+	}
+}
+
+func TestNewCartRules(t *testing.T) {
+	alcoholToys, err := value.NewCategoryCombination("alcohol", "toys")
+	require.NoError(t, err)
+	toysAlcohol, err := value.NewCategoryCombination("toys", "alcohol")
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		maxLines           int
+		maxQuantityPerItem int
+		minimumOrderValue  float64
+		blocked            []value.CategoryCombination
+		wantError          error
+	}{
+		"valid rules": {
+			maxLines:           10,
+			maxQuantityPerItem: 3,
+			minimumOrderValue:  2000,
+			blocked:            []value.CategoryCombination{alcoholToys},
+		},
+		"no rules": {},
+		"negative limit": {
+			maxLines:  -1,
+			wantError: value.ErrCartRuleLimitInvalid,
+		},
+		"negative minimum order value": {
+			minimumOrderValue: -100,
+			wantError:         value.ErrCartRuleLimitInvalid,
+		},
+		"same combination in another order": {
+			blocked:   []value.CategoryCombination{alcoholToys, toysAlcohol},
+			wantError: value.ErrCategoryCombinationDuplicate,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			rules, err := value.NewCartRules(tt.maxLines, tt.maxQuantityPerItem, tt.minimumOrderValue, tt.blocked)
+
+			// Assert
+			if tt.wantError != nil {
+				require.ErrorIs(t, err, tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.maxLines, rules.MaxLines())
+			require.Equal(t, len(tt.blocked) > 0, rules.Blocks("toys", "alcohol"))
+		})
+	}
+}
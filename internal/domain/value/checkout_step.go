@@ -0,0 +1,59 @@
+package value
+
+import (
+	"time"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+)
+
+var ErrCheckoutStepInvalid = errors.InvalidParameter.New("checkout step is invalid")
+
+type CheckoutStep string
+
+const (
+	CheckoutStepReserveInventory CheckoutStep = "RESERVE_INVENTORY"
+	CheckoutStepAuthorizePayment CheckoutStep = "AUTHORIZE_PAYMENT"
+	CheckoutStepPlaceOrder       CheckoutStep = "PLACE_ORDER"
+)
+
+var checkoutSteps = []CheckoutStep{
+	CheckoutStepReserveInventory,
+	CheckoutStepAuthorizePayment,
+	CheckoutStepPlaceOrder,
+}
+
+var checkoutStepTimeouts = map[CheckoutStep]time.Duration{
+	CheckoutStepReserveInventory: 30 * time.Second,
+	CheckoutStepAuthorizePayment: 60 * time.Second,
+	CheckoutStepPlaceOrder:       30 * time.Second,
+}
+
+func NewCheckoutStep(step string) (CheckoutStep, error) {
+	for _, s := range checkoutSteps {
+		if string(s) == step {
+			return s, nil
+		}
+	}
+	return "", ErrCheckoutStepInvalid
+}
+
+func FirstCheckoutStep() CheckoutStep {
+	return checkoutSteps[0]
+}
+
+func (s CheckoutStep) String() string {
+	return string(s)
+}
+
+func (s CheckoutStep) Next() (CheckoutStep, bool) {
+	for i, step := range checkoutSteps {
+		if step == s && i+1 < len(checkoutSteps) {
+			return checkoutSteps[i+1], true
+		}
+	}
+	return "", false
+}
+
+func (s CheckoutStep) Timeout() time.Duration {
+	return checkoutStepTimeouts[s]
+}
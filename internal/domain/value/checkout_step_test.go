@@ -0,0 +1,111 @@
+package value_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+func TestNewCheckoutStep(t *testing.T) {
+	tests := map[string]struct {
+		input     string
+		want      value.CheckoutStep
+		wantError error
+	}{
+		"reserve inventory": {
+			input: "RESERVE_INVENTORY",
+			want:  value.CheckoutStepReserveInventory,
+		},
+		"authorize payment": {
+			input: "AUTHORIZE_PAYMENT",
+			want:  value.CheckoutStepAuthorizePayment,
+		},
+		"place order": {
+			input: "PLACE_ORDER",
+			want:  value.CheckoutStepPlaceOrder,
+		},
+		"lower case step": {
+			input:     "place_order",
+			wantError: value.ErrCheckoutStepInvalid,
+		},
+		"empty step": {
+			input:     "",
+			wantError: value.ErrCheckoutStepInvalid,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			result, err := value.NewCheckoutStep(tt.input)
+			if tt.wantError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.wantError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, result)
+			}
+		})
+	}
+}
+
+func TestCheckoutStep_Next(t *testing.T) {
+	tests := map[string]struct {
+		step     value.CheckoutStep
+		want     value.CheckoutStep
+		wantNext bool
+	}{
+		"reserve inventory is followed by authorize payment": {
+			step:     value.CheckoutStepReserveInventory,
+			want:     value.CheckoutStepAuthorizePayment,
+			wantNext: true,
+		},
+		"authorize payment is followed by place order": {
+			step:     value.CheckoutStepAuthorizePayment,
+			want:     value.CheckoutStepPlaceOrder,
+			wantNext: true,
+		},
+		"place order is the last step": {
+			step:     value.CheckoutStepPlaceOrder,
+			wantNext: false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			result, ok := tt.step.Next()
+			require.Equal(t, tt.wantNext, ok)
+			require.Equal(t, tt.want, result)
+		})
+	}
+}
+
+func TestCheckoutStep_Timeout(t *testing.T) {
+	tests := map[string]struct {
+		step value.CheckoutStep
+		want time.Duration
+	}{
+		"reserve inventory": {
+			step: value.CheckoutStepReserveInventory,
+			want: 30 * time.Second,
+		},
+		"authorize payment": {
+			step: value.CheckoutStepAuthorizePayment,
+			want: 60 * time.Second,
+		},
+		"place order": {
+			step: value.CheckoutStepPlaceOrder,
+			want: 30 * time.Second,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, tt.step.Timeout())
+		})
+	}
+}
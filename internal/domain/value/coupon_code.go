@@ -0,0 +1,31 @@
+package value
+
+import (
+	"strings"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+)
+
+var ErrCouponCodeInvalid = errors.InvalidParameter.New("coupon code must be 3 to 32 letters, digits or hyphens")
+
+// CouponCode is case-insensitive and always stored in upper case.
+type CouponCode string
+
+func NewCouponCode(code string) (CouponCode, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(code))
+	if len(normalized) < 3 || len(normalized) > 32 {
+		return "", ErrCouponCodeInvalid
+	}
+
+	for _, r := range normalized {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') && r != '-' {
+			return "", ErrCouponCodeInvalid
+		}
+	}
+
+	return CouponCode(normalized), nil
+}
+
+func (c CouponCode) String() string {
+	return string(c)
+}
@@ -0,0 +1,54 @@
+package value_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+func TestNewCouponCode(t *testing.T) {
+	tests := map[string]struct {
+		input     string
+		want      value.CouponCode
+		wantError error
+	}{
+		"upper case code": {
+			input: "SUMMER-10",
+			want:  value.CouponCode("SUMMER-10"),
+		},
+		"normalizes case and spaces": {
+			input: "  summer10 ",
+			want:  value.CouponCode("SUMMER10"),
+		},
+		"too short": {
+			input:     "AB",
+			wantError: value.ErrCouponCodeInvalid,
+		},
+		"too long": {
+			input:     "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456",
+			wantError: value.ErrCouponCodeInvalid,
+		},
+		"contains invalid character": {
+			input:     "SUMMER_10",
+			wantError: value.ErrCouponCodeInvalid,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			got, err := value.NewCouponCode(tt.input)
+
+			// Assert
+			if tt.wantError != nil {
+				require.ErrorIs(t, err, tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package value
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+)
+
+var (
+	ErrLineOptionNameRequired  = errors.InvalidParameter.New("option name must not be empty")
+	ErrLineOptionValueRequired = errors.InvalidParameter.New("option value must not be empty")
+	ErrLineOptionDuplicate     = errors.InvalidParameter.New("each option can only be chosen once per item")
+	ErrLineOptionModifierLarge = errors.InvalidParameter.New("option price modifier cannot exceed 1000000")
+)
+
+// LineOption is a variant attribute chosen for a cart line, such as a size,
+// a colour or gift wrap. The modifier is added to the item's base price and
+// may be negative.
+type LineOption struct {
+	name          string
+	value         string
+	priceModifier float64
+}
+
+func NewLineOption(name, value string, priceModifier float64) (LineOption, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return LineOption{}, ErrLineOptionNameRequired
+	}
+
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return LineOption{}, ErrLineOptionValueRequired
+	}
+
+	if priceModifier > 1000000 || priceModifier < -1000000 {
+		return LineOption{}, ErrLineOptionModifierLarge
+	}
+
+	return LineOption{name: name, value: value, priceModifier: priceModifier}, nil
+}
+
+func (o LineOption) Name() string           { return o.name }
+func (o LineOption) Value() string          { return o.value }
+func (o LineOption) PriceModifier() float64 { return o.priceModifier }
+
+// LineOptions are the options of one cart line, kept sorted by name so the
+// same choices always make the same line.
+type LineOptions struct {
+	options []LineOption
+}
+
+func NewLineOptions(options []LineOption) (LineOptions, error) {
+	sorted := slices.Clone(options)
+	slices.SortFunc(sorted, func(a, b LineOption) int {
+		return strings.Compare(a.name, b.name)
+	})
+
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i].name == sorted[i-1].name {
+			return LineOptions{}, ErrLineOptionDuplicate
+		}
+	}
+
+	return LineOptions{options: sorted}, nil
+}
+
+func (o LineOptions) Options() []LineOption { return slices.Clone(o.options) }
+func (o LineOptions) IsEmpty() bool         { return len(o.options) == 0 }
+
+// PriceModifier is what the options add to the base price.
+func (o LineOptions) PriceModifier() float64 {
+	total := 0.0
+	for _, option := range o.options {
+		total += option.priceModifier
+	}
+	return total
+}
+
+// LineID identifies the line of an item with these options. Items without
+// options keep the item ID, so lines added before options existed are unchanged.
+func (o LineOptions) LineID(itemID uuid.UUID) uuid.UUID {
+	if o.IsEmpty() {
+		return itemID
+	}
+
+	parts := make([]string, 0, len(o.options))
+	for _, option := range o.options {
+		parts = append(parts, option.name+"="+option.value)
+	}
+	return uuid.NewSHA1(itemID, []byte(strings.Join(parts, "&")))
+}
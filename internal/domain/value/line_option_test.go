@@ -0,0 +1,168 @@
+package value_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+func TestNewLineOption(t *testing.T) {
+	tests := map[string]struct {
+		name          string
+		value         string
+		priceModifier float64
+		wantError     error
+	}{
+		"valid option": {
+			name:          "size",
+			value:         "M",
+			priceModifier: 0,
+		},
+		"negative modifier": {
+			name:          "gift_wrap",
+			value:         "none",
+			priceModifier: -100,
+		},
+		"empty name": {
+			name:      " ",
+			value:     "M",
+			wantError: value.ErrLineOptionNameRequired,
+		},
+		"empty value": {
+			name:      "size",
+			value:     "",
+			wantError: value.ErrLineOptionValueRequired,
+		},
+		"modifier too large": {
+			name:          "engraving",
+			value:         "yes",
+			priceModifier: 1000001,
+			wantError:     value.ErrLineOptionModifierLarge,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			option, err := value.NewLineOption(tt.name, tt.value, tt.priceModifier)
+
+			// Assert
+			if tt.wantError != nil {
+				require.ErrorIs(t, err, tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.priceModifier, option.PriceModifier())
+		})
+	}
+}
+
+func TestNewLineOptions(t *testing.T) {
+	size, err := value.NewLineOption("size", "M", 0)
+	require.NoError(t, err)
+	giftWrap, err := value.NewLineOption("gift_wrap", "yes", 300)
+	require.NoError(t, err)
+	otherSize, err := value.NewLineOption("size", "L", 0)
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		options      []value.LineOption
+		wantNames    []string
+		wantModifier float64
+		wantError    error
+	}{
+		"options are sorted by name": {
+			options:      []value.LineOption{size, giftWrap},
+			wantNames:    []string{"gift_wrap", "size"},
+			wantModifier: 300,
+		},
+		"no options": {
+			wantNames:    []string{},
+			wantModifier: 0,
+		},
+		"same option twice": {
+			options:   []value.LineOption{size, otherSize},
+			wantError: value.ErrLineOptionDuplicate,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			options, err := value.NewLineOptions(tt.options)
+
+			// Assert
+			if tt.wantError != nil {
+				require.ErrorIs(t, err, tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+			names := make([]string, 0)
+			for _, option := range options.Options() {
+				names = append(names, option.Name())
+			}
+			require.Equal(t, tt.wantNames, names)
+			require.Equal(t, tt.wantModifier, options.PriceModifier())
+		})
+	}
+}
+
+func TestLineOptions_LineID(t *testing.T) {
+	itemID := uuid.New()
+	size, err := value.NewLineOption("size", "M", 0)
+	require.NoError(t, err)
+	giftWrap, err := value.NewLineOption("gift_wrap", "yes", 300)
+	require.NoError(t, err)
+	otherSize, err := value.NewLineOption("size", "L", 0)
+	require.NoError(t, err)
+
+	mustOptions := func(options ...value.LineOption) value.LineOptions {
+		lineOptions, err := value.NewLineOptions(options)
+		require.NoError(t, err)
+		return lineOptions
+	}
+
+	tests := map[string]struct {
+		a        value.LineOptions
+		b        value.LineOptions
+		wantSame bool
+	}{
+		"same options in another order make the same line": {
+			a:        mustOptions(size, giftWrap),
+			b:        mustOptions(giftWrap, size),
+			wantSame: true,
+		},
+		"other options make another line": {
+			a:        mustOptions(size),
+			b:        mustOptions(otherSize),
+			wantSame: false,
+		},
+		"no options keep the item id": {
+			a:        mustOptions(),
+			b:        value.LineOptions{},
+			wantSame: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			a := tt.a.LineID(itemID)
+			b := tt.b.LineID(itemID)
+
+			// Assert
+			require.Equal(t, tt.wantSame, a == b)
+			if tt.a.IsEmpty() {
+				require.Equal(t, itemID, a)
+			}
+		})
+	}
+}
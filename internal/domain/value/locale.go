@@ -0,0 +1,42 @@
+package value
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+)
+
+var ErrLocaleInvalid = errors.InvalidParameter.New("locale must be a language code with an optional region, such as ja-JP")
+
+var localePattern = regexp.MustCompile(`^[a-z]{2}(-[A-Z]{2})?$`)
+
+// DefaultLocale is used for tenants that do not choose one.
+const DefaultLocale Locale = "ja-JP"
+
+// Locale is a language tag such as ja-JP or en.
+type Locale string
+
+// NewLocale normalises the case of the language and region, so "EN-us"
+// becomes "en-US".
+func NewLocale(locale string) (Locale, error) {
+	locale = strings.TrimSpace(locale)
+	if locale == "" {
+		return DefaultLocale, nil
+	}
+
+	language, region, hasRegion := strings.Cut(locale, "-")
+	normalised := strings.ToLower(language)
+	if hasRegion {
+		normalised += "-" + strings.ToUpper(region)
+	}
+
+	if !localePattern.MatchString(normalised) {
+		return "", ErrLocaleInvalid
+	}
+	return Locale(normalised), nil
+}
+
+func (l Locale) String() string {
+	return string(l)
+}
@@ -0,0 +1,54 @@
+package value_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+func TestNewLocale(t *testing.T) {
+	tests := map[string]struct {
+		input     string
+		want      value.Locale
+		wantError error
+	}{
+		"empty defaults to japanese": {
+			input: "",
+			want:  value.DefaultLocale,
+		},
+		"language only": {
+			input: "en",
+			want:  "en",
+		},
+		"case is normalised": {
+			input: "EN-us",
+			want:  "en-US",
+		},
+		"underscore separator": {
+			input:     "en_US",
+			wantError: value.ErrLocaleInvalid,
+		},
+		"three letter region": {
+			input:     "en-USA",
+			wantError: value.ErrLocaleInvalid,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			got, err := value.NewLocale(tt.input)
+
+			// Assert
+			if tt.wantError != nil {
+				require.ErrorIs(t, err, tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
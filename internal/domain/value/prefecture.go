@@ -0,0 +1,37 @@
+package value
+
+import (
+	"strings"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+)
+
+var ErrPrefectureInvalid = errors.InvalidParameter.New("prefecture is not a Japanese prefecture")
+
+// Prefecture is one of the 47 Japanese prefectures, in romanized form.
+type Prefecture string
+
+var prefectures = []Prefecture{
+	"Hokkaido", "Aomori", "Iwate", "Miyagi", "Akita", "Yamagata", "Fukushima",
+	"Ibaraki", "Tochigi", "Gunma", "Saitama", "Chiba", "Tokyo", "Kanagawa",
+	"Niigata", "Toyama", "Ishikawa", "Fukui", "Yamanashi", "Nagano", "Gifu",
+	"Shizuoka", "Aichi", "Mie", "Shiga", "Kyoto", "Osaka", "Hyogo", "Nara",
+	"Wakayama", "Tottori", "Shimane", "Okayama", "Hiroshima", "Yamaguchi",
+	"Tokushima", "Kagawa", "Ehime", "Kochi", "Fukuoka", "Saga", "Nagasaki",
+	"Kumamoto", "Oita", "Miyazaki", "Kagoshima", "Okinawa",
+}
+
+// NewPrefecture accepts any casing and returns the canonical spelling.
+func NewPrefecture(prefecture string) (Prefecture, error) {
+	trimmed := strings.TrimSpace(prefecture)
+	for _, p := range prefectures {
+		if strings.EqualFold(string(p), trimmed) {
+			return p, nil
+		}
+	}
+	return "", ErrPrefectureInvalid
+}
+
+func (p Prefecture) String() string {
+	return string(p)
+}
@@ -0,0 +1,127 @@
+package value
+
+import (
+	"math"
+	"sort"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+)
+
+var (
+	ErrPromotionTypeInvalid     = errors.InvalidParameter.New("promotion type is invalid")
+	ErrPromotionPercentInvalid  = errors.InvalidParameter.New("percent off must be greater than 0 and at most 100")
+	ErrPromotionAmountInvalid   = errors.InvalidParameter.New("amount off must be greater than 0")
+	ErrPromotionQuantityInvalid = errors.InvalidParameter.New("buy and get quantities must be at least 1")
+	ErrPromotionMinimumInvalid  = errors.InvalidParameter.New("minimum cart total must be greater than or equal to 0")
+)
+
+type PromotionType string
+
+const (
+	PromotionTypePercentOff     PromotionType = "PERCENT_OFF"
+	PromotionTypeFixedAmountOff PromotionType = "FIXED_AMOUNT_OFF"
+	PromotionTypeBuyXGetY       PromotionType = "BUY_X_GET_Y"
+)
+
+// Promotion describes how a coupon discounts a cart. It only applies once the
+// cart subtotal reaches MinimumTotal.
+type Promotion struct {
+	promotionType PromotionType
+	value         float64
+	buyQuantity   int
+	getQuantity   int
+	minimumTotal  float64
+}
+
+func NewPromotion(promotionType string, value float64, buyQuantity, getQuantity int, minimumTotal float64) (Promotion, error) {
+	if minimumTotal < 0 {
+		return Promotion{}, ErrPromotionMinimumInvalid
+	}
+
+	p := Promotion{
+		promotionType: PromotionType(promotionType),
+		minimumTotal:  minimumTotal,
+	}
+
+	switch p.promotionType {
+	case PromotionTypePercentOff:
+		if value <= 0 || value > 100 {
+			return Promotion{}, ErrPromotionPercentInvalid
+		}
+		p.value = value
+	case PromotionTypeFixedAmountOff:
+		if value <= 0 {
+			return Promotion{}, ErrPromotionAmountInvalid
+		}
+		p.value = value
+	case PromotionTypeBuyXGetY:
+		if buyQuantity < 1 || getQuantity < 1 {
+			return Promotion{}, ErrPromotionQuantityInvalid
+		}
+		p.buyQuantity = buyQuantity
+		p.getQuantity = getQuantity
+	default:
+		return Promotion{}, ErrPromotionTypeInvalid
+	}
+
+	return p, nil
+}
+
+func (p Promotion) Type() PromotionType   { return p.promotionType }
+func (p Promotion) Value() float64        { return p.value }
+func (p Promotion) BuyQuantity() int      { return p.buyQuantity }
+func (p Promotion) GetQuantity() int      { return p.getQuantity }
+func (p Promotion) MinimumTotal() float64 { return p.minimumTotal }
+
+// Discount returns how much the promotion takes off. prices are the unit
+// prices in the cart and remaining is the total left after promotions that
+// were applied earlier, so stacked promotions never push the total below 0.
+func (p Promotion) Discount(prices []float64, remaining float64) float64 {
+	subtotal := 0.0
+	for _, price := range prices {
+		subtotal += price
+	}
+	if subtotal < p.minimumTotal || remaining <= 0 {
+		return 0
+	}
+
+	var discount float64
+	switch p.promotionType {
+	case PromotionTypePercentOff:
+		discount = math.Round(remaining*p.value) / 100
+	case PromotionTypeFixedAmountOff:
+		discount = p.value
+	case PromotionTypeBuyXGetY:
+		// The cheapest items of every full buy+get group are free.
+		sorted := make([]float64, len(prices))
+		copy(sorted, prices)
+		sort.Sort(sort.Reverse(sort.Float64Slice(sorted)))
+
+		group := p.buyQuantity + p.getQuantity
+		for i := 0; i+group <= len(sorted); i += group {
+			for _, price := range sorted[i+p.buyQuantity : i+group] {
+				discount += price
+			}
+		}
+	}
+
+	return math.Min(discount, remaining)
+}
+
+// ApplyPromotions applies promotions in order and returns the discount of
+// each promotion together with the discounted total.
+func ApplyPromotions(prices []float64, promotions []Promotion) ([]float64, float64) {
+	total := 0.0
+	for _, price := range prices {
+		total += price
+	}
+
+	discounts := make([]float64, 0, len(promotions))
+	for _, promotion := range promotions {
+		discount := promotion.Discount(prices, total)
+		discounts = append(discounts, discount)
+		total -= discount
+	}
+
+	return discounts, total
+}
@@ -0,0 +1,152 @@
+package value_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+func TestNewPromotion(t *testing.T) {
+	tests := map[string]struct {
+		promotionType string
+		value         float64
+		buyQuantity   int
+		getQuantity   int
+		minimumTotal  float64
+		wantError     error
+	}{
+		"valid percent off": {
+			promotionType: "PERCENT_OFF",
+			value:         10,
+		},
+		"valid fixed amount off with minimum total": {
+			promotionType: "FIXED_AMOUNT_OFF",
+			value:         500,
+			minimumTotal:  3000,
+		},
+		"valid buy x get y": {
+			promotionType: "BUY_X_GET_Y",
+			buyQuantity:   2,
+			getQuantity:   1,
+		},
+		"unknown type": {
+			promotionType: "FREE_SHIPPING",
+			value:         10,
+			wantError:     value.ErrPromotionTypeInvalid,
+		},
+		"percent over 100": {
+			promotionType: "PERCENT_OFF",
+			value:         101,
+			wantError:     value.ErrPromotionPercentInvalid,
+		},
+		"zero amount off": {
+			promotionType: "FIXED_AMOUNT_OFF",
+			wantError:     value.ErrPromotionAmountInvalid,
+		},
+		"buy x get y without get quantity": {
+			promotionType: "BUY_X_GET_Y",
+			buyQuantity:   2,
+			wantError:     value.ErrPromotionQuantityInvalid,
+		},
+		"negative minimum total": {
+			promotionType: "PERCENT_OFF",
+			value:         10,
+			minimumTotal:  -1,
+			wantError:     value.ErrPromotionMinimumInvalid,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			got, err := value.NewPromotion(tt.promotionType, tt.value, tt.buyQuantity, tt.getQuantity, tt.minimumTotal)
+
+			// Assert
+			if tt.wantError != nil {
+				require.ErrorIs(t, err, tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, value.PromotionType(tt.promotionType), got.Type())
+		})
+	}
+}
+
+func TestApplyPromotions(t *testing.T) {
+	percentOff, _ := value.NewPromotion("PERCENT_OFF", 10, 0, 0, 0)
+	fixedOff, _ := value.NewPromotion("FIXED_AMOUNT_OFF", 30, 0, 0, 0)
+	bigFixedOff, _ := value.NewPromotion("FIXED_AMOUNT_OFF", 1000, 0, 0, 0)
+	buy2Get1, _ := value.NewPromotion("BUY_X_GET_Y", 0, 2, 1, 0)
+	minimum100, _ := value.NewPromotion("FIXED_AMOUNT_OFF", 10, 0, 0, 100)
+
+	tests := map[string]struct {
+		prices        []float64
+		promotions    []value.Promotion
+		wantDiscounts []float64
+		wantTotal     float64
+	}{
+		"no promotions": {
+			prices:        []float64{50, 25},
+			wantDiscounts: []float64{},
+			wantTotal:     75,
+		},
+		"percent off": {
+			prices:        []float64{50, 50},
+			promotions:    []value.Promotion{percentOff},
+			wantDiscounts: []float64{10},
+			wantTotal:     90,
+		},
+		"stacked promotions apply to remaining total": {
+			prices:        []float64{50, 50},
+			promotions:    []value.Promotion{fixedOff, percentOff},
+			wantDiscounts: []float64{30, 7},
+			wantTotal:     63,
+		},
+		"fixed amount never exceeds total": {
+			prices:        []float64{50},
+			promotions:    []value.Promotion{bigFixedOff},
+			wantDiscounts: []float64{50},
+			wantTotal:     0,
+		},
+		"buy two get cheapest free": {
+			prices:        []float64{10, 30, 20, 40},
+			promotions:    []value.Promotion{buy2Get1},
+			wantDiscounts: []float64{20},
+			wantTotal:     80,
+		},
+		"buy x get y needs a full group": {
+			prices:        []float64{10, 30},
+			promotions:    []value.Promotion{buy2Get1},
+			wantDiscounts: []float64{0},
+			wantTotal:     40,
+		},
+		"minimum total not reached": {
+			prices:        []float64{50, 49},
+			promotions:    []value.Promotion{minimum100},
+			wantDiscounts: []float64{0},
+			wantTotal:     99,
+		},
+		"minimum total reached": {
+			prices:        []float64{50, 50},
+			promotions:    []value.Promotion{minimum100},
+			wantDiscounts: []float64{10},
+			wantTotal:     90,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			discounts, total := value.ApplyPromotions(tt.prices, tt.promotions)
+
+			// Assert
+			require.Equal(t, tt.wantDiscounts, discounts)
+			require.Equal(t, tt.wantTotal, total)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package value
+
+import (
+	"strings"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+)
+
+var (
+	ErrSessionIDEmpty   = errors.InvalidParameter.New("session_id cannot be empty")
+	ErrSessionIDTooLong = errors.InvalidParameter.New("session_id cannot exceed 128 characters")
+)
+
+// SessionID identifies an anonymous shopper until they log in.
+type SessionID string
+
+func NewSessionID(id string) (SessionID, error) {
+	trimmed := strings.TrimSpace(id)
+
+	if trimmed == "" {
+		return "", ErrSessionIDEmpty
+	}
+
+	if len(trimmed) > 128 {
+		return "", ErrSessionIDTooLong
+	}
+
+	return SessionID(trimmed), nil
+}
+
+func (s SessionID) String() string {
+	return string(s)
+}
@@ -0,0 +1,50 @@
+package value_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+func TestNewSessionID(t *testing.T) {
+	tests := map[string]struct {
+		input     string
+		want      value.SessionID
+		wantError error
+	}{
+		"valid session ID": {
+			input: "sess_8f14e45f",
+			want:  value.SessionID("sess_8f14e45f"),
+		},
+		"session ID with spaces": {
+			input: "  sess_8f14e45f  ",
+			want:  value.SessionID("sess_8f14e45f"),
+		},
+		"empty session ID": {
+			input:     "",
+			wantError: value.ErrSessionIDEmpty,
+		},
+		"too long session ID": {
+			input:     strings.Repeat("a", 129),
+			wantError: value.ErrSessionIDTooLong,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := value.NewSessionID(tt.input)
+
+			if tt.wantError != nil {
+				require.Error(t, err)
+				require.ErrorIs(t, err, tt.wantError)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, result)
+			}
+		})
+	}
+}
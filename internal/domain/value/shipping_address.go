@@ -0,0 +1,64 @@
+package value
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+)
+
+var (
+	ErrPostalCodeInvalid       = errors.InvalidParameter.New("postal code must be 7 digits, optionally written as 123-4567")
+	ErrShippingAddressRequired = errors.InvalidParameter.New("recipient name, city and address line 1 are required")
+)
+
+var postalCodePattern = regexp.MustCompile(`^\d{3}-?\d{4}$`)
+
+type ShippingAddress struct {
+	recipientName string
+	postalCode    string
+	prefecture    Prefecture
+	city          string
+	addressLine1  string
+	addressLine2  string
+	phone         string
+}
+
+func NewShippingAddress(recipientName, postalCode, prefecture, city, addressLine1, addressLine2, phone string) (ShippingAddress, error) {
+	recipientName = strings.TrimSpace(recipientName)
+	city = strings.TrimSpace(city)
+	addressLine1 = strings.TrimSpace(addressLine1)
+	if recipientName == "" || city == "" || addressLine1 == "" {
+		return ShippingAddress{}, ErrShippingAddressRequired
+	}
+
+	postalCode = strings.TrimSpace(postalCode)
+	if !postalCodePattern.MatchString(postalCode) {
+		return ShippingAddress{}, ErrPostalCodeInvalid
+	}
+	postalCode = strings.ReplaceAll(postalCode, "-", "")
+	postalCode = postalCode[:3] + "-" + postalCode[3:]
+
+	p, err := NewPrefecture(prefecture)
+	if err != nil {
+		return ShippingAddress{}, err
+	}
+
+	return ShippingAddress{
+		recipientName: recipientName,
+		postalCode:    postalCode,
+		prefecture:    p,
+		city:          city,
+		addressLine1:  addressLine1,
+		addressLine2:  strings.TrimSpace(addressLine2),
+		phone:         strings.TrimSpace(phone),
+	}, nil
+}
+
+func (a ShippingAddress) RecipientName() string  { return a.recipientName }
+func (a ShippingAddress) PostalCode() string     { return a.postalCode }
+func (a ShippingAddress) Prefecture() Prefecture { return a.prefecture }
+func (a ShippingAddress) City() string           { return a.city }
+func (a ShippingAddress) AddressLine1() string   { return a.addressLine1 }
+func (a ShippingAddress) AddressLine2() string   { return a.addressLine2 }
+func (a ShippingAddress) Phone() string          { return a.phone }
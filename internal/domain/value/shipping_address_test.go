@@ -0,0 +1,76 @@
+package value_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+func TestNewShippingAddress(t *testing.T) {
+	tests := map[string]struct {
+		recipientName  string
+		postalCode     string
+		prefecture     string
+		addressLine1   string
+		wantPostalCode string
+		wantPrefecture value.Prefecture
+		wantError      error
+	}{
+		"postal code without hyphen is normalized": {
+			recipientName:  "Taro Yamada",
+			postalCode:     "1000001",
+			prefecture:     "tokyo",
+			addressLine1:   "1-1 Chiyoda",
+			wantPostalCode: "100-0001",
+			wantPrefecture: "Tokyo",
+		},
+		"postal code with hyphen": {
+			recipientName:  "Taro Yamada",
+			postalCode:     "530-0001",
+			prefecture:     "OSAKA",
+			addressLine1:   "1-1 Umeda",
+			wantPostalCode: "530-0001",
+			wantPrefecture: "Osaka",
+		},
+		"invalid postal code": {
+			recipientName: "Taro Yamada",
+			postalCode:    "100-001",
+			prefecture:    "Tokyo",
+			addressLine1:  "1-1 Chiyoda",
+			wantError:     value.ErrPostalCodeInvalid,
+		},
+		"unknown prefecture": {
+			recipientName: "Taro Yamada",
+			postalCode:    "100-0001",
+			prefecture:    "California",
+			addressLine1:  "1-1 Chiyoda",
+			wantError:     value.ErrPrefectureInvalid,
+		},
+		"missing recipient": {
+			recipientName: " ",
+			postalCode:    "100-0001",
+			prefecture:    "Tokyo",
+			addressLine1:  "1-1 Chiyoda",
+			wantError:     value.ErrShippingAddressRequired,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			address, err := value.NewShippingAddress(tt.recipientName, tt.postalCode, tt.prefecture, "Chiyoda-ku", tt.addressLine1, "", "03-1234-5678")
+
+			// Assert
+			if tt.wantError != nil {
+				require.ErrorIs(t, err, tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantPostalCode, address.PostalCode())
+			require.Equal(t, tt.wantPrefecture, address.Prefecture())
+		})
+	}
+}
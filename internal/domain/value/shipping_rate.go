@@ -0,0 +1,109 @@
+package value
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+)
+
+var (
+	ErrShippingMethodInvalid = errors.InvalidParameter.New("shipping method must be 1 to 32 letters, digits or underscores")
+	ErrShippingWeightInvalid = errors.InvalidParameter.New("weight band must be greater than 0 grams")
+	ErrShippingFeeInvalid    = errors.InvalidParameter.New("shipping fee must be greater than or equal to 0")
+	ErrShippingRateNotFound  = errors.UnpermittedOp.New("no shipping rate for this method, prefecture and weight")
+)
+
+// ShippingMethod is a tenant-defined delivery option such as STANDARD or
+// EXPRESS, always stored in upper case.
+type ShippingMethod string
+
+func NewShippingMethod(method string) (ShippingMethod, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(method))
+	if len(normalized) == 0 || len(normalized) > 32 {
+		return "", ErrShippingMethodInvalid
+	}
+
+	for _, r := range normalized {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') && r != '_' {
+			return "", ErrShippingMethodInvalid
+		}
+	}
+
+	return ShippingMethod(normalized), nil
+}
+
+func (m ShippingMethod) String() string {
+	return string(m)
+}
+
+// ShippingRate is the fee for parcels up to MaxWeightGrams sent with a method
+// to a prefecture.
+type ShippingRate struct {
+	method         ShippingMethod
+	prefecture     Prefecture
+	maxWeightGrams int
+	fee            float64
+}
+
+func NewShippingRate(method, prefecture string, maxWeightGrams int, fee float64) (ShippingRate, error) {
+	m, err := NewShippingMethod(method)
+	if err != nil {
+		return ShippingRate{}, err
+	}
+
+	p, err := NewPrefecture(prefecture)
+	if err != nil {
+		return ShippingRate{}, err
+	}
+
+	if maxWeightGrams <= 0 {
+		return ShippingRate{}, ErrShippingWeightInvalid
+	}
+
+	if fee < 0 {
+		return ShippingRate{}, ErrShippingFeeInvalid
+	}
+
+	return ShippingRate{method: m, prefecture: p, maxWeightGrams: maxWeightGrams, fee: fee}, nil
+}
+
+func (r ShippingRate) Method() ShippingMethod { return r.method }
+func (r ShippingRate) Prefecture() Prefecture { return r.prefecture }
+func (r ShippingRate) MaxWeightGrams() int    { return r.maxWeightGrams }
+func (r ShippingRate) Fee() float64           { return r.fee }
+
+// SameBand reports whether both rates cover the same method, prefecture and
+// weight band, regardless of fee.
+func (r ShippingRate) SameBand(other ShippingRate) bool {
+	return r.method == other.method && r.prefecture == other.prefecture && r.maxWeightGrams == other.maxWeightGrams
+}
+
+// ShippingRateTable looks up fees from a tenant's rates.
+type ShippingRateTable struct {
+	rates []ShippingRate
+}
+
+func NewShippingRateTable(rates []ShippingRate) ShippingRateTable {
+	sorted := make([]ShippingRate, len(rates))
+	copy(sorted, rates)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].maxWeightGrams < sorted[j].maxWeightGrams
+	})
+	return ShippingRateTable{rates: sorted}
+}
+
+func (t ShippingRateTable) Rates() []ShippingRate {
+	return t.rates
+}
+
+// FeeFor returns the fee of the lightest weight band that still fits the
+// parcel.
+func (t ShippingRateTable) FeeFor(method ShippingMethod, prefecture Prefecture, weightGrams int) (float64, error) {
+	for _, rate := range t.rates {
+		if rate.method == method && rate.prefecture == prefecture && weightGrams <= rate.maxWeightGrams {
+			return rate.fee, nil
+		}
+	}
+	return 0, ErrShippingRateNotFound
+}
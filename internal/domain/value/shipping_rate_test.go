@@ -0,0 +1,131 @@
+package value_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+func TestNewShippingRate(t *testing.T) {
+	tests := map[string]struct {
+		method         string
+		prefecture     string
+		maxWeightGrams int
+		fee            float64
+		wantMethod     value.ShippingMethod
+		wantError      error
+	}{
+		"method is upper cased": {
+			method:         "express",
+			prefecture:     "Tokyo",
+			maxWeightGrams: 2000,
+			fee:            1200,
+			wantMethod:     "EXPRESS",
+		},
+		"free shipping": {
+			method:         "STANDARD",
+			prefecture:     "Tokyo",
+			maxWeightGrams: 2000,
+			wantMethod:     "STANDARD",
+		},
+		"invalid method": {
+			method:         "next day",
+			prefecture:     "Tokyo",
+			maxWeightGrams: 2000,
+			wantError:      value.ErrShippingMethodInvalid,
+		},
+		"zero weight band": {
+			method:     "STANDARD",
+			prefecture: "Tokyo",
+			wantError:  value.ErrShippingWeightInvalid,
+		},
+		"negative fee": {
+			method:         "STANDARD",
+			prefecture:     "Tokyo",
+			maxWeightGrams: 2000,
+			fee:            -1,
+			wantError:      value.ErrShippingFeeInvalid,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			rate, err := value.NewShippingRate(tt.method, tt.prefecture, tt.maxWeightGrams, tt.fee)
+
+			// Assert
+			if tt.wantError != nil {
+				require.ErrorIs(t, err, tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantMethod, rate.Method())
+		})
+	}
+}
+
+func TestShippingRateTable_FeeFor(t *testing.T) {
+	small, _ := value.NewShippingRate("STANDARD", "Tokyo", 2000, 800)
+	large, _ := value.NewShippingRate("STANDARD", "Tokyo", 10000, 1500)
+	osaka, _ := value.NewShippingRate("STANDARD", "Osaka", 2000, 900)
+	table := value.NewShippingRateTable([]value.ShippingRate{large, osaka, small})
+
+	tests := map[string]struct {
+		method      value.ShippingMethod
+		prefecture  value.Prefecture
+		weightGrams int
+		want        float64
+		wantError   error
+	}{
+		"lightest fitting band": {
+			method:      "STANDARD",
+			prefecture:  "Tokyo",
+			weightGrams: 2000,
+			want:        800,
+		},
+		"heavier band": {
+			method:      "STANDARD",
+			prefecture:  "Tokyo",
+			weightGrams: 2001,
+			want:        1500,
+		},
+		"other prefecture": {
+			method:      "STANDARD",
+			prefecture:  "Osaka",
+			weightGrams: 100,
+			want:        900,
+		},
+		"too heavy": {
+			method:      "STANDARD",
+			prefecture:  "Osaka",
+			weightGrams: 2001,
+			wantError:   value.ErrShippingRateNotFound,
+		},
+		"unknown method": {
+			method:      "EXPRESS",
+			prefecture:  "Tokyo",
+			weightGrams: 100,
+			wantError:   value.ErrShippingRateNotFound,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			fee, err := table.FeeFor(tt.method, tt.prefecture, tt.weightGrams)
+
+			// Assert
+			if tt.wantError != nil {
+				require.ErrorIs(t, err, tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, fee)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package value
+
+import (
+	"strings"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+)
+
+var ErrTaxCategoryInvalid = errors.InvalidParameter.New("tax category must be STANDARD or REDUCED")
+
+// TaxCategory selects the Japanese consumption tax rate of an item. Food and
+// beverages (excluding alcohol and dining in) and newspapers are REDUCED.
+type TaxCategory string
+
+const (
+	TaxCategoryStandard TaxCategory = "STANDARD"
+	TaxCategoryReduced  TaxCategory = "REDUCED"
+)
+
+// NewTaxCategory defaults to STANDARD, so items added before tax categories
+// existed keep being taxed at the standard rate.
+func NewTaxCategory(category string) (TaxCategory, error) {
+	switch TaxCategory(strings.ToUpper(strings.TrimSpace(category))) {
+	case "", TaxCategoryStandard:
+		return TaxCategoryStandard, nil
+	case TaxCategoryReduced:
+		return TaxCategoryReduced, nil
+	default:
+		return "", ErrTaxCategoryInvalid
+	}
+}
+
+// Rate returns the tax rate in percent.
+func (c TaxCategory) Rate() int {
+	if c == TaxCategoryReduced {
+		return 8
+	}
+	return 10
+}
+
+func (c TaxCategory) String() string {
+	return string(c)
+}
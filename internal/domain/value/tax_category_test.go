@@ -0,0 +1,55 @@
+package value_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+func TestNewTaxCategory(t *testing.T) {
+	tests := map[string]struct {
+		input     string
+		want      value.TaxCategory
+		wantRate  int
+		wantError error
+	}{
+		"empty defaults to standard": {
+			input:    "",
+			want:     value.TaxCategoryStandard,
+			wantRate: 10,
+		},
+		"standard": {
+			input:    "STANDARD",
+			want:     value.TaxCategoryStandard,
+			wantRate: 10,
+		},
+		"reduced in lower case": {
+			input:    "reduced",
+			want:     value.TaxCategoryReduced,
+			wantRate: 8,
+		},
+		"unknown category": {
+			input:     "EXEMPT",
+			wantError: value.ErrTaxCategoryInvalid,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			got, err := value.NewTaxCategory(tt.input)
+
+			// Assert
+			if tt.wantError != nil {
+				require.ErrorIs(t, err, tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+			require.Equal(t, tt.wantRate, got.Rate())
+		})
+	}
+}
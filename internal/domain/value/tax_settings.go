@@ -0,0 +1,73 @@
+package value
+
+import (
+	"math"
+	"strings"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+)
+
+var (
+	ErrTaxDisplayInvalid      = errors.InvalidParameter.New("tax display must be INCLUSIVE or EXCLUSIVE")
+	ErrTaxRoundingModeInvalid = errors.InvalidParameter.New("tax rounding mode must be FLOOR, ROUND or CEIL")
+)
+
+// TaxDisplay tells whether item prices already include consumption tax.
+type TaxDisplay string
+
+const (
+	TaxDisplayInclusive TaxDisplay = "INCLUSIVE"
+	TaxDisplayExclusive TaxDisplay = "EXCLUSIVE"
+)
+
+type TaxRoundingMode string
+
+const (
+	TaxRoundingFloor TaxRoundingMode = "FLOOR"
+	TaxRoundingRound TaxRoundingMode = "ROUND"
+	TaxRoundingCeil  TaxRoundingMode = "CEIL"
+)
+
+// TaxSettings is how a tenant charges consumption tax.
+type TaxSettings struct {
+	display      TaxDisplay
+	roundingMode TaxRoundingMode
+}
+
+func NewTaxSettings(display, roundingMode string) (TaxSettings, error) {
+	d := TaxDisplay(strings.ToUpper(strings.TrimSpace(display)))
+	if d != TaxDisplayInclusive && d != TaxDisplayExclusive {
+		return TaxSettings{}, ErrTaxDisplayInvalid
+	}
+
+	m := TaxRoundingMode(strings.ToUpper(strings.TrimSpace(roundingMode)))
+	if m != TaxRoundingFloor && m != TaxRoundingRound && m != TaxRoundingCeil {
+		return TaxSettings{}, ErrTaxRoundingModeInvalid
+	}
+
+	return TaxSettings{display: d, roundingMode: m}, nil
+}
+
+// DefaultTaxSettings applies to tenants that never configured tax: prices are
+// shown without tax and fractions of a yen are truncated.
+func DefaultTaxSettings() TaxSettings {
+	return TaxSettings{display: TaxDisplayExclusive, roundingMode: TaxRoundingFloor}
+}
+
+func (s TaxSettings) Display() TaxDisplay           { return s.display }
+func (s TaxSettings) RoundingMode() TaxRoundingMode { return s.roundingMode }
+
+// Round rounds a tax amount to whole yen.
+func (s TaxSettings) Round(amount float64) float64 {
+	// Drop floating point noise first so 100.00000000000001 does not ceil to 101.
+	amount = math.Round(amount*1e6) / 1e6
+
+	switch s.roundingMode {
+	case TaxRoundingCeil:
+		return math.Ceil(amount)
+	case TaxRoundingRound:
+		return math.Round(amount)
+	default:
+		return math.Floor(amount)
+	}
+}
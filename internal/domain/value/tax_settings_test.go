@@ -0,0 +1,96 @@
+package value_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+func TestNewTaxSettings(t *testing.T) {
+	tests := map[string]struct {
+		display      string
+		roundingMode string
+		wantError    error
+	}{
+		"inclusive with floor": {
+			display:      "INCLUSIVE",
+			roundingMode: "FLOOR",
+		},
+		"exclusive with ceil in lower case": {
+			display:      "exclusive",
+			roundingMode: "ceil",
+		},
+		"invalid display": {
+			display:      "GROSS",
+			roundingMode: "ROUND",
+			wantError:    value.ErrTaxDisplayInvalid,
+		},
+		"invalid rounding mode": {
+			display:      "EXCLUSIVE",
+			roundingMode: "BANKERS",
+			wantError:    value.ErrTaxRoundingModeInvalid,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			_, err := value.NewTaxSettings(tt.display, tt.roundingMode)
+
+			// Assert
+			if tt.wantError != nil {
+				require.ErrorIs(t, err, tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestTaxSettings_Round(t *testing.T) {
+	tests := map[string]struct {
+		roundingMode string
+		amount       float64
+		want         float64
+	}{
+		"floor truncates": {
+			roundingMode: "FLOOR",
+			amount:       90.9,
+			want:         90,
+		},
+		"round half up": {
+			roundingMode: "ROUND",
+			amount:       90.5,
+			want:         91,
+		},
+		"ceil rounds up": {
+			roundingMode: "CEIL",
+			amount:       90.1,
+			want:         91,
+		},
+		"ceil ignores floating point noise": {
+			roundingMode: "CEIL",
+			amount:       100.00000000000001,
+			want:         100,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			settings, err := value.NewTaxSettings("EXCLUSIVE", tt.roundingMode)
+			require.NoError(t, err)
+
+			// Act
+			got := settings.Round(tt.amount)
+
+			// Assert
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
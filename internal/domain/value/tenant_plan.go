@@ -0,0 +1,37 @@
+package value
+
+import (
+	"strings"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+)
+
+var ErrTenantPlanInvalid = errors.InvalidParameter.New("tenant plan must be FREE, STANDARD or ENTERPRISE")
+
+// TenantPlan is the subscription a tenant is on.
+type TenantPlan string
+
+const (
+	TenantPlanFree       TenantPlan = "FREE"
+	TenantPlanStandard   TenantPlan = "STANDARD"
+	TenantPlanEnterprise TenantPlan = "ENTERPRISE"
+)
+
+// NewTenantPlan defaults to FREE, so tenants can be onboarded before they
+// choose a plan.
+func NewTenantPlan(plan string) (TenantPlan, error) {
+	switch TenantPlan(strings.ToUpper(strings.TrimSpace(plan))) {
+	case "", TenantPlanFree:
+		return TenantPlanFree, nil
+	case TenantPlanStandard:
+		return TenantPlanStandard, nil
+	case TenantPlanEnterprise:
+		return TenantPlanEnterprise, nil
+	default:
+		return "", ErrTenantPlanInvalid
+	}
+}
+
+func (p TenantPlan) String() string {
+	return string(p)
+}
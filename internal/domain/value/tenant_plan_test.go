@@ -0,0 +1,46 @@
+package value_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+)
+
+func TestNewTenantPlan(t *testing.T) {
+	tests := map[string]struct {
+		input     string
+		want      value.TenantPlan
+		wantError error
+	}{
+		"empty defaults to free": {
+			input: "",
+			want:  value.TenantPlanFree,
+		},
+		"enterprise in lower case": {
+			input: "enterprise",
+			want:  value.TenantPlanEnterprise,
+		},
+		"unknown plan": {
+			input:     "PLATINUM",
+			wantError: value.ErrTenantPlanInvalid,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			got, err := value.NewTenantPlan(tt.input)
+
+			// Assert
+			if tt.wantError != nil {
+				require.ErrorIs(t, err, tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package value
+
+import (
+	"strings"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+)
+
+var ErrTenantStatusInvalid = errors.InvalidParameter.New("tenant status must be ACTIVE, SUSPENDED or CLOSED")
+
+// TenantStatus is where a tenant is in its lifecycle. Suspended tenants can
+// be reactivated; closed tenants cannot.
+type TenantStatus string
+
+const (
+	TenantStatusActive    TenantStatus = "ACTIVE"
+	TenantStatusSuspended TenantStatus = "SUSPENDED"
+	TenantStatusClosed    TenantStatus = "CLOSED"
+)
+
+func NewTenantStatus(status string) (TenantStatus, error) {
+	switch s := TenantStatus(strings.ToUpper(strings.TrimSpace(status))); s {
+	case TenantStatusActive, TenantStatusSuspended, TenantStatusClosed:
+		return s, nil
+	default:
+		return "", ErrTenantStatusInvalid
+	}
+}
+
+func (s TenantStatus) String() string {
+	return string(s)
+}
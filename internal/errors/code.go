@@ -1,6 +1,9 @@
 package errors
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 type ErrCode string
 
@@ -12,6 +15,9 @@ const (
 	RepositoryError  ErrCode = "R001"
 	QueryError       ErrCode = "R002"
 	OptimisticLock   ErrCode = "R003"
+	// PreconditionFailed means the aggregate is not at the version the
+	// caller expected.
+	PreconditionFailed ErrCode = "R004"
 )
 
 func (code ErrCode) New(message string) error {
@@ -30,6 +36,15 @@ func (code ErrCode) Wrap(err error, message string) error {
 	}
 }
 
+func (code ErrCode) NewWithDetails(message string, details []Detail) error {
+	return &Error{
+		ErrCode: code,
+		Message: message,
+		Err:     errors.New(message),
+		Details: details,
+	}
+}
+
 func IsCode(err error, code ErrCode) bool {
 	var e *Error
 	if errors.As(err, &e) {
@@ -37,3 +52,32 @@ func IsCode(err error, code ErrCode) bool {
 	}
 	return false
 }
+
+// NewVersionMismatch reports that an aggregate the caller expected at one
+// version is at another.
+func NewVersionMismatch(expected, current int) error {
+	return &Error{
+		ErrCode: PreconditionFailed,
+		Message: fmt.Sprintf("expected version %d but aggregate is at version %d", expected, current),
+		Err:     &VersionMismatch{Expected: expected, Current: current},
+	}
+}
+
+// CurrentVersionOf returns the version an aggregate was found at when a
+// version precondition failed.
+func CurrentVersionOf(err error) (int, bool) {
+	var mismatch *VersionMismatch
+	if errors.As(err, &mismatch) {
+		return mismatch.Current, true
+	}
+	return 0, false
+}
+
+// DetailsOf returns nil for errors without details.
+func DetailsOf(err error) []Detail {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Details
+	}
+	return nil
+}
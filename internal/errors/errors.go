@@ -1,9 +1,29 @@
 package errors
 
+import "fmt"
+
 type Error struct {
 	ErrCode ErrCode
 	Err     error
 	Message string
+	Details []Detail
+}
+
+// Detail is one of several reasons behind an error, such as each rule a
+// request broke.
+type Detail struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// VersionMismatch is the cause of a PreconditionFailed error.
+type VersionMismatch struct {
+	Expected int
+	Current  int
+}
+
+func (e *VersionMismatch) Error() string {
+	return fmt.Sprintf("expected version %d, current version %d", e.Expected, e.Current)
 }
 
 func (e *Error) Error() string {
@@ -0,0 +1,154 @@
+package aggregaterepo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+)
+
+type aggregateRepositoryImpl[T repository.AggregateRoot] struct {
+	tx           repository.Transaction
+	eventStore   repository.EventStore
+	outboxRepo   repository.OutboxRepository
+	newAggregate func() T
+	retryPolicy  RetryPolicy
+}
+
+func NewAggregateRepository[T repository.AggregateRoot](tx repository.Transaction, eventStore repository.EventStore, outboxRepo repository.OutboxRepository, newAggregate func() T, retryPolicy RetryPolicy) repository.AggregateRepository[T] {
+	return &aggregateRepositoryImpl[T]{
+		tx:           tx,
+		eventStore:   eventStore,
+		outboxRepo:   outboxRepo,
+		newAggregate: newAggregate,
+		retryPolicy:  retryPolicy,
+	}
+}
+
+func (r *aggregateRepositoryImpl[T]) Load(ctx context.Context, aggregateID uuid.UUID) (T, error) {
+	aggregate, _, err := r.load(ctx, aggregateID)
+	return aggregate, err
+}
+
+func (r *aggregateRepositoryImpl[T]) load(ctx context.Context, aggregateID uuid.UUID) (T, []event.Event, error) {
+	loadedEvents, err := r.eventStore.LoadEvents(ctx, aggregateID)
+	if err != nil && !errors.IsCode(err, errors.NotFound) {
+		var zero T
+		return zero, nil, err
+	}
+
+	aggregate := r.newAggregate()
+	if len(loadedEvents) > 0 {
+		if err := aggregate.Hydration(loadedEvents); err != nil {
+			var zero T
+			return zero, nil, err
+		}
+	}
+
+	return aggregate, loadedEvents, nil
+}
+
+func (r *aggregateRepositoryImpl[T]) Save(ctx context.Context, aggregate T) ([]event.Event, error) {
+	events := aggregate.GetUncommittedEvents()
+	if len(events) == 0 {
+		return events, nil
+	}
+
+	if err := r.eventStore.SaveEvents(ctx, aggregate.GetAggregateID(), events); err != nil {
+		return nil, err
+	}
+
+	if err := r.outboxRepo.SaveEvents(ctx, aggregate.GetAggregateID(), events); err != nil {
+		return nil, err
+	}
+
+	aggregate.MarkEventsAsCommitted()
+
+	return events, nil
+}
+
+func (r *aggregateRepositoryImpl[T]) Update(ctx context.Context, aggregateID uuid.UUID, fn func(ctx context.Context, aggregate T) error) (T, []event.Event, error) {
+	return r.UpdateResolving(ctx, aggregateID, fn, nil)
+}
+
+func (r *aggregateRepositoryImpl[T]) UpdateResolving(ctx context.Context, aggregateID uuid.UUID, fn func(ctx context.Context, aggregate T) error, resolve repository.ConflictResolver[T]) (T, []event.Event, error) {
+	var aggregate T
+	var events []event.Event
+	var err error
+
+	// The events of the attempt that lost the race, and the version they
+	// were decided on
+	var ours []event.Event
+	var oursVersion int
+
+	carried := 0
+	for attempt := 0; ; attempt++ {
+		resolved := false
+		err = r.tx.RWTx(ctx, func(ctx context.Context) error {
+			loaded, loadedEvents, err := r.load(ctx, aggregateID)
+			if err != nil {
+				return err
+			}
+			loadedVersion := loaded.GetVersion()
+
+			resolved = resolve != nil && len(ours) > 0 && resolve(loaded, eventsAfter(loadedEvents, oursVersion), ours)
+			if !resolved {
+				if err := fn(ctx, loaded); err != nil {
+					return err
+				}
+			}
+
+			pending := loaded.GetUncommittedEvents()
+			saved, err := r.Save(ctx, loaded)
+			if err != nil {
+				if errors.IsCode(err, errors.OptimisticLock) {
+					ours = pending
+					oursVersion = loadedVersion
+				}
+				return err
+			}
+
+			aggregate = loaded
+			events = saved
+
+			return nil
+		})
+		// An attempt that only carried ours over decided nothing again, so
+		// losing it does not use up an attempt. There are no more of those
+		// than attempts though, so a stream that never stops changing still
+		// fails
+		if resolved && carried < r.retryPolicy.MaxAttempts {
+			carried++
+			attempt--
+		}
+
+		// Within a shared transaction the retry would reload the snapshot
+		// it lost on, so the caller retries the shared transaction instead
+		if r.tx.Shared(ctx) || !r.retryPolicy.retries(err, attempt) {
+			break
+		}
+
+		if err := r.retryPolicy.wait(ctx, attempt); err != nil {
+			var zero T
+			return zero, nil, err
+		}
+	}
+
+	if err != nil {
+		var zero T
+		return zero, nil, err
+	}
+
+	return aggregate, events, nil
+}
+
+func eventsAfter(events []event.Event, version int) []event.Event {
+	for i, evt := range events {
+		if evt.GetVersion() > version {
+			return events[i:]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,311 @@
+package aggregaterepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/aggregaterepo"
+)
+
+// fakeTransaction runs fn directly. A shared one acts as if every call were
+// within a SharedRWTx.
+type fakeTransaction struct {
+	shared bool
+}
+
+func (fakeTransaction) RWTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (fakeTransaction) SharedRWTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (t fakeTransaction) Shared(ctx context.Context) bool {
+	return t.shared
+}
+
+func (fakeTransaction) AfterCommit(fn func() error) {}
+
+// fakeEventStore keeps streams in memory and reports the first conflicts
+// saves as lost optimistic locks. The first save also loses to the
+// interleaved events, which another writer appends just before it.
+type fakeEventStore struct {
+	repository.EventStore
+	streams     map[uuid.UUID][]event.Event
+	conflicts   int
+	interleaved []event.Event
+	saves       int
+}
+
+func (s *fakeEventStore) SaveEvents(ctx context.Context, aggregateID uuid.UUID, events []event.Event) error {
+	s.saves++
+	if len(s.interleaved) > 0 {
+		s.streams[aggregateID] = append(s.streams[aggregateID], s.interleaved...)
+		s.interleaved = nil
+		return errors.OptimisticLock.New("stream was appended to concurrently")
+	}
+	if s.conflicts > 0 {
+		s.conflicts--
+		return errors.OptimisticLock.New("stream was appended to concurrently")
+	}
+	s.streams[aggregateID] = append(s.streams[aggregateID], events...)
+	return nil
+}
+
+func (s *fakeEventStore) AppendStreams(ctx context.Context, appends []repository.StreamAppend) error {
+	s.saves++
+	for _, stream := range appends {
+		current := -1
+		if events := s.streams[stream.AggregateID]; len(events) > 0 {
+			current = events[len(events)-1].GetVersion()
+		}
+		if current != stream.ExpectedVersion {
+			return errors.OptimisticLock.New("stream was appended to concurrently")
+		}
+	}
+	for _, stream := range appends {
+		s.streams[stream.AggregateID] = append(s.streams[stream.AggregateID], stream.Events...)
+	}
+	return nil
+}
+
+func (s *fakeEventStore) LoadEvents(ctx context.Context, aggregateID uuid.UUID) ([]event.Event, error) {
+	events, ok := s.streams[aggregateID]
+	if !ok {
+		return nil, errors.NotFound.New("aggregate not found")
+	}
+	return events, nil
+}
+
+type fakeOutboxRepository struct {
+	repository.OutboxRepository
+	saved []event.Event
+}
+
+func (r *fakeOutboxRepository) SaveEvents(ctx context.Context, aggregateID uuid.UUID, events []event.Event) error {
+	r.saved = append(r.saved, events...)
+	return nil
+}
+
+func createPolicy(tenantID uuid.UUID) func(ctx context.Context, policy *aggregate.TenantCartAbandonedPolicyAggregate) error {
+	return func(ctx context.Context, policy *aggregate.TenantCartAbandonedPolicyAggregate) error {
+		return policy.ExecuteCreateTenantCartAbandonedPolicyCommand(command.CreateTenantCartAbandonedPolicyCommand{
+			TenantID:         tenantID,
+			Title:            "Test Policy",
+			AbandonedMinutes: 30,
+			QuietTimeFrom:    time.Date(2023, 1, 1, 22, 0, 0, 0, time.UTC),
+			QuietTimeTo:      time.Date(2023, 1, 1, 8, 0, 0, 0, time.UTC),
+		})
+	}
+}
+
+func TestAggregateRepository_Update(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		conflicts     int
+		maxAttempts   int
+		shared        bool
+		wantErrCode   errors.ErrCode
+		wantSaves     int
+		wantEventsLen int
+	}{
+		"saves to the event store and the outbox": {
+			maxAttempts:   3,
+			wantSaves:     1,
+			wantEventsLen: 1,
+		},
+		"retries after a lost optimistic lock": {
+			conflicts:     2,
+			maxAttempts:   3,
+			wantSaves:     3,
+			wantEventsLen: 1,
+		},
+		"gives up after the last attempt": {
+			conflicts:   3,
+			maxAttempts: 3,
+			wantErrCode: errors.OptimisticLock,
+			wantSaves:   3,
+		},
+		"leaves the retry to the shared transaction": {
+			conflicts:   1,
+			maxAttempts: 3,
+			shared:      true,
+			wantErrCode: errors.OptimisticLock,
+			wantSaves:   1,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			tenantID := uuid.New()
+			eventStore := &fakeEventStore{streams: map[uuid.UUID][]event.Event{}, conflicts: tt.conflicts}
+			outboxRepo := &fakeOutboxRepository{}
+			repo := aggregaterepo.NewAggregateRepository(fakeTransaction{shared: tt.shared}, eventStore, outboxRepo, aggregate.NewTenantCartAbandonedPolicyAggregate, aggregaterepo.RetryPolicy{
+				MaxAttempts: tt.maxAttempts,
+				BaseDelay:   time.Millisecond,
+				MaxDelay:    time.Millisecond,
+			})
+
+			// Act
+			policy, events, err := repo.Update(context.Background(), tenantID, createPolicy(tenantID))
+
+			// Assert
+			require.Equal(t, tt.wantSaves, eventStore.saves)
+			if tt.wantErrCode != "" {
+				require.True(t, errors.IsCode(err, tt.wantErrCode))
+				require.Empty(t, outboxRepo.saved)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tenantID, policy.GetAggregateID())
+			require.Equal(t, 1, policy.GetVersion())
+			require.Empty(t, policy.GetUncommittedEvents())
+			require.Len(t, events, tt.wantEventsLen)
+			require.Equal(t, events, eventStore.streams[tenantID])
+			require.Equal(t, events, outboxRepo.saved)
+		})
+	}
+}
+
+func TestAggregateRepository_UpdateResolving(t *testing.T) {
+	t.Parallel()
+
+	cartID := uuid.New()
+	ownerID := uuid.New()
+	tenantID := uuid.New()
+	ourItemID := uuid.New()
+
+	addItem := func(itemID uuid.UUID) func(ctx context.Context, cart *aggregate.CartAggregate) error {
+		return func(ctx context.Context, cart *aggregate.CartAggregate) error {
+			return cart.ExecuteAddItemToCartCommand(command.AddItemToCartCommand{
+				CartID: cartID, UserID: ownerID, ItemID: itemID, Name: "Item", Price: 100, TenantID: tenantID,
+			})
+		}
+	}
+	resolve := func(current *aggregate.CartAggregate, theirs, ours []event.Event) bool {
+		return current.ResolveItemsAdded(theirs, ours, value.CartRules{})
+	}
+
+	tests := map[string]struct {
+		theirItemID uuid.UUID
+		conflicts   int
+		maxAttempts int
+		wantErrCode errors.ErrCode
+		wantRuns    int
+		wantSaves   int
+	}{
+		"carries the events over when they do not conflict": {
+			theirItemID: uuid.New(),
+			maxAttempts: 3,
+			wantRuns:    1,
+			wantSaves:   2,
+		},
+		"runs the command again when they conflict": {
+			theirItemID: ourItemID,
+			maxAttempts: 3,
+			wantRuns:    2,
+			wantSaves:   2,
+		},
+		"carried over events that lose again do not use up attempts": {
+			theirItemID: uuid.New(),
+			conflicts:   2,
+			maxAttempts: 2,
+			wantRuns:    1,
+			wantSaves:   4,
+		},
+		"carried over events that always lose give up": {
+			theirItemID: uuid.New(),
+			conflicts:   100,
+			maxAttempts: 2,
+			wantErrCode: errors.OptimisticLock,
+			wantRuns:    1,
+			wantSaves:   4,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			base := aggregate.NewCartAggregate()
+			require.NoError(t, addItem(uuid.New())(context.Background(), base))
+			theirs := aggregate.NewCartAggregate()
+			require.NoError(t, theirs.Hydration(base.GetUncommittedEvents()))
+			require.NoError(t, addItem(tt.theirItemID)(context.Background(), theirs))
+
+			eventStore := &fakeEventStore{
+				streams:     map[uuid.UUID][]event.Event{cartID: base.GetUncommittedEvents()},
+				interleaved: theirs.GetUncommittedEvents(),
+				conflicts:   tt.conflicts,
+			}
+			outboxRepo := &fakeOutboxRepository{}
+			repo := aggregaterepo.NewAggregateRepository(fakeTransaction{}, eventStore, outboxRepo, aggregate.NewCartAggregate, aggregaterepo.RetryPolicy{
+				MaxAttempts: tt.maxAttempts,
+				BaseDelay:   time.Millisecond,
+				MaxDelay:    time.Millisecond,
+			})
+
+			runs := 0
+			fn := func(ctx context.Context, cart *aggregate.CartAggregate) error {
+				runs++
+				return addItem(ourItemID)(ctx, cart)
+			}
+
+			// Act
+			cart, events, err := repo.UpdateResolving(context.Background(), cartID, fn, resolve)
+
+			// Assert
+			require.Equal(t, tt.wantRuns, runs)
+			require.Equal(t, tt.wantSaves, eventStore.saves)
+			if tt.wantErrCode != "" {
+				require.True(t, errors.IsCode(err, tt.wantErrCode))
+				require.Empty(t, outboxRepo.saved)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, 4, cart.GetVersion())
+			require.Len(t, events, 1)
+			require.Equal(t, 4, events[0].GetVersion())
+			require.Len(t, eventStore.streams[cartID], 4)
+			require.Equal(t, events, outboxRepo.saved)
+		})
+	}
+}
+
+func TestAggregateRepository_Load(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	tenantID := uuid.New()
+	eventStore := &fakeEventStore{streams: map[uuid.UUID][]event.Event{}}
+	repo := aggregaterepo.NewAggregateRepository(fakeTransaction{}, eventStore, &fakeOutboxRepository{}, aggregate.NewTenantCartAbandonedPolicyAggregate, aggregaterepo.DefaultRetryPolicy())
+	_, _, err := repo.Update(context.Background(), tenantID, createPolicy(tenantID))
+	require.NoError(t, err)
+
+	// Act
+	loaded, err := repo.Load(context.Background(), tenantID)
+	missing, missingErr := repo.Load(context.Background(), uuid.New())
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, tenantID, loaded.GetAggregateID())
+	require.Equal(t, 1, loaded.GetVersion())
+	require.NoError(t, missingErr)
+	require.Equal(t, -1, missing.GetVersion())
+}
@@ -0,0 +1,64 @@
+package aggregaterepo
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+)
+
+// RetryPolicy is how often and how long to wait before a transaction that lost
+// an optimistic lock is tried again. The delay doubles with every attempt up
+// to MaxDelay, and Jitter takes up to that fraction off it at random so that
+// writers colliding on the same stream do not retry in lockstep.
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+	Jitter      float64
+}
+
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   10 * time.Millisecond,
+		MaxDelay:    200 * time.Millisecond,
+		Jitter:      0.5,
+	}
+}
+
+// Delay returns how long to wait after the given failed attempt, counted from 0.
+func (p RetryPolicy) Delay(attempt int) time.Duration {
+	delay := p.BaseDelay
+	for range attempt {
+		if p.MaxDelay > 0 && delay >= p.MaxDelay {
+			break
+		}
+		delay *= 2
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+
+	jitter := min(max(p.Jitter, 0), 1)
+	return delay - time.Duration(jitter*rand.Float64()*float64(delay))
+}
+
+// retries reports whether the failed attempt, counted from 0, is tried again.
+func (p RetryPolicy) retries(err error, attempt int) bool {
+	return errors.IsCode(err, errors.OptimisticLock) && attempt < p.MaxAttempts-1
+}
+
+// wait sleeps for the delay after the given attempt, or until ctx is done.
+func (p RetryPolicy) wait(ctx context.Context, attempt int) error {
+	timer := time.NewTimer(p.Delay(attempt))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
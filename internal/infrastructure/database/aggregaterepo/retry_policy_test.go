@@ -0,0 +1,67 @@
+package aggregaterepo_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/aggregaterepo"
+)
+
+func TestRetryPolicy_Delay(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		policy  aggregaterepo.RetryPolicy
+		attempt int
+		min     time.Duration
+		max     time.Duration
+	}{
+		"first retry waits the base delay": {
+			policy:  aggregaterepo.RetryPolicy{BaseDelay: 10 * time.Millisecond, MaxDelay: time.Second},
+			attempt: 0,
+			min:     10 * time.Millisecond,
+			max:     10 * time.Millisecond,
+		},
+		"delay doubles with every attempt": {
+			policy:  aggregaterepo.RetryPolicy{BaseDelay: 10 * time.Millisecond, MaxDelay: time.Second},
+			attempt: 3,
+			min:     80 * time.Millisecond,
+			max:     80 * time.Millisecond,
+		},
+		"delay is capped at the maximum": {
+			policy:  aggregaterepo.RetryPolicy{BaseDelay: 10 * time.Millisecond, MaxDelay: 50 * time.Millisecond},
+			attempt: 10,
+			min:     50 * time.Millisecond,
+			max:     50 * time.Millisecond,
+		},
+		"jitter takes up to its fraction off the delay": {
+			policy:  aggregaterepo.RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second, Jitter: 0.5},
+			attempt: 0,
+			min:     50 * time.Millisecond,
+			max:     100 * time.Millisecond,
+		},
+		"jitter above 1 never makes the delay negative": {
+			policy:  aggregaterepo.RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second, Jitter: 3},
+			attempt: 0,
+			min:     0,
+			max:     100 * time.Millisecond,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			for range 100 {
+				// Act
+				delay := tt.policy.Delay(tt.attempt)
+
+				// Assert
+				require.GreaterOrEqual(t, delay, tt.min)
+				require.LessOrEqual(t, delay, tt.max)
+			}
+		})
+	}
+}
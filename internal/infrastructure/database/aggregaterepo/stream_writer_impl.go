@@ -0,0 +1,70 @@
+package aggregaterepo
+
+import (
+	"context"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+)
+
+type streamWriterImpl struct {
+	tx          repository.Transaction
+	eventStore  repository.EventStore
+	outboxRepo  repository.OutboxRepository
+	retryPolicy RetryPolicy
+}
+
+func NewStreamWriter(tx repository.Transaction, eventStore repository.EventStore, outboxRepo repository.OutboxRepository, retryPolicy RetryPolicy) repository.StreamWriter {
+	return &streamWriterImpl{
+		tx:          tx,
+		eventStore:  eventStore,
+		outboxRepo:  outboxRepo,
+		retryPolicy: retryPolicy,
+	}
+}
+
+// Append skips streams without events, whose versions are then not checked
+// either.
+func (w *streamWriterImpl) Append(ctx context.Context, appends ...repository.StreamAppend) error {
+	pending := make([]repository.StreamAppend, 0, len(appends))
+	for _, stream := range appends {
+		if len(stream.Events) > 0 {
+			pending = append(pending, stream)
+		}
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	if err := w.eventStore.AppendStreams(ctx, pending); err != nil {
+		return err
+	}
+
+	for _, stream := range pending {
+		if err := w.outboxRepo.SaveEvents(ctx, stream.AggregateID, stream.Events); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Update leaves retrying to the caller within a shared transaction, like the
+// aggregate repository.
+func (w *streamWriterImpl) Update(ctx context.Context, fn func(ctx context.Context) ([]repository.StreamAppend, error)) error {
+	for attempt := 0; ; attempt++ {
+		err := w.tx.RWTx(ctx, func(ctx context.Context) error {
+			appends, err := fn(ctx)
+			if err != nil {
+				return err
+			}
+			return w.Append(ctx, appends...)
+		})
+		if w.tx.Shared(ctx) || !w.retryPolicy.retries(err, attempt) {
+			return err
+		}
+
+		if err := w.retryPolicy.wait(ctx, attempt); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package aggregaterepo_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/aggregaterepo"
+)
+
+func TestStreamWriter_Append(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		staleSecond bool
+		wantErrCode errors.ErrCode
+	}{
+		"appends every stream and its outbox entries": {},
+		"appends nothing when one stream conflicts": {
+			staleSecond: true,
+			wantErrCode: errors.OptimisticLock,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			first, second, untouched := uuid.New(), uuid.New(), uuid.New()
+			eventStore := &fakeEventStore{streams: map[uuid.UUID][]event.Event{}}
+			outboxRepo := &fakeOutboxRepository{}
+			writer := aggregaterepo.NewStreamWriter(fakeTransaction{}, eventStore, outboxRepo, aggregaterepo.DefaultRetryPolicy())
+
+			firstEvents := newPolicyEvents(t, first)
+			secondEvents := newPolicyEvents(t, second)
+			if tt.staleSecond {
+				eventStore.streams[second] = newPolicyEvents(t, second)
+			}
+
+			// Act
+			err := writer.Append(context.Background(),
+				repository.StreamAppend{AggregateID: first, ExpectedVersion: -1, Events: firstEvents},
+				repository.StreamAppend{AggregateID: second, ExpectedVersion: -1, Events: secondEvents},
+				repository.StreamAppend{AggregateID: untouched, ExpectedVersion: 5},
+			)
+
+			// Assert
+			if tt.wantErrCode != "" {
+				require.True(t, errors.IsCode(err, tt.wantErrCode))
+				require.Empty(t, eventStore.streams[first])
+				require.Empty(t, outboxRepo.saved)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, 1, eventStore.saves)
+			require.Equal(t, firstEvents, eventStore.streams[first])
+			require.Equal(t, secondEvents, eventStore.streams[second])
+			require.NotContains(t, eventStore.streams, untouched)
+			require.Equal(t, append(firstEvents, secondEvents...), outboxRepo.saved)
+		})
+	}
+}
+
+func TestStreamWriter_Update(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		maxAttempts int
+		shared      bool
+		wantErrCode errors.ErrCode
+		wantCalls   int
+	}{
+		"runs the transaction again after another writer appended first": {
+			maxAttempts: 3,
+			wantCalls:   2,
+		},
+		"gives up after the last attempt": {
+			maxAttempts: 1,
+			wantErrCode: errors.OptimisticLock,
+			wantCalls:   1,
+		},
+		"leaves the retry to the shared transaction": {
+			maxAttempts: 3,
+			shared:      true,
+			wantErrCode: errors.OptimisticLock,
+			wantCalls:   1,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			streamID := uuid.New()
+			eventStore := &fakeEventStore{streams: map[uuid.UUID][]event.Event{}}
+			outboxRepo := &fakeOutboxRepository{}
+			writer := aggregaterepo.NewStreamWriter(fakeTransaction{shared: tt.shared}, eventStore, outboxRepo, aggregaterepo.RetryPolicy{
+				MaxAttempts: tt.maxAttempts,
+			})
+
+			calls := 0
+			fn := func(ctx context.Context) ([]repository.StreamAppend, error) {
+				calls++
+				policy := aggregate.NewTenantCartAbandonedPolicyAggregate()
+				if events, err := eventStore.LoadEvents(ctx, streamID); err == nil {
+					require.NoError(t, policy.Hydration(events))
+				}
+				loadedVersion := policy.GetVersion()
+
+				// Another writer creates the policy after the first load
+				if calls == 1 {
+					eventStore.streams[streamID] = newPolicyEvents(t, streamID)
+					return []repository.StreamAppend{{AggregateID: streamID, ExpectedVersion: loadedVersion, Events: newPolicyEvents(t, streamID)}}, nil
+				}
+
+				if err := policy.ExecuteUpdateTenantCartAbandonedPolicyCommand(command.UpdateTenantCartAbandonedPolicyCommand{
+					TenantID:         streamID,
+					Title:            "Updated Policy",
+					AbandonedMinutes: 45,
+				}); err != nil {
+					return nil, err
+				}
+				return []repository.StreamAppend{{AggregateID: streamID, ExpectedVersion: loadedVersion, Events: policy.GetUncommittedEvents()}}, nil
+			}
+
+			// Act
+			err := writer.Update(context.Background(), fn)
+
+			// Assert
+			require.Equal(t, tt.wantCalls, calls)
+			if tt.wantErrCode != "" {
+				require.True(t, errors.IsCode(err, tt.wantErrCode))
+				require.Empty(t, outboxRepo.saved)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, eventStore.streams[streamID], 2)
+			require.Len(t, outboxRepo.saved, 1)
+		})
+	}
+}
+
+func newPolicyEvents(t *testing.T, tenantID uuid.UUID) []event.Event {
+	t.Helper()
+
+	policy := aggregate.NewTenantCartAbandonedPolicyAggregate()
+	require.NoError(t, createPolicy(tenantID)(context.Background(), policy))
+	return policy.GetUncommittedEvents()
+}
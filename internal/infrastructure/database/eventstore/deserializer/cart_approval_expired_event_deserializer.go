@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type cartApprovalExpiredEventDeserializer struct{}
+
+func NewCartApprovalExpiredEventDeserializer() eventDeserializer {
+	return &cartApprovalExpiredEventDeserializer{}
+}
+
+func (d *cartApprovalExpiredEventDeserializer) EventType() string {
+	return "CartApprovalExpiredEvent"
+}
+
+func (d *cartApprovalExpiredEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.CartApprovalExpiredEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type cartApprovalRejectedEventDeserializer struct{}
+
+func NewCartApprovalRejectedEventDeserializer() eventDeserializer {
+	return &cartApprovalRejectedEventDeserializer{}
+}
+
+func (d *cartApprovalRejectedEventDeserializer) EventType() string {
+	return "CartApprovalRejectedEvent"
+}
+
+func (d *cartApprovalRejectedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.CartApprovalRejectedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type cartApprovalRequestedEventDeserializer struct{}
+
+func NewCartApprovalRequestedEventDeserializer() eventDeserializer {
+	return &cartApprovalRequestedEventDeserializer{}
+}
+
+func (d *cartApprovalRequestedEventDeserializer) EventType() string {
+	return "CartApprovalRequestedEvent"
+}
+
+func (d *cartApprovalRequestedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.CartApprovalRequestedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type cartApprovedEventDeserializer struct{}
+
+func NewCartApprovedEventDeserializer() eventDeserializer {
+	return &cartApprovedEventDeserializer{}
+}
+
+func (d *cartApprovedEventDeserializer) EventType() string {
+	return "CartApprovedEvent"
+}
+
+func (d *cartApprovedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.CartApprovedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
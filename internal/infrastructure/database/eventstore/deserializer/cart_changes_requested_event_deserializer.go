@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type cartChangesRequestedEventDeserializer struct{}
+
+func NewCartChangesRequestedEventDeserializer() eventDeserializer {
+	return &cartChangesRequestedEventDeserializer{}
+}
+
+func (d *cartChangesRequestedEventDeserializer) EventType() string {
+	return "CartChangesRequestedEvent"
+}
+
+func (d *cartChangesRequestedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.CartChangesRequestedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
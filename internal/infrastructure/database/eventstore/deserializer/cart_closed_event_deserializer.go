@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type cartClosedEventDeserializer struct{}
+
+func NewCartClosedEventDeserializer() eventDeserializer {
+	return &cartClosedEventDeserializer{}
+}
+
+func (d *cartClosedEventDeserializer) EventType() string {
+	return "CartClosedEvent"
+}
+
+func (d *cartClosedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.CartClosedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
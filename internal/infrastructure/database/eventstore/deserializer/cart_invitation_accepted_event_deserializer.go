@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type cartInvitationAcceptedEventDeserializer struct{}
+
+func NewCartInvitationAcceptedEventDeserializer() eventDeserializer {
+	return &cartInvitationAcceptedEventDeserializer{}
+}
+
+func (d *cartInvitationAcceptedEventDeserializer) EventType() string {
+	return "CartInvitationAcceptedEvent"
+}
+
+func (d *cartInvitationAcceptedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.CartInvitationAcceptedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
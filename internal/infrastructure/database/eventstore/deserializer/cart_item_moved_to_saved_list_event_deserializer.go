@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type cartItemMovedToSavedListEventDeserializer struct{}
+
+func NewCartItemMovedToSavedListEventDeserializer() eventDeserializer {
+	return &cartItemMovedToSavedListEventDeserializer{}
+}
+
+func (d *cartItemMovedToSavedListEventDeserializer) EventType() string {
+	return "CartItemMovedToSavedListEvent"
+}
+
+func (d *cartItemMovedToSavedListEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.CartItemMovedToSavedListEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type cartMemberInvitedEventDeserializer struct{}
+
+func NewCartMemberInvitedEventDeserializer() eventDeserializer {
+	return &cartMemberInvitedEventDeserializer{}
+}
+
+func (d *cartMemberInvitedEventDeserializer) EventType() string {
+	return "CartMemberInvitedEvent"
+}
+
+func (d *cartMemberInvitedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.CartMemberInvitedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
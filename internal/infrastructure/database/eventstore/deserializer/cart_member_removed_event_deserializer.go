@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type cartMemberRemovedEventDeserializer struct{}
+
+func NewCartMemberRemovedEventDeserializer() eventDeserializer {
+	return &cartMemberRemovedEventDeserializer{}
+}
+
+func (d *cartMemberRemovedEventDeserializer) EventType() string {
+	return "CartMemberRemovedEvent"
+}
+
+func (d *cartMemberRemovedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.CartMemberRemovedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type cartMergedEventDeserializer struct{}
+
+func NewCartMergedEventDeserializer() eventDeserializer {
+	return &cartMergedEventDeserializer{}
+}
+
+func (d *cartMergedEventDeserializer) EventType() string {
+	return "CartMergedEvent"
+}
+
+func (d *cartMergedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.CartMergedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
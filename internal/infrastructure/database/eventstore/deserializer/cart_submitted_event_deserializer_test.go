@@ -33,6 +33,39 @@ func TestCartSubmittedEventDeserializer(t *testing.T) {
 				Version:     1,
 			},
 		},
+		"should deserialize tax breakdown": {
+			input: []byte(`{
+				"AggregateID": "123e4567-e89b-12d3-a456-426614174000",
+				"TotalAmount": 1640,
+				"Subtotal": 1500,
+				"DiscountTotal": 0,
+				"TaxDisplay": "EXCLUSIVE",
+				"TaxRoundingMode": "FLOOR",
+				"StandardTaxableAmount": 1000,
+				"StandardTaxAmount": 100,
+				"ReducedTaxableAmount": 500,
+				"ReducedTaxAmount": 40,
+				"SubmittedAt": "2023-01-01T10:00:00Z",
+				"EventID": "123e4567-e89b-12d3-a456-426614174002",
+				"Timestamp": "2023-01-01T10:00:00Z",
+				"Version": 1
+			}`),
+			want: &event.CartSubmittedEvent{
+				AggregateID:           uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+				TotalAmount:           1640,
+				Subtotal:              1500,
+				TaxDisplay:            "EXCLUSIVE",
+				TaxRoundingMode:       "FLOOR",
+				StandardTaxableAmount: 1000,
+				StandardTaxAmount:     100,
+				ReducedTaxableAmount:  500,
+				ReducedTaxAmount:      40,
+				SubmittedAt:           time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC),
+				EventID:               uuid.MustParse("123e4567-e89b-12d3-a456-426614174002"),
+				Timestamp:             time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC),
+				Version:               1,
+			},
+		},
 	}
 
 	for testName, tt := range tests {
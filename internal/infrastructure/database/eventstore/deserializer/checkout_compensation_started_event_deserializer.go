@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type checkoutCompensationStartedEventDeserializer struct{}
+
+func NewCheckoutCompensationStartedEventDeserializer() eventDeserializer {
+	return &checkoutCompensationStartedEventDeserializer{}
+}
+
+func (d *checkoutCompensationStartedEventDeserializer) EventType() string {
+	return "CheckoutCompensationStartedEvent"
+}
+
+func (d *checkoutCompensationStartedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.CheckoutCompensationStartedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type checkoutSagaAbortedEventDeserializer struct{}
+
+func NewCheckoutSagaAbortedEventDeserializer() eventDeserializer {
+	return &checkoutSagaAbortedEventDeserializer{}
+}
+
+func (d *checkoutSagaAbortedEventDeserializer) EventType() string {
+	return "CheckoutSagaAbortedEvent"
+}
+
+func (d *checkoutSagaAbortedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.CheckoutSagaAbortedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
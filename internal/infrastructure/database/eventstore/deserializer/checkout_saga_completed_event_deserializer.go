@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type checkoutSagaCompletedEventDeserializer struct{}
+
+func NewCheckoutSagaCompletedEventDeserializer() eventDeserializer {
+	return &checkoutSagaCompletedEventDeserializer{}
+}
+
+func (d *checkoutSagaCompletedEventDeserializer) EventType() string {
+	return "CheckoutSagaCompletedEvent"
+}
+
+func (d *checkoutSagaCompletedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.CheckoutSagaCompletedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type checkoutSagaStartedEventDeserializer struct{}
+
+func NewCheckoutSagaStartedEventDeserializer() eventDeserializer {
+	return &checkoutSagaStartedEventDeserializer{}
+}
+
+func (d *checkoutSagaStartedEventDeserializer) EventType() string {
+	return "CheckoutSagaStartedEvent"
+}
+
+func (d *checkoutSagaStartedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.CheckoutSagaStartedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
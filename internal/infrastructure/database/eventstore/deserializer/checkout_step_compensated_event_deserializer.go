@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type checkoutStepCompensatedEventDeserializer struct{}
+
+func NewCheckoutStepCompensatedEventDeserializer() eventDeserializer {
+	return &checkoutStepCompensatedEventDeserializer{}
+}
+
+func (d *checkoutStepCompensatedEventDeserializer) EventType() string {
+	return "CheckoutStepCompensatedEvent"
+}
+
+func (d *checkoutStepCompensatedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.CheckoutStepCompensatedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type checkoutStepCompletedEventDeserializer struct{}
+
+func NewCheckoutStepCompletedEventDeserializer() eventDeserializer {
+	return &checkoutStepCompletedEventDeserializer{}
+}
+
+func (d *checkoutStepCompletedEventDeserializer) EventType() string {
+	return "CheckoutStepCompletedEvent"
+}
+
+func (d *checkoutStepCompletedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.CheckoutStepCompletedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
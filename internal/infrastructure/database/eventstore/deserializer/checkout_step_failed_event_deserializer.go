@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type checkoutStepFailedEventDeserializer struct{}
+
+func NewCheckoutStepFailedEventDeserializer() eventDeserializer {
+	return &checkoutStepFailedEventDeserializer{}
+}
+
+func (d *checkoutStepFailedEventDeserializer) EventType() string {
+	return "CheckoutStepFailedEvent"
+}
+
+func (d *checkoutStepFailedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.CheckoutStepFailedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type checkoutStepStartedEventDeserializer struct{}
+
+func NewCheckoutStepStartedEventDeserializer() eventDeserializer {
+	return &checkoutStepStartedEventDeserializer{}
+}
+
+func (d *checkoutStepStartedEventDeserializer) EventType() string {
+	return "CheckoutStepStartedEvent"
+}
+
+func (d *checkoutStepStartedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.CheckoutStepStartedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type couponAppliedToCartEventDeserializer struct{}
+
+func NewCouponAppliedToCartEventDeserializer() eventDeserializer {
+	return &couponAppliedToCartEventDeserializer{}
+}
+
+func (d *couponAppliedToCartEventDeserializer) EventType() string {
+	return "CouponAppliedToCartEvent"
+}
+
+func (d *couponAppliedToCartEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.CouponAppliedToCartEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type couponCreatedEventDeserializer struct{}
+
+func NewCouponCreatedEventDeserializer() eventDeserializer {
+	return &couponCreatedEventDeserializer{}
+}
+
+func (d *couponCreatedEventDeserializer) EventType() string {
+	return "CouponCreatedEvent"
+}
+
+func (d *couponCreatedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.CouponCreatedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type couponRedeemedEventDeserializer struct{}
+
+func NewCouponRedeemedEventDeserializer() eventDeserializer {
+	return &couponRedeemedEventDeserializer{}
+}
+
+func (d *couponRedeemedEventDeserializer) EventType() string {
+	return "CouponRedeemedEvent"
+}
+
+func (d *couponRedeemedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.CouponRedeemedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
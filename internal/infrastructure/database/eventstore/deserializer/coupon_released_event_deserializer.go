@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type couponReleasedEventDeserializer struct{}
+
+func NewCouponReleasedEventDeserializer() eventDeserializer {
+	return &couponReleasedEventDeserializer{}
+}
+
+func (d *couponReleasedEventDeserializer) EventType() string {
+	return "CouponReleasedEvent"
+}
+
+func (d *couponReleasedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.CouponReleasedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type couponRemovedFromCartEventDeserializer struct{}
+
+func NewCouponRemovedFromCartEventDeserializer() eventDeserializer {
+	return &couponRemovedFromCartEventDeserializer{}
+}
+
+func (d *couponRemovedFromCartEventDeserializer) EventType() string {
+	return "CouponRemovedFromCartEvent"
+}
+
+func (d *couponRemovedFromCartEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.CouponRemovedFromCartEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type dataSubjectAnonymisedEventDeserializer struct{}
+
+func NewDataSubjectAnonymisedEventDeserializer() eventDeserializer {
+	return &dataSubjectAnonymisedEventDeserializer{}
+}
+
+func (d *dataSubjectAnonymisedEventDeserializer) EventType() string {
+	return "DataSubjectAnonymisedEvent"
+}
+
+func (d *dataSubjectAnonymisedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.DataSubjectAnonymisedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type dataSubjectDataExportedEventDeserializer struct{}
+
+func NewDataSubjectDataExportedEventDeserializer() eventDeserializer {
+	return &dataSubjectDataExportedEventDeserializer{}
+}
+
+func (d *dataSubjectDataExportedEventDeserializer) EventType() string {
+	return "DataSubjectDataExportedEvent"
+}
+
+func (d *dataSubjectDataExportedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.DataSubjectDataExportedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type dataSubjectErasedEventDeserializer struct{}
+
+func NewDataSubjectErasedEventDeserializer() eventDeserializer {
+	return &dataSubjectErasedEventDeserializer{}
+}
+
+func (d *dataSubjectErasedEventDeserializer) EventType() string {
+	return "DataSubjectErasedEvent"
+}
+
+func (d *dataSubjectErasedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.DataSubjectErasedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
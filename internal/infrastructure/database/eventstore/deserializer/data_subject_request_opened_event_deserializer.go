@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type dataSubjectRequestOpenedEventDeserializer struct{}
+
+func NewDataSubjectRequestOpenedEventDeserializer() eventDeserializer {
+	return &dataSubjectRequestOpenedEventDeserializer{}
+}
+
+func (d *dataSubjectRequestOpenedEventDeserializer) EventType() string {
+	return "DataSubjectRequestOpenedEvent"
+}
+
+func (d *dataSubjectRequestOpenedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.DataSubjectRequestOpenedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
@@ -1,6 +1,7 @@
 package deserializer
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
@@ -25,10 +26,84 @@ func NewEventDeserializer() repository.EventDeserializer {
 	registry.register(NewCartCreatedEventDeserializer())
 	registry.register(NewItemAddedToCartEventDeserializer())
 	registry.register(NewCartSubmittedEventDeserializer())
+	registry.register(NewCouponAppliedToCartEventDeserializer())
+	registry.register(NewCouponRemovedFromCartEventDeserializer())
+	registry.register(NewShippingAddressSetEventDeserializer())
+	registry.register(NewShippingMethodSelectedEventDeserializer())
+	registry.register(NewCartMergedEventDeserializer())
+	registry.register(NewCartClosedEventDeserializer())
+	registry.register(NewCartMemberInvitedEventDeserializer())
+	registry.register(NewCartInvitationAcceptedEventDeserializer())
+	registry.register(NewCartMemberRemovedEventDeserializer())
+	registry.register(NewCartApprovalRequestedEventDeserializer())
+	registry.register(NewCartApprovedEventDeserializer())
+	registry.register(NewCartApprovalRejectedEventDeserializer())
+	registry.register(NewCartChangesRequestedEventDeserializer())
+	registry.register(NewCartApprovalExpiredEventDeserializer())
+	registry.register(NewCartItemMovedToSavedListEventDeserializer())
 
 	// Tenant policy events
 	registry.register(NewTenantCartAbandonedPolicyCreatedEventDeserializer())
 	registry.register(NewTenantCartAbandonedPolicyUpdatedEventDeserializer())
+	registry.register(NewTenantCartAbandonedPolicySegmentConfiguredEventDeserializer())
+	registry.register(NewTenantCartAbandonedPolicySegmentRemovedEventDeserializer())
+	registry.register(NewTenantCartAbandonedPolicyChangeScheduledEventDeserializer())
+
+	// Tenant tax settings events
+	registry.register(NewTenantTaxSettingsConfiguredEventDeserializer())
+
+	// Tenant shipping rate events
+	registry.register(NewShippingRateSetEventDeserializer())
+
+	// Tenant approval policy events
+	registry.register(NewTenantApprovalPolicyConfiguredEventDeserializer())
+
+	// Tenant cart rules events
+	registry.register(NewTenantCartRulesConfiguredEventDeserializer())
+
+	// Tenant events
+	registry.register(NewTenantOnboardedEventDeserializer())
+	registry.register(NewTenantProfileUpdatedEventDeserializer())
+	registry.register(NewTenantPlanChangedEventDeserializer())
+	registry.register(NewTenantSuspendedEventDeserializer())
+	registry.register(NewTenantReactivatedEventDeserializer())
+	registry.register(NewTenantClosedEventDeserializer())
+
+	// Checkout saga events
+	registry.register(NewCheckoutSagaStartedEventDeserializer())
+	registry.register(NewCheckoutStepStartedEventDeserializer())
+	registry.register(NewCheckoutStepCompletedEventDeserializer())
+	registry.register(NewCheckoutStepFailedEventDeserializer())
+	registry.register(NewCheckoutCompensationStartedEventDeserializer())
+	registry.register(NewCheckoutStepCompensatedEventDeserializer())
+	registry.register(NewCheckoutSagaCompletedEventDeserializer())
+	registry.register(NewCheckoutSagaAbortedEventDeserializer())
+
+	// Payment events
+	registry.register(NewPaymentAuthorizationRequestedEventDeserializer())
+	registry.register(NewPaymentAuthorizedEventDeserializer())
+	registry.register(NewPaymentDeclinedEventDeserializer())
+	registry.register(NewPaymentCaptureRequestedEventDeserializer())
+	registry.register(NewPaymentCapturedEventDeserializer())
+	registry.register(NewPaymentRefundRequestedEventDeserializer())
+	registry.register(NewPaymentRefundedEventDeserializer())
+
+	// Coupon events
+	registry.register(NewCouponCreatedEventDeserializer())
+	registry.register(NewCouponRedeemedEventDeserializer())
+	registry.register(NewCouponReleasedEventDeserializer())
+
+	// Saved list events
+	registry.register(NewSavedListCreatedEventDeserializer())
+	registry.register(NewItemSavedEventDeserializer())
+	registry.register(NewSavedItemRemovedEventDeserializer())
+	registry.register(NewSavedItemMovedToCartEventDeserializer())
+
+	// Data subject request events
+	registry.register(NewDataSubjectRequestOpenedEventDeserializer())
+	registry.register(NewDataSubjectDataExportedEventDeserializer())
+	registry.register(NewDataSubjectAnonymisedEventDeserializer())
+	registry.register(NewDataSubjectErasedEventDeserializer())
 
 	return registry
 }
@@ -37,7 +112,7 @@ func (r *eventRegistry) register(deserializer eventDeserializer) {
 	r.deserializers[deserializer.EventType()] = deserializer
 }
 
-func (r *eventRegistry) Deserialize(eventType string, eventData []byte) (event.Event, error) {
+func (r *eventRegistry) Deserialize(ctx context.Context, eventType string, eventData []byte) (event.Event, error) {
 	deserializer, exists := r.deserializers[eventType]
 	if !exists {
 		return nil, fmt.Errorf("unknown event type: %s", eventType)
@@ -35,6 +35,56 @@ func TestItemAddedToCartEventDeserializer(t *testing.T) {
 				Version:     1,
 			},
 		},
+		"should deserialize the member who added the line": {
+			input: []byte(`{
+				"AggregateID": "123e4567-e89b-12d3-a456-426614174000",
+				"ItemID": "123e4567-e89b-12d3-a456-426614174001",
+				"Name": "Test Item",
+				"Price": 99.99,
+				"AddedBy": "123e4567-e89b-12d3-a456-426614174003",
+				"EventID": "123e4567-e89b-12d3-a456-426614174002",
+				"Timestamp": "2023-01-01T10:00:00Z",
+				"Version": 2
+			}`),
+			want: &event.ItemAddedToCartEvent{
+				AggregateID: uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+				ItemID:      uuid.MustParse("123e4567-e89b-12d3-a456-426614174001"),
+				Name:        "Test Item",
+				Price:       99.99,
+				AddedBy:     uuid.MustParse("123e4567-e89b-12d3-a456-426614174003"),
+				EventID:     uuid.MustParse("123e4567-e89b-12d3-a456-426614174002"),
+				Timestamp:   time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC),
+				Version:     2,
+			},
+		},
+		"should deserialize the options of the line": {
+			input: []byte(`{
+				"AggregateID": "123e4567-e89b-12d3-a456-426614174000",
+				"ItemID": "123e4567-e89b-12d3-a456-426614174001",
+				"Name": "T-Shirt",
+				"Price": 2000,
+				"Options": [
+					{"Name": "gift_wrap", "Value": "yes", "PriceModifier": 300},
+					{"Name": "size", "Value": "L", "PriceModifier": 0}
+				],
+				"EventID": "123e4567-e89b-12d3-a456-426614174002",
+				"Timestamp": "2023-01-01T10:00:00Z",
+				"Version": 3
+			}`),
+			want: &event.ItemAddedToCartEvent{
+				AggregateID: uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"),
+				ItemID:      uuid.MustParse("123e4567-e89b-12d3-a456-426614174001"),
+				Name:        "T-Shirt",
+				Price:       2000,
+				Options: []event.ItemOption{
+					{Name: "gift_wrap", Value: "yes", PriceModifier: 300},
+					{Name: "size", Value: "L", PriceModifier: 0},
+				},
+				EventID:   uuid.MustParse("123e4567-e89b-12d3-a456-426614174002"),
+				Timestamp: time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC),
+				Version:   3,
+			},
+		},
 	}
 
 	for testName, tt := range tests {
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type itemSavedEventDeserializer struct{}
+
+func NewItemSavedEventDeserializer() eventDeserializer {
+	return &itemSavedEventDeserializer{}
+}
+
+func (d *itemSavedEventDeserializer) EventType() string {
+	return "ItemSavedEvent"
+}
+
+func (d *itemSavedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.ItemSavedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
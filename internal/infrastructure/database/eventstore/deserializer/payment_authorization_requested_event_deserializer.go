@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type paymentAuthorizationRequestedEventDeserializer struct{}
+
+func NewPaymentAuthorizationRequestedEventDeserializer() eventDeserializer {
+	return &paymentAuthorizationRequestedEventDeserializer{}
+}
+
+func (d *paymentAuthorizationRequestedEventDeserializer) EventType() string {
+	return "PaymentAuthorizationRequestedEvent"
+}
+
+func (d *paymentAuthorizationRequestedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.PaymentAuthorizationRequestedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
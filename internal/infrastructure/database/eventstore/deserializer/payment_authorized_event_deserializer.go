@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type paymentAuthorizedEventDeserializer struct{}
+
+func NewPaymentAuthorizedEventDeserializer() eventDeserializer {
+	return &paymentAuthorizedEventDeserializer{}
+}
+
+func (d *paymentAuthorizedEventDeserializer) EventType() string {
+	return "PaymentAuthorizedEvent"
+}
+
+func (d *paymentAuthorizedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.PaymentAuthorizedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
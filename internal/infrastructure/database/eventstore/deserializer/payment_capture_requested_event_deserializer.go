@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type paymentCaptureRequestedEventDeserializer struct{}
+
+func NewPaymentCaptureRequestedEventDeserializer() eventDeserializer {
+	return &paymentCaptureRequestedEventDeserializer{}
+}
+
+func (d *paymentCaptureRequestedEventDeserializer) EventType() string {
+	return "PaymentCaptureRequestedEvent"
+}
+
+func (d *paymentCaptureRequestedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.PaymentCaptureRequestedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type paymentCapturedEventDeserializer struct{}
+
+func NewPaymentCapturedEventDeserializer() eventDeserializer {
+	return &paymentCapturedEventDeserializer{}
+}
+
+func (d *paymentCapturedEventDeserializer) EventType() string {
+	return "PaymentCapturedEvent"
+}
+
+func (d *paymentCapturedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.PaymentCapturedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
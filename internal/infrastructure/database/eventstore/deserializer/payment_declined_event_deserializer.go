@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type paymentDeclinedEventDeserializer struct{}
+
+func NewPaymentDeclinedEventDeserializer() eventDeserializer {
+	return &paymentDeclinedEventDeserializer{}
+}
+
+func (d *paymentDeclinedEventDeserializer) EventType() string {
+	return "PaymentDeclinedEvent"
+}
+
+func (d *paymentDeclinedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.PaymentDeclinedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
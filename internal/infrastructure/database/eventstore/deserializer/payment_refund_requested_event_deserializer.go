@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type paymentRefundRequestedEventDeserializer struct{}
+
+func NewPaymentRefundRequestedEventDeserializer() eventDeserializer {
+	return &paymentRefundRequestedEventDeserializer{}
+}
+
+func (d *paymentRefundRequestedEventDeserializer) EventType() string {
+	return "PaymentRefundRequestedEvent"
+}
+
+func (d *paymentRefundRequestedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.PaymentRefundRequestedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type paymentRefundedEventDeserializer struct{}
+
+func NewPaymentRefundedEventDeserializer() eventDeserializer {
+	return &paymentRefundedEventDeserializer{}
+}
+
+func (d *paymentRefundedEventDeserializer) EventType() string {
+	return "PaymentRefundedEvent"
+}
+
+func (d *paymentRefundedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.PaymentRefundedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type savedItemMovedToCartEventDeserializer struct{}
+
+func NewSavedItemMovedToCartEventDeserializer() eventDeserializer {
+	return &savedItemMovedToCartEventDeserializer{}
+}
+
+func (d *savedItemMovedToCartEventDeserializer) EventType() string {
+	return "SavedItemMovedToCartEvent"
+}
+
+func (d *savedItemMovedToCartEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.SavedItemMovedToCartEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type savedItemRemovedEventDeserializer struct{}
+
+func NewSavedItemRemovedEventDeserializer() eventDeserializer {
+	return &savedItemRemovedEventDeserializer{}
+}
+
+func (d *savedItemRemovedEventDeserializer) EventType() string {
+	return "SavedItemRemovedEvent"
+}
+
+func (d *savedItemRemovedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.SavedItemRemovedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
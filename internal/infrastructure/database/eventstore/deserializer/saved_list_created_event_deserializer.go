@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type savedListCreatedEventDeserializer struct{}
+
+func NewSavedListCreatedEventDeserializer() eventDeserializer {
+	return &savedListCreatedEventDeserializer{}
+}
+
+func (d *savedListCreatedEventDeserializer) EventType() string {
+	return "SavedListCreatedEvent"
+}
+
+func (d *savedListCreatedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.SavedListCreatedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type shippingAddressSetEventDeserializer struct{}
+
+func NewShippingAddressSetEventDeserializer() eventDeserializer {
+	return &shippingAddressSetEventDeserializer{}
+}
+
+func (d *shippingAddressSetEventDeserializer) EventType() string {
+	return "ShippingAddressSetEvent"
+}
+
+func (d *shippingAddressSetEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.ShippingAddressSetEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type shippingMethodSelectedEventDeserializer struct{}
+
+func NewShippingMethodSelectedEventDeserializer() eventDeserializer {
+	return &shippingMethodSelectedEventDeserializer{}
+}
+
+func (d *shippingMethodSelectedEventDeserializer) EventType() string {
+	return "ShippingMethodSelectedEvent"
+}
+
+func (d *shippingMethodSelectedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.ShippingMethodSelectedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
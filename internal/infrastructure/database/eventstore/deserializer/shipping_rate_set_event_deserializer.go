@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type shippingRateSetEventDeserializer struct{}
+
+func NewShippingRateSetEventDeserializer() eventDeserializer {
+	return &shippingRateSetEventDeserializer{}
+}
+
+func (d *shippingRateSetEventDeserializer) EventType() string {
+	return "ShippingRateSetEvent"
+}
+
+func (d *shippingRateSetEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.ShippingRateSetEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type tenantApprovalPolicyConfiguredEventDeserializer struct{}
+
+func NewTenantApprovalPolicyConfiguredEventDeserializer() eventDeserializer {
+	return &tenantApprovalPolicyConfiguredEventDeserializer{}
+}
+
+func (d *tenantApprovalPolicyConfiguredEventDeserializer) EventType() string {
+	return "TenantApprovalPolicyConfiguredEvent"
+}
+
+func (d *tenantApprovalPolicyConfiguredEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.TenantApprovalPolicyConfiguredEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
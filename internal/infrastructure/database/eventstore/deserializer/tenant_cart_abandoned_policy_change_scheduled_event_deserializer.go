@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type tenantCartAbandonedPolicyChangeScheduledEventDeserializer struct{}
+
+func NewTenantCartAbandonedPolicyChangeScheduledEventDeserializer() eventDeserializer {
+	return &tenantCartAbandonedPolicyChangeScheduledEventDeserializer{}
+}
+
+func (d *tenantCartAbandonedPolicyChangeScheduledEventDeserializer) EventType() string {
+	return "TenantCartAbandonedPolicyChangeScheduledEvent"
+}
+
+func (d *tenantCartAbandonedPolicyChangeScheduledEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.TenantCartAbandonedPolicyChangeScheduledEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type tenantCartAbandonedPolicySegmentConfiguredEventDeserializer struct{}
+
+func NewTenantCartAbandonedPolicySegmentConfiguredEventDeserializer() eventDeserializer {
+	return &tenantCartAbandonedPolicySegmentConfiguredEventDeserializer{}
+}
+
+func (d *tenantCartAbandonedPolicySegmentConfiguredEventDeserializer) EventType() string {
+	return "TenantCartAbandonedPolicySegmentConfiguredEvent"
+}
+
+func (d *tenantCartAbandonedPolicySegmentConfiguredEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.TenantCartAbandonedPolicySegmentConfiguredEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type tenantCartAbandonedPolicySegmentRemovedEventDeserializer struct{}
+
+func NewTenantCartAbandonedPolicySegmentRemovedEventDeserializer() eventDeserializer {
+	return &tenantCartAbandonedPolicySegmentRemovedEventDeserializer{}
+}
+
+func (d *tenantCartAbandonedPolicySegmentRemovedEventDeserializer) EventType() string {
+	return "TenantCartAbandonedPolicySegmentRemovedEvent"
+}
+
+func (d *tenantCartAbandonedPolicySegmentRemovedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.TenantCartAbandonedPolicySegmentRemovedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
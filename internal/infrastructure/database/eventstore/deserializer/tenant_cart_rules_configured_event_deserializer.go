@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type tenantCartRulesConfiguredEventDeserializer struct{}
+
+func NewTenantCartRulesConfiguredEventDeserializer() eventDeserializer {
+	return &tenantCartRulesConfiguredEventDeserializer{}
+}
+
+func (d *tenantCartRulesConfiguredEventDeserializer) EventType() string {
+	return "TenantCartRulesConfiguredEvent"
+}
+
+func (d *tenantCartRulesConfiguredEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.TenantCartRulesConfiguredEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
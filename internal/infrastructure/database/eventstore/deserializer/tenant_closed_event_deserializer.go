@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type tenantClosedEventDeserializer struct{}
+
+func NewTenantClosedEventDeserializer() eventDeserializer {
+	return &tenantClosedEventDeserializer{}
+}
+
+func (d *tenantClosedEventDeserializer) EventType() string {
+	return "TenantClosedEvent"
+}
+
+func (d *tenantClosedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.TenantClosedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
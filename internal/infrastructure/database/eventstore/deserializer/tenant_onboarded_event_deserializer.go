@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type tenantOnboardedEventDeserializer struct{}
+
+func NewTenantOnboardedEventDeserializer() eventDeserializer {
+	return &tenantOnboardedEventDeserializer{}
+}
+
+func (d *tenantOnboardedEventDeserializer) EventType() string {
+	return "TenantOnboardedEvent"
+}
+
+func (d *tenantOnboardedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.TenantOnboardedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type tenantPlanChangedEventDeserializer struct{}
+
+func NewTenantPlanChangedEventDeserializer() eventDeserializer {
+	return &tenantPlanChangedEventDeserializer{}
+}
+
+func (d *tenantPlanChangedEventDeserializer) EventType() string {
+	return "TenantPlanChangedEvent"
+}
+
+func (d *tenantPlanChangedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.TenantPlanChangedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type tenantProfileUpdatedEventDeserializer struct{}
+
+func NewTenantProfileUpdatedEventDeserializer() eventDeserializer {
+	return &tenantProfileUpdatedEventDeserializer{}
+}
+
+func (d *tenantProfileUpdatedEventDeserializer) EventType() string {
+	return "TenantProfileUpdatedEvent"
+}
+
+func (d *tenantProfileUpdatedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.TenantProfileUpdatedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
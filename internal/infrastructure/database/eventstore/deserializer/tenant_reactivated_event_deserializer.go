@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type tenantReactivatedEventDeserializer struct{}
+
+func NewTenantReactivatedEventDeserializer() eventDeserializer {
+	return &tenantReactivatedEventDeserializer{}
+}
+
+func (d *tenantReactivatedEventDeserializer) EventType() string {
+	return "TenantReactivatedEvent"
+}
+
+func (d *tenantReactivatedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.TenantReactivatedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type tenantSuspendedEventDeserializer struct{}
+
+func NewTenantSuspendedEventDeserializer() eventDeserializer {
+	return &tenantSuspendedEventDeserializer{}
+}
+
+func (d *tenantSuspendedEventDeserializer) EventType() string {
+	return "TenantSuspendedEvent"
+}
+
+func (d *tenantSuspendedEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.TenantSuspendedEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
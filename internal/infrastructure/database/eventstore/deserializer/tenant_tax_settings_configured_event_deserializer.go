@@ -0,0 +1,25 @@
+package deserializer
+
+import (
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type tenantTaxSettingsConfiguredEventDeserializer struct{}
+
+func NewTenantTaxSettingsConfiguredEventDeserializer() eventDeserializer {
+	return &tenantTaxSettingsConfiguredEventDeserializer{}
+}
+
+func (d *tenantTaxSettingsConfiguredEventDeserializer) EventType() string {
+	return "TenantTaxSettingsConfiguredEvent"
+}
+
+func (d *tenantTaxSettingsConfiguredEventDeserializer) Deserialize(eventData []byte) (event.Event, error) {
+	var evt event.TenantTaxSettingsConfiguredEvent
+	if err := json.Unmarshal(eventData, &evt); err != nil {
+		return nil, err
+	}
+	return &evt, nil
+}
@@ -2,14 +2,15 @@ package eventstore
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/go-sql-driver/mysql"
 	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
 	appErrors "github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
@@ -17,11 +18,13 @@ import (
 )
 
 type eventStoreImpl struct {
+	serializer   repository.EventSerializer
 	deserializer repository.EventDeserializer
 }
 
-func NewEventStore(deserializer repository.EventDeserializer) repository.EventStore {
+func NewEventStore(serializer repository.EventSerializer, deserializer repository.EventDeserializer) repository.EventStore {
 	return &eventStoreImpl{
+		serializer:   serializer,
 		deserializer: deserializer,
 	}
 }
@@ -32,6 +35,85 @@ func (e *eventStoreImpl) SaveEvents(ctx context.Context, aggregateID uuid.UUID,
 		return err
 	}
 
+	if expected, ok := repository.ExpectedVersion(ctx, aggregateID); ok {
+		current, err := lockStream(ctx, tx, aggregateID)
+		if err != nil {
+			return err
+		}
+		if current != expected {
+			return appErrors.NewVersionMismatch(expected, current)
+		}
+	}
+
+	return e.insertEvents(ctx, tx, aggregateID, events)
+}
+
+func (e *eventStoreImpl) AppendStreams(ctx context.Context, appends []repository.StreamAppend) error {
+	tx, err := transaction.GetTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Streams are locked in ID order, so that two appends to the same
+	// streams cannot deadlock
+	ordered := slices.Clone(appends)
+	slices.SortFunc(ordered, func(a, b repository.StreamAppend) int {
+		return strings.Compare(a.AggregateID.String(), b.AggregateID.String())
+	})
+
+	conflicts := make([]appErrors.Detail, 0)
+	for _, stream := range ordered {
+		current, err := lockStream(ctx, tx, stream.AggregateID)
+		if err != nil {
+			return err
+		}
+		if expected, ok := repository.ExpectedVersion(ctx, stream.AggregateID); ok && current != expected {
+			return appErrors.NewVersionMismatch(expected, current)
+		}
+		if current != stream.ExpectedVersion {
+			conflicts = append(conflicts, appErrors.Detail{
+				Code:    "STREAM_VERSION_CONFLICT",
+				Message: fmt.Sprintf("aggregate %s is at version %d, expected %d", stream.AggregateID, current, stream.ExpectedVersion),
+			})
+		}
+	}
+	if len(conflicts) > 0 {
+		return appErrors.OptimisticLock.NewWithDetails("streams were appended to concurrently", conflicts)
+	}
+
+	for _, stream := range appends {
+		if err := e.insertEvents(ctx, tx, stream.AggregateID, stream.Events); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// lockStream returns the version of the stream, locked so that it is still
+// the current one when events are inserted. Locking a stream with no events
+// yet locks the gap it would go into, which two writers creating streams can
+// deadlock on, so a deadlock or lock wait timeout is reported as a lost
+// optimistic lock for the transaction to be retried.
+func lockStream(ctx context.Context, tx *sqlx.Tx, aggregateID uuid.UUID) (int, error) {
+	query := `
+		SELECT COALESCE(MAX(version), -1)
+		FROM events
+		WHERE aggregate_id = ?
+		FOR UPDATE
+	`
+
+	var current int
+	if err := tx.GetContext(ctx, &current, query, aggregateID); err != nil {
+		if isLockConflictError(err) {
+			return 0, appErrors.OptimisticLock.Wrap(err, fmt.Sprintf("aggregate %s is being appended to concurrently", aggregateID))
+		}
+		return 0, appErrors.QueryError.Wrap(err, "failed to get aggregate version")
+	}
+	return current, nil
+}
+
+func (e *eventStoreImpl) insertEvents(ctx context.Context, tx *sqlx.Tx, aggregateID uuid.UUID, events []event.Event) error {
 	query := `
 		INSERT INTO events (
 			aggregate_id, 
@@ -44,7 +126,7 @@ func (e *eventStoreImpl) SaveEvents(ctx context.Context, aggregateID uuid.UUID,
 	`
 
 	for _, evt := range events {
-		eventData, err := json.Marshal(evt)
+		eventData, err := e.serializer.Serialize(ctx, evt)
 		if err != nil {
 			return err
 		}
@@ -58,7 +140,7 @@ func (e *eventStoreImpl) SaveEvents(ctx context.Context, aggregateID uuid.UUID,
 			time.Now(),
 		)
 		if err != nil {
-			if isDuplicateKeyError(err) {
+			if isDuplicateKeyError(err) || isLockConflictError(err) {
 				return appErrors.OptimisticLock.Wrap(err, fmt.Sprintf("version conflict for aggregate %s version %d", aggregateID, evt.GetVersion()))
 			}
 			return appErrors.RepositoryError.Wrap(err, "failed to save event")
@@ -76,7 +158,84 @@ func isDuplicateKeyError(err error) bool {
 	return strings.Contains(msg, "duplicate") || strings.Contains(msg, "constraint failed")
 }
 
+// isLockConflictError tells whether MySQL gave up on the statement over a lock
+// held by another transaction: 1213 is a deadlock, 1205 a lock wait timeout.
+func isLockConflictError(err error) bool {
+	return errors.Is(err, &mysql.MySQLError{Number: 1213}) || errors.Is(err, &mysql.MySQLError{Number: 1205})
+}
+
 func (e *eventStoreImpl) LoadEvents(ctx context.Context, aggregateID uuid.UUID) ([]event.Event, error) {
+	return e.loadEvents(ctx, "aggregate_id = ?", aggregateID)
+}
+
+func (e *eventStoreImpl) LoadEventsUntilVersion(ctx context.Context, aggregateID uuid.UUID, version int) ([]event.Event, error) {
+	return e.loadEvents(ctx, "aggregate_id = ? AND version <= ?", aggregateID, version)
+}
+
+func (e *eventStoreImpl) LoadEventsUntil(ctx context.Context, aggregateID uuid.UUID, until time.Time) ([]event.Event, error) {
+	return e.loadEvents(ctx, "aggregate_id = ? AND created_at <= ?", aggregateID, until)
+}
+
+func (e *eventStoreImpl) ReadEvents(ctx context.Context, aggregateID uuid.UUID, r repository.EventRange) ([]repository.StoredEvent, error) {
+	conditions := []string{"aggregate_id = ?"}
+	args := []any{aggregateID}
+	if r.FromVersion != nil {
+		conditions = append(conditions, "version >= ?")
+		args = append(args, *r.FromVersion)
+	}
+	if r.ToVersion != nil {
+		conditions = append(conditions, "version <= ?")
+		args = append(args, *r.ToVersion)
+	}
+	if !r.From.IsZero() {
+		conditions = append(conditions, "created_at >= ?")
+		args = append(args, r.From)
+	}
+	if !r.To.IsZero() {
+		conditions = append(conditions, "created_at <= ?")
+		args = append(args, r.To)
+	}
+	events, err := e.readEvents(ctx, strings.Join(conditions, " AND "), r.Limit, args...)
+	if err != nil {
+		return nil, err
+	}
+	if len(events) > 0 {
+		return events, nil
+	}
+
+	// An empty range of an existing stream is not an error
+	tx, err := transaction.GetTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var exists bool
+	if err := tx.GetContext(ctx, &exists, "SELECT EXISTS(SELECT 1 FROM events WHERE aggregate_id = ?)", aggregateID); err != nil {
+		return nil, appErrors.QueryError.Wrap(err, "failed to check stream")
+	}
+	if !exists {
+		return nil, appErrors.NotFound.New("stream not found")
+	}
+	return events, nil
+}
+
+func (e *eventStoreImpl) loadEvents(ctx context.Context, where string, args ...any) ([]event.Event, error) {
+	stored, err := e.readEvents(ctx, where, 0, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(stored) == 0 {
+		return nil, appErrors.NotFound.New("todo list not found")
+	}
+
+	events := make([]event.Event, 0, len(stored))
+	for _, s := range stored {
+		events = append(events, s.Event)
+	}
+	return events, nil
+}
+
+func (e *eventStoreImpl) readEvents(ctx context.Context, where string, limit int, args ...any) ([]repository.StoredEvent, error) {
 	tx, err := transaction.GetTx(ctx)
 	if err != nil {
 		return nil, err
@@ -85,17 +244,21 @@ func (e *eventStoreImpl) LoadEvents(ctx context.Context, aggregateID uuid.UUID)
 	query := `
 		SELECT event_id, event_type, event_data, version, created_at
 		FROM events 
-		WHERE aggregate_id = ?
+		WHERE ` + where + `
 		ORDER BY version ASC
 	`
+	if limit > 0 {
+		query += "LIMIT ?"
+		args = append(args, limit)
+	}
 
-	rows, err := tx.QueryContext(ctx, query, aggregateID)
+	rows, err := tx.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, appErrors.QueryError.Wrap(err, "failed to load events")
 	}
 	defer rows.Close()
 
-	var events []event.Event
+	events := make([]repository.StoredEvent, 0)
 	for rows.Next() {
 		var eventID uuid.UUID
 		var eventType string
@@ -108,21 +271,17 @@ func (e *eventStoreImpl) LoadEvents(ctx context.Context, aggregateID uuid.UUID)
 			return nil, appErrors.QueryError.Wrap(err, "failed to scan event row")
 		}
 
-		evt, err := e.deserializer.Deserialize(eventType, eventData)
+		evt, err := e.deserializer.Deserialize(ctx, eventType, eventData)
 		if err != nil {
 			return nil, appErrors.QueryError.Wrap(err, fmt.Sprintf("failed to deserialize event %s", eventType))
 		}
 
-		events = append(events, evt)
+		events = append(events, repository.StoredEvent{Event: evt, RecordedAt: createdAt})
 	}
 
 	if err := rows.Err(); err != nil {
 		return nil, appErrors.QueryError.Wrap(err, "rows iteration error")
 	}
 
-	if len(events) == 0 {
-		return nil, appErrors.NotFound.New("todo list not found")
-	}
-
 	return events, nil
 }
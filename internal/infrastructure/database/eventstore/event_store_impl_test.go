@@ -13,6 +13,7 @@ import (
 
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/config"
 	domainevent "github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/client"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/eventstore"
@@ -35,9 +36,15 @@ func (e testEvent) GetVersion() int           { return e.Version }
 func (e testEvent) GetTimestamp() time.Time   { return e.CreatedAt }
 func (e testEvent) GetAggregateType() string  { return "cart" }
 
+type fakeSerializer struct{}
+
+func (f fakeSerializer) Serialize(ctx context.Context, evt domainevent.Event) ([]byte, error) {
+	return json.Marshal(evt)
+}
+
 type fakeDeserializer struct{}
 
-func (f fakeDeserializer) Deserialize(eventType string, data []byte) (domainevent.Event, error) {
+func (f fakeDeserializer) Deserialize(ctx context.Context, eventType string, data []byte) (domainevent.Event, error) {
 	var te testEvent
 	if err := json.Unmarshal(data, &te); err != nil {
 		return nil, err
@@ -163,7 +170,7 @@ func TestEventStore_SaveEvents(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			dbClient := newTestDBClient(t)
 			ctx, tx := beginTxCtx(t, dbClient)
-			store := eventstore.NewEventStore(fakeDeserializer{})
+			store := eventstore.NewEventStore(fakeSerializer{}, fakeDeserializer{})
 
 			err := store.SaveEvents(ctx, testAggregateID, tt.events)
 
@@ -180,6 +187,141 @@ func TestEventStore_SaveEvents(t *testing.T) {
 	}
 }
 
+func TestEventStore_SaveEvents_ExpectedVersion(t *testing.T) {
+	testAggregateID := uuid.MustParse("12345678-1234-1234-1234-123456789012")
+	existing := []domainevent.Event{
+		testEvent{
+			AggregateID: testAggregateID,
+			EventID:     uuid.New(),
+			Type:        "TestEvent",
+			Version:     0,
+			CreatedAt:   time.Now(),
+		},
+		testEvent{
+			AggregateID: testAggregateID,
+			EventID:     uuid.New(),
+			Type:        "TestEvent",
+			Version:     1,
+			CreatedAt:   time.Now(),
+		},
+	}
+
+	tests := map[string]struct {
+		expectedVersion    int
+		wantErr            bool
+		wantCurrentVersion int
+	}{
+		"matching version appends": {
+			expectedVersion: 1,
+		},
+		"stale version is refused with the current version": {
+			expectedVersion:    0,
+			wantErr:            true,
+			wantCurrentVersion: 1,
+		},
+		"version ahead of the stream is refused": {
+			expectedVersion:    5,
+			wantErr:            true,
+			wantCurrentVersion: 1,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			dbClient := newTestDBClient(t)
+			ctx, tx := beginTxCtx(t, dbClient)
+			store := eventstore.NewEventStore(fakeSerializer{}, fakeDeserializer{})
+			err := store.SaveEvents(ctx, testAggregateID, existing)
+			require.NoError(t, err)
+
+			next := []domainevent.Event{
+				testEvent{
+					AggregateID: testAggregateID,
+					EventID:     uuid.New(),
+					Type:        "TestEvent",
+					Version:     2,
+					CreatedAt:   time.Now(),
+				},
+			}
+
+			// Act
+			err = store.SaveEvents(repository.WithExpectedVersion(ctx, testAggregateID, tt.expectedVersion), testAggregateID, next)
+
+			rollbackErr := tx.Rollback()
+			require.NoError(t, rollbackErr)
+
+			// Assert
+			if tt.wantErr {
+				require.True(t, errors.IsCode(err, errors.PreconditionFailed))
+				current, ok := errors.CurrentVersionOf(err)
+				require.True(t, ok)
+				require.Equal(t, tt.wantCurrentVersion, current)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEventStore_AppendStreams(t *testing.T) {
+	newEvent := func(aggregateID uuid.UUID, version int) domainevent.Event {
+		return testEvent{
+			AggregateID: aggregateID,
+			EventID:     uuid.New(),
+			Type:        "TestEvent",
+			Version:     version,
+			CreatedAt:   time.Now(),
+		}
+	}
+
+	tests := map[string]struct {
+		secondExpected int
+		wantErrCode    errors.ErrCode
+	}{
+		"appends to every stream": {
+			secondExpected: 0,
+		},
+		"appends nothing when one stream is stale": {
+			secondExpected: -1,
+			wantErrCode:    errors.OptimisticLock,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			dbClient := newTestDBClient(t)
+			ctx, tx := beginTxCtx(t, dbClient)
+			store := eventstore.NewEventStore(fakeSerializer{}, fakeDeserializer{})
+			first, second := uuid.New(), uuid.New()
+			err := store.SaveEvents(ctx, second, []domainevent.Event{newEvent(second, 0)})
+			require.NoError(t, err)
+
+			// Act
+			err = store.AppendStreams(ctx, []repository.StreamAppend{
+				{AggregateID: first, ExpectedVersion: -1, Events: []domainevent.Event{newEvent(first, 0)}},
+				{AggregateID: second, ExpectedVersion: tt.secondExpected, Events: []domainevent.Event{newEvent(second, tt.secondExpected+1)}},
+			})
+			firstEvents, firstErr := store.LoadEvents(ctx, first)
+
+			rollbackErr := tx.Rollback()
+			require.NoError(t, rollbackErr)
+
+			// Assert
+			if tt.wantErrCode != "" {
+				require.True(t, errors.IsCode(err, tt.wantErrCode))
+				require.Len(t, errors.DetailsOf(err), 1)
+				require.True(t, errors.IsCode(firstErr, errors.NotFound))
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, firstErr)
+			require.Len(t, firstEvents, 1)
+		})
+	}
+}
+
 func TestEventStore_LoadEvents(t *testing.T) {
 	testAggregateID := uuid.MustParse("12345678-1234-1234-1234-123456789012")
 
@@ -232,7 +374,7 @@ func TestEventStore_LoadEvents(t *testing.T) {
 			// Arrange
 			dbClient := newTestDBClient(t)
 			ctx, tx := beginTxCtx(t, dbClient)
-			store := eventstore.NewEventStore(fakeDeserializer{})
+			store := eventstore.NewEventStore(fakeSerializer{}, fakeDeserializer{})
 			if len(tt.savedEvents) > 0 {
 				err := store.SaveEvents(ctx, testAggregateID, tt.savedEvents)
 				require.NoError(t, err)
@@ -255,3 +397,166 @@ func TestEventStore_LoadEvents(t *testing.T) {
 		})
 	}
 }
+
+func TestEventStore_LoadEventsUntil(t *testing.T) {
+	testAggregateID := uuid.MustParse("12345678-1234-1234-1234-123456789012")
+	storedAt := time.Date(2025, 11, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		load         func(ctx context.Context, store repository.EventStore) ([]domainevent.Event, error)
+		wantVersions []int
+		wantNotFound bool
+	}{
+		"up to a version": {
+			load: func(ctx context.Context, store repository.EventStore) ([]domainevent.Event, error) {
+				return store.LoadEventsUntilVersion(ctx, testAggregateID, 1)
+			},
+			wantVersions: []int{0, 1},
+		},
+		"up to a version past the end": {
+			load: func(ctx context.Context, store repository.EventStore) ([]domainevent.Event, error) {
+				return store.LoadEventsUntilVersion(ctx, testAggregateID, 10)
+			},
+			wantVersions: []int{0, 1, 2},
+		},
+		"up to a time": {
+			load: func(ctx context.Context, store repository.EventStore) ([]domainevent.Event, error) {
+				return store.LoadEventsUntil(ctx, testAggregateID, storedAt.Add(90*time.Minute))
+			},
+			wantVersions: []int{0, 1},
+		},
+		"before the stream began": {
+			load: func(ctx context.Context, store repository.EventStore) ([]domainevent.Event, error) {
+				return store.LoadEventsUntil(ctx, testAggregateID, storedAt.Add(-time.Minute))
+			},
+			wantNotFound: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			dbClient := newTestDBClient(t)
+			ctx, tx := beginTxCtx(t, dbClient)
+			store := eventstore.NewEventStore(fakeSerializer{}, fakeDeserializer{})
+			for version := 0; version < 3; version++ {
+				err := store.SaveEvents(ctx, testAggregateID, []domainevent.Event{
+					testEvent{
+						AggregateID: testAggregateID,
+						EventID:     uuid.New(),
+						Type:        "TestEvent",
+						Version:     version,
+						CreatedAt:   time.Now(),
+					},
+				})
+				require.NoError(t, err)
+				_, err = tx.ExecContext(ctx, "UPDATE events SET created_at = ? WHERE aggregate_id = ? AND version = ?",
+					storedAt.Add(time.Duration(version)*time.Hour), testAggregateID, version)
+				require.NoError(t, err)
+			}
+
+			// Act
+			loadedEvents, err := tt.load(ctx, store)
+
+			rollbackErr := tx.Rollback()
+			require.NoError(t, rollbackErr)
+
+			// Assert
+			if tt.wantNotFound {
+				require.True(t, errors.IsCode(err, errors.NotFound))
+				return
+			}
+			require.NoError(t, err)
+			var versions []int
+			for _, e := range loadedEvents {
+				versions = append(versions, e.GetVersion())
+			}
+			require.Equal(t, tt.wantVersions, versions)
+		})
+	}
+}
+
+func TestEventStore_ReadEvents(t *testing.T) {
+	testAggregateID := uuid.MustParse("12345678-1234-1234-1234-123456789012")
+	storedAt := time.Date(2025, 11, 1, 10, 0, 0, 0, time.UTC)
+	one, three := 1, 3
+
+	tests := map[string]struct {
+		aggregateID  uuid.UUID
+		eventRange   repository.EventRange
+		wantVersions []int
+		wantNotFound bool
+	}{
+		"whole stream": {
+			aggregateID:  testAggregateID,
+			wantVersions: []int{0, 1, 2, 3},
+		},
+		"version range": {
+			aggregateID:  testAggregateID,
+			eventRange:   repository.EventRange{FromVersion: &one, ToVersion: &three},
+			wantVersions: []int{1, 2, 3},
+		},
+		"time range": {
+			aggregateID:  testAggregateID,
+			eventRange:   repository.EventRange{From: storedAt.Add(30 * time.Minute), To: storedAt.Add(150 * time.Minute)},
+			wantVersions: []int{1, 2},
+		},
+		"limited": {
+			aggregateID:  testAggregateID,
+			eventRange:   repository.EventRange{FromVersion: &one, Limit: 2},
+			wantVersions: []int{1, 2},
+		},
+		"empty range": {
+			aggregateID:  testAggregateID,
+			eventRange:   repository.EventRange{From: storedAt.Add(24 * time.Hour)},
+			wantVersions: []int{},
+		},
+		"unknown stream": {
+			aggregateID:  uuid.MustParse("99999999-9999-9999-9999-999999999999"),
+			wantNotFound: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			dbClient := newTestDBClient(t)
+			ctx, tx := beginTxCtx(t, dbClient)
+			store := eventstore.NewEventStore(fakeSerializer{}, fakeDeserializer{})
+			for version := 0; version < 4; version++ {
+				err := store.SaveEvents(ctx, testAggregateID, []domainevent.Event{
+					testEvent{
+						AggregateID: testAggregateID,
+						EventID:     uuid.New(),
+						Type:        "TestEvent",
+						Version:     version,
+						CreatedAt:   time.Now(),
+					},
+				})
+				require.NoError(t, err)
+				_, err = tx.ExecContext(ctx, "UPDATE events SET created_at = ? WHERE aggregate_id = ? AND version = ?",
+					storedAt.Add(time.Duration(version)*time.Hour), testAggregateID, version)
+				require.NoError(t, err)
+			}
+
+			// Act
+			stored, err := store.ReadEvents(ctx, tt.aggregateID, tt.eventRange)
+
+			rollbackErr := tx.Rollback()
+			require.NoError(t, rollbackErr)
+
+			// Assert
+			if tt.wantNotFound {
+				require.True(t, errors.IsCode(err, errors.NotFound))
+				return
+			}
+			require.NoError(t, err)
+			versions := []int{}
+			for _, s := range stored {
+				versions = append(versions, s.Event.GetVersion())
+				require.True(t, storedAt.Add(time.Duration(s.Event.GetVersion())*time.Hour).Equal(s.RecordedAt))
+			}
+			require.Equal(t, tt.wantVersions, versions)
+		})
+	}
+}
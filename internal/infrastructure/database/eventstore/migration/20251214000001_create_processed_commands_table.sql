@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    processed_commands (
+        command_key VARCHAR(255) PRIMARY KEY,
+        fingerprint CHAR(64) NOT NULL,
+        aggregate_id VARCHAR(255) NOT NULL,
+        version INT NOT NULL,
+        events JSON NOT NULL,
+        executed_at DATETIME(6) NULL,
+        created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+    );
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE processed_commands;
+
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE
+    subject_keys (
+        subject_id CHAR(36) PRIMARY KEY,
+        key_id CHAR(36) NOT NULL UNIQUE,
+        encryption_key VARBINARY(32) NULL,
+        created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+        erased_at TIMESTAMP NULL
+    );
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE subject_keys;
+
+-- +goose StatementEnd
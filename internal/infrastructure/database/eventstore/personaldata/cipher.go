@@ -0,0 +1,79 @@
+package personaldata
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+)
+
+// sealedPrefix marks an encrypted value, so that values stored before a
+// field was marked as personal are still read as they are. The ID of the key
+// follows it, so the value can be opened without knowing its subject.
+const sealedPrefix = "enc:v1:"
+
+// seal encrypts the value with AES-GCM, bound to the key's ID so that the
+// value cannot be passed off as sealed by another key.
+func seal(key repository.SubjectKey, value string) (string, error) {
+	aead, err := newAEAD(key.Key)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	sealed := aead.Seal(nonce, nonce, []byte(value), key.ID[:])
+	return sealedPrefix + key.ID.String() + ":" + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// sealingKeyID returns the ID of the key the value was sealed with.
+func sealingKeyID(value string) (uuid.UUID, error) {
+	keyID, _, found := strings.Cut(strings.TrimPrefix(value, sealedPrefix), ":")
+	if !found {
+		return uuid.Nil, errors.New("sealed value has no key id")
+	}
+	return uuid.Parse(keyID)
+}
+
+func open(key []byte, keyID uuid.UUID, value string) (string, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return "", err
+	}
+
+	_, encoded, _ := strings.Cut(strings.TrimPrefix(value, sealedPrefix), ":")
+	sealed, err := base64.RawStdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", errors.New("sealed value is too short")
+	}
+
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, keyID[:])
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+func isSealed(value string) bool {
+	return strings.HasPrefix(value, sealedPrefix)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,116 @@
+package personaldata
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+)
+
+type eventDeserializerImpl struct {
+	deserializer repository.EventDeserializer
+	keys         repository.KeyStore
+}
+
+// NewEventDeserializer decrypts the personal data of the events the
+// deserializer returns. A value whose key is erased, or that does not open
+// with its key, reads as event.Redacted, and an ID of a data subject reads
+// as uuid.Nil.
+func NewEventDeserializer(deserializer repository.EventDeserializer, keys repository.KeyStore) repository.EventDeserializer {
+	return &eventDeserializerImpl{
+		deserializer: deserializer,
+		keys:         keys,
+	}
+}
+
+func (d *eventDeserializerImpl) Deserialize(ctx context.Context, eventType string, eventData []byte) (event.Event, error) {
+	if !bytes.Contains(eventData, []byte(sealedPrefix)) {
+		return d.deserializer.Deserialize(ctx, eventType, eventData)
+	}
+
+	// A sealed ID does not decode into its field, so the sealed values are
+	// taken out before decoding and put back once opened
+	var document map[string]json.RawMessage
+	if err := json.Unmarshal(eventData, &document); err != nil {
+		return d.deserializer.Deserialize(ctx, eventType, eventData)
+	}
+	sealed := make(map[string]string)
+	for name, raw := range document {
+		var value string
+		if json.Unmarshal(raw, &value) == nil && isSealed(value) {
+			sealed[name] = value
+			document[name] = json.RawMessage("null")
+		}
+	}
+	if len(sealed) == 0 {
+		return d.deserializer.Deserialize(ctx, eventType, eventData)
+	}
+
+	stripped, err := json.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+	evt, err := d.deserializer.Deserialize(ctx, eventType, stripped)
+	if err != nil {
+		return nil, err
+	}
+
+	value := reflect.ValueOf(evt)
+	if value.Kind() != reflect.Pointer {
+		return nil, fmt.Errorf("event %s with personal data must be deserialized to a pointer", eventType)
+	}
+
+	keys := make(map[uuid.UUID][]byte)
+	for _, field := range personalFields(value.Type()) {
+		sealedValue, ok := sealed[field.jsonName]
+		if !ok {
+			continue
+		}
+		plaintext, opened, err := d.open(ctx, keys, sealedValue)
+		if err != nil {
+			return nil, err
+		}
+		if !opened {
+			plaintext = field.redacted()
+		}
+		field.set(value.Elem(), plaintext)
+	}
+
+	return evt, nil
+}
+
+// open returns the plaintext of the sealed value, or false if its key is
+// gone or the value does not open with it. Only a failure to look the key up
+// is an error.
+func (d *eventDeserializerImpl) open(ctx context.Context, keys map[uuid.UUID][]byte, value string) (string, bool, error) {
+	keyID, err := sealingKeyID(value)
+	if err != nil {
+		return "", false, nil
+	}
+
+	key, ok := keys[keyID]
+	if !ok {
+		found, exists, err := d.keys.FindKey(ctx, keyID)
+		if err != nil {
+			return "", false, err
+		}
+		if exists {
+			key = found
+		}
+		keys[keyID] = key
+	}
+	if key == nil {
+		return "", false, nil
+	}
+
+	plaintext, err := open(key, keyID, value)
+	if err != nil {
+		return "", false, nil
+	}
+	return plaintext, true, nil
+}
@@ -0,0 +1,107 @@
+package personaldata
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+)
+
+// ErrDataSubjectErased rejects an event with new personal data of a subject
+// who has been erased, such as an export for them.
+var ErrDataSubjectErased = errors.UnpermittedOp.New("data subject has been erased")
+
+type eventSerializerImpl struct {
+	keys repository.KeyStore
+}
+
+// NewEventSerializer serializes events to JSON with their personal data
+// encrypted by the key of its data subject. The ID of an erased subject is
+// stored as it reads once erased, uuid.Nil, so others can still refer to
+// them, but an event with other personal data of an erased subject is
+// rejected with ErrDataSubjectErased.
+func NewEventSerializer(keys repository.KeyStore) repository.EventSerializer {
+	return &eventSerializerImpl{
+		keys: keys,
+	}
+}
+
+func (s *eventSerializerImpl) Serialize(ctx context.Context, evt event.Event) ([]byte, error) {
+	data, err := json.Marshal(evt)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := personalFields(reflect.TypeOf(evt))
+	if len(fields) == 0 {
+		return data, nil
+	}
+
+	var dataSubjectID uuid.UUID
+	if personal, ok := evt.(event.PersonalData); ok {
+		dataSubjectID = personal.GetDataSubjectID()
+	}
+
+	var document map[string]json.RawMessage
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[uuid.UUID]*repository.SubjectKey)
+	for _, field := range fields {
+		var value string
+		if err := json.Unmarshal(document[field.jsonName], &value); err != nil {
+			return nil, err
+		}
+
+		// An empty value has nothing to seal, and stays empty once redacted
+		if value == "" {
+			continue
+		}
+
+		subjectID := dataSubjectID
+		if field.subject {
+			if subjectID, err = uuid.Parse(value); err != nil {
+				return nil, err
+			}
+		}
+		if subjectID == uuid.Nil {
+			continue
+		}
+
+		key, ok := keys[subjectID]
+		if !ok {
+			found, exists, err := s.keys.GetOrCreateKey(ctx, subjectID)
+			if err != nil {
+				return nil, err
+			}
+			if exists {
+				key = &found
+			}
+			keys[subjectID] = key
+		}
+
+		// A reference to an erased subject is stored already redacted, but
+		// new personal data of theirs is not stored at all
+		var stored string
+		switch {
+		case key != nil:
+			if stored, err = seal(*key, value); err != nil {
+				return nil, err
+			}
+		case field.subject:
+			stored = field.redacted()
+		default:
+			return nil, ErrDataSubjectErased
+		}
+		if document[field.jsonName], err = json.Marshal(stored); err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(document)
+}
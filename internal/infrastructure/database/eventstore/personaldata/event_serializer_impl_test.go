@@ -0,0 +1,322 @@
+package personaldata_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/eventstore/deserializer"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/eventstore/personaldata"
+)
+
+type fakeKeyStore struct {
+	keys   map[uuid.UUID]repository.SubjectKey
+	erased map[uuid.UUID]bool
+}
+
+func newFakeKeyStore() *fakeKeyStore {
+	return &fakeKeyStore{keys: map[uuid.UUID]repository.SubjectKey{}, erased: map[uuid.UUID]bool{}}
+}
+
+func (s *fakeKeyStore) GetOrCreateKey(ctx context.Context, subjectID uuid.UUID) (repository.SubjectKey, bool, error) {
+	if s.erased[subjectID] {
+		return repository.SubjectKey{}, false, nil
+	}
+	if key, ok := s.keys[subjectID]; ok {
+		return key, true, nil
+	}
+	key := repository.SubjectKey{ID: uuid.New(), Key: make([]byte, 32)}
+	if _, err := rand.Read(key.Key); err != nil {
+		return repository.SubjectKey{}, false, err
+	}
+	s.keys[subjectID] = key
+	return key, true, nil
+}
+
+func (s *fakeKeyStore) FindKey(ctx context.Context, keyID uuid.UUID) ([]byte, bool, error) {
+	for _, key := range s.keys {
+		if key.ID == keyID {
+			return key.Key, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+func (s *fakeKeyStore) EraseKey(ctx context.Context, subjectID uuid.UUID) error {
+	delete(s.keys, subjectID)
+	s.erased[subjectID] = true
+	return nil
+}
+
+func TestPersonalData_RoundTrip(t *testing.T) {
+	subjectID := uuid.New()
+	newEvent := func(subjectID uuid.UUID) *event.ShippingAddressSetEvent {
+		evt := event.NewShippingAddressSetEvent(uuid.New(), 2, subjectID, "Taro Yamada", "150-0001", "Tokyo", "Shibuya", "1-2-3 Jingumae", "Room 101", "090-1234-5678")
+		evt.Timestamp = time.Date(2025, 12, 15, 10, 0, 0, 0, time.UTC)
+		return evt
+	}
+
+	tests := map[string]struct {
+		evt   *event.ShippingAddressSetEvent
+		erase bool
+		want  func(evt *event.ShippingAddressSetEvent) *event.ShippingAddressSetEvent
+	}{
+		"should decrypt personal data while the key exists": {
+			evt: newEvent(subjectID),
+			want: func(evt *event.ShippingAddressSetEvent) *event.ShippingAddressSetEvent {
+				return evt
+			},
+		},
+		"should redact personal data once the key is erased": {
+			evt:   newEvent(subjectID),
+			erase: true,
+			want: func(evt *event.ShippingAddressSetEvent) *event.ShippingAddressSetEvent {
+				redacted := *evt
+				redacted.DataSubjectID = uuid.Nil
+				redacted.RecipientName = event.Redacted
+				redacted.PostalCode = event.Redacted
+				redacted.City = event.Redacted
+				redacted.AddressLine1 = event.Redacted
+				redacted.AddressLine2 = event.Redacted
+				redacted.Phone = event.Redacted
+				return &redacted
+			},
+		},
+		"should store events without a data subject in plaintext": {
+			evt: newEvent(uuid.Nil),
+			want: func(evt *event.ShippingAddressSetEvent) *event.ShippingAddressSetEvent {
+				return evt
+			},
+		},
+	}
+
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ctx := context.Background()
+			keys := newFakeKeyStore()
+			serializer := personaldata.NewEventSerializer(keys)
+			eventDeserializer := personaldata.NewEventDeserializer(deserializer.NewEventDeserializer(), keys)
+
+			// Act
+			data, err := serializer.Serialize(ctx, tt.evt)
+			require.NoError(t, err)
+			if tt.erase {
+				require.NoError(t, keys.EraseKey(ctx, tt.evt.DataSubjectID))
+			}
+			got, err := eventDeserializer.Deserialize(ctx, tt.evt.GetEventType(), data)
+
+			// Assert
+			require.NoError(t, err)
+			require.Equal(t, tt.want(tt.evt), got)
+		})
+	}
+}
+
+func TestEventSerializer_Serialize(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	evt := event.NewShippingAddressSetEvent(uuid.New(), 2, uuid.New(), "Taro Yamada", "150-0001", "Tokyo", "Shibuya", "1-2-3 Jingumae", "", "090-1234-5678")
+	serializer := personaldata.NewEventSerializer(newFakeKeyStore())
+
+	// Act
+	data, err := serializer.Serialize(ctx, evt)
+
+	// Assert
+	require.NoError(t, err)
+	var payload map[string]any
+	require.NoError(t, json.Unmarshal(data, &payload))
+	require.NotEqual(t, "Taro Yamada", payload["RecipientName"])
+	require.NotEqual(t, "090-1234-5678", payload["Phone"])
+	require.Equal(t, "Tokyo", payload["Prefecture"])
+	require.Equal(t, evt.AggregateID.String(), payload["AggregateID"])
+}
+
+func TestEventDeserializer_Deserialize_Plaintext(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	evt := event.NewShippingAddressSetEvent(uuid.New(), 2, uuid.New(), "Taro Yamada", "150-0001", "Tokyo", "Shibuya", "1-2-3 Jingumae", "", "090-1234-5678")
+	evt.Timestamp = time.Date(2025, 12, 15, 10, 0, 0, 0, time.UTC)
+	data, err := json.Marshal(evt)
+	require.NoError(t, err)
+	eventDeserializer := personaldata.NewEventDeserializer(deserializer.NewEventDeserializer(), newFakeKeyStore())
+
+	// Act
+	got, err := eventDeserializer.Deserialize(ctx, evt.GetEventType(), data)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, evt, got)
+}
+
+func TestPersonalData_AfterErasure(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	keys := newFakeKeyStore()
+	serializer := personaldata.NewEventSerializer(keys)
+	eventDeserializer := personaldata.NewEventDeserializer(deserializer.NewEventDeserializer(), keys)
+	subjectID := uuid.New()
+	cartID := uuid.New()
+	created := event.NewCartCreatedEvent(cartID, 1, subjectID, uuid.New(), "")
+	oldAddress := event.NewShippingAddressSetEvent(cartID, 2, subjectID, "Taro Yamada", "150-0001", "Tokyo", "Shibuya", "1-2-3 Jingumae", "", "090-1234-5678")
+	var stored [][]byte
+	for _, evt := range []event.Event{created, oldAddress} {
+		data, err := serializer.Serialize(ctx, evt)
+		require.NoError(t, err)
+		stored = append(stored, data)
+	}
+	require.NoError(t, keys.EraseKey(ctx, subjectID))
+
+	// Act
+	var got []event.Event
+	for i, evt := range []event.Event{created, oldAddress} {
+		reloaded, err := eventDeserializer.Deserialize(ctx, evt.GetEventType(), stored[i])
+		require.NoError(t, err)
+		got = append(got, reloaded)
+	}
+	newAddress := event.NewShippingAddressSetEvent(cartID, 3, subjectID, "Taro Yamada", "150-0001", "Tokyo", "Shibuya", "4-5-6 Jingumae", "", "090-1234-5678")
+	_, addressErr := serializer.Serialize(ctx, newAddress)
+	removedBy := uuid.New()
+	removed := event.NewCartMemberRemovedEvent(cartID, 3, subjectID, removedBy)
+	removedData, removedErr := serializer.Serialize(ctx, removed)
+	require.NoError(t, removedErr)
+	reloadedRemoved, err := eventDeserializer.Deserialize(ctx, removed.GetEventType(), removedData)
+	require.NoError(t, err)
+
+	// Assert
+	require.NotContains(t, keys.keys, subjectID)
+	require.Equal(t, uuid.Nil, got[0].(*event.CartCreatedEvent).UserID)
+	require.Empty(t, got[0].(*event.CartCreatedEvent).SessionID)
+	address := got[1].(*event.ShippingAddressSetEvent)
+	require.True(t, address.IsRedacted())
+	require.Equal(t, uuid.Nil, address.DataSubjectID)
+	require.Equal(t, event.Redacted, address.AddressLine1)
+	require.Equal(t, "Tokyo", address.Prefecture)
+	require.ErrorIs(t, addressErr, personaldata.ErrDataSubjectErased)
+	require.NotContains(t, string(removedData), subjectID.String())
+	require.NotContains(t, keys.keys, subjectID)
+	require.Equal(t, uuid.Nil, reloadedRemoved.(*event.CartMemberRemovedEvent).UserID)
+	require.Equal(t, removedBy, reloadedRemoved.(*event.CartMemberRemovedEvent).RemovedBy)
+}
+
+func TestPersonalData_SubjectIDs(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	keys := newFakeKeyStore()
+	serializer := personaldata.NewEventSerializer(keys)
+	eventDeserializer := personaldata.NewEventDeserializer(deserializer.NewEventDeserializer(), keys)
+	evt := event.NewCartMemberInvitedEvent(uuid.New(), 2, uuid.New(), uuid.New())
+	evt.Timestamp = time.Date(2025, 12, 15, 10, 0, 0, 0, time.UTC)
+
+	// Act
+	data, err := serializer.Serialize(ctx, evt)
+	require.NoError(t, err)
+	got, err := eventDeserializer.Deserialize(ctx, evt.GetEventType(), data)
+	require.NoError(t, err)
+	require.NoError(t, keys.EraseKey(ctx, evt.InvitedBy))
+	redacted, err := eventDeserializer.Deserialize(ctx, evt.GetEventType(), data)
+
+	// Assert
+	require.NoError(t, err)
+	require.NotContains(t, string(data), evt.UserID.String())
+	require.NotContains(t, string(data), evt.InvitedBy.String())
+	require.Equal(t, evt, got)
+	require.Equal(t, evt.UserID, redacted.(*event.CartMemberInvitedEvent).UserID)
+	require.Equal(t, uuid.Nil, redacted.(*event.CartMemberInvitedEvent).InvitedBy)
+}
+
+func TestEventDeserializer_Deserialize_Unopenable(t *testing.T) {
+	ctx := context.Background()
+	subjectID := uuid.New()
+	otherSubjectID := uuid.New()
+
+	tests := map[string]struct {
+		tamper func(t *testing.T, keys *fakeKeyStore, data []byte) []byte
+	}{
+		"should redact a value whose key id is unknown": {
+			tamper: func(t *testing.T, keys *fakeKeyStore, data []byte) []byte {
+				key := keys.keys[subjectID]
+				return bytes.ReplaceAll(data, []byte(key.ID.String()), []byte(uuid.NewString()))
+			},
+		},
+		"should redact a value sealed by another key": {
+			tamper: func(t *testing.T, keys *fakeKeyStore, data []byte) []byte {
+				key := keys.keys[subjectID]
+				other, _, err := keys.GetOrCreateKey(ctx, otherSubjectID)
+				require.NoError(t, err)
+				return bytes.ReplaceAll(data, []byte(key.ID.String()), []byte(other.ID.String()))
+			},
+		},
+		"should redact a value that fails authentication": {
+			tamper: func(t *testing.T, keys *fakeKeyStore, data []byte) []byte {
+				var payload map[string]any
+				require.NoError(t, json.Unmarshal(data, &payload))
+				phone := []byte(payload["Phone"].(string))
+				if phone[len(phone)-10] == 'A' {
+					phone[len(phone)-10] = 'B'
+				} else {
+					phone[len(phone)-10] = 'A'
+				}
+				payload["Phone"] = string(phone)
+				tampered, err := json.Marshal(payload)
+				require.NoError(t, err)
+				return tampered
+			},
+		},
+	}
+
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			keys := newFakeKeyStore()
+			serializer := personaldata.NewEventSerializer(keys)
+			eventDeserializer := personaldata.NewEventDeserializer(deserializer.NewEventDeserializer(), keys)
+			evt := event.NewShippingAddressSetEvent(uuid.New(), 2, subjectID, "Taro Yamada", "150-0001", "Tokyo", "Shibuya", "1-2-3 Jingumae", "", "090-1234-5678")
+			data, err := serializer.Serialize(ctx, evt)
+			require.NoError(t, err)
+
+			// Act
+			got, err := eventDeserializer.Deserialize(ctx, evt.GetEventType(), tt.tamper(t, keys, data))
+
+			// Assert
+			require.NoError(t, err)
+			require.Equal(t, event.Redacted, got.(*event.ShippingAddressSetEvent).Phone)
+		})
+	}
+}
+
+func TestPersonalData_GuestSession(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	keys := newFakeKeyStore()
+	serializer := personaldata.NewEventSerializer(keys)
+	eventDeserializer := personaldata.NewEventDeserializer(deserializer.NewEventDeserializer(), keys)
+	cartID := uuid.New()
+	evt := event.NewCartCreatedEvent(cartID, 1, uuid.Nil, uuid.New(), "session-123")
+	data, err := serializer.Serialize(ctx, evt)
+	require.NoError(t, err)
+
+	// Act
+	got, err := eventDeserializer.Deserialize(ctx, evt.GetEventType(), data)
+	require.NoError(t, err)
+	require.NoError(t, keys.EraseKey(ctx, cartID))
+	erased, err := eventDeserializer.Deserialize(ctx, evt.GetEventType(), data)
+
+	// Assert
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "session-123")
+	require.Equal(t, "session-123", got.(*event.CartCreatedEvent).SessionID)
+	require.Equal(t, event.Redacted, erased.(*event.CartCreatedEvent).SessionID)
+	require.Equal(t, uuid.Nil, erased.(*event.CartCreatedEvent).UserID)
+}
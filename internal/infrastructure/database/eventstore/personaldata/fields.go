@@ -0,0 +1,77 @@
+package personaldata
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+)
+
+type personalField struct {
+	index    []int
+	jsonName string
+	// subject tells the field holds the ID of a data subject, which is
+	// sealed with that subject's own key.
+	subject bool
+}
+
+// redacted is what the field reads as once its key is erased.
+func (f personalField) redacted() string {
+	if f.subject {
+		return uuid.Nil.String()
+	}
+	return event.Redacted
+}
+
+// set stores the plaintext of the field in the event, redacting an ID that
+// does not parse.
+func (f personalField) set(evt reflect.Value, plaintext string) {
+	field := evt.FieldByIndex(f.index)
+	if !f.subject {
+		field.SetString(plaintext)
+		return
+	}
+	id, err := uuid.Parse(plaintext)
+	if err != nil {
+		id = uuid.Nil
+	}
+	field.Set(reflect.ValueOf(id))
+}
+
+var (
+	fieldCache sync.Map
+	uuidType   = reflect.TypeOf(uuid.UUID{})
+)
+
+// personalFields returns the string fields of the event type tagged
+// `personal:"true"` and the ID fields tagged `personal:"subject"`.
+func personalFields(t reflect.Type) []personalField {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if cached, ok := fieldCache.Load(t); ok {
+		return cached.([]personalField)
+	}
+
+	var fields []personalField
+	for _, f := range reflect.VisibleFields(t) {
+		var subject bool
+		switch {
+		case f.Tag.Get("personal") == "true" && f.Type.Kind() == reflect.String:
+		case f.Tag.Get("personal") == "subject" && f.Type == uuidType:
+			subject = true
+		default:
+			continue
+		}
+		name := f.Name
+		if tag, _, _ := strings.Cut(f.Tag.Get("json"), ","); tag != "" {
+			name = tag
+		}
+		fields = append(fields, personalField{index: f.Index, jsonName: name, subject: subject})
+	}
+
+	fieldCache.Store(t, fields)
+	return fields
+}
@@ -0,0 +1,144 @@
+package idempotency
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	appErrors "github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/transaction"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/bus"
+)
+
+// storedEvent keeps an event the way the event store does, so that it is
+// deserialized into the same type when the result is replayed.
+type storedEvent struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+type processedCommandStoreImpl struct {
+	serializer   repository.EventSerializer
+	deserializer repository.EventDeserializer
+}
+
+func NewProcessedCommandStore(serializer repository.EventSerializer, deserializer repository.EventDeserializer) bus.IdempotencyStore {
+	return &processedCommandStoreImpl{
+		serializer:   serializer,
+		deserializer: deserializer,
+	}
+}
+
+func (s *processedCommandStoreImpl) Get(ctx context.Context, key string) (bus.ProcessedCommand, bool, error) {
+	tx, err := transaction.GetTx(ctx)
+	if err != nil {
+		return bus.ProcessedCommand{}, false, err
+	}
+
+	// A result saved without its execution time was executed when it was
+	// recorded
+	query := `
+		SELECT fingerprint, aggregate_id, version, events, COALESCE(executed_at, created_at)
+		FROM processed_commands
+		WHERE command_key = ?
+	`
+
+	var fingerprint, aggregateID string
+	var version int
+	var eventsData []byte
+	var executedAt time.Time
+	err = tx.QueryRowContext(ctx, query, key).Scan(&fingerprint, &aggregateID, &version, &eventsData, &executedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return bus.ProcessedCommand{}, false, nil
+	}
+	if err != nil {
+		return bus.ProcessedCommand{}, false, appErrors.QueryError.Wrap(err, "failed to get processed command")
+	}
+
+	var stored []storedEvent
+	if err := json.Unmarshal(eventsData, &stored); err != nil {
+		return bus.ProcessedCommand{}, false, appErrors.QueryError.Wrap(err, "failed to unmarshal processed command events")
+	}
+
+	events := make([]event.Event, 0, len(stored))
+	for _, se := range stored {
+		evt, err := s.deserializer.Deserialize(ctx, se.Type, se.Data)
+		if err != nil {
+			return bus.ProcessedCommand{}, false, appErrors.QueryError.Wrap(err, fmt.Sprintf("failed to deserialize event %s", se.Type))
+		}
+		events = append(events, evt)
+	}
+
+	return bus.ProcessedCommand{
+		Fingerprint: fingerprint,
+		Result: bus.Result{
+			AggregateID: aggregateID,
+			Version:     version,
+			Events:      events,
+			ExecutedAt:  executedAt,
+		},
+	}, true, nil
+}
+
+func (s *processedCommandStoreImpl) Save(ctx context.Context, key string, processed bus.ProcessedCommand) error {
+	tx, err := transaction.GetTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	stored := make([]storedEvent, 0, len(processed.Result.Events))
+	for _, evt := range processed.Result.Events {
+		data, err := s.serializer.Serialize(ctx, evt)
+		if err != nil {
+			return appErrors.RepositoryError.Wrap(err, "failed to marshal event data")
+		}
+		stored = append(stored, storedEvent{Type: evt.GetEventType(), Data: data})
+	}
+
+	eventsData, err := json.Marshal(stored)
+	if err != nil {
+		return appErrors.RepositoryError.Wrap(err, "failed to marshal processed command events")
+	}
+
+	query := `
+		INSERT INTO processed_commands (
+			command_key,
+			fingerprint,
+			aggregate_id,
+			version,
+			events,
+			executed_at
+		) VALUES (?, ?, ?, ?, ?, ?)
+	`
+
+	_, err = tx.ExecContext(ctx, query,
+		key,
+		processed.Fingerprint,
+		processed.Result.AggregateID,
+		processed.Result.Version,
+		eventsData,
+		sql.NullTime{Time: processed.Result.ExecutedAt, Valid: !processed.Result.ExecutedAt.IsZero()},
+	)
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return appErrors.OptimisticLock.Wrap(err, fmt.Sprintf("command %s is being processed concurrently", key))
+		}
+		return appErrors.RepositoryError.Wrap(err, "failed to save processed command")
+	}
+
+	return nil
+}
+
+func isDuplicateKeyError(err error) bool {
+	if errors.Is(err, &mysql.MySQLError{Number: 1062}) {
+		return true
+	}
+	return strings.Contains(strings.ToLower(err.Error()), "duplicate")
+}
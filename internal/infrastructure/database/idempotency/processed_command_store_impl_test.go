@@ -0,0 +1,96 @@
+package idempotency_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	domainevent "github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	appErrors "github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/idempotency"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/testutil"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/bus"
+)
+
+func TestProcessedCommandStore_SaveAndGet(t *testing.T) {
+	testAggregateID := uuid.MustParse("12345678-1234-1234-1234-123456789012")
+
+	tests := map[string]struct {
+		events []domainevent.Event
+	}{
+		"command with events": {
+			events: []domainevent.Event{
+				testutil.TestEvent{
+					AggregateID: testAggregateID,
+					EventID:     uuid.New(),
+					Type:        testutil.TestTypeA,
+					Version:     1,
+					CreatedAt:   time.Now().UTC().Truncate(time.Second),
+				},
+			},
+		},
+		"command without events": {
+			events: []domainevent.Event{},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			dbClient := testutil.NewTestDBClient(t)
+			ctx, tx := testutil.BeginTxCtx(t, dbClient)
+			store := idempotency.NewProcessedCommandStore(testutil.FakeSerializer{}, testutil.FakeDeserializer{})
+			key := "AddItemToCart:" + uuid.NewString()
+			processed := bus.ProcessedCommand{
+				Fingerprint: "0f343b0931126a20f133d67c2b018a3b5e3f0e7f6d6c3f5e1f3b2a1c0d9e8f7a",
+				Result: bus.Result{
+					AggregateID: testAggregateID.String(),
+					Version:     1,
+					Events:      tt.events,
+					ExecutedAt:  time.Date(2025, 12, 18, 10, 0, 0, 123456000, time.UTC),
+				},
+			}
+
+			// Act
+			saveErr := store.Save(ctx, key, processed)
+			got, found, getErr := store.Get(ctx, key)
+			_, missingFound, missingErr := store.Get(ctx, "AddItemToCart:"+uuid.NewString())
+
+			rollbackErr := tx.Rollback()
+			require.NoError(t, rollbackErr)
+
+			// Assert
+			require.NoError(t, saveErr)
+			require.NoError(t, getErr)
+			require.True(t, found)
+			require.Equal(t, processed.Fingerprint, got.Fingerprint)
+			require.Equal(t, processed.Result.AggregateID, got.Result.AggregateID)
+			require.Equal(t, processed.Result.Version, got.Result.Version)
+			require.True(t, processed.Result.ExecutedAt.Equal(got.Result.ExecutedAt))
+			require.Equal(t, len(tt.events), len(got.Result.Events))
+			require.NoError(t, missingErr)
+			require.False(t, missingFound)
+		})
+	}
+}
+
+func TestProcessedCommandStore_SaveDuplicate(t *testing.T) {
+	// Arrange
+	dbClient := testutil.NewTestDBClient(t)
+	ctx, tx := testutil.BeginTxCtx(t, dbClient)
+	store := idempotency.NewProcessedCommandStore(testutil.FakeSerializer{}, testutil.FakeDeserializer{})
+	key := "AddItemToCart:" + uuid.NewString()
+	processed := bus.ProcessedCommand{Fingerprint: "fingerprint", Result: bus.Result{AggregateID: uuid.NewString()}}
+	require.NoError(t, store.Save(ctx, key, processed))
+
+	// Act
+	err := store.Save(ctx, key, processed)
+
+	rollbackErr := tx.Rollback()
+	require.NoError(t, rollbackErr)
+
+	// Assert
+	require.True(t, appErrors.IsCode(err, appErrors.OptimisticLock))
+}
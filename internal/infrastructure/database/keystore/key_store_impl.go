@@ -0,0 +1,121 @@
+package keystore
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	appErrors "github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/transaction"
+)
+
+const keySize = 32
+
+type keyStoreImpl struct {
+	tx repository.Transaction
+}
+
+func NewKeyStore(tx repository.Transaction) repository.KeyStore {
+	return &keyStoreImpl{
+		tx: tx,
+	}
+}
+
+type subjectKeyRow struct {
+	KeyID         uuid.UUID `db:"key_id"`
+	EncryptionKey []byte    `db:"encryption_key"`
+}
+
+func (s *keyStoreImpl) GetOrCreateKey(ctx context.Context, subjectID uuid.UUID) (repository.SubjectKey, bool, error) {
+	key := make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		return repository.SubjectKey{}, false, err
+	}
+
+	var stored subjectKeyRow
+	err := s.inTx(ctx, func(ctx context.Context) error {
+		tx, err := transaction.GetTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		// A concurrent writer may have created the key first, and an erased
+		// subject keeps its tombstone; keep the row that is there
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO subject_keys (subject_id, key_id, encryption_key)
+			VALUES (?, ?, ?)
+			ON DUPLICATE KEY UPDATE subject_id = subject_id
+		`, subjectID, uuid.New(), key)
+		if err != nil {
+			return appErrors.RepositoryError.Wrap(err, "failed to create subject key")
+		}
+
+		// A plain read would see the snapshot the caller's transaction
+		// started with, which may predate the row a concurrent writer kept;
+		// the locking read sees the row as it is now
+		err = tx.GetContext(ctx, &stored, "SELECT key_id, encryption_key FROM subject_keys WHERE subject_id = ? FOR SHARE", subjectID)
+		if err != nil {
+			return appErrors.QueryError.Wrap(err, "failed to get subject key")
+		}
+		return nil
+	})
+	if err != nil {
+		return repository.SubjectKey{}, false, err
+	}
+	if stored.EncryptionKey == nil {
+		return repository.SubjectKey{}, false, nil
+	}
+	return repository.SubjectKey{ID: stored.KeyID, Key: stored.EncryptionKey}, true, nil
+}
+
+func (s *keyStoreImpl) FindKey(ctx context.Context, keyID uuid.UUID) ([]byte, bool, error) {
+	var key []byte
+	err := s.inTx(ctx, func(ctx context.Context) error {
+		tx, err := transaction.GetTx(ctx)
+		if err != nil {
+			return err
+		}
+		return tx.GetContext(ctx, &key, "SELECT encryption_key FROM subject_keys WHERE key_id = ?", keyID)
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, appErrors.QueryError.Wrap(err, "failed to get subject key")
+	}
+	return key, key != nil, nil
+}
+
+func (s *keyStoreImpl) EraseKey(ctx context.Context, subjectID uuid.UUID) error {
+	return s.inTx(ctx, func(ctx context.Context) error {
+		tx, err := transaction.GetTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		// The tombstone is left even for a subject without a key, so that
+		// none is created for them later
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO subject_keys (subject_id, key_id, encryption_key, erased_at)
+			VALUES (?, ?, NULL, CURRENT_TIMESTAMP)
+			ON DUPLICATE KEY UPDATE encryption_key = NULL, erased_at = COALESCE(erased_at, CURRENT_TIMESTAMP)
+		`, subjectID, uuid.New())
+		if err != nil {
+			return appErrors.RepositoryError.Wrap(err, "failed to erase subject key")
+		}
+		return nil
+	})
+}
+
+// inTx joins the caller's transaction, so that a key created with the events
+// is seen when they are read back in it. Consumers deserialize outside of
+// any transaction.
+func (s *keyStoreImpl) inTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, err := transaction.GetTx(ctx); err == nil {
+		return fn(ctx)
+	}
+	return s.tx.RWTx(ctx, fn)
+}
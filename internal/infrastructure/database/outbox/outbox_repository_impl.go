@@ -3,7 +3,6 @@ package outbox
 import (
 	"context"
 	"database/sql"
-	"encoding/json"
 	"strings"
 	"time"
 
@@ -15,10 +14,14 @@ import (
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/transaction"
 )
 
-type outboxRepositoryImpl struct{}
+type outboxRepositoryImpl struct {
+	serializer repository.EventSerializer
+}
 
-func NewOutboxRepository() repository.OutboxRepository {
-	return &outboxRepositoryImpl{}
+func NewOutboxRepository(serializer repository.EventSerializer) repository.OutboxRepository {
+	return &outboxRepositoryImpl{
+		serializer: serializer,
+	}
 }
 
 func (o *outboxRepositoryImpl) SaveEvents(ctx context.Context, aggregateID uuid.UUID, events []event.Event) error {
@@ -41,7 +44,7 @@ func (o *outboxRepositoryImpl) SaveEvents(ctx context.Context, aggregateID uuid.
 	`
 
 	for _, evt := range events {
-		eventData, err := json.Marshal(evt)
+		eventData, err := o.serializer.Serialize(ctx, evt)
 		if err != nil {
 			return appErrors.RepositoryError.Wrap(err, "failed to marshal event data")
 		}
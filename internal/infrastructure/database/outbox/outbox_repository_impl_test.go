@@ -58,7 +58,7 @@ func TestOutboxRepository_SaveEvents(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			dbClient := testutil.NewTestDBClient(t)
 			ctx, tx := testutil.BeginTxCtx(t, dbClient)
-			repo := outbox.NewOutboxRepository()
+			repo := outbox.NewOutboxRepository(testutil.FakeSerializer{})
 
 			err := repo.SaveEvents(ctx, testAggregateID, tt.events)
 
@@ -132,7 +132,7 @@ func TestOutboxRepository_GetPendingEvents(t *testing.T) {
 			// Arrange
 			dbClient := testutil.NewTestDBClient(t)
 			ctx, tx := testutil.BeginTxCtx(t, dbClient)
-			repo := outbox.NewOutboxRepository()
+			repo := outbox.NewOutboxRepository(testutil.FakeSerializer{})
 
 			if len(tt.savedEvents) > 0 {
 				err := repo.SaveEvents(ctx, testAggregateID, tt.savedEvents)
@@ -220,7 +220,7 @@ func TestOutboxRepository_MarkAsPublished(t *testing.T) {
 			// Arrange
 			dbClient := testutil.NewTestDBClient(t)
 			ctx, tx := testutil.BeginTxCtx(t, dbClient)
-			repo := outbox.NewOutboxRepository()
+			repo := outbox.NewOutboxRepository(testutil.FakeSerializer{})
 
 			if len(tt.savedEvents) > 0 {
 				err := repo.SaveEvents(ctx, testAggregateID, tt.savedEvents)
@@ -281,7 +281,7 @@ func TestOutboxRepository_MarkAsFailed(t *testing.T) {
 			// Arrange
 			dbClient := testutil.NewTestDBClient(t)
 			ctx, tx := testutil.BeginTxCtx(t, dbClient)
-			repo := outbox.NewOutboxRepository()
+			repo := outbox.NewOutboxRepository(testutil.FakeSerializer{})
 
 			if len(tt.savedEvents) > 0 {
 				err := repo.SaveEvents(ctx, testAggregateID, tt.savedEvents)
@@ -331,7 +331,7 @@ func TestOutboxRepository_IncrementRetryCount(t *testing.T) {
 			// Arrange
 			dbClient := testutil.NewTestDBClient(t)
 			ctx, tx := testutil.BeginTxCtx(t, dbClient)
-			repo := outbox.NewOutboxRepository()
+			repo := outbox.NewOutboxRepository(testutil.FakeSerializer{})
 
 			if len(tt.savedEvents) > 0 {
 				err := repo.SaveEvents(ctx, testAggregateID, tt.savedEvents)
@@ -0,0 +1,169 @@
+package approval
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	appErrors "github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/transaction"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+const cartApprovalColumns = `cart_id, tenant_id, status, amount, requested_by, requested_at, deadline_at, decided_by, decided_at, comment, version`
+
+type CartApprovalReadModelImpl struct {
+	tx repository.Transaction
+}
+
+func NewCartApprovalReadModel(tx repository.Transaction) readmodelstore.CartApprovalStore {
+	return &CartApprovalReadModelImpl{
+		tx: tx,
+	}
+}
+
+func (c *CartApprovalReadModelImpl) Get(ctx context.Context, cartID string) (*dto.CartApprovalViewDTO, error) {
+	var approval *dto.CartApprovalViewDTO
+	err := c.tx.RWTx(ctx, func(ctx context.Context) error {
+		tx, err := transaction.GetTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		approvalQuery := `SELECT ` + cartApprovalColumns + ` FROM cart_approvals WHERE cart_id = ?`
+
+		view, err := scanCartApproval(tx.QueryRowContext(ctx, approvalQuery, cartID))
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return appErrors.NotFound.New("cart approval not found")
+			}
+			return appErrors.QueryError.Wrap(err, "failed to get cart approval")
+		}
+
+		approval = view
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return approval, nil
+}
+
+func (c *CartApprovalReadModelImpl) ListPending(ctx context.Context, tenantID string) ([]dto.CartApprovalViewDTO, error) {
+	approvals := make([]dto.CartApprovalViewDTO, 0)
+	err := c.tx.RWTx(ctx, func(ctx context.Context) error {
+		tx, err := transaction.GetTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		pendingQuery := `SELECT ` + cartApprovalColumns + `
+			FROM cart_approvals
+			WHERE tenant_id = ? AND status = 'PENDING'
+			ORDER BY deadline_at ASC
+		`
+
+		rows, err := tx.QueryContext(ctx, pendingQuery, tenantID)
+		if err != nil {
+			return appErrors.QueryError.Wrap(err, "failed to list pending cart approvals")
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			view, err := scanCartApproval(rows)
+			if err != nil {
+				return appErrors.QueryError.Wrap(err, "failed to scan cart approval")
+			}
+			approvals = append(approvals, *view)
+		}
+
+		if err := rows.Err(); err != nil {
+			return appErrors.QueryError.Wrap(err, "rows iteration error")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return approvals, nil
+}
+
+func (c *CartApprovalReadModelImpl) Upsert(ctx context.Context, view *dto.CartApprovalViewDTO) error {
+	return c.tx.RWTx(ctx, func(ctx context.Context) error {
+		tx, err := transaction.GetTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		approvalQuery := `
+			INSERT INTO cart_approvals (` + cartApprovalColumns + `)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			ON DUPLICATE KEY UPDATE
+				status = VALUES(status),
+				amount = VALUES(amount),
+				requested_by = VALUES(requested_by),
+				requested_at = VALUES(requested_at),
+				deadline_at = VALUES(deadline_at),
+				decided_by = VALUES(decided_by),
+				decided_at = VALUES(decided_at),
+				comment = VALUES(comment),
+				version = VALUES(version)
+		`
+
+		_, err = tx.ExecContext(ctx, approvalQuery,
+			view.CartID,
+			view.TenantID,
+			view.Status,
+			view.Amount,
+			view.RequestedBy,
+			view.RequestedAt,
+			view.DeadlineAt,
+			sql.NullString{String: view.DecidedBy, Valid: view.DecidedBy != ""},
+			view.DecidedAt,
+			sql.NullString{String: view.Comment, Valid: view.Comment != ""},
+			view.Version,
+		)
+		if err != nil {
+			return appErrors.RepositoryError.Wrap(err, "failed to upsert cart approval")
+		}
+
+		return nil
+	})
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanCartApproval(row rowScanner) (*dto.CartApprovalViewDTO, error) {
+	var view dto.CartApprovalViewDTO
+	var decidedBy, comment sql.NullString
+	var decidedAt sql.NullTime
+
+	err := row.Scan(
+		&view.CartID,
+		&view.TenantID,
+		&view.Status,
+		&view.Amount,
+		&view.RequestedBy,
+		&view.RequestedAt,
+		&view.DeadlineAt,
+		&decidedBy,
+		&decidedAt,
+		&comment,
+		&view.Version,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	view.DecidedBy = decidedBy.String
+	view.Comment = comment.String
+	if decidedAt.Valid {
+		view.DecidedAt = &decidedAt.Time
+	}
+
+	return &view, nil
+}
@@ -32,24 +32,57 @@ func (c *CartReadModelImpl) Get(ctx context.Context, aggregateID string) (*dto.C
 
 		// Get cart basic info
 		cartQuery := `
-			SELECT id, user_id, tenant_id, status, total_amount, item_count, created_at, updated_at, purchased_at, version
+			SELECT id, user_id, session_id, tenant_id, status, approval_status, merged_into_cart_id, subtotal, discount_total, total_amount, item_count,
+				tax_display, tax_rounding_mode, standard_taxable_amount, standard_tax_amount,
+				reduced_taxable_amount, reduced_tax_amount, tax_total,
+				recipient_name, postal_code, prefecture, city, address_line1, address_line2, phone,
+				shipping_method, shipping_fee,
+				created_at, updated_at, purchased_at, closed_at, version
 			FROM carts 
 			WHERE id = ?
 		`
 
 		var cartView dto.CartViewDTO
-		var purchasedAt sql.NullTime
+		var purchasedAt, closedAt sql.NullTime
+		var sessionID, approvalStatus, mergedIntoCartID sql.NullString
+		var taxDisplay sql.NullString
+		var taxRoundingMode sql.NullString
+		var tax dto.CartTaxViewDTO
+		var recipientName, postalCode, prefecture, city sql.NullString
+		var addressLine1, addressLine2, phone, shippingMethod sql.NullString
 
 		err = tx.QueryRowContext(ctx, cartQuery, aggregateID).Scan(
 			&cartView.ID,
 			&cartView.UserID,
+			&sessionID,
 			&cartView.TenantID,
 			&cartView.Status,
+			&approvalStatus,
+			&mergedIntoCartID,
+			&cartView.Subtotal,
+			&cartView.DiscountTotal,
 			&cartView.TotalAmount,
 			&cartView.ItemCount,
+			&taxDisplay,
+			&taxRoundingMode,
+			&tax.StandardTaxableAmount,
+			&tax.StandardTaxAmount,
+			&tax.ReducedTaxableAmount,
+			&tax.ReducedTaxAmount,
+			&tax.TaxTotal,
+			&recipientName,
+			&postalCode,
+			&prefecture,
+			&city,
+			&addressLine1,
+			&addressLine2,
+			&phone,
+			&shippingMethod,
+			&cartView.ShippingFee,
 			&cartView.CreatedAt,
 			&cartView.UpdatedAt,
 			&purchasedAt,
+			&closedAt,
 			&cartView.Version,
 		)
 		if err != nil {
@@ -62,10 +95,36 @@ func (c *CartReadModelImpl) Get(ctx context.Context, aggregateID string) (*dto.C
 		if purchasedAt.Valid {
 			cartView.PurchasedAt = &purchasedAt.Time
 		}
+		if closedAt.Valid {
+			cartView.ClosedAt = &closedAt.Time
+		}
+		cartView.SessionID = sessionID.String
+		cartView.ApprovalStatus = approvalStatus.String
+		cartView.MergedIntoCartID = mergedIntoCartID.String
+
+		// Tax is only known once the cart is submitted
+		if taxDisplay.Valid {
+			tax.Display = taxDisplay.String
+			tax.RoundingMode = taxRoundingMode.String
+			cartView.Tax = &tax
+		}
+
+		if recipientName.Valid {
+			cartView.ShippingAddress = &dto.CartShippingAddressViewDTO{
+				RecipientName: recipientName.String,
+				PostalCode:    postalCode.String,
+				Prefecture:    prefecture.String,
+				City:          city.String,
+				AddressLine1:  addressLine1.String,
+				AddressLine2:  addressLine2.String,
+				Phone:         phone.String,
+			}
+		}
+		cartView.ShippingMethod = shippingMethod.String
 
 		// Get cart items
 		itemsQuery := `
-			SELECT id, cart_id, name, price
+			SELECT id, line_id, cart_id, name, price, quantity, tax_category, weight_grams, category, added_by
 			FROM cart_items 
 			WHERE cart_id = ?
 		`
@@ -80,15 +139,23 @@ func (c *CartReadModelImpl) Get(ctx context.Context, aggregateID string) (*dto.C
 		itemCount := 0
 		for rows.Next() {
 			var item dto.CartItemViewDTO
+			var addedBy sql.NullString
 			err := rows.Scan(
 				&item.ID,
+				&item.LineID,
 				&item.CartID,
 				&item.Name,
 				&item.Price,
+				&item.Quantity,
+				&item.TaxCategory,
+				&item.WeightGrams,
+				&item.Category,
+				&addedBy,
 			)
 			if err != nil {
 				return appErrors.QueryError.Wrap(err, "failed to scan cart item")
 			}
+			item.AddedBy = addedBy.String
 			items = append(items, item)
 			itemCount++
 		}
@@ -97,7 +164,110 @@ func (c *CartReadModelImpl) Get(ctx context.Context, aggregateID string) (*dto.C
 			return appErrors.QueryError.Wrap(err, "rows iteration error")
 		}
 
+		optionsQuery := `
+			SELECT line_id, name, value, price_modifier
+			FROM cart_item_options
+			WHERE cart_id = ?
+			ORDER BY position ASC
+		`
+
+		optionRows, err := tx.QueryContext(ctx, optionsQuery, aggregateID)
+		if err != nil {
+			return appErrors.QueryError.Wrap(err, "failed to get cart item options")
+		}
+		defer optionRows.Close()
+
+		for optionRows.Next() {
+			var lineID string
+			var option dto.CartItemOptionViewDTO
+			err := optionRows.Scan(
+				&lineID,
+				&option.Name,
+				&option.Value,
+				&option.PriceModifier,
+			)
+			if err != nil {
+				return appErrors.QueryError.Wrap(err, "failed to scan cart item option")
+			}
+			for i := range items {
+				if items[i].LineID == lineID {
+					items[i].Options = append(items[i].Options, option)
+				}
+			}
+		}
+
+		if err := optionRows.Err(); err != nil {
+			return appErrors.QueryError.Wrap(err, "rows iteration error")
+		}
+
 		cartView.Items = items
+
+		// Get cart discounts
+		discountsQuery := `
+			SELECT coupon_id, code, promotion_type, value, buy_quantity, get_quantity, minimum_total, automatic, amount
+			FROM cart_discounts
+			WHERE cart_id = ?
+			ORDER BY position ASC
+		`
+
+		discountRows, err := tx.QueryContext(ctx, discountsQuery, aggregateID)
+		if err != nil {
+			return appErrors.QueryError.Wrap(err, "failed to get cart discounts")
+		}
+		defer discountRows.Close()
+
+		discounts := make([]dto.CartDiscountViewDTO, 0)
+		for discountRows.Next() {
+			var discount dto.CartDiscountViewDTO
+			err := discountRows.Scan(
+				&discount.CouponID,
+				&discount.Code,
+				&discount.PromotionType,
+				&discount.Value,
+				&discount.BuyQuantity,
+				&discount.GetQuantity,
+				&discount.MinimumTotal,
+				&discount.Automatic,
+				&discount.Amount,
+			)
+			if err != nil {
+				return appErrors.QueryError.Wrap(err, "failed to scan cart discount")
+			}
+			discounts = append(discounts, discount)
+		}
+
+		if err := discountRows.Err(); err != nil {
+			return appErrors.QueryError.Wrap(err, "rows iteration error")
+		}
+
+		cartView.Discounts = discounts
+
+		// Get cart members
+		membersQuery := `
+			SELECT user_id, status
+			FROM cart_members
+			WHERE cart_id = ?
+			ORDER BY position ASC
+		`
+
+		memberRows, err := tx.QueryContext(ctx, membersQuery, aggregateID)
+		if err != nil {
+			return appErrors.QueryError.Wrap(err, "failed to get cart members")
+		}
+		defer memberRows.Close()
+
+		for memberRows.Next() {
+			var member dto.CartMemberViewDTO
+			if err := memberRows.Scan(&member.UserID, &member.Status); err != nil {
+				return appErrors.QueryError.Wrap(err, "failed to scan cart member")
+			}
+			cartView.Members = append(cartView.Members, member)
+		}
+
+		if err := memberRows.Err(); err != nil {
+			return appErrors.QueryError.Wrap(err, "rows iteration error")
+		}
+
 		cart = &cartView
 		return nil
 	})
@@ -107,6 +277,113 @@ func (c *CartReadModelImpl) Get(ctx context.Context, aggregateID string) (*dto.C
 	return cart, nil
 }
 
+func (c *CartReadModelImpl) CountSubmitted(ctx context.Context, tenantID, userID string) (int, error) {
+	var count int
+	err := c.tx.RWTx(ctx, func(ctx context.Context) error {
+		tx, err := transaction.GetTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		query := `
+			SELECT COUNT(*)
+			FROM carts
+			WHERE tenant_id = ? AND user_id = ? AND status = 'SUBMITTED'
+		`
+		if err := tx.QueryRowContext(ctx, query, tenantID, userID).Scan(&count); err != nil {
+			return appErrors.QueryError.Wrap(err, "failed to count submitted carts")
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (c *CartReadModelImpl) ListIDsBySubject(ctx context.Context, subjectID string) ([]string, error) {
+	ids := make([]string, 0)
+	err := c.tx.RWTx(ctx, func(ctx context.Context) error {
+		tx, err := transaction.GetTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		query := `
+			SELECT id
+			FROM carts
+			WHERE user_id = ? OR id = ?
+				OR id IN (SELECT cart_id FROM cart_members WHERE user_id = ?)
+			ORDER BY created_at ASC
+		`
+		if err := tx.SelectContext(ctx, &ids, query, subjectID, subjectID, subjectID); err != nil {
+			return appErrors.QueryError.Wrap(err, "failed to list carts of subject")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (c *CartReadModelImpl) AnonymiseSubject(ctx context.Context, subjectID string) (int, error) {
+	var count int
+	err := c.tx.RWTx(ctx, func(ctx context.Context) error {
+		tx, err := transaction.GetTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		// Anonymised carts look like guest carts without a session
+		cartsQuery := `
+			UPDATE carts
+			SET user_id = '', session_id = NULL,
+				recipient_name = NULL, postal_code = NULL, city = NULL,
+				address_line1 = NULL, address_line2 = NULL, phone = NULL
+			WHERE user_id = ? OR id = ?
+		`
+		result, err := tx.ExecContext(ctx, cartsQuery, subjectID, subjectID)
+		if err != nil {
+			return appErrors.QueryError.Wrap(err, "failed to anonymise carts")
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return appErrors.QueryError.Wrap(err, "failed to anonymise carts")
+		}
+		count = int(affected)
+
+		itemsQuery := `
+			UPDATE cart_items
+			SET added_by = NULL
+			WHERE added_by = ?
+		`
+		if _, err := tx.ExecContext(ctx, itemsQuery, subjectID); err != nil {
+			return appErrors.QueryError.Wrap(err, "failed to anonymise cart items")
+		}
+
+		membersQuery := `DELETE FROM cart_members WHERE user_id = ?`
+		if _, err := tx.ExecContext(ctx, membersQuery, subjectID); err != nil {
+			return appErrors.QueryError.Wrap(err, "failed to anonymise cart members")
+		}
+
+		approvalsQuery := `
+			UPDATE cart_approvals
+			SET requested_by = CASE WHEN requested_by = ? THEN '' ELSE requested_by END,
+				decided_by = CASE WHEN decided_by = ? THEN NULL ELSE decided_by END
+			WHERE requested_by = ? OR decided_by = ?
+		`
+		if _, err := tx.ExecContext(ctx, approvalsQuery, subjectID, subjectID, subjectID, subjectID); err != nil {
+			return appErrors.QueryError.Wrap(err, "failed to anonymise cart approvals")
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
 func (c *CartReadModelImpl) Upsert(ctx context.Context, aggregateID string, view *dto.CartViewDTO) error {
 	return c.tx.RWTx(ctx, func(ctx context.Context) error {
 		tx, err := transaction.GetTx(ctx)
@@ -116,29 +393,99 @@ func (c *CartReadModelImpl) Upsert(ctx context.Context, aggregateID string, view
 
 		// Upsert cart
 		cartQuery := `
-			INSERT INTO carts (id, user_id, tenant_id, status, total_amount, item_count, created_at, updated_at, purchased_at, version)
-			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			INSERT INTO carts (id, user_id, session_id, tenant_id, status, approval_status, merged_into_cart_id, subtotal, discount_total, total_amount, item_count,
+				tax_display, tax_rounding_mode, standard_taxable_amount, standard_tax_amount,
+				reduced_taxable_amount, reduced_tax_amount, tax_total,
+				recipient_name, postal_code, prefecture, city, address_line1, address_line2, phone,
+				shipping_method, shipping_fee,
+				created_at, updated_at, purchased_at, closed_at, version)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 			ON DUPLICATE KEY UPDATE
 				user_id = VALUES(user_id),
+				session_id = VALUES(session_id),
 				tenant_id = VALUES(tenant_id),
 				status = VALUES(status),
+				approval_status = VALUES(approval_status),
+				merged_into_cart_id = VALUES(merged_into_cart_id),
+				subtotal = VALUES(subtotal),
+				discount_total = VALUES(discount_total),
 				total_amount = VALUES(total_amount),
+				tax_display = VALUES(tax_display),
+				tax_rounding_mode = VALUES(tax_rounding_mode),
+				standard_taxable_amount = VALUES(standard_taxable_amount),
+				standard_tax_amount = VALUES(standard_tax_amount),
+				reduced_taxable_amount = VALUES(reduced_taxable_amount),
+				reduced_tax_amount = VALUES(reduced_tax_amount),
+				tax_total = VALUES(tax_total),
+				recipient_name = VALUES(recipient_name),
+				postal_code = VALUES(postal_code),
+				prefecture = VALUES(prefecture),
+				city = VALUES(city),
+				address_line1 = VALUES(address_line1),
+				address_line2 = VALUES(address_line2),
+				phone = VALUES(phone),
+				shipping_method = VALUES(shipping_method),
+				shipping_fee = VALUES(shipping_fee),
 				item_count = VALUES(item_count),
 				updated_at = VALUES(updated_at),
 				purchased_at = VALUES(purchased_at),
+				closed_at = VALUES(closed_at),
 				version = VALUES(version)
 		`
 
+		var taxDisplay, taxRoundingMode sql.NullString
+		tax := dto.CartTaxViewDTO{}
+		if view.Tax != nil {
+			tax = *view.Tax
+			taxDisplay = sql.NullString{String: tax.Display, Valid: true}
+			taxRoundingMode = sql.NullString{String: tax.RoundingMode, Valid: true}
+		}
+
+		var recipientName, postalCode, prefecture, city sql.NullString
+		var addressLine1, addressLine2, phone sql.NullString
+		if address := view.ShippingAddress; address != nil {
+			recipientName = sql.NullString{String: address.RecipientName, Valid: true}
+			postalCode = sql.NullString{String: address.PostalCode, Valid: true}
+			prefecture = sql.NullString{String: address.Prefecture, Valid: true}
+			city = sql.NullString{String: address.City, Valid: true}
+			addressLine1 = sql.NullString{String: address.AddressLine1, Valid: true}
+			addressLine2 = sql.NullString{String: address.AddressLine2, Valid: true}
+			phone = sql.NullString{String: address.Phone, Valid: true}
+		}
+		shippingMethod := sql.NullString{String: view.ShippingMethod, Valid: view.ShippingMethod != ""}
+
 		_, err = tx.ExecContext(ctx, cartQuery,
 			view.ID,
 			view.UserID,
+			sql.NullString{String: view.SessionID, Valid: view.SessionID != ""},
 			view.TenantID,
 			view.Status,
+			sql.NullString{String: view.ApprovalStatus, Valid: view.ApprovalStatus != ""},
+			sql.NullString{String: view.MergedIntoCartID, Valid: view.MergedIntoCartID != ""},
+			view.Subtotal,
+			view.DiscountTotal,
 			view.TotalAmount,
 			view.ItemCount,
+			taxDisplay,
+			taxRoundingMode,
+			tax.StandardTaxableAmount,
+			tax.StandardTaxAmount,
+			tax.ReducedTaxableAmount,
+			tax.ReducedTaxAmount,
+			tax.TaxTotal,
+			recipientName,
+			postalCode,
+			prefecture,
+			city,
+			addressLine1,
+			addressLine2,
+			phone,
+			shippingMethod,
+			view.ShippingFee,
 			view.CreatedAt,
 			view.UpdatedAt,
 			view.PurchasedAt,
+			view.ClosedAt,
 			view.Version,
 		)
 		if err != nil {
@@ -152,21 +499,115 @@ func (c *CartReadModelImpl) Upsert(ctx context.Context, aggregateID string, view
 		}
 
 		if len(view.Items) > 0 {
-			values := make([]interface{}, 0, len(view.Items)*4)
+			values := make([]interface{}, 0, len(view.Items)*10)
 			placeholders := make([]string, 0, len(view.Items))
+			optionValues := make([]interface{}, 0)
+			optionPlaceholders := make([]string, 0)
 
 			for _, item := range view.Items {
-				placeholders = append(placeholders, "(?, ?, ?, ?)")
-				values = append(values, item.ID, item.CartID, item.Name, item.Price)
+				taxCategory := item.TaxCategory
+				if taxCategory == "" {
+					taxCategory = "STANDARD"
+				}
+				// Lines without options are keyed by their item
+				lineID := item.LineID
+				if lineID == "" {
+					lineID = item.ID
+				}
+				quantity := item.Quantity
+				if quantity == 0 {
+					quantity = 1
+				}
+				var addedBy sql.NullString
+				if item.AddedBy != "" {
+					addedBy = sql.NullString{String: item.AddedBy, Valid: true}
+				}
+				placeholders = append(placeholders, "(?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
+				values = append(values, item.ID, lineID, item.CartID, item.Name, item.Price, quantity, taxCategory, item.WeightGrams, item.Category, addedBy)
+
+				for i, option := range item.Options {
+					optionPlaceholders = append(optionPlaceholders, "(?, ?, ?, ?, ?, ?)")
+					optionValues = append(optionValues, aggregateID, lineID, option.Name, option.Value, option.PriceModifier, i)
+				}
 			}
 
-			itemQuery := "INSERT INTO cart_items (id, cart_id, name, price) VALUES " +
+			itemQuery := "INSERT INTO cart_items (id, line_id, cart_id, name, price, quantity, tax_category, weight_grams, category, added_by) VALUES " +
 				strings.Join(placeholders, ", ")
 
 			_, err = tx.ExecContext(ctx, itemQuery, values...)
 			if err != nil {
 				return appErrors.RepositoryError.Wrap(err, "failed to bulk insert cart items")
 			}
+
+			if len(optionPlaceholders) > 0 {
+				optionQuery := "INSERT INTO cart_item_options (cart_id, line_id, name, value, price_modifier, position) VALUES " +
+					strings.Join(optionPlaceholders, ", ")
+
+				_, err = tx.ExecContext(ctx, optionQuery, optionValues...)
+				if err != nil {
+					return appErrors.RepositoryError.Wrap(err, "failed to bulk insert cart item options")
+				}
+			}
+		}
+
+		deleteDiscountsQuery := `DELETE FROM cart_discounts WHERE cart_id = ?`
+		_, err = tx.ExecContext(ctx, deleteDiscountsQuery, aggregateID)
+		if err != nil {
+			return appErrors.RepositoryError.Wrap(err, "failed to delete existing cart discounts")
+		}
+
+		if len(view.Discounts) > 0 {
+			values := make([]interface{}, 0, len(view.Discounts)*11)
+			placeholders := make([]string, 0, len(view.Discounts))
+
+			for i, discount := range view.Discounts {
+				placeholders = append(placeholders, "(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)")
+				values = append(values,
+					aggregateID,
+					discount.CouponID,
+					discount.Code,
+					discount.PromotionType,
+					discount.Value,
+					discount.BuyQuantity,
+					discount.GetQuantity,
+					discount.MinimumTotal,
+					discount.Automatic,
+					discount.Amount,
+					i,
+				)
+			}
+
+			discountQuery := "INSERT INTO cart_discounts (cart_id, coupon_id, code, promotion_type, value, buy_quantity, get_quantity, minimum_total, automatic, amount, position) VALUES " +
+				strings.Join(placeholders, ", ")
+
+			_, err = tx.ExecContext(ctx, discountQuery, values...)
+			if err != nil {
+				return appErrors.RepositoryError.Wrap(err, "failed to bulk insert cart discounts")
+			}
+		}
+
+		deleteMembersQuery := `DELETE FROM cart_members WHERE cart_id = ?`
+		_, err = tx.ExecContext(ctx, deleteMembersQuery, aggregateID)
+		if err != nil {
+			return appErrors.RepositoryError.Wrap(err, "failed to delete existing cart members")
+		}
+
+		if len(view.Members) > 0 {
+			values := make([]interface{}, 0, len(view.Members)*4)
+			placeholders := make([]string, 0, len(view.Members))
+
+			for i, member := range view.Members {
+				placeholders = append(placeholders, "(?, ?, ?, ?)")
+				values = append(values, aggregateID, member.UserID, member.Status, i)
+			}
+
+			memberQuery := "INSERT INTO cart_members (cart_id, user_id, status, position) VALUES " +
+				strings.Join(placeholders, ", ")
+
+			_, err = tx.ExecContext(ctx, memberQuery, values...)
+			if err != nil {
+				return appErrors.RepositoryError.Wrap(err, "failed to bulk insert cart members")
+			}
 		}
 
 		return nil
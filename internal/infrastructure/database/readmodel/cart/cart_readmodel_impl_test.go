@@ -87,6 +87,8 @@ func TestCartReadModel_Get(t *testing.T) {
 
 func TestCartReadModel_Upsert(t *testing.T) {
 	testCartID := "12345678-1234-1234-1234-123456789012"
+	shirtID := uuid.New().String()
+	closedAt := time.Now()
 
 	tests := map[string]struct {
 		cartData  *dto.CartViewDTO
@@ -115,6 +117,206 @@ func TestCartReadModel_Upsert(t *testing.T) {
 			},
 			wantError: false,
 		},
+		"successful upsert of submitted cart with tax": {
+			cartData: &dto.CartViewDTO{
+				ID:          testCartID,
+				UserID:      "user123",
+				TenantID:    "tenant123",
+				Status:      "SUBMITTED",
+				Subtotal:    1500.0,
+				TotalAmount: 1640.0,
+				ItemCount:   2,
+				CreatedAt:   time.Now(),
+				UpdatedAt:   time.Now(),
+				Version:     4,
+				Items: []dto.CartItemViewDTO{
+					{
+						ID:          uuid.New().String(),
+						CartID:      testCartID,
+						Name:        "Standard Item",
+						Price:       1000.0,
+						TaxCategory: "STANDARD",
+					},
+					{
+						ID:          uuid.New().String(),
+						CartID:      testCartID,
+						Name:        "Reduced Item",
+						Price:       500.0,
+						TaxCategory: "REDUCED",
+					},
+				},
+				Tax: &dto.CartTaxViewDTO{
+					Display:               "EXCLUSIVE",
+					RoundingMode:          "FLOOR",
+					StandardTaxableAmount: 1000.0,
+					StandardTaxAmount:     100.0,
+					ReducedTaxableAmount:  500.0,
+					ReducedTaxAmount:      40.0,
+					TaxTotal:              140.0,
+				},
+			},
+			wantError: false,
+		},
+		"successful upsert of cart with shipping": {
+			cartData: &dto.CartViewDTO{
+				ID:          testCartID,
+				UserID:      "user123",
+				TenantID:    "tenant123",
+				Status:      "OPEN",
+				Subtotal:    1000.0,
+				TotalAmount: 1800.0,
+				ItemCount:   1,
+				CreatedAt:   time.Now(),
+				UpdatedAt:   time.Now(),
+				Version:     4,
+				Items: []dto.CartItemViewDTO{
+					{
+						ID:          uuid.New().String(),
+						CartID:      testCartID,
+						Name:        "Heavy Item",
+						Price:       1000.0,
+						TaxCategory: "STANDARD",
+						WeightGrams: 1500,
+					},
+				},
+				ShippingAddress: &dto.CartShippingAddressViewDTO{
+					RecipientName: "Taro Yamada",
+					PostalCode:    "100-0001",
+					Prefecture:    "Tokyo",
+					City:          "Chiyoda-ku",
+					AddressLine1:  "1-1 Chiyoda",
+					Phone:         "03-1234-5678",
+				},
+				ShippingMethod: "STANDARD",
+				ShippingFee:    800.0,
+			},
+			wantError: false,
+		},
+		"successful upsert of closed cart": {
+			cartData: &dto.CartViewDTO{
+				ID:          testCartID,
+				UserID:      "user123",
+				TenantID:    "tenant123",
+				Status:      "CLOSED",
+				Subtotal:    100.0,
+				TotalAmount: 100.0,
+				ItemCount:   1,
+				CreatedAt:   time.Now(),
+				UpdatedAt:   time.Now(),
+				ClosedAt:    &closedAt,
+				Version:     3,
+				Items: []dto.CartItemViewDTO{
+					{
+						ID:          uuid.New().String(),
+						CartID:      testCartID,
+						Name:        "Forgotten Item",
+						Price:       100.0,
+						TaxCategory: "STANDARD",
+					},
+				},
+			},
+			wantError: false,
+		},
+		"successful upsert of shared cart": {
+			cartData: &dto.CartViewDTO{
+				ID:          testCartID,
+				UserID:      uuid.New().String(),
+				TenantID:    "tenant123",
+				Status:      "OPEN",
+				Subtotal:    300.0,
+				TotalAmount: 300.0,
+				ItemCount:   2,
+				CreatedAt:   time.Now(),
+				UpdatedAt:   time.Now(),
+				Version:     6,
+				Items: []dto.CartItemViewDTO{
+					{
+						ID:          uuid.New().String(),
+						CartID:      testCartID,
+						Name:        "Owner Item",
+						Price:       100.0,
+						TaxCategory: "STANDARD",
+						AddedBy:     uuid.New().String(),
+					},
+					{
+						ID:          uuid.New().String(),
+						CartID:      testCartID,
+						Name:        "Member Item",
+						Price:       200.0,
+						TaxCategory: "STANDARD",
+						AddedBy:     uuid.New().String(),
+					},
+				},
+			},
+			wantError: false,
+		},
+		"successful upsert of item in two variants": {
+			cartData: &dto.CartViewDTO{
+				ID:          testCartID,
+				UserID:      uuid.New().String(),
+				TenantID:    "tenant123",
+				Status:      "OPEN",
+				Subtotal:    7500.0,
+				TotalAmount: 7500.0,
+				ItemCount:   3,
+				CreatedAt:   time.Now(),
+				UpdatedAt:   time.Now(),
+				Version:     4,
+				Items: []dto.CartItemViewDTO{
+					{
+						ID:          shirtID,
+						LineID:      uuid.NewSHA1(uuid.MustParse(shirtID), []byte("size=M")).String(),
+						CartID:      testCartID,
+						Name:        "T-Shirt",
+						Price:       2500.0,
+						Quantity:    2,
+						TaxCategory: "STANDARD",
+						Options: []dto.CartItemOptionViewDTO{
+							{Name: "size", Value: "M"},
+						},
+					},
+					{
+						ID:          shirtID,
+						LineID:      uuid.NewSHA1(uuid.MustParse(shirtID), []byte("gift_wrap=yes&size=L")).String(),
+						CartID:      testCartID,
+						Name:        "T-Shirt",
+						Price:       2500.0,
+						Quantity:    1,
+						TaxCategory: "STANDARD",
+						Options: []dto.CartItemOptionViewDTO{
+							{Name: "gift_wrap", Value: "yes", PriceModifier: 300.0},
+							{Name: "size", Value: "L"},
+						},
+					},
+				},
+			},
+			wantError: false,
+		},
+		"successful upsert of cart pending approval": {
+			cartData: &dto.CartViewDTO{
+				ID:             testCartID,
+				UserID:         uuid.New().String(),
+				TenantID:       "tenant123",
+				Status:         "OPEN",
+				ApprovalStatus: "PENDING",
+				Subtotal:       150000.0,
+				TotalAmount:    150000.0,
+				ItemCount:      1,
+				CreatedAt:      time.Now(),
+				UpdatedAt:      time.Now(),
+				Version:        3,
+				Items: []dto.CartItemViewDTO{
+					{
+						ID:          uuid.New().String(),
+						CartID:      testCartID,
+						Name:        "Office Chairs",
+						Price:       150000.0,
+						TaxCategory: "STANDARD",
+					},
+				},
+			},
+			wantError: false,
+		},
 	}
 
 	for name, tt := range tests {
@@ -149,3 +351,130 @@ func TestCartReadModel_Upsert(t *testing.T) {
 		})
 	}
 }
+
+func TestCartReadModel_CountSubmitted(t *testing.T) {
+	tests := map[string]struct {
+		tenantID  string
+		userID    string
+		wantCount int
+	}{
+		"user without submitted carts": {
+			tenantID:  "99999999-9999-9999-9999-999999999999",
+			userID:    "99999999-9999-9999-9999-999999999999",
+			wantCount: 0,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			dbClient := newTestDBClient(t)
+			ctx, tx := beginTxCtx(t, dbClient)
+			store := cart.NewCartReadModel(transaction.NewTransaction(dbClient.GetDB()))
+
+			count, err := store.CountSubmitted(ctx, tt.tenantID, tt.userID)
+
+			rollbackErr := tx.Rollback()
+			require.NoError(t, rollbackErr)
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantCount, count)
+		})
+	}
+}
+
+func TestCartReadModel_AnonymiseSubject(t *testing.T) {
+	tests := map[string]struct {
+		ownedBySubject  bool
+		sharedToSubject bool
+		wantListed      int
+		wantCount       int
+	}{
+		"cart of the subject is anonymised": {
+			ownedBySubject: true,
+			wantListed:     1,
+			wantCount:      1,
+		},
+		"cart shared with the subject loses them as a member": {
+			sharedToSubject: true,
+			wantListed:      1,
+			wantCount:       0,
+		},
+		"cart of someone else is left alone": {
+			ownedBySubject: false,
+			wantListed:     0,
+			wantCount:      0,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			dbClient := newTestDBClient(t)
+			store := cart.NewCartReadModel(transaction.NewTransaction(dbClient.GetDB()))
+			ctx := context.Background()
+
+			subjectID := uuid.New().String()
+			ownerID := uuid.New().String()
+			if tt.ownedBySubject {
+				ownerID = subjectID
+			}
+			cartID := uuid.New().String()
+			view := &dto.CartViewDTO{
+				ID:        cartID,
+				UserID:    ownerID,
+				TenantID:  uuid.New().String(),
+				Status:    "OPEN",
+				CreatedAt: time.Now(),
+				UpdatedAt: time.Now(),
+				Version:   1,
+				ShippingAddress: &dto.CartShippingAddressViewDTO{
+					RecipientName: "Taro Yamada",
+					PostalCode:    "100-0001",
+					Prefecture:    "Tokyo",
+					City:          "Chiyoda-ku",
+					AddressLine1:  "1-1 Chiyoda",
+					Phone:         "03-1234-5678",
+				},
+				Items: []dto.CartItemViewDTO{
+					{ID: uuid.New().String(), LineID: uuid.New().String(), CartID: cartID, Name: "Shirt", Price: 10.0, Quantity: 1, TaxCategory: "STANDARD", AddedBy: subjectID},
+				},
+			}
+			if tt.sharedToSubject {
+				view.Members = []dto.CartMemberViewDTO{{UserID: subjectID, Status: "MEMBER"}}
+			}
+			require.NoError(t, store.Upsert(ctx, cartID, view))
+			t.Cleanup(func() {
+				_, _ = dbClient.GetDB().Exec("DELETE FROM cart_members WHERE cart_id = ?", cartID)
+				_, _ = dbClient.GetDB().Exec("DELETE FROM cart_items WHERE cart_id = ?", cartID)
+				_, _ = dbClient.GetDB().Exec("DELETE FROM carts WHERE id = ?", cartID)
+			})
+
+			ids, err := store.ListIDsBySubject(ctx, subjectID)
+			require.NoError(t, err)
+			require.Len(t, ids, tt.wantListed)
+
+			// Act
+			count, err := store.AnonymiseSubject(ctx, subjectID)
+
+			// Assert
+			require.NoError(t, err)
+			require.Equal(t, tt.wantCount, count)
+
+			got, err := store.Get(ctx, cartID)
+			require.NoError(t, err)
+			require.Empty(t, got.Items[0].AddedBy)
+			require.Empty(t, got.Members)
+			if tt.ownedBySubject {
+				require.Empty(t, got.UserID)
+				require.Nil(t, got.ShippingAddress)
+			} else {
+				require.Equal(t, ownerID, got.UserID)
+				require.NotNil(t, got.ShippingAddress)
+			}
+
+			ids, err = store.ListIDsBySubject(ctx, subjectID)
+			require.NoError(t, err)
+			require.Empty(t, ids)
+		})
+	}
+}
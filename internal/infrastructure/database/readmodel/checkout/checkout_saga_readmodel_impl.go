@@ -0,0 +1,176 @@
+package checkout
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	appErrors "github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/transaction"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+type CheckoutSagaReadModelImpl struct {
+	tx repository.Transaction
+}
+
+func NewCheckoutSagaReadModel(tx repository.Transaction) readmodelstore.CheckoutSagaStore {
+	return &CheckoutSagaReadModelImpl{
+		tx: tx,
+	}
+}
+
+func (c *CheckoutSagaReadModelImpl) Get(ctx context.Context, sagaID string) (*dto.CheckoutSagaViewDTO, error) {
+	var saga *dto.CheckoutSagaViewDTO
+	err := c.tx.RWTx(ctx, func(ctx context.Context) error {
+		tx, err := transaction.GetTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		sagaQuery := `
+			SELECT id, cart_id, status, current_step, total_amount, failure_reason, created_at, updated_at, version
+			FROM checkout_sagas
+			WHERE id = ?
+		`
+
+		var sagaView dto.CheckoutSagaViewDTO
+		var currentStep sql.NullString
+		var failureReason sql.NullString
+
+		err = tx.QueryRowContext(ctx, sagaQuery, sagaID).Scan(
+			&sagaView.ID,
+			&sagaView.CartID,
+			&sagaView.Status,
+			&currentStep,
+			&sagaView.TotalAmount,
+			&failureReason,
+			&sagaView.CreatedAt,
+			&sagaView.UpdatedAt,
+			&sagaView.Version,
+		)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return appErrors.NotFound.New("checkout saga not found")
+			}
+			return appErrors.QueryError.Wrap(err, "failed to get checkout saga")
+		}
+
+		sagaView.CurrentStep = currentStep.String
+		sagaView.FailureReason = failureReason.String
+
+		stepsQuery := `
+			SELECT saga_id, step, status, reference, reason, updated_at
+			FROM checkout_saga_steps
+			WHERE saga_id = ?
+			ORDER BY updated_at ASC
+		`
+
+		rows, err := tx.QueryContext(ctx, stepsQuery, sagaID)
+		if err != nil {
+			return appErrors.QueryError.Wrap(err, "failed to get checkout saga steps")
+		}
+		defer rows.Close()
+
+		steps := make([]dto.CheckoutSagaStepViewDTO, 0)
+		for rows.Next() {
+			var step dto.CheckoutSagaStepViewDTO
+			var reference sql.NullString
+			var reason sql.NullString
+			err := rows.Scan(
+				&step.SagaID,
+				&step.Step,
+				&step.Status,
+				&reference,
+				&reason,
+				&step.UpdatedAt,
+			)
+			if err != nil {
+				return appErrors.QueryError.Wrap(err, "failed to scan checkout saga step")
+			}
+			step.Reference = reference.String
+			step.Reason = reason.String
+			steps = append(steps, step)
+		}
+
+		if err := rows.Err(); err != nil {
+			return appErrors.QueryError.Wrap(err, "rows iteration error")
+		}
+
+		sagaView.Steps = steps
+		saga = &sagaView
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return saga, nil
+}
+
+func (c *CheckoutSagaReadModelImpl) Upsert(ctx context.Context, sagaID string, view *dto.CheckoutSagaViewDTO) error {
+	return c.tx.RWTx(ctx, func(ctx context.Context) error {
+		tx, err := transaction.GetTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		sagaQuery := `
+			INSERT INTO checkout_sagas (id, cart_id, status, current_step, total_amount, failure_reason, created_at, updated_at, version)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+			ON DUPLICATE KEY UPDATE
+				status = VALUES(status),
+				current_step = VALUES(current_step),
+				total_amount = VALUES(total_amount),
+				failure_reason = VALUES(failure_reason),
+				updated_at = VALUES(updated_at),
+				version = VALUES(version)
+		`
+
+		_, err = tx.ExecContext(ctx, sagaQuery,
+			view.ID,
+			view.CartID,
+			view.Status,
+			nullableString(view.CurrentStep),
+			view.TotalAmount,
+			nullableString(view.FailureReason),
+			view.CreatedAt,
+			view.UpdatedAt,
+			view.Version,
+		)
+		if err != nil {
+			return appErrors.RepositoryError.Wrap(err, "failed to upsert checkout saga")
+		}
+
+		deleteStepsQuery := `DELETE FROM checkout_saga_steps WHERE saga_id = ?`
+		_, err = tx.ExecContext(ctx, deleteStepsQuery, sagaID)
+		if err != nil {
+			return appErrors.RepositoryError.Wrap(err, "failed to delete existing checkout saga steps")
+		}
+
+		if len(view.Steps) > 0 {
+			values := make([]any, 0, len(view.Steps)*6)
+			placeholders := make([]string, 0, len(view.Steps))
+
+			for _, step := range view.Steps {
+				placeholders = append(placeholders, "(?, ?, ?, ?, ?, ?)")
+				values = append(values, sagaID, step.Step, step.Status, nullableString(step.Reference), nullableString(step.Reason), step.UpdatedAt)
+			}
+
+			stepQuery := "INSERT INTO checkout_saga_steps (saga_id, step, status, reference, reason, updated_at) VALUES " +
+				strings.Join(placeholders, ", ")
+
+			_, err = tx.ExecContext(ctx, stepQuery, values...)
+			if err != nil {
+				return appErrors.RepositoryError.Wrap(err, "failed to bulk insert checkout saga steps")
+			}
+		}
+
+		return nil
+	})
+}
+
+func nullableString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
@@ -0,0 +1,155 @@
+package coupon
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	appErrors "github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/transaction"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+const couponColumns = `id, tenant_id, code, promotion_type, value, buy_quantity, get_quantity, minimum_total,
+	usage_limit, redemption_count, automatic, created_at, updated_at, version`
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+type CouponReadModelImpl struct {
+	tx repository.Transaction
+}
+
+func NewCouponReadModel(tx repository.Transaction) readmodelstore.CouponStore {
+	return &CouponReadModelImpl{
+		tx: tx,
+	}
+}
+
+func (c *CouponReadModelImpl) Get(ctx context.Context, couponID string) (*dto.CouponViewDTO, error) {
+	var coupon *dto.CouponViewDTO
+	err := c.tx.RWTx(ctx, func(ctx context.Context) error {
+		tx, err := transaction.GetTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		couponQuery := `SELECT ` + couponColumns + ` FROM coupons WHERE id = ?`
+
+		coupon, err = scanCoupon(tx.QueryRowContext(ctx, couponQuery, couponID))
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return appErrors.NotFound.New("coupon not found")
+			}
+			return appErrors.QueryError.Wrap(err, "failed to get coupon")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return coupon, nil
+}
+
+func (c *CouponReadModelImpl) ListAutomatic(ctx context.Context, tenantID string) ([]*dto.CouponViewDTO, error) {
+	coupons := make([]*dto.CouponViewDTO, 0)
+	err := c.tx.RWTx(ctx, func(ctx context.Context) error {
+		tx, err := transaction.GetTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		couponsQuery := `SELECT ` + couponColumns + ` FROM coupons WHERE tenant_id = ? AND automatic = TRUE ORDER BY created_at ASC`
+
+		rows, err := tx.QueryContext(ctx, couponsQuery, tenantID)
+		if err != nil {
+			return appErrors.QueryError.Wrap(err, "failed to list automatic promotions")
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			coupon, err := scanCoupon(rows)
+			if err != nil {
+				return appErrors.QueryError.Wrap(err, "failed to scan coupon")
+			}
+			coupons = append(coupons, coupon)
+		}
+
+		if err := rows.Err(); err != nil {
+			return appErrors.QueryError.Wrap(err, "rows iteration error")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return coupons, nil
+}
+
+func (c *CouponReadModelImpl) Upsert(ctx context.Context, couponID string, view *dto.CouponViewDTO) error {
+	return c.tx.RWTx(ctx, func(ctx context.Context) error {
+		tx, err := transaction.GetTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		couponQuery := `
+			INSERT INTO coupons (` + couponColumns + `)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			ON DUPLICATE KEY UPDATE
+				redemption_count = VALUES(redemption_count),
+				updated_at = VALUES(updated_at),
+				version = VALUES(version)
+		`
+
+		_, err = tx.ExecContext(ctx, couponQuery,
+			couponID,
+			view.TenantID,
+			view.Code,
+			view.PromotionType,
+			view.Value,
+			view.BuyQuantity,
+			view.GetQuantity,
+			view.MinimumTotal,
+			view.UsageLimit,
+			view.RedemptionCount,
+			view.Automatic,
+			view.CreatedAt,
+			view.UpdatedAt,
+			view.Version,
+		)
+		if err != nil {
+			return appErrors.RepositoryError.Wrap(err, "failed to upsert coupon")
+		}
+
+		return nil
+	})
+}
+
+func scanCoupon(row rowScanner) (*dto.CouponViewDTO, error) {
+	var coupon dto.CouponViewDTO
+	err := row.Scan(
+		&coupon.ID,
+		&coupon.TenantID,
+		&coupon.Code,
+		&coupon.PromotionType,
+		&coupon.Value,
+		&coupon.BuyQuantity,
+		&coupon.GetQuantity,
+		&coupon.MinimumTotal,
+		&coupon.UsageLimit,
+		&coupon.RedemptionCount,
+		&coupon.Automatic,
+		&coupon.CreatedAt,
+		&coupon.UpdatedAt,
+		&coupon.Version,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &coupon, nil
+}
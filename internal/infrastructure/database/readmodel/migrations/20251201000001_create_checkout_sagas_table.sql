@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE checkout_sagas (
+    id VARCHAR(36) PRIMARY KEY,
+    cart_id VARCHAR(36) NOT NULL,
+    status ENUM('STARTED', 'COMPENSATING', 'COMPLETED', 'ABORTED') NOT NULL DEFAULT 'STARTED',
+    current_step VARCHAR(50) NULL,
+    total_amount DECIMAL(10,2) NOT NULL DEFAULT 0.0,
+    failure_reason TEXT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    version INT NOT NULL DEFAULT 1,
+    UNIQUE INDEX idx_checkout_sagas_cart_id (cart_id),
+    INDEX idx_checkout_sagas_status (status)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS checkout_sagas;
+-- +goose StatementEnd
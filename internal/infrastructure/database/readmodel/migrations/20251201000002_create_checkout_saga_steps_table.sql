@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE checkout_saga_steps (
+    saga_id VARCHAR(36) NOT NULL,
+    step VARCHAR(50) NOT NULL,
+    status ENUM('PENDING', 'COMPLETED', 'FAILED', 'COMPENSATING', 'COMPENSATED') NOT NULL,
+    reference VARCHAR(255) NULL,
+    reason TEXT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (saga_id, step),
+    FOREIGN KEY (saga_id) REFERENCES checkout_sagas(id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS checkout_saga_steps;
+-- +goose StatementEnd
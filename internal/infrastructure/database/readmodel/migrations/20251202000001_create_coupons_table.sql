@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE coupons (
+    id VARCHAR(36) PRIMARY KEY,
+    tenant_id VARCHAR(36) NOT NULL,
+    code VARCHAR(32) NOT NULL,
+    promotion_type ENUM('PERCENT_OFF', 'FIXED_AMOUNT_OFF', 'BUY_X_GET_Y') NOT NULL,
+    value DECIMAL(10,2) NOT NULL DEFAULT 0.0,
+    buy_quantity INT NOT NULL DEFAULT 0,
+    get_quantity INT NOT NULL DEFAULT 0,
+    minimum_total DECIMAL(10,2) NOT NULL DEFAULT 0.0,
+    usage_limit INT NOT NULL DEFAULT 0,
+    redemption_count INT NOT NULL DEFAULT 0,
+    automatic BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    version INT NOT NULL DEFAULT 1,
+    UNIQUE KEY unique_tenant_code (tenant_id, code),
+    INDEX idx_tenant_automatic (tenant_id, automatic)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS coupons;
+-- +goose StatementEnd
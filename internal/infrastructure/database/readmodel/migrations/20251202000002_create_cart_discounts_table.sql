@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE carts ADD COLUMN subtotal DECIMAL(10,2) NOT NULL DEFAULT 0.0 AFTER status;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE cart_discounts (
+    cart_id VARCHAR(36) NOT NULL,
+    coupon_id VARCHAR(36) NOT NULL,
+    code VARCHAR(32) NOT NULL,
+    promotion_type ENUM('PERCENT_OFF', 'FIXED_AMOUNT_OFF', 'BUY_X_GET_Y') NOT NULL,
+    value DECIMAL(10,2) NOT NULL DEFAULT 0.0,
+    buy_quantity INT NOT NULL DEFAULT 0,
+    get_quantity INT NOT NULL DEFAULT 0,
+    minimum_total DECIMAL(10,2) NOT NULL DEFAULT 0.0,
+    automatic BOOLEAN NOT NULL DEFAULT FALSE,
+    amount DECIMAL(10,2) NOT NULL DEFAULT 0.0,
+    position INT NOT NULL,
+    PRIMARY KEY (cart_id, coupon_id),
+    FOREIGN KEY (cart_id) REFERENCES carts(id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS cart_discounts;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE carts DROP COLUMN subtotal;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE tenant_tax_settings (
+    tenant_id VARCHAR(36) PRIMARY KEY,
+    tax_display ENUM('INCLUSIVE', 'EXCLUSIVE') NOT NULL,
+    rounding_mode ENUM('FLOOR', 'ROUND', 'CEIL') NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    version INT NOT NULL DEFAULT 1
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS tenant_tax_settings;
+-- +goose StatementEnd
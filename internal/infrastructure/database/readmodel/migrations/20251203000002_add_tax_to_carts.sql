@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE cart_items ADD COLUMN tax_category ENUM('STANDARD', 'REDUCED') NOT NULL DEFAULT 'STANDARD' AFTER price;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE carts
+    ADD COLUMN discount_total DECIMAL(10,2) NOT NULL DEFAULT 0.0 AFTER subtotal,
+    ADD COLUMN tax_display ENUM('INCLUSIVE', 'EXCLUSIVE') NULL AFTER discount_total,
+    ADD COLUMN tax_rounding_mode ENUM('FLOOR', 'ROUND', 'CEIL') NULL AFTER tax_display,
+    ADD COLUMN standard_taxable_amount DECIMAL(10,2) NOT NULL DEFAULT 0.0 AFTER tax_rounding_mode,
+    ADD COLUMN standard_tax_amount DECIMAL(10,2) NOT NULL DEFAULT 0.0 AFTER standard_taxable_amount,
+    ADD COLUMN reduced_taxable_amount DECIMAL(10,2) NOT NULL DEFAULT 0.0 AFTER standard_tax_amount,
+    ADD COLUMN reduced_tax_amount DECIMAL(10,2) NOT NULL DEFAULT 0.0 AFTER reduced_taxable_amount,
+    ADD COLUMN tax_total DECIMAL(10,2) NOT NULL DEFAULT 0.0 AFTER reduced_tax_amount;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE carts
+    DROP COLUMN tax_total,
+    DROP COLUMN reduced_tax_amount,
+    DROP COLUMN reduced_taxable_amount,
+    DROP COLUMN standard_tax_amount,
+    DROP COLUMN standard_taxable_amount,
+    DROP COLUMN tax_rounding_mode,
+    DROP COLUMN tax_display,
+    DROP COLUMN discount_total;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE cart_items DROP COLUMN tax_category;
+-- +goose StatementEnd
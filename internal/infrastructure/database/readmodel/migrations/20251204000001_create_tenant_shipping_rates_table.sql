@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE tenant_shipping_rates (
+    tenant_id VARCHAR(36) NOT NULL,
+    method VARCHAR(32) NOT NULL,
+    prefecture VARCHAR(16) NOT NULL,
+    max_weight_grams INT NOT NULL,
+    fee DECIMAL(10,2) NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    version INT NOT NULL DEFAULT 1,
+    PRIMARY KEY (tenant_id, method, prefecture, max_weight_grams)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS tenant_shipping_rates;
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE cart_items ADD COLUMN weight_grams INT NOT NULL DEFAULT 0 AFTER tax_category;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE carts
+    ADD COLUMN recipient_name VARCHAR(255) NULL AFTER tax_total,
+    ADD COLUMN postal_code VARCHAR(8) NULL AFTER recipient_name,
+    ADD COLUMN prefecture VARCHAR(16) NULL AFTER postal_code,
+    ADD COLUMN city VARCHAR(255) NULL AFTER prefecture,
+    ADD COLUMN address_line1 VARCHAR(255) NULL AFTER city,
+    ADD COLUMN address_line2 VARCHAR(255) NULL AFTER address_line1,
+    ADD COLUMN phone VARCHAR(32) NULL AFTER address_line2,
+    ADD COLUMN shipping_method VARCHAR(32) NULL AFTER phone,
+    ADD COLUMN shipping_fee DECIMAL(10,2) NOT NULL DEFAULT 0.0 AFTER shipping_method;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE carts
+    DROP COLUMN shipping_fee,
+    DROP COLUMN shipping_method,
+    DROP COLUMN phone,
+    DROP COLUMN address_line2,
+    DROP COLUMN address_line1,
+    DROP COLUMN city,
+    DROP COLUMN prefecture,
+    DROP COLUMN postal_code,
+    DROP COLUMN recipient_name;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE cart_items DROP COLUMN weight_grams;
+-- +goose StatementEnd
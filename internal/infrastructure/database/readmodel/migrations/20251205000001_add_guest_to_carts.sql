@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE carts
+    MODIFY COLUMN status ENUM('OPEN', 'SUBMITTED', 'CLOSED', 'ABANDONED', 'MERGED') NOT NULL DEFAULT 'OPEN',
+    ADD COLUMN session_id VARCHAR(128) NULL AFTER user_id,
+    ADD COLUMN merged_into_cart_id VARCHAR(36) NULL AFTER status,
+    ADD INDEX idx_session_id (session_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE carts
+    DROP INDEX idx_session_id,
+    DROP COLUMN merged_into_cart_id,
+    DROP COLUMN session_id,
+    MODIFY COLUMN status ENUM('OPEN', 'SUBMITTED', 'CLOSED', 'ABANDONED') NOT NULL DEFAULT 'OPEN';
+-- +goose StatementEnd
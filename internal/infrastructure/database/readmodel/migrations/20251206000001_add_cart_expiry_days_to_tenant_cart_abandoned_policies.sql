@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE tenant_cart_abandoned_policies
+    ADD COLUMN cart_expiry_days INT NOT NULL DEFAULT 0 AFTER quiet_time_to;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE tenant_cart_abandoned_policies
+    DROP COLUMN cart_expiry_days;
+-- +goose StatementEnd
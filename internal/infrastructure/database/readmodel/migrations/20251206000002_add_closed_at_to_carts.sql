@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE carts
+    ADD COLUMN closed_at TIMESTAMP NULL AFTER purchased_at;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE carts
+    DROP COLUMN closed_at;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE saved_list_items (
+    list_id VARCHAR(36) NOT NULL,
+    item_id VARCHAR(36) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    price DECIMAL(10,2) NOT NULL,
+    tax_category VARCHAR(16) NOT NULL DEFAULT 'STANDARD',
+    weight_grams INT NOT NULL DEFAULT 0,
+    saved_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (list_id, item_id),
+    FOREIGN KEY (list_id) REFERENCES saved_lists(id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS saved_list_items;
+-- +goose StatementEnd
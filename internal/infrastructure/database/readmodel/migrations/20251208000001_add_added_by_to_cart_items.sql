@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE cart_items
+    ADD COLUMN added_by VARCHAR(36) NULL AFTER weight_grams;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE cart_items
+    DROP COLUMN added_by;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE tenant_approval_policy_approvers (
+    tenant_id VARCHAR(36) NOT NULL,
+    approver_id VARCHAR(36) NOT NULL,
+    PRIMARY KEY (tenant_id, approver_id),
+    FOREIGN KEY (tenant_id) REFERENCES tenant_approval_policies(tenant_id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS tenant_approval_policy_approvers;
+-- +goose StatementEnd
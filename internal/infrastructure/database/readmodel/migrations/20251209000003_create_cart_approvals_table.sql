@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE cart_approvals (
+    cart_id VARCHAR(36) PRIMARY KEY,
+    tenant_id VARCHAR(36) NOT NULL,
+    status ENUM('PENDING', 'APPROVED', 'REJECTED', 'CHANGES_REQUESTED', 'EXPIRED') NOT NULL,
+    amount DECIMAL(12,2) NOT NULL,
+    requested_by VARCHAR(36) NOT NULL,
+    requested_at TIMESTAMP NOT NULL,
+    deadline_at TIMESTAMP NOT NULL,
+    decided_by VARCHAR(36) NULL,
+    decided_at TIMESTAMP NULL,
+    comment TEXT NULL,
+    version INT NOT NULL DEFAULT 1,
+    INDEX idx_cart_approvals_tenant_status (tenant_id, status, deadline_at)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS cart_approvals;
+-- +goose StatementEnd
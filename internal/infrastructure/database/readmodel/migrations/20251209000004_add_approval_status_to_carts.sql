@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE carts
+    ADD COLUMN approval_status VARCHAR(32) NULL AFTER status;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE carts
+    DROP COLUMN approval_status;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE cart_items
+    ADD COLUMN line_id VARCHAR(36) NULL AFTER id;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+UPDATE cart_items SET line_id = id;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- Lines are keyed per cart, so one item can have a line per set of options
+-- and appear in many carts
+ALTER TABLE cart_items
+    MODIFY COLUMN line_id VARCHAR(36) NOT NULL,
+    DROP PRIMARY KEY,
+    DROP INDEX unique_cart_item,
+    ADD PRIMARY KEY (cart_id, line_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE cart_items
+    DROP PRIMARY KEY,
+    ADD PRIMARY KEY (id),
+    ADD UNIQUE KEY unique_cart_item (cart_id, id),
+    DROP COLUMN line_id;
+-- +goose StatementEnd
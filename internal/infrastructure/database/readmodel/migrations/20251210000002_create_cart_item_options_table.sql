@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE cart_item_options (
+    cart_id VARCHAR(36) NOT NULL,
+    line_id VARCHAR(36) NOT NULL,
+    name VARCHAR(64) NOT NULL,
+    value VARCHAR(255) NOT NULL,
+    price_modifier DECIMAL(10,2) NOT NULL DEFAULT 0.0,
+    position INT NOT NULL,
+    PRIMARY KEY (cart_id, line_id, name),
+    FOREIGN KEY (cart_id, line_id) REFERENCES cart_items(cart_id, line_id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS cart_item_options;
+-- +goose StatementEnd
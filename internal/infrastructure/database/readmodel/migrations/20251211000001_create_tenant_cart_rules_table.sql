@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE tenant_cart_rules (
+    tenant_id VARCHAR(36) PRIMARY KEY,
+    max_lines INT NOT NULL DEFAULT 0,
+    max_quantity_per_item INT NOT NULL DEFAULT 0,
+    minimum_order_value DECIMAL(12,2) NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    version INT NOT NULL DEFAULT 1
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS tenant_cart_rules;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE tenant_cart_rule_blocked_combinations (
+    tenant_id VARCHAR(36) NOT NULL,
+    first_category VARCHAR(255) NOT NULL,
+    second_category VARCHAR(255) NOT NULL,
+    PRIMARY KEY (tenant_id, first_category, second_category),
+    FOREIGN KEY (tenant_id) REFERENCES tenant_cart_rules(tenant_id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS tenant_cart_rule_blocked_combinations;
+-- +goose StatementEnd
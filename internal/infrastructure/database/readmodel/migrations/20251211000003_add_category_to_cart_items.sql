@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE cart_items
+    ADD COLUMN category VARCHAR(255) NOT NULL DEFAULT '' AFTER weight_grams;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE cart_items
+    DROP COLUMN category;
+-- +goose StatementEnd
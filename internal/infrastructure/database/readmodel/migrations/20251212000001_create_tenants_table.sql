@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE tenants (
+    tenant_id VARCHAR(36) PRIMARY KEY,
+    display_name VARCHAR(255) NOT NULL,
+    plan ENUM('FREE', 'STANDARD', 'ENTERPRISE') NOT NULL,
+    status ENUM('ACTIVE', 'SUSPENDED', 'CLOSED') NOT NULL,
+    default_locale VARCHAR(16) NOT NULL,
+    suspension_reason VARCHAR(255) NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    version INT NOT NULL DEFAULT 1
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS tenants;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE tenant_cart_abandoned_policy_segments (
+    id VARCHAR(36) PRIMARY KEY,
+    tenant_id VARCHAR(36) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    priority INT NOT NULL DEFAULT 0,
+    abandoned_minutes INT NOT NULL,
+    min_cart_total DECIMAL(12,2) NOT NULL DEFAULT 0.00,
+    max_cart_total DECIMAL(12,2) NOT NULL DEFAULT 0.00,
+    customer_segment VARCHAR(20) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    version INT NOT NULL DEFAULT 1,
+    INDEX idx_tenant_cart_abandoned_policy_segments_tenant_id (tenant_id, priority)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS tenant_cart_abandoned_policy_segments;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE tenant_cart_abandoned_policy_segment_categories (
+    segment_id VARCHAR(36) NOT NULL,
+    category VARCHAR(255) NOT NULL,
+    PRIMARY KEY (segment_id, category),
+    FOREIGN KEY (segment_id) REFERENCES tenant_cart_abandoned_policy_segments(id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS tenant_cart_abandoned_policy_segment_categories;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE cart_members (
+    cart_id VARCHAR(36) NOT NULL,
+    user_id VARCHAR(36) NOT NULL,
+    status ENUM('INVITED', 'MEMBER') NOT NULL,
+    position INT NOT NULL,
+    PRIMARY KEY (cart_id, user_id),
+    INDEX idx_cart_members_user_id (user_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS cart_members;
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE saved_list_items
+    ADD COLUMN category VARCHAR(255) NOT NULL DEFAULT '' AFTER weight_grams,
+    ADD COLUMN quantity INT NOT NULL DEFAULT 1 AFTER category;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE saved_list_item_options (
+    list_id VARCHAR(36) NOT NULL,
+    item_id VARCHAR(36) NOT NULL,
+    name VARCHAR(64) NOT NULL,
+    value VARCHAR(255) NOT NULL,
+    price_modifier DECIMAL(10,2) NOT NULL DEFAULT 0.0,
+    position INT NOT NULL,
+    PRIMARY KEY (list_id, item_id, name),
+    FOREIGN KEY (list_id, item_id) REFERENCES saved_list_items(list_id, item_id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS saved_list_item_options;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE saved_list_items
+    DROP COLUMN quantity,
+    DROP COLUMN category;
+-- +goose StatementEnd
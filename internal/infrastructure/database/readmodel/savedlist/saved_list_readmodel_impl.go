@@ -0,0 +1,264 @@
+package savedlist
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	appErrors "github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/transaction"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+type SavedListReadModelImpl struct {
+	tx repository.Transaction
+}
+
+func NewSavedListReadModel(tx repository.Transaction) readmodelstore.SavedListStore {
+	return &SavedListReadModelImpl{
+		tx: tx,
+	}
+}
+
+func (s *SavedListReadModelImpl) Get(ctx context.Context, listID string) (*dto.SavedListViewDTO, error) {
+	var list *dto.SavedListViewDTO
+	err := s.tx.RWTx(ctx, func(ctx context.Context) error {
+		tx, err := transaction.GetTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		listQuery := `
+			SELECT id, tenant_id, user_id, created_at, updated_at, version
+			FROM saved_lists
+			WHERE id = ?
+		`
+
+		var view dto.SavedListViewDTO
+		err = tx.QueryRowContext(ctx, listQuery, listID).Scan(
+			&view.ID,
+			&view.TenantID,
+			&view.UserID,
+			&view.CreatedAt,
+			&view.UpdatedAt,
+			&view.Version,
+		)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return appErrors.NotFound.New("saved list not found")
+			}
+			return appErrors.QueryError.Wrap(err, "failed to get saved list")
+		}
+
+		itemsQuery := `
+			SELECT item_id, name, price, quantity, tax_category, weight_grams, category, saved_at
+			FROM saved_list_items
+			WHERE list_id = ?
+			ORDER BY saved_at ASC
+		`
+
+		rows, err := tx.QueryContext(ctx, itemsQuery, listID)
+		if err != nil {
+			return appErrors.QueryError.Wrap(err, "failed to get saved items")
+		}
+		defer rows.Close()
+
+		view.Items = make([]dto.SavedItemViewDTO, 0)
+		for rows.Next() {
+			var item dto.SavedItemViewDTO
+			err := rows.Scan(
+				&item.ID,
+				&item.Name,
+				&item.Price,
+				&item.Quantity,
+				&item.TaxCategory,
+				&item.WeightGrams,
+				&item.Category,
+				&item.SavedAt,
+			)
+			if err != nil {
+				return appErrors.QueryError.Wrap(err, "failed to scan saved item")
+			}
+			view.Items = append(view.Items, item)
+		}
+
+		if err := rows.Err(); err != nil {
+			return appErrors.QueryError.Wrap(err, "rows iteration error")
+		}
+
+		optionsQuery := `
+			SELECT item_id, name, value, price_modifier
+			FROM saved_list_item_options
+			WHERE list_id = ?
+			ORDER BY position ASC
+		`
+
+		optionRows, err := tx.QueryContext(ctx, optionsQuery, listID)
+		if err != nil {
+			return appErrors.QueryError.Wrap(err, "failed to get saved item options")
+		}
+		defer optionRows.Close()
+
+		for optionRows.Next() {
+			var itemID string
+			var option dto.CartItemOptionViewDTO
+			err := optionRows.Scan(
+				&itemID,
+				&option.Name,
+				&option.Value,
+				&option.PriceModifier,
+			)
+			if err != nil {
+				return appErrors.QueryError.Wrap(err, "failed to scan saved item option")
+			}
+			for i := range view.Items {
+				if view.Items[i].ID == itemID {
+					view.Items[i].Options = append(view.Items[i].Options, option)
+				}
+			}
+		}
+
+		if err := optionRows.Err(); err != nil {
+			return appErrors.QueryError.Wrap(err, "rows iteration error")
+		}
+
+		list = &view
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (s *SavedListReadModelImpl) ListIDsBySubject(ctx context.Context, subjectID string) ([]string, error) {
+	ids := make([]string, 0)
+	err := s.tx.RWTx(ctx, func(ctx context.Context) error {
+		tx, err := transaction.GetTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		query := `
+			SELECT id
+			FROM saved_lists
+			WHERE user_id = ?
+			ORDER BY created_at ASC
+		`
+		if err := tx.SelectContext(ctx, &ids, query, subjectID); err != nil {
+			return appErrors.QueryError.Wrap(err, "failed to list saved lists of subject")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (s *SavedListReadModelImpl) AnonymiseSubject(ctx context.Context, subjectID string) (int, error) {
+	var count int
+	err := s.tx.RWTx(ctx, func(ctx context.Context) error {
+		tx, err := transaction.GetTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		// A list is unique per tenant and user, so an anonymised list stands
+		// in for its user with its own ID
+		result, err := tx.ExecContext(ctx, "UPDATE saved_lists SET user_id = id WHERE user_id = ?", subjectID)
+		if err != nil {
+			return appErrors.QueryError.Wrap(err, "failed to anonymise saved lists")
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return appErrors.QueryError.Wrap(err, "failed to anonymise saved lists")
+		}
+		count = int(affected)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (s *SavedListReadModelImpl) Upsert(ctx context.Context, listID string, view *dto.SavedListViewDTO) error {
+	return s.tx.RWTx(ctx, func(ctx context.Context) error {
+		tx, err := transaction.GetTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		listQuery := `
+			INSERT INTO saved_lists (id, tenant_id, user_id, created_at, updated_at, version)
+			VALUES (?, ?, ?, ?, ?, ?)
+			ON DUPLICATE KEY UPDATE
+				updated_at = VALUES(updated_at),
+				version = VALUES(version)
+		`
+
+		_, err = tx.ExecContext(ctx, listQuery,
+			listID,
+			view.TenantID,
+			view.UserID,
+			view.CreatedAt,
+			view.UpdatedAt,
+			view.Version,
+		)
+		if err != nil {
+			return appErrors.RepositoryError.Wrap(err, "failed to upsert saved list")
+		}
+
+		deleteQuery := `DELETE FROM saved_list_items WHERE list_id = ?`
+		_, err = tx.ExecContext(ctx, deleteQuery, listID)
+		if err != nil {
+			return appErrors.RepositoryError.Wrap(err, "failed to delete existing saved items")
+		}
+
+		if len(view.Items) == 0 {
+			return nil
+		}
+
+		values := make([]interface{}, 0, len(view.Items)*9)
+		placeholders := make([]string, 0, len(view.Items))
+		optionValues := make([]interface{}, 0)
+		optionPlaceholders := make([]string, 0)
+
+		for _, item := range view.Items {
+			quantity := item.Quantity
+			if quantity == 0 {
+				quantity = 1
+			}
+			placeholders = append(placeholders, "(?, ?, ?, ?, ?, ?, ?, ?, ?)")
+			values = append(values, listID, item.ID, item.Name, item.Price, quantity, item.TaxCategory, item.WeightGrams, item.Category, item.SavedAt)
+
+			for i, option := range item.Options {
+				optionPlaceholders = append(optionPlaceholders, "(?, ?, ?, ?, ?, ?)")
+				optionValues = append(optionValues, listID, item.ID, option.Name, option.Value, option.PriceModifier, i)
+			}
+		}
+
+		itemsQuery := "INSERT INTO saved_list_items (list_id, item_id, name, price, quantity, tax_category, weight_grams, category, saved_at) VALUES " +
+			strings.Join(placeholders, ", ")
+
+		_, err = tx.ExecContext(ctx, itemsQuery, values...)
+		if err != nil {
+			return appErrors.RepositoryError.Wrap(err, "failed to bulk insert saved items")
+		}
+
+		if len(optionPlaceholders) > 0 {
+			optionQuery := "INSERT INTO saved_list_item_options (list_id, item_id, name, value, price_modifier, position) VALUES " +
+				strings.Join(optionPlaceholders, ", ")
+
+			_, err = tx.ExecContext(ctx, optionQuery, optionValues...)
+			if err != nil {
+				return appErrors.RepositoryError.Wrap(err, "failed to bulk insert saved item options")
+			}
+		}
+
+		return nil
+	})
+}
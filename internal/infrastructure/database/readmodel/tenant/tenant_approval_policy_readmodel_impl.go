@@ -0,0 +1,147 @@
+package tenant
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	appErrors "github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/transaction"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+type TenantApprovalPolicyReadModelImpl struct {
+	tx repository.Transaction
+}
+
+func NewTenantApprovalPolicyReadModel(tx repository.Transaction) readmodelstore.TenantApprovalPolicyStore {
+	return &TenantApprovalPolicyReadModelImpl{
+		tx: tx,
+	}
+}
+
+func (t *TenantApprovalPolicyReadModelImpl) Get(ctx context.Context, tenantID string) (*dto.TenantApprovalPolicyViewDTO, error) {
+	var policy *dto.TenantApprovalPolicyViewDTO
+	err := t.tx.RWTx(ctx, func(ctx context.Context) error {
+		tx, err := transaction.GetTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		policyQuery := `
+			SELECT tenant_id, threshold, deadline_hours, created_at, updated_at, version
+			FROM tenant_approval_policies
+			WHERE tenant_id = ?
+		`
+
+		var policyView dto.TenantApprovalPolicyViewDTO
+		err = tx.QueryRowContext(ctx, policyQuery, tenantID).Scan(
+			&policyView.TenantID,
+			&policyView.Threshold,
+			&policyView.DeadlineHours,
+			&policyView.CreatedAt,
+			&policyView.UpdatedAt,
+			&policyView.Version,
+		)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return appErrors.NotFound.New("tenant approval policy not found")
+			}
+			return appErrors.QueryError.Wrap(err, "failed to get tenant approval policy")
+		}
+
+		approversQuery := `
+			SELECT approver_id
+			FROM tenant_approval_policy_approvers
+			WHERE tenant_id = ?
+			ORDER BY approver_id ASC
+		`
+
+		rows, err := tx.QueryContext(ctx, approversQuery, tenantID)
+		if err != nil {
+			return appErrors.QueryError.Wrap(err, "failed to get tenant approvers")
+		}
+		defer rows.Close()
+
+		policyView.ApproverIDs = make([]string, 0)
+		for rows.Next() {
+			var approverID string
+			if err := rows.Scan(&approverID); err != nil {
+				return appErrors.QueryError.Wrap(err, "failed to scan tenant approver")
+			}
+			policyView.ApproverIDs = append(policyView.ApproverIDs, approverID)
+		}
+
+		if err := rows.Err(); err != nil {
+			return appErrors.QueryError.Wrap(err, "rows iteration error")
+		}
+
+		policy = &policyView
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+func (t *TenantApprovalPolicyReadModelImpl) Upsert(ctx context.Context, tenantID string, view *dto.TenantApprovalPolicyViewDTO) error {
+	return t.tx.RWTx(ctx, func(ctx context.Context) error {
+		tx, err := transaction.GetTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		policyQuery := `
+			INSERT INTO tenant_approval_policies (tenant_id, threshold, deadline_hours, created_at, updated_at, version)
+			VALUES (?, ?, ?, ?, ?, ?)
+			ON DUPLICATE KEY UPDATE
+				threshold = VALUES(threshold),
+				deadline_hours = VALUES(deadline_hours),
+				updated_at = VALUES(updated_at),
+				version = VALUES(version)
+		`
+
+		_, err = tx.ExecContext(ctx, policyQuery,
+			tenantID,
+			view.Threshold,
+			view.DeadlineHours,
+			view.CreatedAt,
+			view.UpdatedAt,
+			view.Version,
+		)
+		if err != nil {
+			return appErrors.RepositoryError.Wrap(err, "failed to upsert tenant approval policy")
+		}
+
+		deleteQuery := `DELETE FROM tenant_approval_policy_approvers WHERE tenant_id = ?`
+		_, err = tx.ExecContext(ctx, deleteQuery, tenantID)
+		if err != nil {
+			return appErrors.RepositoryError.Wrap(err, "failed to delete existing tenant approvers")
+		}
+
+		if len(view.ApproverIDs) == 0 {
+			return nil
+		}
+
+		values := make([]interface{}, 0, len(view.ApproverIDs)*2)
+		placeholders := make([]string, 0, len(view.ApproverIDs))
+
+		for _, approverID := range view.ApproverIDs {
+			placeholders = append(placeholders, "(?, ?)")
+			values = append(values, tenantID, approverID)
+		}
+
+		approversQuery := "INSERT INTO tenant_approval_policy_approvers (tenant_id, approver_id) VALUES " +
+			strings.Join(placeholders, ", ")
+
+		_, err = tx.ExecContext(ctx, approversQuery, values...)
+		if err != nil {
+			return appErrors.RepositoryError.Wrap(err, "failed to bulk insert tenant approvers")
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,153 @@
+package tenant
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	appErrors "github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/transaction"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+type TenantCartRulesReadModelImpl struct {
+	tx repository.Transaction
+}
+
+func NewTenantCartRulesReadModel(tx repository.Transaction) readmodelstore.TenantCartRulesStore {
+	return &TenantCartRulesReadModelImpl{
+		tx: tx,
+	}
+}
+
+func (t *TenantCartRulesReadModelImpl) Get(ctx context.Context, tenantID string) (*dto.TenantCartRulesViewDTO, error) {
+	var rules *dto.TenantCartRulesViewDTO
+	err := t.tx.RWTx(ctx, func(ctx context.Context) error {
+		tx, err := transaction.GetTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		rulesQuery := `
+			SELECT tenant_id, max_lines, max_quantity_per_item, minimum_order_value, created_at, updated_at, version
+			FROM tenant_cart_rules
+			WHERE tenant_id = ?
+		`
+
+		var rulesView dto.TenantCartRulesViewDTO
+		err = tx.QueryRowContext(ctx, rulesQuery, tenantID).Scan(
+			&rulesView.TenantID,
+			&rulesView.MaxLines,
+			&rulesView.MaxQuantityPerItem,
+			&rulesView.MinimumOrderValue,
+			&rulesView.CreatedAt,
+			&rulesView.UpdatedAt,
+			&rulesView.Version,
+		)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return appErrors.NotFound.New("tenant cart rules not found")
+			}
+			return appErrors.QueryError.Wrap(err, "failed to get tenant cart rules")
+		}
+
+		combinationsQuery := `
+			SELECT first_category, second_category
+			FROM tenant_cart_rule_blocked_combinations
+			WHERE tenant_id = ?
+			ORDER BY first_category ASC, second_category ASC
+		`
+
+		rows, err := tx.QueryContext(ctx, combinationsQuery, tenantID)
+		if err != nil {
+			return appErrors.QueryError.Wrap(err, "failed to get blocked category combinations")
+		}
+		defer rows.Close()
+
+		rulesView.BlockedCategoryCombinations = make([][]string, 0)
+		for rows.Next() {
+			var first, second string
+			if err := rows.Scan(&first, &second); err != nil {
+				return appErrors.QueryError.Wrap(err, "failed to scan blocked category combination")
+			}
+			rulesView.BlockedCategoryCombinations = append(rulesView.BlockedCategoryCombinations, []string{first, second})
+		}
+
+		if err := rows.Err(); err != nil {
+			return appErrors.QueryError.Wrap(err, "rows iteration error")
+		}
+
+		rules = &rulesView
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+func (t *TenantCartRulesReadModelImpl) Upsert(ctx context.Context, tenantID string, view *dto.TenantCartRulesViewDTO) error {
+	return t.tx.RWTx(ctx, func(ctx context.Context) error {
+		tx, err := transaction.GetTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		rulesQuery := `
+			INSERT INTO tenant_cart_rules (tenant_id, max_lines, max_quantity_per_item, minimum_order_value, created_at, updated_at, version)
+			VALUES (?, ?, ?, ?, ?, ?, ?)
+			ON DUPLICATE KEY UPDATE
+				max_lines = VALUES(max_lines),
+				max_quantity_per_item = VALUES(max_quantity_per_item),
+				minimum_order_value = VALUES(minimum_order_value),
+				updated_at = VALUES(updated_at),
+				version = VALUES(version)
+		`
+
+		_, err = tx.ExecContext(ctx, rulesQuery,
+			tenantID,
+			view.MaxLines,
+			view.MaxQuantityPerItem,
+			view.MinimumOrderValue,
+			view.CreatedAt,
+			view.UpdatedAt,
+			view.Version,
+		)
+		if err != nil {
+			return appErrors.RepositoryError.Wrap(err, "failed to upsert tenant cart rules")
+		}
+
+		deleteQuery := `DELETE FROM tenant_cart_rule_blocked_combinations WHERE tenant_id = ?`
+		_, err = tx.ExecContext(ctx, deleteQuery, tenantID)
+		if err != nil {
+			return appErrors.RepositoryError.Wrap(err, "failed to delete existing blocked category combinations")
+		}
+
+		if len(view.BlockedCategoryCombinations) == 0 {
+			return nil
+		}
+
+		values := make([]interface{}, 0, len(view.BlockedCategoryCombinations)*3)
+		placeholders := make([]string, 0, len(view.BlockedCategoryCombinations))
+
+		for _, combination := range view.BlockedCategoryCombinations {
+			if len(combination) != 2 {
+				return appErrors.InvalidParameter.New("a blocked category combination must have two categories")
+			}
+			placeholders = append(placeholders, "(?, ?, ?)")
+			values = append(values, tenantID, combination[0], combination[1])
+		}
+
+		combinationsQuery := "INSERT INTO tenant_cart_rule_blocked_combinations (tenant_id, first_category, second_category) VALUES " +
+			strings.Join(placeholders, ", ")
+
+		_, err = tx.ExecContext(ctx, combinationsQuery, values...)
+		if err != nil {
+			return appErrors.RepositoryError.Wrap(err, "failed to bulk insert blocked category combinations")
+		}
+
+		return nil
+	})
+}
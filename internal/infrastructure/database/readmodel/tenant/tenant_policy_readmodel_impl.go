@@ -3,6 +3,7 @@ package tenant
 import (
 	"context"
 	"database/sql"
+	"strings"
 
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
 	appErrors "github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
@@ -30,7 +31,7 @@ func (t *TenantPolicyReadModelImpl) Get(ctx context.Context, tenantID string) (*
 		}
 
 		policyQuery := `
-			SELECT id, title, abandoned_minutes, quiet_time_from, quiet_time_to, created_at, updated_at, version
+			SELECT id, title, abandoned_minutes, quiet_time_from, quiet_time_to, cart_expiry_days, created_at, updated_at, version
 			FROM tenant_cart_abandoned_policies 
 			WHERE id = ?
 		`
@@ -43,6 +44,7 @@ func (t *TenantPolicyReadModelImpl) Get(ctx context.Context, tenantID string) (*
 			&policyView.AbandonedMinutes,
 			&policyView.QuietTimeFrom,
 			&policyView.QuietTimeTo,
+			&policyView.CartExpiryDays,
 			&policyView.CreatedAt,
 			&policyView.UpdatedAt,
 			&policyView.Version,
@@ -71,13 +73,14 @@ func (t *TenantPolicyReadModelImpl) Upsert(ctx context.Context, tenantID string,
 		}
 
 		policyQuery := `
-			INSERT INTO tenant_cart_abandoned_policies (id, title, abandoned_minutes, quiet_time_from, quiet_time_to, created_at, updated_at, version)
-			VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+			INSERT INTO tenant_cart_abandoned_policies (id, title, abandoned_minutes, quiet_time_from, quiet_time_to, cart_expiry_days, created_at, updated_at, version)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
 			ON DUPLICATE KEY UPDATE
 				title = VALUES(title),
 				abandoned_minutes = VALUES(abandoned_minutes),
 				quiet_time_from = VALUES(quiet_time_from),
 				quiet_time_to = VALUES(quiet_time_to),
+				cart_expiry_days = VALUES(cart_expiry_days),
 				updated_at = VALUES(updated_at),
 				version = VALUES(version)
 		`
@@ -88,6 +91,7 @@ func (t *TenantPolicyReadModelImpl) Upsert(ctx context.Context, tenantID string,
 			view.AbandonedMinutes,
 			view.QuietTimeFrom,
 			view.QuietTimeTo,
+			view.CartExpiryDays,
 			view.CreatedAt,
 			view.UpdatedAt,
 			view.Version,
@@ -99,3 +103,245 @@ func (t *TenantPolicyReadModelImpl) Upsert(ctx context.Context, tenantID string,
 		return nil
 	})
 }
+
+func (t *TenantPolicyReadModelImpl) GetSegment(ctx context.Context, segmentID string) (*dto.TenantPolicySegmentViewDTO, error) {
+	var segment *dto.TenantPolicySegmentViewDTO
+	err := t.tx.RWTx(ctx, func(ctx context.Context) error {
+		tx, err := transaction.GetTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		segmentQuery := `
+			SELECT id, tenant_id, title, priority, abandoned_minutes, min_cart_total, max_cart_total, customer_segment, created_at, updated_at, version
+			FROM tenant_cart_abandoned_policy_segments
+			WHERE id = ?
+		`
+
+		var segmentView dto.TenantPolicySegmentViewDTO
+		err = tx.QueryRowContext(ctx, segmentQuery, segmentID).Scan(
+			&segmentView.ID,
+			&segmentView.TenantID,
+			&segmentView.Title,
+			&segmentView.Priority,
+			&segmentView.AbandonedMinutes,
+			&segmentView.MinCartTotal,
+			&segmentView.MaxCartTotal,
+			&segmentView.CustomerSegment,
+			&segmentView.CreatedAt,
+			&segmentView.UpdatedAt,
+			&segmentView.Version,
+		)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return appErrors.NotFound.New("abandonment segment not found")
+			}
+			return appErrors.QueryError.Wrap(err, "failed to get abandonment segment")
+		}
+
+		categoriesQuery := `
+			SELECT category
+			FROM tenant_cart_abandoned_policy_segment_categories
+			WHERE segment_id = ?
+			ORDER BY category ASC
+		`
+
+		rows, err := tx.QueryContext(ctx, categoriesQuery, segmentID)
+		if err != nil {
+			return appErrors.QueryError.Wrap(err, "failed to get abandonment segment categories")
+		}
+		defer rows.Close()
+
+		segmentView.Categories = make([]string, 0)
+		for rows.Next() {
+			var category string
+			if err := rows.Scan(&category); err != nil {
+				return appErrors.QueryError.Wrap(err, "failed to scan abandonment segment category")
+			}
+			segmentView.Categories = append(segmentView.Categories, category)
+		}
+
+		if err := rows.Err(); err != nil {
+			return appErrors.QueryError.Wrap(err, "rows iteration error")
+		}
+
+		segment = &segmentView
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return segment, nil
+}
+
+func (t *TenantPolicyReadModelImpl) ListSegments(ctx context.Context, tenantID string) ([]dto.TenantPolicySegmentViewDTO, error) {
+	segments := make([]dto.TenantPolicySegmentViewDTO, 0)
+	err := t.tx.RWTx(ctx, func(ctx context.Context) error {
+		tx, err := transaction.GetTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		segmentsQuery := `
+			SELECT id, tenant_id, title, priority, abandoned_minutes, min_cart_total, max_cart_total, customer_segment, created_at, updated_at, version
+			FROM tenant_cart_abandoned_policy_segments
+			WHERE tenant_id = ?
+			ORDER BY priority ASC, id ASC
+		`
+
+		rows, err := tx.QueryContext(ctx, segmentsQuery, tenantID)
+		if err != nil {
+			return appErrors.QueryError.Wrap(err, "failed to list abandonment segments")
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var segment dto.TenantPolicySegmentViewDTO
+			err := rows.Scan(
+				&segment.ID,
+				&segment.TenantID,
+				&segment.Title,
+				&segment.Priority,
+				&segment.AbandonedMinutes,
+				&segment.MinCartTotal,
+				&segment.MaxCartTotal,
+				&segment.CustomerSegment,
+				&segment.CreatedAt,
+				&segment.UpdatedAt,
+				&segment.Version,
+			)
+			if err != nil {
+				return appErrors.QueryError.Wrap(err, "failed to scan abandonment segment")
+			}
+			segment.Categories = make([]string, 0)
+			segments = append(segments, segment)
+		}
+
+		if err := rows.Err(); err != nil {
+			return appErrors.QueryError.Wrap(err, "rows iteration error")
+		}
+
+		categoriesQuery := `
+			SELECT c.segment_id, c.category
+			FROM tenant_cart_abandoned_policy_segment_categories c
+			JOIN tenant_cart_abandoned_policy_segments s ON s.id = c.segment_id
+			WHERE s.tenant_id = ?
+			ORDER BY c.category ASC
+		`
+
+		categoryRows, err := tx.QueryContext(ctx, categoriesQuery, tenantID)
+		if err != nil {
+			return appErrors.QueryError.Wrap(err, "failed to list abandonment segment categories")
+		}
+		defer categoryRows.Close()
+
+		indexes := make(map[string]int, len(segments))
+		for i, segment := range segments {
+			indexes[segment.ID] = i
+		}
+
+		for categoryRows.Next() {
+			var segmentID, category string
+			if err := categoryRows.Scan(&segmentID, &category); err != nil {
+				return appErrors.QueryError.Wrap(err, "failed to scan abandonment segment category")
+			}
+			if i, ok := indexes[segmentID]; ok {
+				segments[i].Categories = append(segments[i].Categories, category)
+			}
+		}
+
+		if err := categoryRows.Err(); err != nil {
+			return appErrors.QueryError.Wrap(err, "rows iteration error")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return segments, nil
+}
+
+func (t *TenantPolicyReadModelImpl) UpsertSegment(ctx context.Context, segmentID string, view *dto.TenantPolicySegmentViewDTO) error {
+	return t.tx.RWTx(ctx, func(ctx context.Context) error {
+		tx, err := transaction.GetTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		segmentQuery := `
+			INSERT INTO tenant_cart_abandoned_policy_segments (id, tenant_id, title, priority, abandoned_minutes, min_cart_total, max_cart_total, customer_segment, created_at, updated_at, version)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			ON DUPLICATE KEY UPDATE
+				title = VALUES(title),
+				priority = VALUES(priority),
+				abandoned_minutes = VALUES(abandoned_minutes),
+				min_cart_total = VALUES(min_cart_total),
+				max_cart_total = VALUES(max_cart_total),
+				customer_segment = VALUES(customer_segment),
+				updated_at = VALUES(updated_at),
+				version = VALUES(version)
+		`
+
+		_, err = tx.ExecContext(ctx, segmentQuery,
+			segmentID,
+			view.TenantID,
+			view.Title,
+			view.Priority,
+			view.AbandonedMinutes,
+			view.MinCartTotal,
+			view.MaxCartTotal,
+			view.CustomerSegment,
+			view.CreatedAt,
+			view.UpdatedAt,
+			view.Version,
+		)
+		if err != nil {
+			return appErrors.RepositoryError.Wrap(err, "failed to upsert abandonment segment")
+		}
+
+		deleteQuery := `DELETE FROM tenant_cart_abandoned_policy_segment_categories WHERE segment_id = ?`
+		_, err = tx.ExecContext(ctx, deleteQuery, segmentID)
+		if err != nil {
+			return appErrors.RepositoryError.Wrap(err, "failed to delete existing abandonment segment categories")
+		}
+
+		if len(view.Categories) == 0 {
+			return nil
+		}
+
+		values := make([]interface{}, 0, len(view.Categories)*2)
+		placeholders := make([]string, 0, len(view.Categories))
+
+		for _, category := range view.Categories {
+			placeholders = append(placeholders, "(?, ?)")
+			values = append(values, segmentID, category)
+		}
+
+		categoriesQuery := "INSERT INTO tenant_cart_abandoned_policy_segment_categories (segment_id, category) VALUES " +
+			strings.Join(placeholders, ", ")
+
+		_, err = tx.ExecContext(ctx, categoriesQuery, values...)
+		if err != nil {
+			return appErrors.RepositoryError.Wrap(err, "failed to bulk insert abandonment segment categories")
+		}
+
+		return nil
+	})
+}
+
+func (t *TenantPolicyReadModelImpl) DeleteSegment(ctx context.Context, segmentID string) error {
+	return t.tx.RWTx(ctx, func(ctx context.Context) error {
+		tx, err := transaction.GetTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `DELETE FROM tenant_cart_abandoned_policy_segments WHERE id = ?`, segmentID)
+		if err != nil {
+			return appErrors.RepositoryError.Wrap(err, "failed to delete abandonment segment")
+		}
+
+		return nil
+	})
+}
@@ -104,6 +104,20 @@ func TestTenantPolicyReadModel_Upsert(t *testing.T) {
 			},
 			wantError: false,
 		},
+		"successful upsert with cart expiry": {
+			policyData: &dto.TenantPolicyViewDTO{
+				ID:               testTenantID,
+				Title:            "Expiring Policy",
+				AbandonedMinutes: 30,
+				QuietTimeFrom:    time.Date(2023, 1, 1, 9, 0, 0, 0, time.UTC),
+				QuietTimeTo:      time.Date(2023, 1, 1, 17, 0, 0, 0, time.UTC),
+				CartExpiryDays:   14,
+				CreatedAt:        time.Now(),
+				UpdatedAt:        time.Now(),
+				Version:          2,
+			},
+			wantError: false,
+		},
 	}
 
 	for name, tt := range tests {
@@ -0,0 +1,111 @@
+package tenant
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	appErrors "github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/transaction"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+type TenantReadModelImpl struct {
+	tx repository.Transaction
+}
+
+func NewTenantReadModel(tx repository.Transaction) readmodelstore.TenantStore {
+	return &TenantReadModelImpl{
+		tx: tx,
+	}
+}
+
+func (t *TenantReadModelImpl) Get(ctx context.Context, tenantID string) (*dto.TenantViewDTO, error) {
+	var tenant *dto.TenantViewDTO
+	err := t.tx.RWTx(ctx, func(ctx context.Context) error {
+		tx, err := transaction.GetTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		tenantQuery := `
+			SELECT tenant_id, display_name, plan, status, default_locale, suspension_reason, created_at, updated_at, version
+			FROM tenants
+			WHERE tenant_id = ?
+		`
+
+		var tenantView dto.TenantViewDTO
+		var suspensionReason sql.NullString
+
+		err = tx.QueryRowContext(ctx, tenantQuery, tenantID).Scan(
+			&tenantView.TenantID,
+			&tenantView.DisplayName,
+			&tenantView.Plan,
+			&tenantView.Status,
+			&tenantView.DefaultLocale,
+			&suspensionReason,
+			&tenantView.CreatedAt,
+			&tenantView.UpdatedAt,
+			&tenantView.Version,
+		)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return appErrors.NotFound.New("tenant not found")
+			}
+			return appErrors.QueryError.Wrap(err, "failed to get tenant")
+		}
+		tenantView.SuspensionReason = suspensionReason.String
+
+		tenant = &tenantView
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tenant, nil
+}
+
+func (t *TenantReadModelImpl) Upsert(ctx context.Context, tenantID string, view *dto.TenantViewDTO) error {
+	return t.tx.RWTx(ctx, func(ctx context.Context) error {
+		tx, err := transaction.GetTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		tenantQuery := `
+			INSERT INTO tenants (tenant_id, display_name, plan, status, default_locale, suspension_reason, created_at, updated_at, version)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+			ON DUPLICATE KEY UPDATE
+				display_name = VALUES(display_name),
+				plan = VALUES(plan),
+				status = VALUES(status),
+				default_locale = VALUES(default_locale),
+				suspension_reason = VALUES(suspension_reason),
+				updated_at = VALUES(updated_at),
+				version = VALUES(version)
+		`
+
+		var suspensionReason sql.NullString
+		if view.SuspensionReason != "" {
+			suspensionReason = sql.NullString{String: view.SuspensionReason, Valid: true}
+		}
+
+		_, err = tx.ExecContext(ctx, tenantQuery,
+			tenantID,
+			view.DisplayName,
+			view.Plan,
+			view.Status,
+			view.DefaultLocale,
+			suspensionReason,
+			view.CreatedAt,
+			view.UpdatedAt,
+			view.Version,
+		)
+		if err != nil {
+			return appErrors.RepositoryError.Wrap(err, "failed to upsert tenant")
+		}
+
+		return nil
+	})
+}
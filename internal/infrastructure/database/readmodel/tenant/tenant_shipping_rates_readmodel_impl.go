@@ -0,0 +1,119 @@
+package tenant
+
+import (
+	"context"
+	"strings"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	appErrors "github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/transaction"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+type TenantShippingRatesReadModelImpl struct {
+	tx repository.Transaction
+}
+
+func NewTenantShippingRatesReadModel(tx repository.Transaction) readmodelstore.TenantShippingRatesStore {
+	return &TenantShippingRatesReadModelImpl{
+		tx: tx,
+	}
+}
+
+func (t *TenantShippingRatesReadModelImpl) Get(ctx context.Context, tenantID string) (*dto.TenantShippingRatesViewDTO, error) {
+	var rates *dto.TenantShippingRatesViewDTO
+	err := t.tx.RWTx(ctx, func(ctx context.Context) error {
+		tx, err := transaction.GetTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		ratesQuery := `
+			SELECT method, prefecture, max_weight_grams, fee, updated_at, version
+			FROM tenant_shipping_rates
+			WHERE tenant_id = ?
+			ORDER BY method ASC, prefecture ASC, max_weight_grams ASC
+		`
+
+		rows, err := tx.QueryContext(ctx, ratesQuery, tenantID)
+		if err != nil {
+			return appErrors.QueryError.Wrap(err, "failed to get tenant shipping rates")
+		}
+		defer rows.Close()
+
+		view := dto.TenantShippingRatesViewDTO{
+			TenantID: tenantID,
+			Rates:    make([]dto.ShippingRateViewDTO, 0),
+		}
+		for rows.Next() {
+			var rate dto.ShippingRateViewDTO
+			var version int
+			err := rows.Scan(
+				&rate.Method,
+				&rate.Prefecture,
+				&rate.MaxWeightGrams,
+				&rate.Fee,
+				&rate.UpdatedAt,
+				&version,
+			)
+			if err != nil {
+				return appErrors.QueryError.Wrap(err, "failed to scan shipping rate")
+			}
+			view.Rates = append(view.Rates, rate)
+			view.Version = max(view.Version, version)
+		}
+
+		if err := rows.Err(); err != nil {
+			return appErrors.QueryError.Wrap(err, "rows iteration error")
+		}
+
+		if len(view.Rates) == 0 {
+			return appErrors.NotFound.New("tenant shipping rates not found")
+		}
+
+		rates = &view
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rates, nil
+}
+
+func (t *TenantShippingRatesReadModelImpl) Upsert(ctx context.Context, tenantID string, view *dto.TenantShippingRatesViewDTO) error {
+	return t.tx.RWTx(ctx, func(ctx context.Context) error {
+		tx, err := transaction.GetTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		deleteQuery := `DELETE FROM tenant_shipping_rates WHERE tenant_id = ?`
+		_, err = tx.ExecContext(ctx, deleteQuery, tenantID)
+		if err != nil {
+			return appErrors.RepositoryError.Wrap(err, "failed to delete existing tenant shipping rates")
+		}
+
+		if len(view.Rates) == 0 {
+			return nil
+		}
+
+		values := make([]interface{}, 0, len(view.Rates)*7)
+		placeholders := make([]string, 0, len(view.Rates))
+
+		for _, rate := range view.Rates {
+			placeholders = append(placeholders, "(?, ?, ?, ?, ?, ?, ?)")
+			values = append(values, tenantID, rate.Method, rate.Prefecture, rate.MaxWeightGrams, rate.Fee, rate.UpdatedAt, view.Version)
+		}
+
+		ratesQuery := "INSERT INTO tenant_shipping_rates (tenant_id, method, prefecture, max_weight_grams, fee, updated_at, version) VALUES " +
+			strings.Join(placeholders, ", ")
+
+		_, err = tx.ExecContext(ctx, ratesQuery, values...)
+		if err != nil {
+			return appErrors.RepositoryError.Wrap(err, "failed to bulk insert tenant shipping rates")
+		}
+
+		return nil
+	})
+}
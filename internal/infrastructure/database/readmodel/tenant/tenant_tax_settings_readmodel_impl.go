@@ -0,0 +1,95 @@
+package tenant
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	appErrors "github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/transaction"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+type TenantTaxSettingsReadModelImpl struct {
+	tx repository.Transaction
+}
+
+func NewTenantTaxSettingsReadModel(tx repository.Transaction) readmodelstore.TenantTaxSettingsStore {
+	return &TenantTaxSettingsReadModelImpl{
+		tx: tx,
+	}
+}
+
+func (t *TenantTaxSettingsReadModelImpl) Get(ctx context.Context, tenantID string) (*dto.TenantTaxSettingsViewDTO, error) {
+	var settings *dto.TenantTaxSettingsViewDTO
+	err := t.tx.RWTx(ctx, func(ctx context.Context) error {
+		tx, err := transaction.GetTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		settingsQuery := `
+			SELECT tenant_id, tax_display, rounding_mode, created_at, updated_at, version
+			FROM tenant_tax_settings
+			WHERE tenant_id = ?
+		`
+
+		var settingsView dto.TenantTaxSettingsViewDTO
+
+		err = tx.QueryRowContext(ctx, settingsQuery, tenantID).Scan(
+			&settingsView.TenantID,
+			&settingsView.TaxDisplay,
+			&settingsView.RoundingMode,
+			&settingsView.CreatedAt,
+			&settingsView.UpdatedAt,
+			&settingsView.Version,
+		)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return appErrors.NotFound.New("tenant tax settings not found")
+			}
+			return appErrors.QueryError.Wrap(err, "failed to get tenant tax settings")
+		}
+
+		settings = &settingsView
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+func (t *TenantTaxSettingsReadModelImpl) Upsert(ctx context.Context, tenantID string, view *dto.TenantTaxSettingsViewDTO) error {
+	return t.tx.RWTx(ctx, func(ctx context.Context) error {
+		tx, err := transaction.GetTx(ctx)
+		if err != nil {
+			return err
+		}
+
+		settingsQuery := `
+			INSERT INTO tenant_tax_settings (tenant_id, tax_display, rounding_mode, created_at, updated_at, version)
+			VALUES (?, ?, ?, ?, ?, ?)
+			ON DUPLICATE KEY UPDATE
+				tax_display = VALUES(tax_display),
+				rounding_mode = VALUES(rounding_mode),
+				updated_at = VALUES(updated_at),
+				version = VALUES(version)
+		`
+
+		_, err = tx.ExecContext(ctx, settingsQuery,
+			tenantID,
+			view.TaxDisplay,
+			view.RoundingMode,
+			view.CreatedAt,
+			view.UpdatedAt,
+			view.Version,
+		)
+		if err != nil {
+			return appErrors.RepositoryError.Wrap(err, "failed to upsert tenant tax settings")
+		}
+
+		return nil
+	})
+}
@@ -43,9 +43,15 @@ func (e TestEvent) GetVersion() int           { return e.Version }
 func (e TestEvent) GetTimestamp() time.Time   { return e.CreatedAt }
 func (e TestEvent) GetAggregateType() string  { return "cart" }
 
+type FakeSerializer struct{}
+
+func (f FakeSerializer) Serialize(ctx context.Context, evt domainevent.Event) ([]byte, error) {
+	return json.Marshal(evt)
+}
+
 type FakeDeserializer struct{}
 
-func (f FakeDeserializer) Deserialize(eventType string, data []byte) (domainevent.Event, error) {
+func (f FakeDeserializer) Deserialize(ctx context.Context, eventType string, data []byte) (domainevent.Event, error) {
 	var te TestEvent
 	if err := json.Unmarshal(data, &te); err != nil {
 		return nil, err
@@ -11,7 +11,10 @@ import (
 
 type txKeyType string
 
-const TxKey txKeyType = "tx"
+const (
+	TxKey       txKeyType = "tx"
+	sharedTxKey txKeyType = "shared_tx"
+)
 
 type transaction struct {
 	db               *sqlx.DB
@@ -26,9 +29,31 @@ func NewTransaction(db *sqlx.DB) repository.Transaction {
 }
 
 func (t *transaction) RWTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if shared, ok := ctx.Value(sharedTxKey).(*sqlx.Tx); ok {
+		return fn(WithTx(ctx, shared))
+	}
 	return t.runTx(ctx, sql.LevelRepeatableRead, fn)
 }
 
+// SharedRWTx within another SharedRWTx joins the outer transaction.
+func (t *transaction) SharedRWTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if shared, ok := ctx.Value(sharedTxKey).(*sqlx.Tx); ok {
+		return fn(WithTx(ctx, shared))
+	}
+	return t.runTx(ctx, sql.LevelRepeatableRead, func(ctx context.Context) error {
+		tx, err := GetTx(ctx)
+		if err != nil {
+			return err
+		}
+		return fn(context.WithValue(ctx, sharedTxKey, tx))
+	})
+}
+
+func (t *transaction) Shared(ctx context.Context) bool {
+	_, ok := ctx.Value(sharedTxKey).(*sqlx.Tx)
+	return ok
+}
+
 func (t *transaction) AfterCommit(fn func() error) {
 	t.afterCommitHooks = append(t.afterCommitHooks, fn)
 }
@@ -6,6 +6,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/messaging"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/messaging/dto"
 )
 
@@ -18,15 +19,23 @@ type DelayedMessage struct {
 
 type MemoryDelayQueue struct {
 	messages map[string]*DelayedMessage
+	handlers []messaging.MessageHandler
 	mu       sync.RWMutex
 }
 
 func NewMemoryDelayQueue() *MemoryDelayQueue {
 	return &MemoryDelayQueue{
 		messages: make(map[string]*DelayedMessage),
+		handlers: make([]messaging.MessageHandler, 0),
 	}
 }
 
+func (q *MemoryDelayQueue) AddHandler(handler messaging.MessageHandler) {
+	q.mu.Lock()
+	q.handlers = append(q.handlers, handler)
+	q.mu.Unlock()
+}
+
 func (q *MemoryDelayQueue) PublishDelayedMessage(topic, key string, message *dto.Message, delay time.Duration) error {
 	executeAt := time.Now().Add(delay)
 
@@ -59,12 +68,12 @@ func (q *MemoryDelayQueue) Start(ctx context.Context) error {
 			log.Println("Memory Delay Queue stopped")
 			return nil
 		case <-ticker.C:
-			q.processExpiredMessages()
+			q.processExpiredMessages(ctx)
 		}
 	}
 }
 
-func (q *MemoryDelayQueue) processExpiredMessages() {
+func (q *MemoryDelayQueue) processExpiredMessages(ctx context.Context) {
 	now := time.Now()
 	var expiredMessages []*DelayedMessage
 
@@ -78,11 +87,11 @@ func (q *MemoryDelayQueue) processExpiredMessages() {
 	q.mu.Unlock()
 
 	for _, delayedMsg := range expiredMessages {
-		q.processMessage(delayedMsg.Topic, delayedMsg.Key, delayedMsg.Message)
+		q.processMessage(ctx, delayedMsg.Topic, delayedMsg.Key, delayedMsg.Message)
 	}
 }
 
-func (q *MemoryDelayQueue) processMessage(topic, key string, message *dto.Message) {
+func (q *MemoryDelayQueue) processMessage(ctx context.Context, topic, key string, message *dto.Message) {
 	// 簡単な処理：ログ出力のみ
 	log.Printf(" PROCESSING DELAYED MESSAGE:")
 	log.Printf("   Topic: %s", topic)
@@ -100,4 +109,14 @@ func (q *MemoryDelayQueue) processMessage(topic, key string, message *dto.Messag
 			log.Printf("   -> Sending abandonment notification email/push...")
 		}
 	}
+
+	q.mu.RLock()
+	handlers := q.handlers
+	q.mu.RUnlock()
+
+	for _, handler := range handlers {
+		if err := handler(ctx, message); err != nil {
+			log.Printf("Error handling delayed message %s: %v", message.ID.String(), err)
+		}
+	}
 }
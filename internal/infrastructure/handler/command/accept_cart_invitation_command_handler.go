@@ -0,0 +1,43 @@
+package command
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/view"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/bus"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+)
+
+type AcceptCartInvitationCommandHandler struct {
+	commandBus bus.CommandBusInterface
+}
+
+func NewAcceptCartInvitationCommandHandler(commandBus bus.CommandBusInterface) *AcceptCartInvitationCommandHandler {
+	return &AcceptCartInvitationCommandHandler{
+		commandBus: commandBus,
+	}
+}
+
+func (h *AcceptCartInvitationCommandHandler) AcceptCartInvitation(w http.ResponseWriter, req *http.Request) {
+	vars := mux.Vars(req)
+
+	requestBody := input.AcceptCartInvitationInput{
+		CartID: vars["aggregate_id"],
+		UserID: vars["member_id"],
+	}
+
+	requestBody.IdempotencyKey = idempotencyKey(req, requestBody.IdempotencyKey)
+	if err := setPrecondition(req, vars["aggregate_id"], &requestBody.Precondition); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	httpView := view.NewHTTPCommandResultView(w)
+	commandPresenter := presenter.NewCommandResultPresenterImpl(httpView)
+
+	if err := h.commandBus.Dispatch(req.Context(), &requestBody, commandPresenter); err != nil {
+		commandPresenter.PresentError(req.Context(), err)
+	}
+}
@@ -0,0 +1,47 @@
+package command
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/view"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/bus"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+)
+
+type ApplyCouponCommandHandler struct {
+	commandBus bus.CommandBusInterface
+}
+
+func NewApplyCouponCommandHandler(commandBus bus.CommandBusInterface) *ApplyCouponCommandHandler {
+	return &ApplyCouponCommandHandler{
+		commandBus: commandBus,
+	}
+}
+
+func (h *ApplyCouponCommandHandler) ApplyCoupon(w http.ResponseWriter, req *http.Request) {
+	vars := mux.Vars(req)
+	aggregateID := vars["aggregate_id"]
+
+	var requestBody input.ApplyCouponInput
+	if err := json.NewDecoder(req.Body).Decode(&requestBody); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	requestBody.CartID = aggregateID
+	requestBody.IdempotencyKey = idempotencyKey(req, requestBody.IdempotencyKey)
+	if err := setPrecondition(req, aggregateID, &requestBody.Precondition); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	httpView := view.NewHTTPCommandResultView(w)
+	commandPresenter := presenter.NewCommandResultPresenterImpl(httpView)
+
+	if err := h.commandBus.Dispatch(req.Context(), &requestBody, commandPresenter); err != nil {
+		commandPresenter.PresentError(req.Context(), err)
+	}
+}
@@ -0,0 +1,43 @@
+package command
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/view"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/bus"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+)
+
+type AuthorizePaymentCommandHandler struct {
+	commandBus bus.CommandBusInterface
+}
+
+func NewAuthorizePaymentCommandHandler(commandBus bus.CommandBusInterface) *AuthorizePaymentCommandHandler {
+	return &AuthorizePaymentCommandHandler{
+		commandBus: commandBus,
+	}
+}
+
+func (h *AuthorizePaymentCommandHandler) AuthorizePayment(w http.ResponseWriter, req *http.Request) {
+	vars := mux.Vars(req)
+	aggregateID := vars["aggregate_id"]
+
+	var requestBody input.AuthorizePaymentInput
+	if err := json.NewDecoder(req.Body).Decode(&requestBody); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	requestBody.CartID = aggregateID
+	requestBody.IdempotencyKey = idempotencyKey(req, requestBody.IdempotencyKey)
+
+	httpView := view.NewHTTPCommandResultView(w)
+	commandPresenter := presenter.NewCommandResultPresenterImpl(httpView)
+
+	if err := h.commandBus.Dispatch(req.Context(), &requestBody, commandPresenter); err != nil {
+		commandPresenter.PresentError(req.Context(), err)
+	}
+}
@@ -0,0 +1,39 @@
+package command
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/view"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/bus"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+)
+
+type CapturePaymentCommandHandler struct {
+	commandBus bus.CommandBusInterface
+}
+
+func NewCapturePaymentCommandHandler(commandBus bus.CommandBusInterface) *CapturePaymentCommandHandler {
+	return &CapturePaymentCommandHandler{
+		commandBus: commandBus,
+	}
+}
+
+func (h *CapturePaymentCommandHandler) CapturePayment(w http.ResponseWriter, req *http.Request) {
+	vars := mux.Vars(req)
+	aggregateID := vars["aggregate_id"]
+
+	requestBody := input.CapturePaymentInput{
+		CartID: aggregateID,
+	}
+
+	requestBody.IdempotencyKey = idempotencyKey(req, requestBody.IdempotencyKey)
+
+	httpView := view.NewHTTPCommandResultView(w)
+	commandPresenter := presenter.NewCommandResultPresenterImpl(httpView)
+
+	if err := h.commandBus.Dispatch(req.Context(), &requestBody, commandPresenter); err != nil {
+		commandPresenter.PresentError(req.Context(), err)
+	}
+}
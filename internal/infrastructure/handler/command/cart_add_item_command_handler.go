@@ -7,17 +7,17 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/presenter"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/view"
-	commandUseCase "github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/bus"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
 )
 
 type CartAddItemCommandHandler struct {
-	cartAddItemCommand commandUseCase.CartAddItemCommandInterface
+	commandBus bus.CommandBusInterface
 }
 
-func NewCartAddItemCommandHandler(cartAddItemCommand commandUseCase.CartAddItemCommandInterface) *CartAddItemCommandHandler {
+func NewCartAddItemCommandHandler(commandBus bus.CommandBusInterface) *CartAddItemCommandHandler {
 	return &CartAddItemCommandHandler{
-		cartAddItemCommand: cartAddItemCommand,
+		commandBus: commandBus,
 	}
 }
 
@@ -32,11 +32,16 @@ func (h *CartAddItemCommandHandler) AddItemToCart(w http.ResponseWriter, req *ht
 	}
 
 	requestBody.CartID = aggregateID
+	requestBody.IdempotencyKey = idempotencyKey(req, requestBody.IdempotencyKey)
+	if err := setPrecondition(req, aggregateID, &requestBody.Precondition); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 
 	httpView := view.NewHTTPCommandResultView(w)
 	commandPresenter := presenter.NewCommandResultPresenterImpl(httpView)
 
-	if err := h.cartAddItemCommand.Execute(req.Context(), &requestBody, commandPresenter); err != nil {
+	if err := h.commandBus.Dispatch(req.Context(), &requestBody, commandPresenter); err != nil {
 		commandPresenter.PresentError(req.Context(), err)
 	}
 }
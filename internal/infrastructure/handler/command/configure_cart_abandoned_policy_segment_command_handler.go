@@ -0,0 +1,47 @@
+package command
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/view"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/bus"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+)
+
+type ConfigureCartAbandonedPolicySegmentCommandHandler struct {
+	commandBus bus.CommandBusInterface
+}
+
+func NewConfigureCartAbandonedPolicySegmentCommandHandler(commandBus bus.CommandBusInterface) *ConfigureCartAbandonedPolicySegmentCommandHandler {
+	return &ConfigureCartAbandonedPolicySegmentCommandHandler{
+		commandBus: commandBus,
+	}
+}
+
+func (h *ConfigureCartAbandonedPolicySegmentCommandHandler) ConfigureCartAbandonedPolicySegment(w http.ResponseWriter, req *http.Request) {
+	vars := mux.Vars(req)
+
+	var requestBody input.ConfigureCartAbandonedPolicySegmentInput
+	if err := json.NewDecoder(req.Body).Decode(&requestBody); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	requestBody.TenantID = vars["aggregate_id"]
+	requestBody.SegmentID = vars["segment_id"]
+	requestBody.IdempotencyKey = idempotencyKey(req, requestBody.IdempotencyKey)
+	if err := setPrecondition(req, vars["aggregate_id"], &requestBody.Precondition); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	httpView := view.NewHTTPCommandResultView(w)
+	commandPresenter := presenter.NewCommandResultPresenterImpl(httpView)
+
+	if err := h.commandBus.Dispatch(req.Context(), &requestBody, commandPresenter); err != nil {
+		commandPresenter.PresentError(req.Context(), err)
+	}
+}
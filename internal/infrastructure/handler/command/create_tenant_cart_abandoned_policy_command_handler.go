@@ -7,17 +7,17 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/presenter"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/view"
-	commandUseCase "github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/bus"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
 )
 
 type CreateTenantCartAbandonedPolicyCommandHandler struct {
-	createTenantCartAbandonedPolicyCommand commandUseCase.CreateTenantCartAbandonedPolicyCommandInterface
+	commandBus bus.CommandBusInterface
 }
 
-func NewCreateTenantCartAbandonedPolicyCommandHandler(createTenantCartAbandonedPolicyCommand commandUseCase.CreateTenantCartAbandonedPolicyCommandInterface) *CreateTenantCartAbandonedPolicyCommandHandler {
+func NewCreateTenantCartAbandonedPolicyCommandHandler(commandBus bus.CommandBusInterface) *CreateTenantCartAbandonedPolicyCommandHandler {
 	return &CreateTenantCartAbandonedPolicyCommandHandler{
-		createTenantCartAbandonedPolicyCommand: createTenantCartAbandonedPolicyCommand,
+		commandBus: commandBus,
 	}
 }
 
@@ -32,11 +32,12 @@ func (h *CreateTenantCartAbandonedPolicyCommandHandler) CreateTenantCartAbandone
 	}
 
 	requestBody.TenantID = aggregateID
+	requestBody.IdempotencyKey = idempotencyKey(req, requestBody.IdempotencyKey)
 
 	httpView := view.NewHTTPCommandResultView(w)
 	commandPresenter := presenter.NewCommandResultPresenterImpl(httpView)
 
-	if err := h.createTenantCartAbandonedPolicyCommand.Execute(req.Context(), &requestBody, commandPresenter); err != nil {
+	if err := h.commandBus.Dispatch(req.Context(), &requestBody, commandPresenter); err != nil {
 		commandPresenter.PresentError(req.Context(), err)
 	}
 }
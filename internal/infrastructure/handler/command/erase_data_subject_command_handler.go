@@ -0,0 +1,38 @@
+package command
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/view"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/bus"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+)
+
+type EraseDataSubjectCommandHandler struct {
+	commandBus bus.CommandBusInterface
+}
+
+func NewEraseDataSubjectCommandHandler(commandBus bus.CommandBusInterface) *EraseDataSubjectCommandHandler {
+	return &EraseDataSubjectCommandHandler{
+		commandBus: commandBus,
+	}
+}
+
+func (h *EraseDataSubjectCommandHandler) EraseDataSubject(w http.ResponseWriter, req *http.Request) {
+	vars := mux.Vars(req)
+
+	requestBody := input.EraseDataSubjectInput{
+		SubjectID: vars["subject_id"],
+	}
+
+	requestBody.IdempotencyKey = idempotencyKey(req, requestBody.IdempotencyKey)
+
+	httpView := view.NewHTTPCommandResultView(w)
+	commandPresenter := presenter.NewCommandResultPresenterImpl(httpView)
+
+	if err := h.commandBus.Dispatch(req.Context(), &requestBody, commandPresenter); err != nil {
+		commandPresenter.PresentError(req.Context(), err)
+	}
+}
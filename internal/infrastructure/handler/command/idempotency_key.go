@@ -0,0 +1,14 @@
+package command
+
+import "net/http"
+
+const idempotencyKeyHeader = "Idempotency-Key"
+
+// idempotencyKey prefers the Idempotency-Key header over the command_id field
+// of the body.
+func idempotencyKey(req *http.Request, commandID string) string {
+	if key := req.Header.Get(idempotencyKeyHeader); key != "" {
+		return key
+	}
+	return commandID
+}
@@ -0,0 +1,35 @@
+package command
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+)
+
+const ifMatchHeader = "If-Match"
+
+// setPrecondition makes the command expect the aggregate at the version of
+// the If-Match header, or else of the expected_version field of the body. The
+// header holds an ETag from a GET, such as "7"; "*" matches any version.
+func setPrecondition(req *http.Request, aggregateID string, precondition *input.Precondition) error {
+	precondition.AggregateID = aggregateID
+
+	ifMatch := strings.TrimSpace(req.Header.Get(ifMatchHeader))
+	if ifMatch == "" {
+		return nil
+	}
+	if ifMatch == "*" {
+		precondition.ExpectedVersion = nil
+		return nil
+	}
+
+	version, err := strconv.Atoi(strings.Trim(strings.TrimPrefix(ifMatch, "W/"), `"`))
+	if err != nil {
+		return fmt.Errorf("invalid %s header %q", ifMatchHeader, ifMatch)
+	}
+	precondition.ExpectedVersion = &version
+	return nil
+}
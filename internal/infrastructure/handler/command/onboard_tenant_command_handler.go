@@ -0,0 +1,38 @@
+package command
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/view"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/bus"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+)
+
+type OnboardTenantCommandHandler struct {
+	commandBus bus.CommandBusInterface
+}
+
+func NewOnboardTenantCommandHandler(commandBus bus.CommandBusInterface) *OnboardTenantCommandHandler {
+	return &OnboardTenantCommandHandler{
+		commandBus: commandBus,
+	}
+}
+
+func (h *OnboardTenantCommandHandler) OnboardTenant(w http.ResponseWriter, req *http.Request) {
+	var requestBody input.OnboardTenantInput
+	if err := json.NewDecoder(req.Body).Decode(&requestBody); err != nil {
+		http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		return
+	}
+
+	requestBody.IdempotencyKey = idempotencyKey(req, requestBody.IdempotencyKey)
+
+	httpView := view.NewHTTPCommandResultView(w)
+	commandPresenter := presenter.NewCommandResultPresenterImpl(httpView)
+
+	if err := h.commandBus.Dispatch(req.Context(), &requestBody, commandPresenter); err != nil {
+		commandPresenter.PresentError(req.Context(), err)
+	}
+}
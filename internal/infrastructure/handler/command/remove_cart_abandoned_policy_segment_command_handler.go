@@ -0,0 +1,43 @@
+package command
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/view"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/bus"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+)
+
+type RemoveCartAbandonedPolicySegmentCommandHandler struct {
+	commandBus bus.CommandBusInterface
+}
+
+func NewRemoveCartAbandonedPolicySegmentCommandHandler(commandBus bus.CommandBusInterface) *RemoveCartAbandonedPolicySegmentCommandHandler {
+	return &RemoveCartAbandonedPolicySegmentCommandHandler{
+		commandBus: commandBus,
+	}
+}
+
+func (h *RemoveCartAbandonedPolicySegmentCommandHandler) RemoveCartAbandonedPolicySegment(w http.ResponseWriter, req *http.Request) {
+	vars := mux.Vars(req)
+
+	requestBody := input.RemoveCartAbandonedPolicySegmentInput{
+		TenantID:  vars["aggregate_id"],
+		SegmentID: vars["segment_id"],
+	}
+
+	requestBody.IdempotencyKey = idempotencyKey(req, requestBody.IdempotencyKey)
+	if err := setPrecondition(req, vars["aggregate_id"], &requestBody.Precondition); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	httpView := view.NewHTTPCommandResultView(w)
+	commandPresenter := presenter.NewCommandResultPresenterImpl(httpView)
+
+	if err := h.commandBus.Dispatch(req.Context(), &requestBody, commandPresenter); err != nil {
+		commandPresenter.PresentError(req.Context(), err)
+	}
+}
@@ -0,0 +1,44 @@
+package command
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/view"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/bus"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+)
+
+type RemoveCartMemberCommandHandler struct {
+	commandBus bus.CommandBusInterface
+}
+
+func NewRemoveCartMemberCommandHandler(commandBus bus.CommandBusInterface) *RemoveCartMemberCommandHandler {
+	return &RemoveCartMemberCommandHandler{
+		commandBus: commandBus,
+	}
+}
+
+func (h *RemoveCartMemberCommandHandler) RemoveCartMember(w http.ResponseWriter, req *http.Request) {
+	vars := mux.Vars(req)
+
+	requestBody := input.RemoveCartMemberInput{
+		CartID:   vars["aggregate_id"],
+		UserID:   req.URL.Query().Get("user_id"),
+		MemberID: vars["member_id"],
+	}
+
+	requestBody.IdempotencyKey = idempotencyKey(req, requestBody.IdempotencyKey)
+	if err := setPrecondition(req, vars["aggregate_id"], &requestBody.Precondition); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	httpView := view.NewHTTPCommandResultView(w)
+	commandPresenter := presenter.NewCommandResultPresenterImpl(httpView)
+
+	if err := h.commandBus.Dispatch(req.Context(), &requestBody, commandPresenter); err != nil {
+		commandPresenter.PresentError(req.Context(), err)
+	}
+}
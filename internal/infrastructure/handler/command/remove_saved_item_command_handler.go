@@ -0,0 +1,40 @@
+package command
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/view"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/bus"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+)
+
+type RemoveSavedItemCommandHandler struct {
+	commandBus bus.CommandBusInterface
+}
+
+func NewRemoveSavedItemCommandHandler(commandBus bus.CommandBusInterface) *RemoveSavedItemCommandHandler {
+	return &RemoveSavedItemCommandHandler{
+		commandBus: commandBus,
+	}
+}
+
+func (h *RemoveSavedItemCommandHandler) RemoveSavedItem(w http.ResponseWriter, req *http.Request) {
+	vars := mux.Vars(req)
+
+	requestBody := input.RemoveSavedItemInput{
+		UserID:   vars["user_id"],
+		TenantID: req.URL.Query().Get("tenant_id"),
+		ItemID:   vars["item_id"],
+	}
+
+	requestBody.IdempotencyKey = idempotencyKey(req, requestBody.IdempotencyKey)
+
+	httpView := view.NewHTTPCommandResultView(w)
+	commandPresenter := presenter.NewCommandResultPresenterImpl(httpView)
+
+	if err := h.commandBus.Dispatch(req.Context(), &requestBody, commandPresenter); err != nil {
+		commandPresenter.PresentError(req.Context(), err)
+	}
+}
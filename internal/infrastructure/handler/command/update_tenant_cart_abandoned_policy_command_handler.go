@@ -7,17 +7,17 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/presenter"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/view"
-	commandUseCase "github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/bus"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
 )
 
 type UpdateTenantCartAbandonedPolicyCommandHandler struct {
-	updateTenantCartAbandonedPolicyCommand commandUseCase.UpdateTenantCartAbandonedPolicyCommandInterface
+	commandBus bus.CommandBusInterface
 }
 
-func NewUpdateTenantCartAbandonedPolicyCommandHandler(updateTenantCartAbandonedPolicyCommand commandUseCase.UpdateTenantCartAbandonedPolicyCommandInterface) *UpdateTenantCartAbandonedPolicyCommandHandler {
+func NewUpdateTenantCartAbandonedPolicyCommandHandler(commandBus bus.CommandBusInterface) *UpdateTenantCartAbandonedPolicyCommandHandler {
 	return &UpdateTenantCartAbandonedPolicyCommandHandler{
-		updateTenantCartAbandonedPolicyCommand: updateTenantCartAbandonedPolicyCommand,
+		commandBus: commandBus,
 	}
 }
 
@@ -32,11 +32,16 @@ func (h *UpdateTenantCartAbandonedPolicyCommandHandler) UpdateTenantCartAbandone
 	}
 
 	requestBody.TenantID = aggregateID
+	requestBody.IdempotencyKey = idempotencyKey(req, requestBody.IdempotencyKey)
+	if err := setPrecondition(req, aggregateID, &requestBody.Precondition); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 
 	httpView := view.NewHTTPCommandResultView(w)
 	commandPresenter := presenter.NewCommandResultPresenterImpl(httpView)
 
-	if err := h.updateTenantCartAbandonedPolicyCommand.Execute(req.Context(), &requestBody, commandPresenter); err != nil {
+	if err := h.commandBus.Dispatch(req.Context(), &requestBody, commandPresenter); err != nil {
 		commandPresenter.PresentError(req.Context(), err)
 	}
 }
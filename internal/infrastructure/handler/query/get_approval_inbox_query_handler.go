@@ -0,0 +1,33 @@
+package query
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/view"
+	queryUseCase "github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/query"
+)
+
+type GetApprovalInboxQueryHandler struct {
+	getApprovalInboxQuery queryUseCase.GetApprovalInboxQueryInterface
+}
+
+func NewGetApprovalInboxQueryHandler(getApprovalInboxQuery queryUseCase.GetApprovalInboxQueryInterface) *GetApprovalInboxQueryHandler {
+	return &GetApprovalInboxQueryHandler{
+		getApprovalInboxQuery: getApprovalInboxQuery,
+	}
+}
+
+func (h *GetApprovalInboxQueryHandler) GetApprovalInbox(w http.ResponseWriter, req *http.Request) {
+	vars := mux.Vars(req)
+	tenantID := vars["aggregate_id"]
+	approverID := req.URL.Query().Get("approver_id")
+
+	httpView := view.NewHTTPQueryResultView(w)
+	queryPresenter := presenter.NewQueryResultPresenterImpl(httpView)
+
+	if err := h.getApprovalInboxQuery.Query(req.Context(), tenantID, approverID, queryPresenter); err != nil {
+		queryPresenter.PresentError(req.Context(), err)
+	}
+}
@@ -2,31 +2,63 @@ package query
 
 import (
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/presenter"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/view"
 	queryUseCase "github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/query"
 )
 
 type GetCartQueryHandler struct {
-	getCartQuery queryUseCase.GetCartQueryInterface
+	getCartQuery     queryUseCase.GetCartQueryInterface
+	getCartAsOfQuery queryUseCase.GetCartAsOfQueryInterface
 }
 
-func NewGetCartQueryHandler(getCartQuery queryUseCase.GetCartQueryInterface) *GetCartQueryHandler {
+func NewGetCartQueryHandler(getCartQuery queryUseCase.GetCartQueryInterface, getCartAsOfQuery queryUseCase.GetCartAsOfQueryInterface) *GetCartQueryHandler {
 	return &GetCartQueryHandler{
-		getCartQuery: getCartQuery,
+		getCartQuery:     getCartQuery,
+		getCartAsOfQuery: getCartAsOfQuery,
 	}
 }
 
+// GetCart returns the cart from the read model, or with asOfVersion or asOf
+// (RFC 3339) the cart as it was then, replayed from its events.
 func (h *GetCartQueryHandler) GetCart(w http.ResponseWriter, req *http.Request) {
 	vars := mux.Vars(req)
 	aggregateID := vars["aggregate_id"]
+	asOfVersion := req.URL.Query().Get("asOfVersion")
+	asOf := req.URL.Query().Get("asOf")
 
 	httpView := view.NewHTTPQueryResultView(w)
 	queryPresenter := presenter.NewQueryResultPresenterImpl(httpView)
 
-	if err := h.getCartQuery.Query(req.Context(), aggregateID, queryPresenter); err != nil {
+	var err error
+	switch {
+	case asOfVersion != "" && asOf != "":
+		err = errors.InvalidParameter.New("asOfVersion and asOf cannot be combined")
+	case asOfVersion != "":
+		var version int
+		version, err = strconv.Atoi(asOfVersion)
+		if err != nil {
+			err = errors.InvalidParameter.Wrap(err, "invalid asOfVersion")
+			break
+		}
+		err = h.getCartAsOfQuery.QueryAsOfVersion(req.Context(), aggregateID, version, queryPresenter)
+	case asOf != "":
+		var at time.Time
+		at, err = time.Parse(time.RFC3339, asOf)
+		if err != nil {
+			err = errors.InvalidParameter.Wrap(err, "invalid asOf")
+			break
+		}
+		err = h.getCartAsOfQuery.QueryAsOf(req.Context(), aggregateID, at, queryPresenter)
+	default:
+		err = h.getCartQuery.Query(req.Context(), aggregateID, queryPresenter)
+	}
+	if err != nil {
 		queryPresenter.PresentError(req.Context(), err)
 	}
 }
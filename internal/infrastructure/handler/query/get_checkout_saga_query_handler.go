@@ -0,0 +1,32 @@
+package query
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/view"
+	queryUseCase "github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/query"
+)
+
+type GetCheckoutSagaQueryHandler struct {
+	getCheckoutSagaQuery queryUseCase.GetCheckoutSagaQueryInterface
+}
+
+func NewGetCheckoutSagaQueryHandler(getCheckoutSagaQuery queryUseCase.GetCheckoutSagaQueryInterface) *GetCheckoutSagaQueryHandler {
+	return &GetCheckoutSagaQueryHandler{
+		getCheckoutSagaQuery: getCheckoutSagaQuery,
+	}
+}
+
+func (h *GetCheckoutSagaQueryHandler) GetCheckoutSaga(w http.ResponseWriter, req *http.Request) {
+	vars := mux.Vars(req)
+	cartID := vars["aggregate_id"]
+
+	httpView := view.NewHTTPQueryResultView(w)
+	queryPresenter := presenter.NewQueryResultPresenterImpl(httpView)
+
+	if err := h.getCheckoutSagaQuery.Query(req.Context(), cartID, queryPresenter); err != nil {
+		queryPresenter.PresentError(req.Context(), err)
+	}
+}
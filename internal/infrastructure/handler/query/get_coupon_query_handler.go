@@ -0,0 +1,33 @@
+package query
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/view"
+	queryUseCase "github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/query"
+)
+
+type GetCouponQueryHandler struct {
+	getCouponQuery queryUseCase.GetCouponQueryInterface
+}
+
+func NewGetCouponQueryHandler(getCouponQuery queryUseCase.GetCouponQueryInterface) *GetCouponQueryHandler {
+	return &GetCouponQueryHandler{
+		getCouponQuery: getCouponQuery,
+	}
+}
+
+func (h *GetCouponQueryHandler) GetCoupon(w http.ResponseWriter, req *http.Request) {
+	vars := mux.Vars(req)
+	tenantID := vars["aggregate_id"]
+	code := vars["code"]
+
+	httpView := view.NewHTTPQueryResultView(w)
+	queryPresenter := presenter.NewQueryResultPresenterImpl(httpView)
+
+	if err := h.getCouponQuery.Query(req.Context(), tenantID, code, queryPresenter); err != nil {
+		queryPresenter.PresentError(req.Context(), err)
+	}
+}
@@ -0,0 +1,32 @@
+package query
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/view"
+	queryUseCase "github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/query"
+)
+
+type GetDataSubjectRequestQueryHandler struct {
+	getDataSubjectRequestQuery queryUseCase.GetDataSubjectRequestQueryInterface
+}
+
+func NewGetDataSubjectRequestQueryHandler(getDataSubjectRequestQuery queryUseCase.GetDataSubjectRequestQueryInterface) *GetDataSubjectRequestQueryHandler {
+	return &GetDataSubjectRequestQueryHandler{
+		getDataSubjectRequestQuery: getDataSubjectRequestQuery,
+	}
+}
+
+func (h *GetDataSubjectRequestQueryHandler) GetDataSubjectRequest(w http.ResponseWriter, req *http.Request) {
+	vars := mux.Vars(req)
+	requestID := vars["request_id"]
+
+	httpView := view.NewHTTPQueryResultView(w)
+	queryPresenter := presenter.NewQueryResultPresenterImpl(httpView)
+
+	if err := h.getDataSubjectRequestQuery.Query(req.Context(), requestID, queryPresenter); err != nil {
+		queryPresenter.PresentError(req.Context(), err)
+	}
+}
@@ -0,0 +1,102 @@
+package query
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/view"
+	queryUseCase "github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/query"
+)
+
+type GetEventHistoryQueryHandler struct {
+	getEventHistoryQuery queryUseCase.GetEventHistoryQueryInterface
+}
+
+func NewGetEventHistoryQueryHandler(getEventHistoryQuery queryUseCase.GetEventHistoryQueryInterface) *GetEventHistoryQueryHandler {
+	return &GetEventHistoryQueryHandler{
+		getEventHistoryQuery: getEventHistoryQuery,
+	}
+}
+
+func (h *GetEventHistoryQueryHandler) GetEventHistory(w http.ResponseWriter, req *http.Request) {
+	vars := mux.Vars(req)
+	aggregateID := vars["aggregate_id"]
+
+	httpView := view.NewHTTPQueryResultView(w)
+	queryPresenter := presenter.NewQueryResultPresenterImpl(httpView)
+
+	filter, err := eventHistoryFilter(req.URL.Query())
+	if err == nil {
+		err = h.getEventHistoryQuery.Query(req.Context(), aggregateID, filter, queryPresenter)
+	}
+	if err != nil {
+		queryPresenter.PresentError(req.Context(), err)
+	}
+}
+
+func eventHistoryFilter(params url.Values) (queryUseCase.EventHistoryFilter, error) {
+	filter := queryUseCase.EventHistoryFilter{
+		Cursor: params.Get("cursor"),
+	}
+
+	var err error
+	if filter.FromVersion, err = versionParam(params, "fromVersion"); err != nil {
+		return filter, err
+	}
+	if filter.ToVersion, err = versionParam(params, "toVersion"); err != nil {
+		return filter, err
+	}
+	if filter.From, err = timeParam(params, "from"); err != nil {
+		return filter, err
+	}
+	if filter.To, err = timeParam(params, "to"); err != nil {
+		return filter, err
+	}
+
+	if value := params.Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit <= 0 {
+			return filter, errors.InvalidParameter.New("invalid limit")
+		}
+		filter.Limit = limit
+	}
+
+	if value := params.Get("includeMetadata"); value != "" {
+		include, err := strconv.ParseBool(value)
+		if err != nil {
+			return filter, errors.InvalidParameter.Wrap(err, "invalid includeMetadata")
+		}
+		filter.IncludeMetadata = include
+	}
+
+	return filter, nil
+}
+
+func versionParam(params url.Values, name string) (*int, error) {
+	value := params.Get(name)
+	if value == "" {
+		return nil, nil
+	}
+	version, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, errors.InvalidParameter.Wrap(err, "invalid "+name)
+	}
+	return &version, nil
+}
+
+func timeParam(params url.Values, name string) (time.Time, error) {
+	value := params.Get(name)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	at, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, errors.InvalidParameter.Wrap(err, "invalid "+name)
+	}
+	return at, nil
+}
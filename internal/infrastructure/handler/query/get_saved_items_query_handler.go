@@ -0,0 +1,33 @@
+package query
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/view"
+	queryUseCase "github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/query"
+)
+
+type GetSavedItemsQueryHandler struct {
+	getSavedItemsQuery queryUseCase.GetSavedItemsQueryInterface
+}
+
+func NewGetSavedItemsQueryHandler(getSavedItemsQuery queryUseCase.GetSavedItemsQueryInterface) *GetSavedItemsQueryHandler {
+	return &GetSavedItemsQueryHandler{
+		getSavedItemsQuery: getSavedItemsQuery,
+	}
+}
+
+func (h *GetSavedItemsQueryHandler) GetSavedItems(w http.ResponseWriter, req *http.Request) {
+	vars := mux.Vars(req)
+	userID := vars["user_id"]
+	tenantID := req.URL.Query().Get("tenant_id")
+
+	httpView := view.NewHTTPQueryResultView(w)
+	queryPresenter := presenter.NewQueryResultPresenterImpl(httpView)
+
+	if err := h.getSavedItemsQuery.Query(req.Context(), tenantID, userID, queryPresenter); err != nil {
+		queryPresenter.PresentError(req.Context(), err)
+	}
+}
@@ -0,0 +1,32 @@
+package query
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/view"
+	queryUseCase "github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/query"
+)
+
+type GetTenantCartRulesQueryHandler struct {
+	getTenantCartRulesQuery queryUseCase.GetTenantCartRulesQueryInterface
+}
+
+func NewGetTenantCartRulesQueryHandler(getTenantCartRulesQuery queryUseCase.GetTenantCartRulesQueryInterface) *GetTenantCartRulesQueryHandler {
+	return &GetTenantCartRulesQueryHandler{
+		getTenantCartRulesQuery: getTenantCartRulesQuery,
+	}
+}
+
+func (h *GetTenantCartRulesQueryHandler) GetTenantCartRules(w http.ResponseWriter, req *http.Request) {
+	vars := mux.Vars(req)
+	tenantID := vars["aggregate_id"]
+
+	httpView := view.NewHTTPQueryResultView(w)
+	queryPresenter := presenter.NewQueryResultPresenterImpl(httpView)
+
+	if err := h.getTenantCartRulesQuery.Query(req.Context(), tenantID, queryPresenter); err != nil {
+		queryPresenter.PresentError(req.Context(), err)
+	}
+}
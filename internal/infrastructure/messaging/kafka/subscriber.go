@@ -35,7 +35,7 @@ func (es *EventSubscriber) Subscribe(handler func(context.Context, event.Event)
 			return err
 		}
 
-		domainEvent, err := es.deserializer.Deserialize(message.Type, dataBytes)
+		domainEvent, err := es.deserializer.Deserialize(ctx, message.Type, dataBytes)
 		if err != nil {
 			return err
 		}
@@ -11,6 +11,15 @@ func NewStaticTopicRouter() messaging.TopicRouter {
 		aggregateTopicMap: map[string]string{
 			"Cart":                      "ec.cart-events",
 			"TenantCartAbandonedPolicy": "ec.cart-events",
+			"CheckoutSaga":              "ec.checkout-events",
+			"Payment":                   "ec.payment-events",
+			"Coupon":                    "ec.cart-events",
+			"TenantTaxSettings":         "ec.cart-events",
+			"TenantShippingRates":       "ec.cart-events",
+			"SavedList":                 "ec.cart-events",
+			"TenantApprovalPolicy":      "ec.cart-events",
+			"TenantCartRules":           "ec.cart-events",
+			"Tenant":                    "ec.cart-events",
 		},
 	}
 }
@@ -0,0 +1,34 @@
+package metrics
+
+import (
+	"expvar"
+	"time"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/bus"
+)
+
+const commandMetricsName = "commands"
+
+// ExpvarCommandMetrics counts commands by name and outcome and sums up their
+// durations. The counters are served as JSON under /debug/vars.
+type ExpvarCommandMetrics struct {
+	commands *expvar.Map
+}
+
+func NewExpvarCommandMetrics() bus.CommandMetrics {
+	// expvar names are process-wide, so a second container reuses the map
+	commands, ok := expvar.Get(commandMetricsName).(*expvar.Map)
+	if !ok {
+		commands = expvar.NewMap(commandMetricsName)
+	}
+	return &ExpvarCommandMetrics{commands: commands}
+}
+
+func (m *ExpvarCommandMetrics) ObserveCommand(name string, duration time.Duration, err error) {
+	outcome := "succeeded"
+	if err != nil {
+		outcome = "failed"
+	}
+	m.commands.Add(name+"."+outcome, 1)
+	m.commands.Add(name+".duration_ms", duration.Milliseconds())
+}
@@ -0,0 +1,114 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/gateway"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/gateway/dto"
+)
+
+// Magic card numbers understood by the fake gateway. Any other card number
+// is approved.
+const (
+	CardDeclined          = "4000000000000002"
+	CardInsufficientFunds = "4000000000009995"
+	CardExpired           = "4000000000000069"
+	CardProcessingError   = "4000000000000119"
+)
+
+var declineReasons = map[string]string{
+	CardDeclined:          "card declined",
+	CardInsufficientFunds: "insufficient funds",
+	CardExpired:           "expired card",
+}
+
+var authorizationNamespace = uuid.MustParse("5a7c9e1b-3d2f-4b6a-8c0e-1f2a3b4c5d6e")
+
+type fakeAuthorization struct {
+	amount   float64
+	captured bool
+	refunded bool
+}
+
+type FakePaymentGateway struct {
+	mu             sync.Mutex
+	results        map[string]*dto.PaymentAuthorizationResult
+	authorizations map[string]*fakeAuthorization
+}
+
+func NewFakePaymentGateway() gateway.PaymentGateway {
+	return &FakePaymentGateway{
+		results:        make(map[string]*dto.PaymentAuthorizationResult),
+		authorizations: make(map[string]*fakeAuthorization),
+	}
+}
+
+func (g *FakePaymentGateway) Authorize(ctx context.Context, req *dto.PaymentAuthorizationRequest) (*dto.PaymentAuthorizationResult, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if result, ok := g.results[req.IdempotencyKey]; ok {
+		return result, nil
+	}
+
+	if req.CardNumber == CardProcessingError {
+		return nil, fmt.Errorf("payment gateway processing error")
+	}
+
+	result := &dto.PaymentAuthorizationResult{}
+	if reason, ok := declineReasons[req.CardNumber]; ok {
+		result.DeclineReason = reason
+	} else {
+		result.Approved = true
+		result.AuthorizationID = "auth_" + uuid.NewSHA1(authorizationNamespace, []byte(req.IdempotencyKey)).String()
+		g.authorizations[result.AuthorizationID] = &fakeAuthorization{amount: req.Amount}
+	}
+
+	g.results[req.IdempotencyKey] = result
+	return result, nil
+}
+
+func (g *FakePaymentGateway) Capture(ctx context.Context, authorizationID string, amount float64) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	auth, ok := g.authorizations[authorizationID]
+	if !ok {
+		return fmt.Errorf("authorization %s not found", authorizationID)
+	}
+
+	if auth.captured {
+		return nil
+	}
+
+	if amount > auth.amount {
+		return fmt.Errorf("capture amount exceeds authorized amount")
+	}
+
+	auth.captured = true
+	return nil
+}
+
+func (g *FakePaymentGateway) Refund(ctx context.Context, authorizationID string, amount float64) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	auth, ok := g.authorizations[authorizationID]
+	if !ok {
+		return fmt.Errorf("authorization %s not found", authorizationID)
+	}
+
+	if auth.refunded {
+		return nil
+	}
+
+	if !auth.captured {
+		return fmt.Errorf("authorization %s has not been captured", authorizationID)
+	}
+
+	auth.refunded = true
+	return nil
+}
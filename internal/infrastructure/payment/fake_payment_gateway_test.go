@@ -0,0 +1,116 @@
+package payment_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/payment"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/gateway/dto"
+)
+
+func TestFakePaymentGateway_Authorize(t *testing.T) {
+	tests := map[string]struct {
+		cardNumber   string
+		wantApproved bool
+		wantReason   string
+		wantErr      bool
+	}{
+		"approves regular card": {
+			cardNumber:   "4242424242424242",
+			wantApproved: true,
+		},
+		"declines card": {
+			cardNumber: payment.CardDeclined,
+			wantReason: "card declined",
+		},
+		"declines for insufficient funds": {
+			cardNumber: payment.CardInsufficientFunds,
+			wantReason: "insufficient funds",
+		},
+		"declines expired card": {
+			cardNumber: payment.CardExpired,
+			wantReason: "expired card",
+		},
+		"returns processing error": {
+			cardNumber: payment.CardProcessingError,
+			wantErr:    true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			gw := payment.NewFakePaymentGateway()
+
+			// Act
+			result, err := gw.Authorize(context.Background(), &dto.PaymentAuthorizationRequest{
+				IdempotencyKey: "payment-1",
+				CardNumber:     tt.cardNumber,
+				Amount:         100.0,
+			})
+
+			// Assert
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantApproved, result.Approved)
+			require.Equal(t, tt.wantReason, result.DeclineReason)
+			if tt.wantApproved {
+				require.NotEmpty(t, result.AuthorizationID)
+			}
+		})
+	}
+}
+
+func TestFakePaymentGateway_AuthorizeIsIdempotent(t *testing.T) {
+	// Arrange
+	gw := payment.NewFakePaymentGateway()
+	req := &dto.PaymentAuthorizationRequest{
+		IdempotencyKey: "payment-1",
+		CardNumber:     "4242424242424242",
+		Amount:         100.0,
+	}
+
+	// Act
+	first, err := gw.Authorize(context.Background(), req)
+	require.NoError(t, err)
+	second, err := gw.Authorize(context.Background(), req)
+	require.NoError(t, err)
+	other, err := gw.Authorize(context.Background(), &dto.PaymentAuthorizationRequest{
+		IdempotencyKey: "payment-2",
+		CardNumber:     "4242424242424242",
+		Amount:         100.0,
+	})
+	require.NoError(t, err)
+
+	// Assert
+	require.Equal(t, first.AuthorizationID, second.AuthorizationID)
+	require.NotEqual(t, first.AuthorizationID, other.AuthorizationID)
+}
+
+func TestFakePaymentGateway_CaptureAndRefund(t *testing.T) {
+	// Arrange
+	ctx := context.Background()
+	gw := payment.NewFakePaymentGateway()
+	result, err := gw.Authorize(ctx, &dto.PaymentAuthorizationRequest{
+		IdempotencyKey: "payment-1",
+		CardNumber:     "4242424242424242",
+		Amount:         100.0,
+	})
+	require.NoError(t, err)
+
+	// Act & Assert
+	require.Error(t, gw.Refund(ctx, result.AuthorizationID, 100.0), "refund before capture")
+	require.Error(t, gw.Capture(ctx, result.AuthorizationID, 120.0), "capture above authorized amount")
+	require.Error(t, gw.Capture(ctx, "unknown", 100.0), "capture unknown authorization")
+	require.NoError(t, gw.Capture(ctx, result.AuthorizationID, 100.0))
+	require.NoError(t, gw.Capture(ctx, result.AuthorizationID, 100.0))
+	require.NoError(t, gw.Refund(ctx, result.AuthorizationID, 100.0))
+	require.NoError(t, gw.Refund(ctx, result.AuthorizationID, 100.0))
+}
@@ -21,6 +21,10 @@ func NewCommandResultPresenterImpl(view CommandView) presenter.CommandResultPres
 }
 
 func (p *CommandResultPresenterImpl) PresentSuccess(ctx context.Context, aggregateID string, version int, events []event.Event) error {
+	return p.PresentSuccessAt(ctx, aggregateID, version, events, time.Now())
+}
+
+func (p *CommandResultPresenterImpl) PresentSuccessAt(ctx context.Context, aggregateID string, version int, events []event.Event, executedAt time.Time) error {
 	eventVMs := make([]viewmodel.EventViewModel, 0, len(events))
 	for _, ev := range events {
 		eventVMs = append(eventVMs, viewmodel.EventViewModel{
@@ -36,7 +40,7 @@ func (p *CommandResultPresenterImpl) PresentSuccess(ctx context.Context, aggrega
 		Version:     version,
 		Events:      eventVMs,
 		Status:      "success",
-		ExecutedAt:  time.Now().Format(time.RFC3339),
+		ExecutedAt:  executedAt.Format(time.RFC3339),
 	}
 
 	return p.view.Render(ctx, vm, 200, nil)
@@ -59,7 +63,10 @@ func (p *CommandResultPresenterImpl) determineStatusCode(err error) int {
 	if errors.IsCode(err, errors.NotFound) {
 		return 404
 	}
-	if errors.IsCode(err, errors.OptimisticLock) {
+	if errors.IsCode(err, errors.PreconditionFailed) {
+		return 412
+	}
+	if errors.IsCode(err, errors.OptimisticLock) || errors.IsCode(err, errors.UnpermittedOp) {
 		return 409
 	}
 	return 500
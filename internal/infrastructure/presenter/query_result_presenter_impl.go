@@ -21,6 +21,10 @@ func (p *QueryResultPresenterImpl) PresentSuccess(ctx context.Context, data []by
 	return p.view.Success(data)
 }
 
+func (p *QueryResultPresenterImpl) PresentVersionedSuccess(ctx context.Context, data []byte, version int) error {
+	return p.view.VersionedSuccess(data, version)
+}
+
 func (p *QueryResultPresenterImpl) PresentError(ctx context.Context, err error) error {
 	return p.view.Error(err)
 }
@@ -0,0 +1,114 @@
+package approval
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/gateway"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+type CartApprovalProjectorImpl struct {
+	viewRepo readmodelstore.CartApprovalStore
+	seen     map[string]struct{}
+}
+
+func NewCartApprovalProjector(viewRepo readmodelstore.CartApprovalStore) gateway.Projector {
+	return &CartApprovalProjectorImpl{
+		viewRepo: viewRepo,
+		seen:     make(map[string]struct{}),
+	}
+}
+
+func (p *CartApprovalProjectorImpl) Handle(ctx context.Context, e event.Event) error {
+	eventID := e.GetEventID().String()
+	if _, ok := p.seen[eventID]; ok {
+		return nil
+	}
+	p.seen[eventID] = struct{}{}
+
+	switch e.(type) {
+	case *event.CartApprovalRequestedEvent, *event.CartApprovedEvent, *event.CartApprovalRejectedEvent,
+		*event.CartChangesRequestedEvent, *event.CartApprovalExpiredEvent:
+		cartID := e.GetAggregateID().String()
+
+		current, err := p.viewRepo.Get(ctx, cartID)
+		if err != nil {
+			if errors.IsCode(err, errors.NotFound) {
+				current = nil
+			} else {
+				return err
+			}
+		}
+
+		updated := p.applyToView(current, e)
+		if updated == nil {
+			return nil
+		}
+
+		return p.viewRepo.Upsert(ctx, updated)
+	default:
+		return nil
+	}
+}
+
+func (p *CartApprovalProjectorImpl) Start(ctx context.Context, bus gateway.EventSubscriber) error {
+	bus.Subscribe(p.Handle)
+	return nil
+}
+
+func (p *CartApprovalProjectorImpl) applyToView(view *dto.CartApprovalViewDTO, e event.Event) *dto.CartApprovalViewDTO {
+	// A new request replaces the outcome of the previous one
+	if evt, ok := e.(*event.CartApprovalRequestedEvent); ok {
+		return &dto.CartApprovalViewDTO{
+			CartID:      evt.GetAggregateID().String(),
+			TenantID:    evt.GetTenantID().String(),
+			Status:      "PENDING",
+			Amount:      evt.GetAmount(),
+			RequestedBy: userID(evt.GetRequestedBy()),
+			RequestedAt: evt.GetTimestamp(),
+			DeadlineAt:  evt.GetDeadlineAt(),
+			Version:     evt.GetVersion(),
+		}
+	}
+
+	if view == nil {
+		return nil
+	}
+
+	decidedAt := e.GetTimestamp()
+	updated := *view
+	updated.DecidedAt = &decidedAt
+	updated.Version = e.GetVersion()
+
+	switch evt := e.(type) {
+	case *event.CartApprovedEvent:
+		updated.Status = "APPROVED"
+		updated.DecidedBy = userID(evt.GetApprovedBy())
+		updated.Comment = evt.GetComment()
+	case *event.CartApprovalRejectedEvent:
+		updated.Status = "REJECTED"
+		updated.DecidedBy = userID(evt.GetRejectedBy())
+		updated.Comment = evt.GetComment()
+	case *event.CartChangesRequestedEvent:
+		updated.Status = "CHANGES_REQUESTED"
+		updated.DecidedBy = userID(evt.GetRequestedBy())
+		updated.Comment = evt.GetComment()
+	case *event.CartApprovalExpiredEvent:
+		updated.Status = "EXPIRED"
+	}
+
+	return &updated
+}
+
+// userID leaves out a user who has been erased, who reads as the nil ID.
+func userID(id uuid.UUID) string {
+	if id == uuid.Nil {
+		return ""
+	}
+	return id.String()
+}
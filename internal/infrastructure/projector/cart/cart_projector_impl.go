@@ -2,8 +2,12 @@ package cart
 
 import (
 	"context"
+	"math"
+
+	"github.com/google/uuid"
 
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/gateway"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore"
@@ -31,7 +35,12 @@ func (p *CartProjectorImpl) Handle(ctx context.Context, e event.Event) error {
 	p.seen[eventID] = struct{}{}
 
 	switch e.(type) {
-	case *event.CartCreatedEvent, *event.ItemAddedToCartEvent, *event.CartSubmittedEvent:
+	case *event.CartCreatedEvent, *event.ItemAddedToCartEvent, *event.CartSubmittedEvent,
+		*event.CouponAppliedToCartEvent, *event.CouponRemovedFromCartEvent,
+		*event.ShippingAddressSetEvent, *event.ShippingMethodSelectedEvent, *event.CartMergedEvent, *event.CartClosedEvent,
+		*event.CartMemberInvitedEvent, *event.CartInvitationAcceptedEvent, *event.CartMemberRemovedEvent,
+		*event.CartApprovalRequestedEvent, *event.CartApprovedEvent, *event.CartApprovalRejectedEvent,
+		*event.CartChangesRequestedEvent, *event.CartApprovalExpiredEvent, *event.CartItemMovedToSavedListEvent:
 		aggID := e.GetAggregateID().String()
 
 		current, err := p.viewRepo.Get(ctx, aggID)
@@ -67,14 +76,28 @@ func (p *CartProjectorImpl) Start(ctx context.Context, bus gateway.EventSubscrib
 func (p *CartProjectorImpl) applyToView(view *dto.CartViewDTO, e event.Event) *dto.CartViewDTO {
 	switch evt := e.(type) {
 	case *event.CartCreatedEvent:
+		// Guest carts have no user until they are merged, and an erased user
+		// reads as the nil ID, leaving the cart as anonymised
+		userID := ""
+		if evt.GetUserID() != uuid.Nil {
+			userID = evt.GetUserID().String()
+		}
+		sessionID := evt.GetSessionID()
+		if sessionID == event.Redacted {
+			sessionID = ""
+		}
+
 		return &dto.CartViewDTO{
 			ID:          evt.GetAggregateID().String(),
-			UserID:      evt.GetUserID().String(),
+			UserID:      userID,
+			SessionID:   sessionID,
 			TenantID:    evt.GetTenantID().String(),
 			Status:      "OPEN",
+			Subtotal:    0.0,
 			TotalAmount: 0.0,
 			ItemCount:   0,
 			Items:       []dto.CartItemViewDTO{},
+			Discounts:   []dto.CartDiscountViewDTO{},
 			CreatedAt:   evt.GetTimestamp(),
 			UpdatedAt:   evt.GetTimestamp(),
 			Version:     evt.GetVersion(),
@@ -87,52 +110,373 @@ func (p *CartProjectorImpl) applyToView(view *dto.CartViewDTO, e event.Event) *d
 		newItems := make([]dto.CartItemViewDTO, len(view.Items))
 		copy(newItems, view.Items)
 
-		newItems = append(newItems, dto.CartItemViewDTO{
-			ID:     evt.GetItemID().String(),
-			CartID: evt.GetAggregateID().String(),
-			Name:   evt.GetName(),
-			Price:  evt.GetPrice(),
+		// Lines added before carts could be shared were all added by the owner
+		addedBy := view.UserID
+		if evt.GetAddedBy() != uuid.Nil {
+			addedBy = evt.GetAddedBy().String()
+		}
+
+		options := evt.GetLineOptions()
+		lineID := options.LineID(evt.GetItemID()).String()
+
+		found := false
+		for i := range newItems {
+			if newItems[i].LineID == lineID {
+				newItems[i].Quantity++
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			taxCategory, _ := value.NewTaxCategory(evt.GetTaxCategory())
+			newItems = append(newItems, dto.CartItemViewDTO{
+				ID:          evt.GetItemID().String(),
+				LineID:      lineID,
+				CartID:      evt.GetAggregateID().String(),
+				Name:        evt.GetName(),
+				Price:       evt.GetPrice() + options.PriceModifier(),
+				Quantity:    1,
+				TaxCategory: taxCategory.String(),
+				WeightGrams: evt.GetWeightGrams(),
+				Category:    evt.GetCategory(),
+				AddedBy:     addedBy,
+				Options:     optionViews(options),
+			})
+		}
+
+		updated := &dto.CartViewDTO{
+			ID:              view.ID,
+			UserID:          view.UserID,
+			SessionID:       view.SessionID,
+			TenantID:        view.TenantID,
+			Status:          view.Status,
+			ApprovalStatus:  voidApproval(view.ApprovalStatus),
+			Items:           newItems,
+			Members:         view.Members,
+			Discounts:       copyDiscounts(view.Discounts),
+			ShippingAddress: view.ShippingAddress,
+			ShippingMethod:  view.ShippingMethod,
+			ShippingFee:     view.ShippingFee,
+			CreatedAt:       view.CreatedAt,
+			UpdatedAt:       evt.GetTimestamp(),
+			PurchasedAt:     view.PurchasedAt,
+			Version:         evt.GetVersion(),
+		}
+		recalculateTotals(updated)
+
+		return updated
+	case *event.CartItemMovedToSavedListEvent:
+		if view == nil {
+			return nil
+		}
+
+		lineID := evt.GetLineID().String()
+		newItems := make([]dto.CartItemViewDTO, 0, len(view.Items))
+		for _, item := range view.Items {
+			if item.LineID != lineID {
+				newItems = append(newItems, item)
+			}
+		}
+
+		updated := *view
+		updated.ApprovalStatus = voidApproval(view.ApprovalStatus)
+		updated.Items = newItems
+		updated.Discounts = copyDiscounts(view.Discounts)
+		updated.UpdatedAt = evt.GetTimestamp()
+		updated.Version = evt.GetVersion()
+		recalculateTotals(&updated)
+
+		return &updated
+	case *event.CouponAppliedToCartEvent:
+		if view == nil {
+			return nil
+		}
+
+		updated := *view
+		updated.ApprovalStatus = voidApproval(view.ApprovalStatus)
+		updated.Discounts = append(copyDiscounts(view.Discounts), dto.CartDiscountViewDTO{
+			CouponID:      evt.GetCouponID().String(),
+			Code:          evt.GetCode(),
+			PromotionType: evt.GetPromotionType(),
+			Value:         evt.GetValue(),
+			BuyQuantity:   evt.GetBuyQuantity(),
+			GetQuantity:   evt.GetGetQuantity(),
+			MinimumTotal:  evt.GetMinimumTotal(),
+			Automatic:     evt.GetAutomatic(),
 		})
+		updated.UpdatedAt = evt.GetTimestamp()
+		updated.Version = evt.GetVersion()
+		recalculateTotals(&updated)
 
-		totalAmount := 0.0
-		itemCount := 0
-		for _, item := range newItems {
-			totalAmount += item.Price
-			itemCount++
+		return &updated
+	case *event.CouponRemovedFromCartEvent:
+		if view == nil {
+			return nil
 		}
 
-		return &dto.CartViewDTO{
-			ID:          view.ID,
-			UserID:      view.UserID,
-			TenantID:    view.TenantID,
-			Status:      view.Status,
-			TotalAmount: totalAmount,
-			ItemCount:   itemCount,
-			Items:       newItems,
-			CreatedAt:   view.CreatedAt,
-			UpdatedAt:   evt.GetTimestamp(),
-			PurchasedAt: view.PurchasedAt,
-			Version:     evt.GetVersion(),
+		updated := *view
+		updated.ApprovalStatus = voidApproval(view.ApprovalStatus)
+		updated.Discounts = make([]dto.CartDiscountViewDTO, 0, len(view.Discounts))
+		for _, discount := range view.Discounts {
+			if discount.Code != evt.GetCode() {
+				updated.Discounts = append(updated.Discounts, discount)
+			}
+		}
+		updated.UpdatedAt = evt.GetTimestamp()
+		updated.Version = evt.GetVersion()
+		recalculateTotals(&updated)
+
+		return &updated
+	case *event.ShippingAddressSetEvent:
+		if view == nil {
+			return nil
+		}
+
+		updated := *view
+		updated.ApprovalStatus = voidApproval(view.ApprovalStatus)
+		// Fees are quoted per prefecture, so moving to another one drops the method
+		if view.ShippingAddress == nil || view.ShippingAddress.Prefecture != evt.GetPrefecture() {
+			updated.ShippingMethod = ""
+			updated.ShippingFee = 0
+		}
+		// An erased address is left out, as anonymising the subject leaves it
+		updated.ShippingAddress = nil
+		if !evt.IsRedacted() {
+			updated.ShippingAddress = &dto.CartShippingAddressViewDTO{
+				RecipientName: evt.GetRecipientName(),
+				PostalCode:    evt.GetPostalCode(),
+				Prefecture:    evt.GetPrefecture(),
+				City:          evt.GetCity(),
+				AddressLine1:  evt.GetAddressLine1(),
+				AddressLine2:  evt.GetAddressLine2(),
+				Phone:         evt.GetPhone(),
+			}
+		}
+		updated.Discounts = copyDiscounts(view.Discounts)
+		updated.UpdatedAt = evt.GetTimestamp()
+		updated.Version = evt.GetVersion()
+		recalculateTotals(&updated)
+
+		return &updated
+	case *event.ShippingMethodSelectedEvent:
+		if view == nil {
+			return nil
+		}
+
+		updated := *view
+		updated.ApprovalStatus = voidApproval(view.ApprovalStatus)
+		updated.ShippingMethod = evt.GetMethod()
+		updated.ShippingFee = evt.GetFee()
+		updated.Discounts = copyDiscounts(view.Discounts)
+		updated.UpdatedAt = evt.GetTimestamp()
+		updated.Version = evt.GetVersion()
+		recalculateTotals(&updated)
+
+		return &updated
+	case *event.CartMergedEvent:
+		if view == nil {
+			return nil
+		}
+
+		updated := *view
+		updated.Status = "MERGED"
+		updated.MergedIntoCartID = evt.GetIntoCartID().String()
+		updated.UpdatedAt = evt.GetTimestamp()
+		updated.Version = evt.GetVersion()
+
+		return &updated
+	case *event.CartClosedEvent:
+		if view == nil {
+			return nil
+		}
+
+		closedAt := evt.GetTimestamp()
+		updated := *view
+		updated.Status = "CLOSED"
+		updated.ClosedAt = &closedAt
+		updated.UpdatedAt = closedAt
+		updated.Version = evt.GetVersion()
+
+		return &updated
+	case *event.CartMemberInvitedEvent, *event.CartInvitationAcceptedEvent, *event.CartMemberRemovedEvent:
+		if view == nil {
+			return nil
+		}
+
+		updated := *view
+		updated.Members = applyMember(view.Members, e)
+		updated.UpdatedAt = e.GetTimestamp()
+		updated.Version = e.GetVersion()
+
+		return &updated
+	case *event.CartApprovalRequestedEvent, *event.CartApprovedEvent, *event.CartApprovalRejectedEvent,
+		*event.CartChangesRequestedEvent, *event.CartApprovalExpiredEvent:
+		if view == nil {
+			return nil
 		}
+
+		updated := *view
+		updated.ApprovalStatus = approvalStatus(e)
+		updated.UpdatedAt = e.GetTimestamp()
+		updated.Version = e.GetVersion()
+
+		return &updated
 	case *event.CartSubmittedEvent:
 		if view == nil {
 			return nil
 		}
 
 		return &dto.CartViewDTO{
-			ID:          view.ID,
-			UserID:      view.UserID,
-			TenantID:    view.TenantID,
-			Status:      "SUBMITTED",
-			TotalAmount: view.TotalAmount,
-			ItemCount:   view.ItemCount,
-			Items:       view.Items,
-			CreatedAt:   view.CreatedAt,
-			UpdatedAt:   evt.GetTimestamp(),
-			PurchasedAt: view.PurchasedAt,
-			Version:     evt.GetVersion(),
+			ID:              view.ID,
+			UserID:          view.UserID,
+			SessionID:       view.SessionID,
+			TenantID:        view.TenantID,
+			Status:          "SUBMITTED",
+			ApprovalStatus:  view.ApprovalStatus,
+			Subtotal:        view.Subtotal,
+			DiscountTotal:   view.DiscountTotal,
+			TotalAmount:     evt.GetTotalAmount(),
+			ItemCount:       view.ItemCount,
+			Items:           view.Items,
+			Members:         view.Members,
+			Discounts:       view.Discounts,
+			Tax:             taxView(evt),
+			ShippingAddress: view.ShippingAddress,
+			ShippingMethod:  evt.GetShippingMethod(),
+			ShippingFee:     evt.GetShippingFee(),
+			CreatedAt:       view.CreatedAt,
+			UpdatedAt:       evt.GetTimestamp(),
+			PurchasedAt:     view.PurchasedAt,
+			Version:         evt.GetVersion(),
 		}
 	}
 
 	return view
 }
+
+// applyMember returns the members after the membership event. A member
+// who has been erased reads as the nil ID and is left out.
+func applyMember(members []dto.CartMemberViewDTO, e event.Event) []dto.CartMemberViewDTO {
+	updated := make([]dto.CartMemberViewDTO, 0, len(members)+1)
+	switch evt := e.(type) {
+	case *event.CartMemberInvitedEvent:
+		updated = append(updated, members...)
+		if evt.GetUserID() != uuid.Nil {
+			updated = append(updated, dto.CartMemberViewDTO{UserID: evt.GetUserID().String(), Status: "INVITED"})
+		}
+	case *event.CartInvitationAcceptedEvent:
+		for _, member := range members {
+			if member.UserID == evt.GetUserID().String() {
+				member.Status = "MEMBER"
+			}
+			updated = append(updated, member)
+		}
+	case *event.CartMemberRemovedEvent:
+		for _, member := range members {
+			if member.UserID != evt.GetUserID().String() {
+				updated = append(updated, member)
+			}
+		}
+	}
+	return updated
+}
+
+// approvalStatus mirrors aggregate.CartApprovalStatus for the approval events.
+func approvalStatus(e event.Event) string {
+	switch e.(type) {
+	case *event.CartApprovalRequestedEvent:
+		return "PENDING"
+	case *event.CartApprovedEvent:
+		return "APPROVED"
+	case *event.CartApprovalRejectedEvent:
+		return "REJECTED"
+	case *event.CartChangesRequestedEvent:
+		return "CHANGES_REQUESTED"
+	case *event.CartApprovalExpiredEvent:
+		return "EXPIRED"
+	}
+
+	return ""
+}
+
+// voidApproval drops an approval once the approved cart is changed; the
+// other outcomes stay visible until the next request.
+func voidApproval(status string) string {
+	if status == "APPROVED" {
+		return ""
+	}
+
+	return status
+}
+
+func optionViews(options value.LineOptions) []dto.CartItemOptionViewDTO {
+	if options.IsEmpty() {
+		return nil
+	}
+
+	views := make([]dto.CartItemOptionViewDTO, 0, len(options.Options()))
+	for _, option := range options.Options() {
+		views = append(views, dto.CartItemOptionViewDTO{
+			Name:          option.Name(),
+			Value:         option.Value(),
+			PriceModifier: option.PriceModifier(),
+		})
+	}
+	return views
+}
+
+// taxView returns nil for carts submitted before tax was recorded.
+func taxView(evt *event.CartSubmittedEvent) *dto.CartTaxViewDTO {
+	if evt.GetTaxDisplay() == "" {
+		return nil
+	}
+
+	return &dto.CartTaxViewDTO{
+		Display:               evt.GetTaxDisplay(),
+		RoundingMode:          evt.GetTaxRoundingMode(),
+		StandardTaxableAmount: evt.GetStandardTaxableAmount(),
+		StandardTaxAmount:     evt.GetStandardTaxAmount(),
+		ReducedTaxableAmount:  evt.GetReducedTaxableAmount(),
+		ReducedTaxAmount:      evt.GetReducedTaxAmount(),
+		TaxTotal:              evt.GetTaxTotal(),
+	}
+}
+
+func copyDiscounts(discounts []dto.CartDiscountViewDTO) []dto.CartDiscountViewDTO {
+	copied := make([]dto.CartDiscountViewDTO, len(discounts))
+	copy(copied, discounts)
+	return copied
+}
+
+// recalculateTotals prices the cart the same way CartAggregate does, so the
+// view always shows the total the cart will be submitted with.
+func recalculateTotals(view *dto.CartViewDTO) {
+	prices := make([]float64, 0, len(view.Items))
+	subtotal := 0.0
+	itemCount := 0
+	for _, item := range view.Items {
+		// Promotions price every unit on its own
+		for range item.Quantity {
+			prices = append(prices, item.Price)
+		}
+		subtotal += item.Price * float64(item.Quantity)
+		itemCount += item.Quantity
+	}
+
+	promotions := make([]value.Promotion, 0, len(view.Discounts))
+	for _, discount := range view.Discounts {
+		promotion, _ := value.NewPromotion(discount.PromotionType, discount.Value, discount.BuyQuantity, discount.GetQuantity, discount.MinimumTotal)
+		promotions = append(promotions, promotion)
+	}
+
+	amounts, total := value.ApplyPromotions(prices, promotions)
+	for i := range view.Discounts {
+		view.Discounts[i].Amount = amounts[i]
+	}
+
+	view.Subtotal = subtotal
+	view.DiscountTotal = math.Round((subtotal-total)*100) / 100
+	view.TotalAmount = total + view.ShippingFee
+	view.ItemCount = itemCount
+}
@@ -0,0 +1,147 @@
+package checkout
+
+import (
+	"context"
+	"time"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/gateway"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+const (
+	stepStatusPending      = "PENDING"
+	stepStatusCompleted    = "COMPLETED"
+	stepStatusFailed       = "FAILED"
+	stepStatusCompensating = "COMPENSATING"
+	stepStatusCompensated  = "COMPENSATED"
+)
+
+type CheckoutSagaProjectorImpl struct {
+	viewRepo readmodelstore.CheckoutSagaStore
+	seen     map[string]struct{}
+}
+
+func NewCheckoutSagaProjector(viewRepo readmodelstore.CheckoutSagaStore) gateway.Projector {
+	return &CheckoutSagaProjectorImpl{
+		viewRepo: viewRepo,
+		seen:     make(map[string]struct{}),
+	}
+}
+
+func (p *CheckoutSagaProjectorImpl) Handle(ctx context.Context, e event.Event) error {
+	eventID := e.GetEventID().String()
+	if _, ok := p.seen[eventID]; ok {
+		return nil
+	}
+	p.seen[eventID] = struct{}{}
+
+	if e.GetAggregateType() != "CheckoutSaga" {
+		return nil
+	}
+
+	aggID := e.GetAggregateID().String()
+
+	current, err := p.viewRepo.Get(ctx, aggID)
+	if err != nil {
+		if errors.IsCode(err, errors.NotFound) {
+			current = nil
+		} else {
+			return err
+		}
+	}
+
+	updated := p.applyToView(current, e)
+	if updated != nil {
+		return p.viewRepo.Upsert(ctx, aggID, updated)
+	}
+
+	return nil
+}
+
+func (p *CheckoutSagaProjectorImpl) Start(ctx context.Context, bus gateway.EventSubscriber) error {
+	bus.Subscribe(p.Handle)
+	return nil
+}
+
+func (p *CheckoutSagaProjectorImpl) applyToView(view *dto.CheckoutSagaViewDTO, e event.Event) *dto.CheckoutSagaViewDTO {
+	if started, ok := e.(*event.CheckoutSagaStartedEvent); ok {
+		return &dto.CheckoutSagaViewDTO{
+			ID:          started.GetAggregateID().String(),
+			CartID:      started.GetCartID().String(),
+			Status:      "STARTED",
+			TotalAmount: started.GetTotalAmount(),
+			Steps:       []dto.CheckoutSagaStepViewDTO{},
+			CreatedAt:   started.GetTimestamp(),
+			UpdatedAt:   started.GetTimestamp(),
+			Version:     started.GetVersion(),
+		}
+	}
+
+	if view == nil {
+		return nil
+	}
+
+	updated := *view
+	updated.Steps = make([]dto.CheckoutSagaStepViewDTO, len(view.Steps))
+	copy(updated.Steps, view.Steps)
+	updated.UpdatedAt = e.GetTimestamp()
+	updated.Version = e.GetVersion()
+
+	switch evt := e.(type) {
+	case *event.CheckoutStepStartedEvent:
+		updated.CurrentStep = evt.GetStep().String()
+		setStep(&updated, evt.GetStep().String(), stepStatusPending, "", "", evt.GetTimestamp())
+	case *event.CheckoutStepCompletedEvent:
+		setStep(&updated, evt.GetStep().String(), stepStatusCompleted, evt.GetReference(), "", evt.GetTimestamp())
+	case *event.CheckoutStepFailedEvent:
+		updated.Status = "COMPENSATING"
+		updated.FailureReason = evt.GetReason()
+		setStep(&updated, evt.GetStep().String(), stepStatusFailed, "", evt.GetReason(), evt.GetTimestamp())
+	case *event.CheckoutCompensationStartedEvent:
+		updated.Status = "COMPENSATING"
+		updated.CurrentStep = evt.GetStep().String()
+		setStep(&updated, evt.GetStep().String(), stepStatusCompensating, "", "", evt.GetTimestamp())
+	case *event.CheckoutStepCompensatedEvent:
+		setStep(&updated, evt.GetStep().String(), stepStatusCompensated, "", "", evt.GetTimestamp())
+	case *event.CheckoutSagaCompletedEvent:
+		updated.Status = "COMPLETED"
+		updated.CurrentStep = ""
+	case *event.CheckoutSagaAbortedEvent:
+		updated.Status = "ABORTED"
+		updated.CurrentStep = ""
+		updated.FailureReason = evt.GetReason()
+	default:
+		return view
+	}
+
+	return &updated
+}
+
+func setStep(view *dto.CheckoutSagaViewDTO, step, status, reference, reason string, at time.Time) {
+	for i := range view.Steps {
+		if view.Steps[i].Step != step {
+			continue
+		}
+		view.Steps[i].Status = status
+		if reference != "" {
+			view.Steps[i].Reference = reference
+		}
+		if reason != "" {
+			view.Steps[i].Reason = reason
+		}
+		view.Steps[i].UpdatedAt = at
+		return
+	}
+
+	view.Steps = append(view.Steps, dto.CheckoutSagaStepViewDTO{
+		SagaID:    view.ID,
+		Step:      step,
+		Status:    status,
+		Reference: reference,
+		Reason:    reason,
+		UpdatedAt: at,
+	})
+}
@@ -0,0 +1,97 @@
+package coupon
+
+import (
+	"context"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/gateway"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+type CouponProjectorImpl struct {
+	viewRepo readmodelstore.CouponStore
+	seen     map[string]struct{}
+}
+
+func NewCouponProjector(viewRepo readmodelstore.CouponStore) gateway.Projector {
+	return &CouponProjectorImpl{
+		viewRepo: viewRepo,
+		seen:     make(map[string]struct{}),
+	}
+}
+
+func (p *CouponProjectorImpl) Handle(ctx context.Context, e event.Event) error {
+	eventID := e.GetEventID().String()
+	if _, ok := p.seen[eventID]; ok {
+		return nil
+	}
+	p.seen[eventID] = struct{}{}
+
+	if e.GetAggregateType() != "Coupon" {
+		return nil
+	}
+
+	aggID := e.GetAggregateID().String()
+
+	current, err := p.viewRepo.Get(ctx, aggID)
+	if err != nil {
+		if errors.IsCode(err, errors.NotFound) {
+			current = nil
+		} else {
+			return err
+		}
+	}
+
+	updated := p.applyToView(current, e)
+	if updated != nil {
+		return p.viewRepo.Upsert(ctx, aggID, updated)
+	}
+
+	return nil
+}
+
+func (p *CouponProjectorImpl) Start(ctx context.Context, bus gateway.EventSubscriber) error {
+	bus.Subscribe(p.Handle)
+	return nil
+}
+
+func (p *CouponProjectorImpl) applyToView(view *dto.CouponViewDTO, e event.Event) *dto.CouponViewDTO {
+	if created, ok := e.(*event.CouponCreatedEvent); ok {
+		return &dto.CouponViewDTO{
+			ID:            created.GetAggregateID().String(),
+			TenantID:      created.GetTenantID().String(),
+			Code:          created.GetCode(),
+			PromotionType: created.GetPromotionType(),
+			Value:         created.GetValue(),
+			BuyQuantity:   created.GetBuyQuantity(),
+			GetQuantity:   created.GetGetQuantity(),
+			MinimumTotal:  created.GetMinimumTotal(),
+			UsageLimit:    created.GetUsageLimit(),
+			Automatic:     created.GetAutomatic(),
+			CreatedAt:     created.GetTimestamp(),
+			UpdatedAt:     created.GetTimestamp(),
+			Version:       created.GetVersion(),
+		}
+	}
+
+	if view == nil {
+		return nil
+	}
+
+	updated := *view
+	updated.UpdatedAt = e.GetTimestamp()
+	updated.Version = e.GetVersion()
+
+	switch e.(type) {
+	case *event.CouponRedeemedEvent:
+		updated.RedemptionCount++
+	case *event.CouponReleasedEvent:
+		updated.RedemptionCount--
+	default:
+		return view
+	}
+
+	return &updated
+}
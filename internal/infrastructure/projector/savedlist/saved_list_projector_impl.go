@@ -0,0 +1,116 @@
+package savedlist
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/gateway"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+type SavedListProjectorImpl struct {
+	viewRepo readmodelstore.SavedListStore
+	seen     map[string]struct{}
+}
+
+func NewSavedListProjector(viewRepo readmodelstore.SavedListStore) gateway.Projector {
+	return &SavedListProjectorImpl{
+		viewRepo: viewRepo,
+		seen:     make(map[string]struct{}),
+	}
+}
+
+func (p *SavedListProjectorImpl) Handle(ctx context.Context, e event.Event) error {
+	eventID := e.GetEventID().String()
+	if _, ok := p.seen[eventID]; ok {
+		return nil
+	}
+	p.seen[eventID] = struct{}{}
+
+	switch e.(type) {
+	case *event.SavedListCreatedEvent, *event.ItemSavedEvent, *event.SavedItemRemovedEvent, *event.SavedItemMovedToCartEvent:
+	default:
+		return nil
+	}
+
+	listID := e.GetAggregateID().String()
+
+	current, err := p.viewRepo.Get(ctx, listID)
+	if err != nil {
+		if errors.IsCode(err, errors.NotFound) {
+			current = nil
+		} else {
+			return err
+		}
+	}
+
+	return p.viewRepo.Upsert(ctx, listID, p.applyToView(current, e))
+}
+
+func (p *SavedListProjectorImpl) Start(ctx context.Context, bus gateway.EventSubscriber) error {
+	bus.Subscribe(p.Handle)
+	return nil
+}
+
+func (p *SavedListProjectorImpl) applyToView(view *dto.SavedListViewDTO, e event.Event) *dto.SavedListViewDTO {
+	if view == nil {
+		view = &dto.SavedListViewDTO{
+			ID:        e.GetAggregateID().String(),
+			Items:     make([]dto.SavedItemViewDTO, 0),
+			CreatedAt: e.GetTimestamp(),
+		}
+	}
+
+	switch evt := e.(type) {
+	case *event.SavedListCreatedEvent:
+		view.TenantID = evt.GetTenantID().String()
+		view.UserID = evt.GetUserID().String()
+		// An erased user reads as the nil ID; the list stands in for them
+		// as it does once anonymised
+		if evt.GetUserID() == uuid.Nil {
+			view.UserID = view.ID
+		}
+		view.CreatedAt = evt.GetTimestamp()
+	case *event.ItemSavedEvent:
+		options := evt.GetLineOptions()
+		item := dto.SavedItemViewDTO{
+			ID:          evt.GetItemID().String(),
+			Name:        evt.GetName(),
+			Price:       evt.GetPrice() + options.PriceModifier(),
+			Quantity:    evt.GetQuantity(),
+			TaxCategory: evt.GetTaxCategory(),
+			WeightGrams: evt.GetWeightGrams(),
+			Category:    evt.GetCategory(),
+			SavedAt:     evt.GetTimestamp(),
+		}
+		for _, option := range options.Options() {
+			item.Options = append(item.Options, dto.CartItemOptionViewDTO{
+				Name:          option.Name(),
+				Value:         option.Value(),
+				PriceModifier: option.PriceModifier(),
+			})
+		}
+		view.Items = append(view.Items, item)
+	case *event.SavedItemRemovedEvent:
+		view.Items = removeSavedItem(view.Items, evt.GetItemID().String())
+	case *event.SavedItemMovedToCartEvent:
+		view.Items = removeSavedItem(view.Items, evt.GetItemID().String())
+	}
+
+	view.UpdatedAt = e.GetTimestamp()
+	view.Version = e.GetVersion()
+	return view
+}
+
+func removeSavedItem(items []dto.SavedItemViewDTO, itemID string) []dto.SavedItemViewDTO {
+	for i, item := range items {
+		if item.ID == itemID {
+			return append(items[:i], items[i+1:]...)
+		}
+	}
+	return items
+}
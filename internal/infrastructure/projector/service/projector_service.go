@@ -44,7 +44,7 @@ func (s *ProjectorService) handleMessage(ctx context.Context, msg *dto.Message)
 	}
 
 	// Deserialize event
-	event, err := s.deserializer.Deserialize(msg.Type, eventData)
+	event, err := s.deserializer.Deserialize(ctx, msg.Type, eventData)
 	if err != nil {
 		return err
 	}
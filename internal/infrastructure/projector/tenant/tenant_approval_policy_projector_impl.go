@@ -0,0 +1,77 @@
+package tenant
+
+import (
+	"context"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/gateway"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+type TenantApprovalPolicyProjectorImpl struct {
+	viewRepo readmodelstore.TenantApprovalPolicyStore
+	seen     map[string]struct{}
+}
+
+func NewTenantApprovalPolicyProjector(viewRepo readmodelstore.TenantApprovalPolicyStore) gateway.Projector {
+	return &TenantApprovalPolicyProjectorImpl{
+		viewRepo: viewRepo,
+		seen:     make(map[string]struct{}),
+	}
+}
+
+func (p *TenantApprovalPolicyProjectorImpl) Handle(ctx context.Context, e event.Event) error {
+	eventID := e.GetEventID().String()
+	if _, ok := p.seen[eventID]; ok {
+		return nil
+	}
+	p.seen[eventID] = struct{}{}
+
+	evt, ok := e.(*event.TenantApprovalPolicyConfiguredEvent)
+	if !ok {
+		return nil
+	}
+
+	// The view is keyed by tenant, not by the derived stream ID
+	tenantID := evt.GetTenantID().String()
+
+	current, err := p.viewRepo.Get(ctx, tenantID)
+	if err != nil {
+		if errors.IsCode(err, errors.NotFound) {
+			current = nil
+		} else {
+			return err
+		}
+	}
+
+	return p.viewRepo.Upsert(ctx, tenantID, p.applyToView(current, evt))
+}
+
+func (p *TenantApprovalPolicyProjectorImpl) Start(ctx context.Context, bus gateway.EventSubscriber) error {
+	bus.Subscribe(p.Handle)
+	return nil
+}
+
+func (p *TenantApprovalPolicyProjectorImpl) applyToView(view *dto.TenantApprovalPolicyViewDTO, evt *event.TenantApprovalPolicyConfiguredEvent) *dto.TenantApprovalPolicyViewDTO {
+	createdAt := evt.GetTimestamp()
+	if view != nil {
+		createdAt = view.CreatedAt
+	}
+
+	approverIDs := make([]string, 0, len(evt.GetApproverIDs()))
+	for _, approverID := range evt.GetApproverIDs() {
+		approverIDs = append(approverIDs, approverID.String())
+	}
+
+	return &dto.TenantApprovalPolicyViewDTO{
+		TenantID:      evt.GetTenantID().String(),
+		Threshold:     evt.GetThreshold(),
+		ApproverIDs:   approverIDs,
+		DeadlineHours: evt.GetDeadlineHours(),
+		CreatedAt:     createdAt,
+		UpdatedAt:     evt.GetTimestamp(),
+		Version:       evt.GetVersion(),
+	}
+}
@@ -0,0 +1,79 @@
+package tenant
+
+import (
+	"context"
+	"slices"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/gateway"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+type TenantCartRulesProjectorImpl struct {
+	viewRepo readmodelstore.TenantCartRulesStore
+	seen     map[string]struct{}
+}
+
+func NewTenantCartRulesProjector(viewRepo readmodelstore.TenantCartRulesStore) gateway.Projector {
+	return &TenantCartRulesProjectorImpl{
+		viewRepo: viewRepo,
+		seen:     make(map[string]struct{}),
+	}
+}
+
+func (p *TenantCartRulesProjectorImpl) Handle(ctx context.Context, e event.Event) error {
+	eventID := e.GetEventID().String()
+	if _, ok := p.seen[eventID]; ok {
+		return nil
+	}
+	p.seen[eventID] = struct{}{}
+
+	evt, ok := e.(*event.TenantCartRulesConfiguredEvent)
+	if !ok {
+		return nil
+	}
+
+	// The view is keyed by tenant, not by the derived stream ID
+	tenantID := evt.GetTenantID().String()
+
+	current, err := p.viewRepo.Get(ctx, tenantID)
+	if err != nil {
+		if errors.IsCode(err, errors.NotFound) {
+			current = nil
+		} else {
+			return err
+		}
+	}
+
+	return p.viewRepo.Upsert(ctx, tenantID, p.applyToView(current, evt))
+}
+
+func (p *TenantCartRulesProjectorImpl) Start(ctx context.Context, bus gateway.EventSubscriber) error {
+	bus.Subscribe(p.Handle)
+	return nil
+}
+
+func (p *TenantCartRulesProjectorImpl) applyToView(view *dto.TenantCartRulesViewDTO, evt *event.TenantCartRulesConfiguredEvent) *dto.TenantCartRulesViewDTO {
+	createdAt := evt.GetTimestamp()
+	if view != nil {
+		createdAt = view.CreatedAt
+	}
+
+	combinations := make([][]string, 0, len(evt.GetBlockedCategoryCombinations()))
+	for _, combination := range evt.GetBlockedCategoryCombinations() {
+		combinations = append(combinations, slices.Clone(combination))
+	}
+
+	return &dto.TenantCartRulesViewDTO{
+		TenantID:                    evt.GetTenantID().String(),
+		MaxLines:                    evt.GetMaxLines(),
+		MaxQuantityPerItem:          evt.GetMaxQuantityPerItem(),
+		MinimumOrderValue:           evt.GetMinimumOrderValue(),
+		BlockedCategoryCombinations: combinations,
+		CreatedAt:                   createdAt,
+		UpdatedAt:                   evt.GetTimestamp(),
+		Version:                     evt.GetVersion(),
+	}
+}
@@ -29,7 +29,7 @@ func (p *TenantPolicyProjectorImpl) Handle(ctx context.Context, e event.Event) e
 	}
 	p.seen[eventID] = struct{}{}
 
-	switch e.(type) {
+	switch evt := e.(type) {
 	case *event.TenantCartAbandonedPolicyCreatedEvent, *event.TenantCartAbandonedPolicyUpdatedEvent:
 		aggID := e.GetAggregateID().String()
 
@@ -46,6 +46,18 @@ func (p *TenantPolicyProjectorImpl) Handle(ctx context.Context, e event.Event) e
 		if updated != nil {
 			return p.viewRepo.Upsert(ctx, aggID, updated)
 		}
+	case *event.TenantCartAbandonedPolicySegmentConfiguredEvent:
+		if err := p.projectSegment(ctx, evt); err != nil {
+			return err
+		}
+		return p.advanceVersion(ctx, e)
+	case *event.TenantCartAbandonedPolicySegmentRemovedEvent:
+		if err := p.viewRepo.DeleteSegment(ctx, evt.GetSegmentID().String()); err != nil {
+			return err
+		}
+		return p.advanceVersion(ctx, e)
+	case *event.TenantCartAbandonedPolicyChangeScheduledEvent:
+		return p.advanceVersion(ctx, e)
 	default:
 		return nil
 	}
@@ -58,6 +70,62 @@ func (p *TenantPolicyProjectorImpl) Start(ctx context.Context, bus gateway.Event
 	return nil
 }
 
+// advanceVersion keeps the version of the policy view at the version of its
+// stream, which every event of the policy moves, so that it can be used as
+// the ETag of the policy.
+func (p *TenantPolicyProjectorImpl) advanceVersion(ctx context.Context, e event.Event) error {
+	aggID := e.GetAggregateID().String()
+
+	current, err := p.viewRepo.Get(ctx, aggID)
+	if err != nil {
+		if errors.IsCode(err, errors.NotFound) {
+			return nil
+		}
+		return err
+	}
+	if current.Version >= e.GetVersion() {
+		return nil
+	}
+
+	updated := *current
+	updated.Version = e.GetVersion()
+	return p.viewRepo.Upsert(ctx, aggID, &updated)
+}
+
+func (p *TenantPolicyProjectorImpl) projectSegment(ctx context.Context, evt *event.TenantCartAbandonedPolicySegmentConfiguredEvent) error {
+	segmentID := evt.GetSegmentID().String()
+
+	createdAt := evt.GetTimestamp()
+	current, err := p.viewRepo.GetSegment(ctx, segmentID)
+	if err != nil {
+		if !errors.IsCode(err, errors.NotFound) {
+			return err
+		}
+	} else {
+		createdAt = current.CreatedAt
+	}
+
+	categories := evt.GetCategories()
+	if categories == nil {
+		categories = make([]string, 0)
+	}
+
+	return p.viewRepo.UpsertSegment(ctx, segmentID, &dto.TenantPolicySegmentViewDTO{
+		ID:               segmentID,
+		TenantID:         evt.GetAggregateID().String(),
+		Title:            evt.GetTitle(),
+		Priority:         evt.GetPriority(),
+		AbandonedMinutes: evt.GetAbandonedMinutes(),
+		MinCartTotal:     evt.GetMinCartTotal(),
+		MaxCartTotal:     evt.GetMaxCartTotal(),
+		Categories:       categories,
+		CustomerSegment:  evt.GetCustomerSegment(),
+		CreatedAt:        createdAt,
+		UpdatedAt:        evt.GetTimestamp(),
+		Version:          evt.GetVersion(),
+	})
+}
+
 func (p *TenantPolicyProjectorImpl) applyToView(view *dto.TenantPolicyViewDTO, e event.Event) *dto.TenantPolicyViewDTO {
 	switch evt := e.(type) {
 	case *event.TenantCartAbandonedPolicyCreatedEvent:
@@ -67,6 +135,7 @@ func (p *TenantPolicyProjectorImpl) applyToView(view *dto.TenantPolicyViewDTO, e
 			AbandonedMinutes: evt.AbandonedMinutes,
 			QuietTimeFrom:    evt.QuietTimeFrom,
 			QuietTimeTo:      evt.QuietTimeTo,
+			CartExpiryDays:   evt.CartExpiryDays,
 			CreatedAt:        evt.GetTimestamp(),
 			UpdatedAt:        evt.GetTimestamp(),
 			Version:          evt.GetVersion(),
@@ -82,6 +151,7 @@ func (p *TenantPolicyProjectorImpl) applyToView(view *dto.TenantPolicyViewDTO, e
 			AbandonedMinutes: evt.AbandonedMinutes,
 			QuietTimeFrom:    evt.QuietTimeFrom,
 			QuietTimeTo:      evt.QuietTimeTo,
+			CartExpiryDays:   evt.CartExpiryDays,
 			CreatedAt:        view.CreatedAt,
 			UpdatedAt:        evt.GetTimestamp(),
 			Version:          evt.GetVersion(),
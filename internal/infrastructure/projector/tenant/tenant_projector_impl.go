@@ -0,0 +1,119 @@
+package tenant
+
+import (
+	"context"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/gateway"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+type TenantProjectorImpl struct {
+	viewRepo readmodelstore.TenantStore
+	seen     map[string]struct{}
+}
+
+func NewTenantProjector(viewRepo readmodelstore.TenantStore) gateway.Projector {
+	return &TenantProjectorImpl{
+		viewRepo: viewRepo,
+		seen:     make(map[string]struct{}),
+	}
+}
+
+func (p *TenantProjectorImpl) Handle(ctx context.Context, e event.Event) error {
+	eventID := e.GetEventID().String()
+	if _, ok := p.seen[eventID]; ok {
+		return nil
+	}
+	p.seen[eventID] = struct{}{}
+
+	// The view is keyed by tenant, not by the derived stream ID
+	tenantID, ok := tenantIDOf(e)
+	if !ok {
+		return nil
+	}
+
+	current, err := p.viewRepo.Get(ctx, tenantID)
+	if err != nil {
+		if errors.IsCode(err, errors.NotFound) {
+			current = nil
+		} else {
+			return err
+		}
+	}
+
+	updated := p.applyToView(current, e)
+	if updated == nil {
+		return nil
+	}
+	return p.viewRepo.Upsert(ctx, tenantID, updated)
+}
+
+func (p *TenantProjectorImpl) Start(ctx context.Context, bus gateway.EventSubscriber) error {
+	bus.Subscribe(p.Handle)
+	return nil
+}
+
+func tenantIDOf(e event.Event) (string, bool) {
+	switch evt := e.(type) {
+	case *event.TenantOnboardedEvent:
+		return evt.GetTenantID().String(), true
+	case *event.TenantProfileUpdatedEvent:
+		return evt.GetTenantID().String(), true
+	case *event.TenantPlanChangedEvent:
+		return evt.GetTenantID().String(), true
+	case *event.TenantSuspendedEvent:
+		return evt.GetTenantID().String(), true
+	case *event.TenantReactivatedEvent:
+		return evt.GetTenantID().String(), true
+	case *event.TenantClosedEvent:
+		return evt.GetTenantID().String(), true
+	default:
+		return "", false
+	}
+}
+
+func (p *TenantProjectorImpl) applyToView(view *dto.TenantViewDTO, e event.Event) *dto.TenantViewDTO {
+	if evt, ok := e.(*event.TenantOnboardedEvent); ok {
+		return &dto.TenantViewDTO{
+			TenantID:      evt.GetTenantID().String(),
+			DisplayName:   evt.GetDisplayName(),
+			Plan:          evt.GetPlan(),
+			Status:        string(value.TenantStatusActive),
+			DefaultLocale: evt.GetDefaultLocale(),
+			CreatedAt:     evt.GetTimestamp(),
+			UpdatedAt:     evt.GetTimestamp(),
+			Version:       evt.GetVersion(),
+		}
+	}
+
+	if view == nil {
+		return nil
+	}
+
+	updated := *view
+	switch evt := e.(type) {
+	case *event.TenantProfileUpdatedEvent:
+		updated.DisplayName = evt.GetDisplayName()
+		updated.DefaultLocale = evt.GetDefaultLocale()
+	case *event.TenantPlanChangedEvent:
+		updated.Plan = evt.GetPlan()
+	case *event.TenantSuspendedEvent:
+		updated.Status = string(value.TenantStatusSuspended)
+		updated.SuspensionReason = evt.GetReason()
+	case *event.TenantReactivatedEvent:
+		updated.Status = string(value.TenantStatusActive)
+		updated.SuspensionReason = ""
+	case *event.TenantClosedEvent:
+		updated.Status = string(value.TenantStatusClosed)
+	default:
+		return nil
+	}
+	updated.UpdatedAt = e.GetTimestamp()
+	updated.Version = e.GetVersion()
+
+	return &updated
+}
@@ -0,0 +1,86 @@
+package tenant
+
+import (
+	"context"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/gateway"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+type TenantShippingRatesProjectorImpl struct {
+	viewRepo readmodelstore.TenantShippingRatesStore
+	seen     map[string]struct{}
+}
+
+func NewTenantShippingRatesProjector(viewRepo readmodelstore.TenantShippingRatesStore) gateway.Projector {
+	return &TenantShippingRatesProjectorImpl{
+		viewRepo: viewRepo,
+		seen:     make(map[string]struct{}),
+	}
+}
+
+func (p *TenantShippingRatesProjectorImpl) Handle(ctx context.Context, e event.Event) error {
+	eventID := e.GetEventID().String()
+	if _, ok := p.seen[eventID]; ok {
+		return nil
+	}
+	p.seen[eventID] = struct{}{}
+
+	evt, ok := e.(*event.ShippingRateSetEvent)
+	if !ok {
+		return nil
+	}
+
+	// The view is keyed by tenant, not by the derived stream ID
+	tenantID := evt.GetTenantID().String()
+
+	current, err := p.viewRepo.Get(ctx, tenantID)
+	if err != nil {
+		if errors.IsCode(err, errors.NotFound) {
+			current = nil
+		} else {
+			return err
+		}
+	}
+
+	return p.viewRepo.Upsert(ctx, tenantID, p.applyToView(current, evt))
+}
+
+func (p *TenantShippingRatesProjectorImpl) Start(ctx context.Context, bus gateway.EventSubscriber) error {
+	bus.Subscribe(p.Handle)
+	return nil
+}
+
+func (p *TenantShippingRatesProjectorImpl) applyToView(view *dto.TenantShippingRatesViewDTO, evt *event.ShippingRateSetEvent) *dto.TenantShippingRatesViewDTO {
+	if view == nil {
+		view = &dto.TenantShippingRatesViewDTO{
+			TenantID: evt.GetTenantID().String(),
+			Rates:    make([]dto.ShippingRateViewDTO, 0),
+		}
+	}
+
+	rate := dto.ShippingRateViewDTO{
+		Method:         evt.GetMethod(),
+		Prefecture:     evt.GetPrefecture(),
+		MaxWeightGrams: evt.GetMaxWeightGrams(),
+		Fee:            evt.GetFee(),
+		UpdatedAt:      evt.GetTimestamp(),
+	}
+
+	replaced := false
+	for i, existing := range view.Rates {
+		if existing.Method == rate.Method && existing.Prefecture == rate.Prefecture && existing.MaxWeightGrams == rate.MaxWeightGrams {
+			view.Rates[i] = rate
+			replaced = true
+		}
+	}
+	if !replaced {
+		view.Rates = append(view.Rates, rate)
+	}
+
+	view.Version = evt.GetVersion()
+	return view
+}
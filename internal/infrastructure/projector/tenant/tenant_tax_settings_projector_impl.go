@@ -0,0 +1,71 @@
+package tenant
+
+import (
+	"context"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/gateway"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+type TenantTaxSettingsProjectorImpl struct {
+	viewRepo readmodelstore.TenantTaxSettingsStore
+	seen     map[string]struct{}
+}
+
+func NewTenantTaxSettingsProjector(viewRepo readmodelstore.TenantTaxSettingsStore) gateway.Projector {
+	return &TenantTaxSettingsProjectorImpl{
+		viewRepo: viewRepo,
+		seen:     make(map[string]struct{}),
+	}
+}
+
+func (p *TenantTaxSettingsProjectorImpl) Handle(ctx context.Context, e event.Event) error {
+	eventID := e.GetEventID().String()
+	if _, ok := p.seen[eventID]; ok {
+		return nil
+	}
+	p.seen[eventID] = struct{}{}
+
+	evt, ok := e.(*event.TenantTaxSettingsConfiguredEvent)
+	if !ok {
+		return nil
+	}
+
+	// The view is keyed by tenant, not by the derived stream ID
+	tenantID := evt.GetTenantID().String()
+
+	current, err := p.viewRepo.Get(ctx, tenantID)
+	if err != nil {
+		if errors.IsCode(err, errors.NotFound) {
+			current = nil
+		} else {
+			return err
+		}
+	}
+
+	return p.viewRepo.Upsert(ctx, tenantID, p.applyToView(current, evt))
+}
+
+func (p *TenantTaxSettingsProjectorImpl) Start(ctx context.Context, bus gateway.EventSubscriber) error {
+	bus.Subscribe(p.Handle)
+	return nil
+}
+
+func (p *TenantTaxSettingsProjectorImpl) applyToView(view *dto.TenantTaxSettingsViewDTO, evt *event.TenantTaxSettingsConfiguredEvent) *dto.TenantTaxSettingsViewDTO {
+	createdAt := evt.GetTimestamp()
+	if view != nil {
+		createdAt = view.CreatedAt
+	}
+
+	return &dto.TenantTaxSettingsViewDTO{
+		TenantID:     evt.GetTenantID().String(),
+		TaxDisplay:   evt.GetTaxDisplay(),
+		RoundingMode: evt.GetRoundingMode(),
+		CreatedAt:    createdAt,
+		UpdatedAt:    evt.GetTimestamp(),
+		Version:      evt.GetVersion(),
+	}
+}
@@ -19,14 +19,106 @@ func NewHandlerRegister(container *container.Container) *HandlerRegister {
 
 func (r *HandlerRegister) SetupRouter() *router.Router {
 	// Command handlers
-	addItemCommandHandler := command.NewCartAddItemCommandHandler(r.container.CartAddItemCommand)
-	createTenantPolicyCommandHandler := command.NewCreateTenantCartAbandonedPolicyCommandHandler(r.container.CreateTenantCartAbandonedPolicyCommand)
-	updateTenantPolicyCommandHandler := command.NewUpdateTenantCartAbandonedPolicyCommandHandler(r.container.UpdateTenantCartAbandonedPolicyCommand)
+	addItemCommandHandler := command.NewCartAddItemCommandHandler(r.container.CommandBus)
+	createTenantPolicyCommandHandler := command.NewCreateTenantCartAbandonedPolicyCommandHandler(r.container.CommandBus)
+	updateTenantPolicyCommandHandler := command.NewUpdateTenantCartAbandonedPolicyCommandHandler(r.container.CommandBus)
+	submitCartCommandHandler := command.NewSubmitCartCommandHandler(r.container.CommandBus)
+	authorizePaymentCommandHandler := command.NewAuthorizePaymentCommandHandler(r.container.CommandBus)
+	capturePaymentCommandHandler := command.NewCapturePaymentCommandHandler(r.container.CommandBus)
+	refundPaymentCommandHandler := command.NewRefundPaymentCommandHandler(r.container.CommandBus)
+	createCouponCommandHandler := command.NewCreateCouponCommandHandler(r.container.CommandBus)
+	applyCouponCommandHandler := command.NewApplyCouponCommandHandler(r.container.CommandBus)
+	removeCouponCommandHandler := command.NewRemoveCouponCommandHandler(r.container.CommandBus)
+	configureTaxSettingsCommandHandler := command.NewConfigureTenantTaxSettingsCommandHandler(r.container.CommandBus)
+	setShippingAddressCommandHandler := command.NewSetShippingAddressCommandHandler(r.container.CommandBus)
+	selectShippingMethodCommandHandler := command.NewSelectShippingMethodCommandHandler(r.container.CommandBus)
+	setShippingRatesCommandHandler := command.NewSetShippingRatesCommandHandler(r.container.CommandBus)
+	mergeCartCommandHandler := command.NewMergeCartCommandHandler(r.container.CommandBus)
+	saveItemCommandHandler := command.NewSaveItemCommandHandler(r.container.CommandBus)
+	removeSavedItemCommandHandler := command.NewRemoveSavedItemCommandHandler(r.container.CommandBus)
+	moveSavedItemToCartCommandHandler := command.NewMoveSavedItemToCartCommandHandler(r.container.CommandBus)
+	moveCartItemToSavedListCommandHandler := command.NewMoveCartItemToSavedListCommandHandler(r.container.CommandBus)
+	inviteCartMemberCommandHandler := command.NewInviteCartMemberCommandHandler(r.container.CommandBus)
+	acceptCartInvitationCommandHandler := command.NewAcceptCartInvitationCommandHandler(r.container.CommandBus)
+	removeCartMemberCommandHandler := command.NewRemoveCartMemberCommandHandler(r.container.CommandBus)
+	configureApprovalPolicyCommandHandler := command.NewConfigureTenantApprovalPolicyCommandHandler(r.container.CommandBus)
+	requestCartApprovalCommandHandler := command.NewRequestCartApprovalCommandHandler(r.container.CommandBus)
+	decideCartApprovalCommandHandler := command.NewDecideCartApprovalCommandHandler(r.container.CommandBus)
+	configureCartRulesCommandHandler := command.NewConfigureTenantCartRulesCommandHandler(r.container.CommandBus)
+	onboardTenantCommandHandler := command.NewOnboardTenantCommandHandler(r.container.CommandBus)
+	updateTenantCommandHandler := command.NewUpdateTenantCommandHandler(r.container.CommandBus)
+	changeTenantStatusCommandHandler := command.NewChangeTenantStatusCommandHandler(r.container.CommandBus)
+	configureSegmentCommandHandler := command.NewConfigureCartAbandonedPolicySegmentCommandHandler(r.container.CommandBus)
+	removeSegmentCommandHandler := command.NewRemoveCartAbandonedPolicySegmentCommandHandler(r.container.CommandBus)
+	exportDataSubjectCommandHandler := command.NewExportDataSubjectCommandHandler(r.container.CommandBus)
+	eraseDataSubjectCommandHandler := command.NewEraseDataSubjectCommandHandler(r.container.CommandBus)
 
 	// Query handlers
-	getCartQueryHandler := query.NewGetCartQueryHandler(r.container.GetCartQuery)
+	getCartQueryHandler := query.NewGetCartQueryHandler(r.container.GetCartQuery, r.container.GetCartAsOfQuery)
 	getTenantPolicyQueryHandler := query.NewGetTenantPolicyQueryHandler(r.container.GetTenantPolicyQuery)
+	getCheckoutSagaQueryHandler := query.NewGetCheckoutSagaQueryHandler(r.container.GetCheckoutSagaQuery)
+	getCouponQueryHandler := query.NewGetCouponQueryHandler(r.container.GetCouponQuery)
+	getTaxSettingsQueryHandler := query.NewGetTenantTaxSettingsQueryHandler(r.container.GetTenantTaxSettingsQuery)
+	getShippingRatesQueryHandler := query.NewGetTenantShippingRatesQueryHandler(r.container.GetTenantShippingRatesQuery)
+	getSavedItemsQueryHandler := query.NewGetSavedItemsQueryHandler(r.container.GetSavedItemsQuery)
+	getApprovalPolicyQueryHandler := query.NewGetTenantApprovalPolicyQueryHandler(r.container.GetTenantApprovalPolicyQuery)
+	getApprovalInboxQueryHandler := query.NewGetApprovalInboxQueryHandler(r.container.GetApprovalInboxQuery)
+	getCartRulesQueryHandler := query.NewGetTenantCartRulesQueryHandler(r.container.GetTenantCartRulesQuery)
+	getTenantQueryHandler := query.NewGetTenantQueryHandler(r.container.GetTenantQuery)
+	getTenantPolicyHistoryQueryHandler := query.NewGetTenantPolicyHistoryQueryHandler(r.container.GetTenantPolicyHistoryQuery)
+	getCartEventHistoryQueryHandler := query.NewGetEventHistoryQueryHandler(r.container.GetCartEventHistoryQuery)
+	getTenantPolicyEventHistoryQueryHandler := query.NewGetEventHistoryQueryHandler(r.container.GetTenantPolicyEventHistoryQuery)
+	getDataSubjectRequestQueryHandler := query.NewGetDataSubjectRequestQueryHandler(r.container.GetDataSubjectRequestQuery)
 
 	// Router setup
-	return router.NewRouter(addItemCommandHandler, getCartQueryHandler, createTenantPolicyCommandHandler, updateTenantPolicyCommandHandler, getTenantPolicyQueryHandler)
+	return router.NewRouter(
+		addItemCommandHandler,
+		getCartQueryHandler,
+		createTenantPolicyCommandHandler,
+		updateTenantPolicyCommandHandler,
+		getTenantPolicyQueryHandler,
+		submitCartCommandHandler,
+		getCheckoutSagaQueryHandler,
+		authorizePaymentCommandHandler,
+		capturePaymentCommandHandler,
+		refundPaymentCommandHandler,
+		createCouponCommandHandler,
+		applyCouponCommandHandler,
+		removeCouponCommandHandler,
+		getCouponQueryHandler,
+		configureTaxSettingsCommandHandler,
+		getTaxSettingsQueryHandler,
+		setShippingAddressCommandHandler,
+		selectShippingMethodCommandHandler,
+		setShippingRatesCommandHandler,
+		getShippingRatesQueryHandler,
+		mergeCartCommandHandler,
+		saveItemCommandHandler,
+		removeSavedItemCommandHandler,
+		moveSavedItemToCartCommandHandler,
+		moveCartItemToSavedListCommandHandler,
+		getSavedItemsQueryHandler,
+		inviteCartMemberCommandHandler,
+		acceptCartInvitationCommandHandler,
+		removeCartMemberCommandHandler,
+		configureApprovalPolicyCommandHandler,
+		getApprovalPolicyQueryHandler,
+		requestCartApprovalCommandHandler,
+		decideCartApprovalCommandHandler,
+		getApprovalInboxQueryHandler,
+		configureCartRulesCommandHandler,
+		getCartRulesQueryHandler,
+		onboardTenantCommandHandler,
+		updateTenantCommandHandler,
+		changeTenantStatusCommandHandler,
+		getTenantQueryHandler,
+		configureSegmentCommandHandler,
+		removeSegmentCommandHandler,
+		getTenantPolicyHistoryQueryHandler,
+		getCartEventHistoryQueryHandler,
+		getTenantPolicyEventHistoryQueryHandler,
+		exportDataSubjectCommandHandler,
+		eraseDataSubjectCommandHandler,
+		getDataSubjectRequestQueryHandler,
+	)
 }
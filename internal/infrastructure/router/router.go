@@ -1,17 +1,62 @@
 package router
 
 import (
+	"expvar"
+
 	"github.com/gorilla/mux"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/handler/command"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/handler/query"
 )
 
 type Router struct {
-	cartAddItemHandler        *command.CartAddItemCommandHandler
-	getCartHandler            *query.GetCartQueryHandler
-	createTenantPolicyHandler *command.CreateTenantCartAbandonedPolicyCommandHandler
-	updateTenantPolicyHandler *command.UpdateTenantCartAbandonedPolicyCommandHandler
-	getTenantPolicyHandler    *query.GetTenantPolicyQueryHandler
+	cartAddItemHandler             *command.CartAddItemCommandHandler
+	getCartHandler                 *query.GetCartQueryHandler
+	createTenantPolicyHandler      *command.CreateTenantCartAbandonedPolicyCommandHandler
+	updateTenantPolicyHandler      *command.UpdateTenantCartAbandonedPolicyCommandHandler
+	getTenantPolicyHandler         *query.GetTenantPolicyQueryHandler
+	submitCartHandler              *command.SubmitCartCommandHandler
+	getCheckoutSagaHandler         *query.GetCheckoutSagaQueryHandler
+	authorizePaymentHandler        *command.AuthorizePaymentCommandHandler
+	capturePaymentHandler          *command.CapturePaymentCommandHandler
+	refundPaymentHandler           *command.RefundPaymentCommandHandler
+	createCouponHandler            *command.CreateCouponCommandHandler
+	applyCouponHandler             *command.ApplyCouponCommandHandler
+	removeCouponHandler            *command.RemoveCouponCommandHandler
+	getCouponHandler               *query.GetCouponQueryHandler
+	configureTaxSettingsHandler    *command.ConfigureTenantTaxSettingsCommandHandler
+	getTaxSettingsHandler          *query.GetTenantTaxSettingsQueryHandler
+	setShippingAddressHandler      *command.SetShippingAddressCommandHandler
+	selectShippingMethodHandler    *command.SelectShippingMethodCommandHandler
+	setShippingRatesHandler        *command.SetShippingRatesCommandHandler
+	getShippingRatesHandler        *query.GetTenantShippingRatesQueryHandler
+	mergeCartHandler               *command.MergeCartCommandHandler
+	saveItemHandler                *command.SaveItemCommandHandler
+	removeSavedItemHandler         *command.RemoveSavedItemCommandHandler
+	moveSavedItemToCartHandler     *command.MoveSavedItemToCartCommandHandler
+	moveCartItemToSavedListHandler *command.MoveCartItemToSavedListCommandHandler
+	getSavedItemsHandler           *query.GetSavedItemsQueryHandler
+	inviteCartMemberHandler        *command.InviteCartMemberCommandHandler
+	acceptCartInvitationHandler    *command.AcceptCartInvitationCommandHandler
+	removeCartMemberHandler        *command.RemoveCartMemberCommandHandler
+	configureApprovalPolicyHandler *command.ConfigureTenantApprovalPolicyCommandHandler
+	getApprovalPolicyHandler       *query.GetTenantApprovalPolicyQueryHandler
+	requestCartApprovalHandler     *command.RequestCartApprovalCommandHandler
+	decideCartApprovalHandler      *command.DecideCartApprovalCommandHandler
+	getApprovalInboxHandler        *query.GetApprovalInboxQueryHandler
+	configureCartRulesHandler      *command.ConfigureTenantCartRulesCommandHandler
+	getCartRulesHandler            *query.GetTenantCartRulesQueryHandler
+	onboardTenantHandler           *command.OnboardTenantCommandHandler
+	updateTenantHandler            *command.UpdateTenantCommandHandler
+	changeTenantStatusHandler      *command.ChangeTenantStatusCommandHandler
+	getTenantHandler               *query.GetTenantQueryHandler
+	configureSegmentHandler        *command.ConfigureCartAbandonedPolicySegmentCommandHandler
+	removeSegmentHandler           *command.RemoveCartAbandonedPolicySegmentCommandHandler
+	getTenantPolicyHistoryHandler  *query.GetTenantPolicyHistoryQueryHandler
+	getCartEventsHandler           *query.GetEventHistoryQueryHandler
+	getTenantPolicyEventsHandler   *query.GetEventHistoryQueryHandler
+	exportDataSubjectHandler       *command.ExportDataSubjectCommandHandler
+	eraseDataSubjectHandler        *command.EraseDataSubjectCommandHandler
+	getDataSubjectRequestHandler   *query.GetDataSubjectRequestQueryHandler
 }
 
 func NewRouter(
@@ -20,27 +65,182 @@ func NewRouter(
 	createTenantPolicyHandler *command.CreateTenantCartAbandonedPolicyCommandHandler,
 	updateTenantPolicyHandler *command.UpdateTenantCartAbandonedPolicyCommandHandler,
 	getTenantPolicyHandler *query.GetTenantPolicyQueryHandler,
+	submitCartHandler *command.SubmitCartCommandHandler,
+	getCheckoutSagaHandler *query.GetCheckoutSagaQueryHandler,
+	authorizePaymentHandler *command.AuthorizePaymentCommandHandler,
+	capturePaymentHandler *command.CapturePaymentCommandHandler,
+	refundPaymentHandler *command.RefundPaymentCommandHandler,
+	createCouponHandler *command.CreateCouponCommandHandler,
+	applyCouponHandler *command.ApplyCouponCommandHandler,
+	removeCouponHandler *command.RemoveCouponCommandHandler,
+	getCouponHandler *query.GetCouponQueryHandler,
+	configureTaxSettingsHandler *command.ConfigureTenantTaxSettingsCommandHandler,
+	getTaxSettingsHandler *query.GetTenantTaxSettingsQueryHandler,
+	setShippingAddressHandler *command.SetShippingAddressCommandHandler,
+	selectShippingMethodHandler *command.SelectShippingMethodCommandHandler,
+	setShippingRatesHandler *command.SetShippingRatesCommandHandler,
+	getShippingRatesHandler *query.GetTenantShippingRatesQueryHandler,
+	mergeCartHandler *command.MergeCartCommandHandler,
+	saveItemHandler *command.SaveItemCommandHandler,
+	removeSavedItemHandler *command.RemoveSavedItemCommandHandler,
+	moveSavedItemToCartHandler *command.MoveSavedItemToCartCommandHandler,
+	moveCartItemToSavedListHandler *command.MoveCartItemToSavedListCommandHandler,
+	getSavedItemsHandler *query.GetSavedItemsQueryHandler,
+	inviteCartMemberHandler *command.InviteCartMemberCommandHandler,
+	acceptCartInvitationHandler *command.AcceptCartInvitationCommandHandler,
+	removeCartMemberHandler *command.RemoveCartMemberCommandHandler,
+	configureApprovalPolicyHandler *command.ConfigureTenantApprovalPolicyCommandHandler,
+	getApprovalPolicyHandler *query.GetTenantApprovalPolicyQueryHandler,
+	requestCartApprovalHandler *command.RequestCartApprovalCommandHandler,
+	decideCartApprovalHandler *command.DecideCartApprovalCommandHandler,
+	getApprovalInboxHandler *query.GetApprovalInboxQueryHandler,
+	configureCartRulesHandler *command.ConfigureTenantCartRulesCommandHandler,
+	getCartRulesHandler *query.GetTenantCartRulesQueryHandler,
+	onboardTenantHandler *command.OnboardTenantCommandHandler,
+	updateTenantHandler *command.UpdateTenantCommandHandler,
+	changeTenantStatusHandler *command.ChangeTenantStatusCommandHandler,
+	getTenantHandler *query.GetTenantQueryHandler,
+	configureSegmentHandler *command.ConfigureCartAbandonedPolicySegmentCommandHandler,
+	removeSegmentHandler *command.RemoveCartAbandonedPolicySegmentCommandHandler,
+	getTenantPolicyHistoryHandler *query.GetTenantPolicyHistoryQueryHandler,
+	getCartEventsHandler *query.GetEventHistoryQueryHandler,
+	getTenantPolicyEventsHandler *query.GetEventHistoryQueryHandler,
+	exportDataSubjectHandler *command.ExportDataSubjectCommandHandler,
+	eraseDataSubjectHandler *command.EraseDataSubjectCommandHandler,
+	getDataSubjectRequestHandler *query.GetDataSubjectRequestQueryHandler,
 ) *Router {
 	return &Router{
-		cartAddItemHandler:        cartAddItemHandler,
-		getCartHandler:            getCartHandler,
-		createTenantPolicyHandler: createTenantPolicyHandler,
-		updateTenantPolicyHandler: updateTenantPolicyHandler,
-		getTenantPolicyHandler:    getTenantPolicyHandler,
+		cartAddItemHandler:             cartAddItemHandler,
+		getCartHandler:                 getCartHandler,
+		createTenantPolicyHandler:      createTenantPolicyHandler,
+		updateTenantPolicyHandler:      updateTenantPolicyHandler,
+		getTenantPolicyHandler:         getTenantPolicyHandler,
+		submitCartHandler:              submitCartHandler,
+		getCheckoutSagaHandler:         getCheckoutSagaHandler,
+		authorizePaymentHandler:        authorizePaymentHandler,
+		capturePaymentHandler:          capturePaymentHandler,
+		refundPaymentHandler:           refundPaymentHandler,
+		createCouponHandler:            createCouponHandler,
+		applyCouponHandler:             applyCouponHandler,
+		removeCouponHandler:            removeCouponHandler,
+		getCouponHandler:               getCouponHandler,
+		configureTaxSettingsHandler:    configureTaxSettingsHandler,
+		getTaxSettingsHandler:          getTaxSettingsHandler,
+		setShippingAddressHandler:      setShippingAddressHandler,
+		selectShippingMethodHandler:    selectShippingMethodHandler,
+		setShippingRatesHandler:        setShippingRatesHandler,
+		getShippingRatesHandler:        getShippingRatesHandler,
+		mergeCartHandler:               mergeCartHandler,
+		saveItemHandler:                saveItemHandler,
+		removeSavedItemHandler:         removeSavedItemHandler,
+		moveSavedItemToCartHandler:     moveSavedItemToCartHandler,
+		moveCartItemToSavedListHandler: moveCartItemToSavedListHandler,
+		getSavedItemsHandler:           getSavedItemsHandler,
+		inviteCartMemberHandler:        inviteCartMemberHandler,
+		acceptCartInvitationHandler:    acceptCartInvitationHandler,
+		removeCartMemberHandler:        removeCartMemberHandler,
+		configureApprovalPolicyHandler: configureApprovalPolicyHandler,
+		getApprovalPolicyHandler:       getApprovalPolicyHandler,
+		requestCartApprovalHandler:     requestCartApprovalHandler,
+		decideCartApprovalHandler:      decideCartApprovalHandler,
+		getApprovalInboxHandler:        getApprovalInboxHandler,
+		configureCartRulesHandler:      configureCartRulesHandler,
+		getCartRulesHandler:            getCartRulesHandler,
+		onboardTenantHandler:           onboardTenantHandler,
+		updateTenantHandler:            updateTenantHandler,
+		changeTenantStatusHandler:      changeTenantStatusHandler,
+		getTenantHandler:               getTenantHandler,
+		configureSegmentHandler:        configureSegmentHandler,
+		removeSegmentHandler:           removeSegmentHandler,
+		getTenantPolicyHistoryHandler:  getTenantPolicyHistoryHandler,
+		getCartEventsHandler:           getCartEventsHandler,
+		getTenantPolicyEventsHandler:   getTenantPolicyEventsHandler,
+		exportDataSubjectHandler:       exportDataSubjectHandler,
+		eraseDataSubjectHandler:        eraseDataSubjectHandler,
+		getDataSubjectRequestHandler:   getDataSubjectRequestHandler,
 	}
 }
 
 func (r *Router) SetupRoutes() *mux.Router {
 	router := mux.NewRouter()
+	router.Use(traceID)
 
 	// Cart routes
 	router.HandleFunc("/carts/{aggregate_id}/items", r.cartAddItemHandler.AddItemToCart).Methods("POST")
 	router.HandleFunc("/carts/{aggregate_id}", r.getCartHandler.GetCart).Methods("GET")
+	router.HandleFunc("/carts/{aggregate_id}/submit", r.submitCartHandler.SubmitCart).Methods("POST")
+	router.HandleFunc("/carts/{aggregate_id}/checkout", r.getCheckoutSagaHandler.GetCheckoutSaga).Methods("GET")
+	router.HandleFunc("/carts/{aggregate_id}/merge", r.mergeCartHandler.MergeCart).Methods("POST")
+	router.HandleFunc("/carts/{aggregate_id}/events", r.getCartEventsHandler.GetEventHistory).Methods("GET")
+
+	// Cart member routes
+	router.HandleFunc("/carts/{aggregate_id}/members", r.inviteCartMemberHandler.InviteCartMember).Methods("POST")
+	router.HandleFunc("/carts/{aggregate_id}/members/{member_id}/accept", r.acceptCartInvitationHandler.AcceptCartInvitation).Methods("POST")
+	router.HandleFunc("/carts/{aggregate_id}/members/{member_id}", r.removeCartMemberHandler.RemoveCartMember).Methods("DELETE")
+
+	// Cart approval routes
+	router.HandleFunc("/carts/{aggregate_id}/approval", r.requestCartApprovalHandler.RequestCartApproval).Methods("POST")
+	router.HandleFunc("/carts/{aggregate_id}/approval/decision", r.decideCartApprovalHandler.DecideCartApproval).Methods("POST")
+
+	// Shipping routes
+	router.HandleFunc("/carts/{aggregate_id}/shipping-address", r.setShippingAddressHandler.SetShippingAddress).Methods("PUT")
+	router.HandleFunc("/carts/{aggregate_id}/shipping-method", r.selectShippingMethodHandler.SelectShippingMethod).Methods("PUT")
+	router.HandleFunc("/tenants/{aggregate_id}/shipping-rates", r.setShippingRatesHandler.SetShippingRates).Methods("PUT")
+	router.HandleFunc("/tenants/{aggregate_id}/shipping-rates", r.getShippingRatesHandler.GetTenantShippingRates).Methods("GET")
+
+	// Payment routes
+	router.HandleFunc("/carts/{aggregate_id}/payment/authorize", r.authorizePaymentHandler.AuthorizePayment).Methods("POST")
+	router.HandleFunc("/carts/{aggregate_id}/payment/capture", r.capturePaymentHandler.CapturePayment).Methods("POST")
+	router.HandleFunc("/carts/{aggregate_id}/payment/refund", r.refundPaymentHandler.RefundPayment).Methods("POST")
+
+	// Coupon routes
+	router.HandleFunc("/carts/{aggregate_id}/coupons", r.applyCouponHandler.ApplyCoupon).Methods("POST")
+	router.HandleFunc("/carts/{aggregate_id}/coupons/{code}", r.removeCouponHandler.RemoveCoupon).Methods("DELETE")
+	router.HandleFunc("/tenants/{aggregate_id}/coupons", r.createCouponHandler.CreateCoupon).Methods("POST")
+	router.HandleFunc("/tenants/{aggregate_id}/coupons/{code}", r.getCouponHandler.GetCoupon).Methods("GET")
+
+	// Tenant routes
+	router.HandleFunc("/tenants", r.onboardTenantHandler.OnboardTenant).Methods("POST")
+	router.HandleFunc("/tenants/{aggregate_id}", r.updateTenantHandler.UpdateTenant).Methods("PUT")
+	router.HandleFunc("/tenants/{aggregate_id}", r.getTenantHandler.GetTenant).Methods("GET")
+	router.HandleFunc("/tenants/{aggregate_id}/status", r.changeTenantStatusHandler.ChangeTenantStatus).Methods("PUT")
+	router.HandleFunc("/tenants/{aggregate_id}/events", r.getTenantPolicyEventsHandler.GetEventHistory).Methods("GET")
 
 	// Tenant policy routes
 	router.HandleFunc("/tenants/{aggregate_id}/cart-abandoned-policies", r.createTenantPolicyHandler.CreateTenantCartAbandonedPolicy).Methods("POST")
 	router.HandleFunc("/tenants/{aggregate_id}/cart-abandoned-policies", r.updateTenantPolicyHandler.UpdateTenantCartAbandonedPolicy).Methods("PUT")
 	router.HandleFunc("/tenants/{aggregate_id}/cart-abandoned-policies", r.getTenantPolicyHandler.GetTenantPolicy).Methods("GET")
+	router.HandleFunc("/tenants/{aggregate_id}/cart-abandoned-policies/history", r.getTenantPolicyHistoryHandler.GetTenantPolicyHistory).Methods("GET")
+	router.HandleFunc("/tenants/{aggregate_id}/cart-abandoned-policies/segments/{segment_id}", r.configureSegmentHandler.ConfigureCartAbandonedPolicySegment).Methods("PUT")
+	router.HandleFunc("/tenants/{aggregate_id}/cart-abandoned-policies/segments/{segment_id}", r.removeSegmentHandler.RemoveCartAbandonedPolicySegment).Methods("DELETE")
+
+	// Tenant tax settings routes
+	router.HandleFunc("/tenants/{aggregate_id}/tax-settings", r.configureTaxSettingsHandler.ConfigureTenantTaxSettings).Methods("PUT")
+	router.HandleFunc("/tenants/{aggregate_id}/tax-settings", r.getTaxSettingsHandler.GetTenantTaxSettings).Methods("GET")
+
+	// Tenant approval routes
+	router.HandleFunc("/tenants/{aggregate_id}/approval-policy", r.configureApprovalPolicyHandler.ConfigureTenantApprovalPolicy).Methods("PUT")
+	router.HandleFunc("/tenants/{aggregate_id}/approval-policy", r.getApprovalPolicyHandler.GetTenantApprovalPolicy).Methods("GET")
+	router.HandleFunc("/tenants/{aggregate_id}/approvals", r.getApprovalInboxHandler.GetApprovalInbox).Methods("GET")
+
+	// Tenant cart rules routes
+	router.HandleFunc("/tenants/{aggregate_id}/cart-rules", r.configureCartRulesHandler.ConfigureTenantCartRules).Methods("PUT")
+	router.HandleFunc("/tenants/{aggregate_id}/cart-rules", r.getCartRulesHandler.GetTenantCartRules).Methods("GET")
+
+	// Saved item routes
+	router.HandleFunc("/users/{user_id}/saved-items", r.saveItemHandler.SaveItem).Methods("POST")
+	router.HandleFunc("/users/{user_id}/saved-items", r.getSavedItemsHandler.GetSavedItems).Methods("GET")
+	router.HandleFunc("/users/{user_id}/saved-items/{item_id}", r.removeSavedItemHandler.RemoveSavedItem).Methods("DELETE")
+	router.HandleFunc("/users/{user_id}/saved-items/{item_id}/move-to-cart", r.moveSavedItemToCartHandler.MoveSavedItemToCart).Methods("POST")
+	router.HandleFunc("/carts/{aggregate_id}/items/{line_id}/save-for-later", r.moveCartItemToSavedListHandler.MoveCartItemToSavedList).Methods("POST")
+
+	// Admin data subject routes
+	router.HandleFunc("/admin/data-subjects/{subject_id}/export", r.exportDataSubjectHandler.ExportDataSubject).Methods("POST")
+	router.HandleFunc("/admin/data-subjects/{subject_id}/erase", r.eraseDataSubjectHandler.EraseDataSubject).Methods("POST")
+	router.HandleFunc("/admin/data-subject-requests/{request_id}", r.getDataSubjectRequestHandler.GetDataSubjectRequest).Methods("GET")
+
+	// Command counters and durations
+	router.Handle("/debug/vars", expvar.Handler()).Methods("GET")
 
 	return router
 }
@@ -0,0 +1,24 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/bus"
+)
+
+const traceIDHeader = "X-Request-ID"
+
+// traceID takes the caller's request ID, or makes one up, as the trace ID of
+// the commands the request dispatches and echoes it in the response.
+func traceID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		id := req.Header.Get(traceIDHeader)
+		if id == "" {
+			id = uuid.NewString()
+		}
+
+		w.Header().Set(traceIDHeader, id)
+		next.ServeHTTP(w, req.WithContext(bus.WithTraceID(req.Context(), id)))
+	})
+}
@@ -3,33 +3,42 @@ package subscriber
 import (
 	"context"
 	"log"
+	"slices"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
-	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/service"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/gateway"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/messaging"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/messaging/dto"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore"
 )
 
 type CartAbandonmentSubscriber struct {
 	tx         repository.Transaction
 	eventStore repository.EventStore
+	policyRepo repository.AggregateRepository[*aggregate.TenantCartAbandonedPolicyAggregate]
 	delayQueue messaging.DelayQueue
+	cartStore  readmodelstore.CartStore
 	seen       map[string]struct{}
 }
 
 func NewCartAbandonmentSubscriber(
 	tx repository.Transaction,
 	eventStore repository.EventStore,
+	policyRepo repository.AggregateRepository[*aggregate.TenantCartAbandonedPolicyAggregate],
 	delayQueue messaging.DelayQueue,
+	cartStore readmodelstore.CartStore,
 ) *CartAbandonmentSubscriber {
 	return &CartAbandonmentSubscriber{
 		tx:         tx,
 		eventStore: eventStore,
+		policyRepo: policyRepo,
 		delayQueue: delayQueue,
+		cartStore:  cartStore,
 		seen:       make(map[string]struct{}),
 	}
 }
@@ -58,16 +67,34 @@ func (s *CartAbandonmentSubscriber) scheduleCartAbandonmentCheck(ctx context.Con
 	cartID := itemAdded.GetAggregateID()
 	tenantID := itemAdded.GetTenantID()
 
-	policy, err := s.loadTenantPolicy(ctx, tenantID)
+	var policy *aggregate.TenantCartAbandonedPolicyAggregate
+	err := s.tx.RWTx(ctx, func(ctx context.Context) error {
+		var err error
+		policy, err = s.policyRepo.Load(ctx, tenantID)
+		return err
+	})
 	if err != nil {
-		if errors.IsCode(err, errors.NotFound) {
-			log.Printf("No tenant policy found for tenant %s, skipping cart abandonment check", tenantID)
-			return nil
-		}
 		return err
 	}
 
-	delay := policy.CartAbandonedDelay()
+	if policy.GetVersion() == -1 {
+		log.Printf("No tenant policy found for tenant %s, skipping cart abandonment check", tenantID)
+		return nil
+	}
+
+	cart, err := s.abandonmentCart(ctx, itemAdded)
+	if err != nil {
+		return err
+	}
+
+	// Carts that match no segment fall under the tenant's default policy, as
+	// it stood when the item was added
+	delay := policy.PolicyAt(itemAdded.GetTimestamp()).CartAbandonedDelay()
+	policyID := tenantID.String()
+	if segment, ok := policy.SelectSegment(cart); ok {
+		delay = time.Duration(segment.AbandonedMinutes()) * time.Minute
+		policyID = segment.ID().String()
+	}
 
 	delayedMessage := &dto.Message{
 		ID:   uuid.New(),
@@ -75,6 +102,7 @@ func (s *CartAbandonmentSubscriber) scheduleCartAbandonmentCheck(ctx context.Con
 		Data: map[string]any{
 			"cart_id":             cartID.String(),
 			"tenant_id":           tenantID.String(),
+			"policy_id":           policyID,
 			"item_added_event_id": itemAdded.GetEventID().String(),
 			"item_added_at":       itemAdded.GetTimestamp().Unix(),
 			"delay_minutes":       delay.Minutes(),
@@ -86,30 +114,44 @@ func (s *CartAbandonmentSubscriber) scheduleCartAbandonmentCheck(ctx context.Con
 	return s.delayQueue.PublishDelayedMessage("cart-abandonment-check", cartID.String(), delayedMessage, delay)
 }
 
-func (s *CartAbandonmentSubscriber) loadTenantPolicy(ctx context.Context, tenantID uuid.UUID) (*aggregate.TenantCartAbandonedPolicyAggregate, error) {
-	var policy *aggregate.TenantCartAbandonedPolicyAggregate
+// abandonmentCart describes the cart for policy selection as it was when the
+// item was added, so a redelivered event selects the same segment. Guests
+// cannot be recognised across visits, so they always count as first-time
+// customers.
+func (s *CartAbandonmentSubscriber) abandonmentCart(ctx context.Context, itemAdded *event.ItemAddedToCartEvent) (service.AbandonmentCart, error) {
+	tenantID := itemAdded.GetTenantID()
+
+	cart := aggregate.NewCartAggregate()
 	err := s.tx.RWTx(ctx, func(ctx context.Context) error {
-		events, err := s.eventStore.LoadEvents(ctx, tenantID)
+		events, err := s.eventStore.LoadEventsUntilVersion(ctx, itemAdded.GetAggregateID(), itemAdded.GetVersion())
 		if err != nil {
 			return err
 		}
+		return cart.Hydration(events)
+	})
+	if err != nil {
+		return service.AbandonmentCart{}, err
+	}
 
-		policy = aggregate.NewTenantCartAbandonedPolicyAggregate()
-		if len(events) > 0 {
-			if err := policy.Hydration(events); err != nil {
-				return err
-			}
+	categories := make([]string, 0)
+	for _, item := range cart.GetItems() {
+		if item.GetCategory() != "" && !slices.Contains(categories, item.GetCategory()) {
+			categories = append(categories, item.GetCategory())
 		}
+	}
 
-		if policy.GetVersion() == -1 {
-			return errors.NotFound.New("tenant policy not found")
+	firstTime := true
+	if !cart.IsGuest() {
+		submitted, err := s.cartStore.CountSubmitted(ctx, tenantID.String(), cart.GetUserID().String())
+		if err != nil {
+			return service.AbandonmentCart{}, err
 		}
-
-		return nil
-	})
-	if err != nil {
-		return nil, err
+		firstTime = submitted == 0
 	}
 
-	return policy, nil
+	return service.AbandonmentCart{
+		Total:             cart.GetTotalAmount().Float64(),
+		Categories:        categories,
+		FirstTimeCustomer: firstTime,
+	}, nil
 }
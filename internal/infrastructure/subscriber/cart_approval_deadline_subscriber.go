@@ -0,0 +1,89 @@
+package subscriber
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/messaging"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/messaging/dto"
+)
+
+const (
+	ExpireCartApprovalMessageType = "ExpireCartApprovalCommand"
+
+	cartApprovalDeadlineTopic = "cart-approval-deadline"
+)
+
+// CartApprovalDeadlineSubscriber lapses approval requests nobody decided on
+// in time. Each request schedules a check for its deadline that carries the
+// version of the request, so a check only expires the request it was
+// scheduled for and a redelivered request only schedules a check that does
+// nothing.
+type CartApprovalDeadlineSubscriber struct {
+	cartRepo   repository.AggregateRepository[*aggregate.CartAggregate]
+	delayQueue messaging.DelayQueue
+}
+
+func NewCartApprovalDeadlineSubscriber(
+	cartRepo repository.AggregateRepository[*aggregate.CartAggregate],
+	delayQueue messaging.DelayQueue,
+) *CartApprovalDeadlineSubscriber {
+	return &CartApprovalDeadlineSubscriber{
+		cartRepo:   cartRepo,
+		delayQueue: delayQueue,
+	}
+}
+
+func (s *CartApprovalDeadlineSubscriber) Handle(ctx context.Context, e event.Event) error {
+	requested, ok := e.(*event.CartApprovalRequestedEvent)
+	if !ok {
+		return nil
+	}
+
+	cartID := requested.GetAggregateID()
+	checkMessage := &dto.Message{
+		ID:   uuid.New(),
+		Type: ExpireCartApprovalMessageType,
+		Data: map[string]any{
+			"cart_id":     cartID.String(),
+			"tenant_id":   requested.GetTenantID().String(),
+			"deadline_at": requested.GetDeadlineAt(),
+		},
+		AggregateID: cartID,
+		Version:     requested.GetVersion(),
+	}
+
+	delay := max(time.Until(requested.GetDeadlineAt()), 0)
+
+	return s.delayQueue.PublishDelayedMessage(cartApprovalDeadlineTopic, cartID.String(), checkMessage, delay)
+}
+
+func (s *CartApprovalDeadlineSubscriber) HandleMessage(ctx context.Context, msg *dto.Message) error {
+	if msg.Type != ExpireCartApprovalMessageType {
+		return nil
+	}
+
+	cmd := command.ExpireCartApprovalCommand{
+		CartID:             msg.AggregateID,
+		RequestedAtVersion: msg.Version,
+	}
+
+	_, events, err := s.cartRepo.Update(ctx, cmd.CartID, func(ctx context.Context, cart *aggregate.CartAggregate) error {
+		return cart.ExecuteExpireCartApprovalCommand(cmd)
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(events) > 0 {
+		log.Printf("Approval request for cart %s expired", cmd.CartID)
+	}
+
+	return nil
+}
@@ -0,0 +1,185 @@
+package subscriber
+
+import (
+	"context"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/messaging"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/messaging/dto"
+)
+
+const (
+	CloseExpiredCartMessageType = "CloseExpiredCartCommand"
+
+	cartExpiryCheckTopic = "cart-expiry-check"
+)
+
+// CartExpirySubscriber closes carts that stay idle for longer than their
+// tenant's cart expiry. Every change to a cart schedules a check that carries
+// the cart version; the check only closes the cart if that is still the
+// latest version when it fires, so a redelivered change only schedules a
+// check that does nothing.
+type CartExpirySubscriber struct {
+	tx           repository.Transaction
+	cartRepo     repository.AggregateRepository[*aggregate.CartAggregate]
+	couponRepo   repository.AggregateRepository[*aggregate.CouponAggregate]
+	policyRepo   repository.AggregateRepository[*aggregate.TenantCartAbandonedPolicyAggregate]
+	streamWriter repository.StreamWriter
+	delayQueue   messaging.DelayQueue
+}
+
+func NewCartExpirySubscriber(
+	tx repository.Transaction,
+	cartRepo repository.AggregateRepository[*aggregate.CartAggregate],
+	couponRepo repository.AggregateRepository[*aggregate.CouponAggregate],
+	policyRepo repository.AggregateRepository[*aggregate.TenantCartAbandonedPolicyAggregate],
+	streamWriter repository.StreamWriter,
+	delayQueue messaging.DelayQueue,
+) *CartExpirySubscriber {
+	return &CartExpirySubscriber{
+		tx:           tx,
+		cartRepo:     cartRepo,
+		couponRepo:   couponRepo,
+		policyRepo:   policyRepo,
+		streamWriter: streamWriter,
+		delayQueue:   delayQueue,
+	}
+}
+
+func (s *CartExpirySubscriber) Handle(ctx context.Context, e event.Event) error {
+	// Any cart event raises the version the pending check was scheduled at,
+	// so each one restarts the clock. Events that leave the cart submitted,
+	// merged or closed schedule a check that does nothing.
+	if e.GetAggregateType() != "Cart" {
+		return nil
+	}
+
+	return s.scheduleExpiryCheck(ctx, e)
+}
+
+func (s *CartExpirySubscriber) HandleMessage(ctx context.Context, msg *dto.Message) error {
+	if msg.Type != CloseExpiredCartMessageType {
+		return nil
+	}
+
+	cmd := command.CloseCartCommand{
+		CartID:           msg.AggregateID,
+		IdleSinceVersion: msg.Version,
+	}
+
+	closed := false
+	err := s.streamWriter.Update(ctx, func(ctx context.Context) ([]repository.StreamAppend, error) {
+		cart, err := s.cartRepo.Load(ctx, cmd.CartID)
+		if err != nil {
+			return nil, err
+		}
+		cartVersion := cart.GetVersion()
+
+		if err := cart.ExecuteCloseCartCommand(cmd); err != nil {
+			return nil, err
+		}
+
+		closed = len(cart.GetUncommittedEvents()) > 0
+		if !closed {
+			return nil, nil
+		}
+
+		appends := []repository.StreamAppend{{
+			AggregateID:     cart.GetAggregateID(),
+			ExpectedVersion: cartVersion,
+			Events:          cart.GetUncommittedEvents(),
+		}}
+
+		// A closed cart can no longer be checked out, so give its coupon
+		// redemptions back
+		for _, applied := range cart.GetCoupons() {
+			released, err := s.releaseCoupon(ctx, applied.GetCouponID(), cmd.CartID)
+			if err != nil {
+				return nil, err
+			}
+			appends = append(appends, released)
+		}
+
+		return appends, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if closed {
+		log.Printf("Closed idle cart %s", cmd.CartID)
+	}
+
+	return nil
+}
+
+func (s *CartExpirySubscriber) scheduleExpiryCheck(ctx context.Context, e event.Event) error {
+	cartID := e.GetAggregateID()
+
+	var tenantID uuid.UUID
+	var policy *aggregate.TenantCartAbandonedPolicyAggregate
+	err := s.tx.RWTx(ctx, func(ctx context.Context) error {
+		cart, err := s.cartRepo.Load(ctx, cartID)
+		if err != nil {
+			return err
+		}
+		tenantID = cart.GetTenantID()
+
+		policy, err = s.policyRepo.Load(ctx, tenantID)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	// Tenants without a policy do not expire carts
+	if policy.GetVersion() == -1 {
+		return nil
+	}
+
+	delay := policy.PolicyAt(e.GetTimestamp()).CartExpiryDelay()
+	if delay == 0 {
+		return nil
+	}
+
+	checkMessage := &dto.Message{
+		ID:   uuid.New(),
+		Type: CloseExpiredCartMessageType,
+		Data: map[string]any{
+			"cart_id":   cartID.String(),
+			"tenant_id": tenantID.String(),
+		},
+		AggregateID: cartID,
+		Version:     e.GetVersion(),
+	}
+
+	return s.delayQueue.PublishDelayedMessage(cartExpiryCheckTopic, cartID.String(), checkMessage, delay)
+}
+
+// releaseCoupon gives the cart's redemption of the coupon back. The release
+// is appended together with the closed cart.
+func (s *CartExpirySubscriber) releaseCoupon(ctx context.Context, couponID, cartID uuid.UUID) (repository.StreamAppend, error) {
+	coupon, err := s.couponRepo.Load(ctx, couponID)
+	if err != nil {
+		return repository.StreamAppend{}, err
+	}
+	couponVersion := coupon.GetVersion()
+
+	if err := coupon.ExecuteReleaseCouponCommand(command.ReleaseCouponCommand{
+		CouponID: couponID,
+		CartID:   cartID,
+	}); err != nil {
+		return repository.StreamAppend{}, err
+	}
+
+	return repository.StreamAppend{
+		AggregateID:     couponID,
+		ExpectedVersion: couponVersion,
+		Events:          coupon.GetUncommittedEvents(),
+	}, nil
+}
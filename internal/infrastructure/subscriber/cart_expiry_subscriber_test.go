@@ -0,0 +1,128 @@
+package subscriber_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/aggregaterepo"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/eventstore"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/eventstore/deserializer"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/outbox"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/testutil"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/transaction"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/subscriber"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/messaging"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/messaging/dto"
+)
+
+type publishedMessage struct {
+	topic   string
+	message *dto.Message
+	delay   time.Duration
+}
+
+type recordingDelayQueue struct {
+	published []publishedMessage
+}
+
+func (q *recordingDelayQueue) PublishDelayedMessage(topic, key string, message *dto.Message, delay time.Duration) error {
+	q.published = append(q.published, publishedMessage{topic: topic, message: message, delay: delay})
+	return nil
+}
+
+func (q *recordingDelayQueue) AddHandler(handler messaging.MessageHandler) {}
+
+func (q *recordingDelayQueue) Start(ctx context.Context) error { return nil }
+
+func TestCartExpirySubscriber_Handle(t *testing.T) {
+	tests := map[string]struct {
+		change          func(cartID, userID uuid.UUID) event.Event
+		cartExpiryDays  int
+		expectedVersion int
+		expectedDelay   time.Duration
+	}{
+		"moving an item to the saved list schedules a check at the new version": {
+			change: func(cartID, userID uuid.UUID) event.Event {
+				return event.NewCartItemMovedToSavedListEvent(cartID, 3, uuid.New(), uuid.New(), uuid.New(), userID)
+			},
+			cartExpiryDays:  7,
+			expectedVersion: 3,
+			expectedDelay:   7 * 24 * time.Hour,
+		},
+		"inviting a member schedules a check at the new version": {
+			change: func(cartID, userID uuid.UUID) event.Event {
+				return event.NewCartMemberInvitedEvent(cartID, 3, uuid.New(), userID)
+			},
+			cartExpiryDays:  7,
+			expectedVersion: 3,
+			expectedDelay:   7 * 24 * time.Hour,
+		},
+		"removing a member schedules a check at the new version": {
+			change: func(cartID, userID uuid.UUID) event.Event {
+				return event.NewCartMemberRemovedEvent(cartID, 3, uuid.New(), userID)
+			},
+			cartExpiryDays:  7,
+			expectedVersion: 3,
+			expectedDelay:   7 * 24 * time.Hour,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			dbClient := testutil.NewTestDBClient(t)
+			txRepo := transaction.NewTransaction(dbClient.GetDB())
+			eventStore := eventstore.NewEventStore(testutil.FakeSerializer{}, deserializer.NewEventDeserializer())
+			outboxRepo := outbox.NewOutboxRepository(testutil.FakeSerializer{})
+			cartRepo := aggregaterepo.NewAggregateRepository(txRepo, eventStore, outboxRepo, aggregate.NewCartAggregate, aggregaterepo.DefaultRetryPolicy())
+			couponRepo := aggregaterepo.NewAggregateRepository(txRepo, eventStore, outboxRepo, aggregate.NewCouponAggregate, aggregaterepo.DefaultRetryPolicy())
+			policyRepo := aggregaterepo.NewAggregateRepository(txRepo, eventStore, outboxRepo, aggregate.NewTenantCartAbandonedPolicyAggregate, aggregaterepo.DefaultRetryPolicy())
+			streamWriter := aggregaterepo.NewStreamWriter(txRepo, eventStore, outboxRepo, aggregaterepo.DefaultRetryPolicy())
+			delayQueue := &recordingDelayQueue{}
+
+			cartID := uuid.New()
+			tenantID := uuid.New()
+			userID := uuid.New()
+			change := tt.change(cartID, userID)
+			err := txRepo.RWTx(context.Background(), func(ctx context.Context) error {
+				if err := eventStore.SaveEvents(ctx, tenantID, []event.Event{
+					event.NewTenantCartAbandonedPolicyCreatedEvent(tenantID, 1, "Default", 30, time.Time{}, time.Time{}, tt.cartExpiryDays),
+				}); err != nil {
+					return err
+				}
+				return eventStore.SaveEvents(ctx, cartID, []event.Event{
+					event.NewCartCreatedEvent(cartID, 1, userID, tenantID, ""),
+					event.NewItemAddedToCartEvent(cartID, 2, uuid.New(), "Test Item", 100.0, tenantID, "STANDARD", 500, userID, nil, ""),
+					change,
+				})
+			})
+			require.NoError(t, err)
+
+			expirySubscriber := subscriber.NewCartExpirySubscriber(txRepo, cartRepo, couponRepo, policyRepo, streamWriter, delayQueue)
+
+			// Act
+			err = expirySubscriber.Handle(context.Background(), change)
+
+			// Assert
+			require.NoError(t, err)
+			require.Len(t, delayQueue.published, 1)
+			require.Equal(t, subscriber.CloseExpiredCartMessageType, delayQueue.published[0].message.Type)
+			require.Equal(t, cartID, delayQueue.published[0].message.AggregateID)
+			require.Equal(t, tt.expectedVersion, delayQueue.published[0].message.Version)
+			require.Equal(t, tt.expectedDelay, delayQueue.published[0].delay)
+
+			t.Cleanup(func() {
+				for _, aggregateID := range []uuid.UUID{cartID, tenantID} {
+					_, cleanupErr := dbClient.GetDB().Exec("DELETE FROM events WHERE aggregate_id = ?", aggregateID.String())
+					require.NoError(t, cleanupErr)
+				}
+			})
+		})
+	}
+}
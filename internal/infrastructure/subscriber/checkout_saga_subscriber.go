@@ -0,0 +1,168 @@
+package subscriber
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/messaging"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/messaging/dto"
+)
+
+const (
+	CheckoutStepSucceededMessageType         = "CheckoutStepSucceeded"
+	CheckoutStepFailedMessageType            = "CheckoutStepFailed"
+	CheckoutCompensationSucceededMessageType = "CheckoutCompensationSucceeded"
+	CheckoutStepTimeoutMessageType           = "CheckoutStepTimeoutCommand"
+
+	checkoutStepTimeoutTopic = "checkout-step-timeout"
+)
+
+type checkoutReplyData struct {
+	Step      string `json:"step"`
+	Reference string `json:"reference"`
+	Reason    string `json:"reason"`
+}
+
+type CheckoutSagaSubscriber struct {
+	sagaRepo   repository.AggregateRepository[*aggregate.CheckoutSagaAggregate]
+	delayQueue messaging.DelayQueue
+}
+
+func NewCheckoutSagaSubscriber(
+	sagaRepo repository.AggregateRepository[*aggregate.CheckoutSagaAggregate],
+	delayQueue messaging.DelayQueue,
+) *CheckoutSagaSubscriber {
+	return &CheckoutSagaSubscriber{
+		sagaRepo:   sagaRepo,
+		delayQueue: delayQueue,
+	}
+}
+
+func (s *CheckoutSagaSubscriber) Handle(ctx context.Context, e event.Event) error {
+	submitted, ok := e.(*event.CartSubmittedEvent)
+	if !ok {
+		return nil
+	}
+
+	log.Printf("Starting checkout saga for cart: %s", submitted.GetAggregateID())
+	cmd := command.StartCheckoutCommand{
+		CartID:      submitted.GetAggregateID(),
+		TotalAmount: submitted.GetTotalAmount(),
+	}
+
+	// The saga's stream ID derives from the cart, so a redelivered
+	// submission finds the saga already started instead of starting another
+	err := s.execute(ctx, aggregate.CheckoutSagaIDForCart(cmd.CartID), func(saga *aggregate.CheckoutSagaAggregate) error {
+		return saga.ExecuteStartCheckoutCommand(cmd)
+	})
+	if errors.Is(err, aggregate.ErrCheckoutSagaAlreadyStarted) {
+		log.Printf("Checkout saga for cart %s already started, skipping", cmd.CartID)
+		return nil
+	}
+	return err
+}
+
+func (s *CheckoutSagaSubscriber) HandleMessage(ctx context.Context, msg *dto.Message) error {
+	switch msg.Type {
+	case CheckoutStepSucceededMessageType, CheckoutStepFailedMessageType, CheckoutCompensationSucceededMessageType, CheckoutStepTimeoutMessageType:
+	default:
+		return nil
+	}
+
+	var data checkoutReplyData
+	if err := decodeMessageData(msg, &data); err != nil {
+		return err
+	}
+
+	step, err := value.NewCheckoutStep(data.Step)
+	if err != nil {
+		return err
+	}
+
+	sagaID := msg.AggregateID
+
+	return s.execute(ctx, sagaID, func(saga *aggregate.CheckoutSagaAggregate) error {
+		switch msg.Type {
+		case CheckoutStepSucceededMessageType:
+			return saga.ExecuteCompleteCheckoutStepCommand(command.CompleteCheckoutStepCommand{
+				SagaID:    sagaID,
+				Step:      step,
+				Reference: data.Reference,
+			})
+		case CheckoutStepFailedMessageType:
+			return saga.ExecuteFailCheckoutStepCommand(command.FailCheckoutStepCommand{
+				SagaID: sagaID,
+				Step:   step,
+				Reason: data.Reason,
+			})
+		case CheckoutCompensationSucceededMessageType:
+			return saga.ExecuteConfirmCheckoutCompensationCommand(command.ConfirmCheckoutCompensationCommand{
+				SagaID: sagaID,
+				Step:   step,
+			})
+		default:
+			return saga.ExecuteTimeoutCheckoutStepCommand(command.TimeoutCheckoutStepCommand{
+				SagaID:         sagaID,
+				Step:           step,
+				StartedVersion: msg.Version,
+			})
+		}
+	})
+}
+
+func (s *CheckoutSagaSubscriber) execute(ctx context.Context, sagaID uuid.UUID, fn func(saga *aggregate.CheckoutSagaAggregate) error) error {
+	_, events, err := s.sagaRepo.Update(ctx, sagaID, func(ctx context.Context, saga *aggregate.CheckoutSagaAggregate) error {
+		return fn(saga)
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.scheduleStepTimeouts(events)
+}
+
+func (s *CheckoutSagaSubscriber) scheduleStepTimeouts(events []event.Event) error {
+	for _, ev := range events {
+		var step value.CheckoutStep
+		switch e := ev.(type) {
+		case *event.CheckoutStepStartedEvent:
+			step = e.GetStep()
+		case *event.CheckoutCompensationStartedEvent:
+			step = e.GetStep()
+		default:
+			continue
+		}
+
+		timeoutMessage := &dto.Message{
+			ID:   uuid.New(),
+			Type: CheckoutStepTimeoutMessageType,
+			Data: map[string]any{
+				"step": step.String(),
+			},
+			AggregateID: ev.GetAggregateID(),
+			Version:     ev.GetVersion(),
+		}
+
+		if err := s.delayQueue.PublishDelayedMessage(checkoutStepTimeoutTopic, ev.GetAggregateID().String(), timeoutMessage, step.Timeout()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func decodeMessageData(msg *dto.Message, v any) error {
+	data, err := json.Marshal(msg.Data)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
@@ -44,7 +44,7 @@ func (s *CartAbandonmentService) handleMessage(ctx context.Context, msg *dto.Mes
 	}
 
 	// Deserialize event
-	event, err := s.deserializer.Deserialize(msg.Type, eventData)
+	event, err := s.deserializer.Deserialize(ctx, msg.Type, eventData)
 	if err != nil {
 		return err
 	}
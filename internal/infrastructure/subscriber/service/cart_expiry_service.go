@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/messaging"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/messaging/dto"
+)
+
+type CartExpiryService struct {
+	deserializer   repository.EventDeserializer
+	processManager messaging.ProcessManager
+	consumerGroup  messaging.ConsumerGroup
+}
+
+func NewCartExpiryService(
+	deserializer repository.EventDeserializer,
+	processManager messaging.ProcessManager,
+	consumerGroup messaging.ConsumerGroup,
+	delayQueue messaging.DelayQueue,
+) *CartExpiryService {
+	service := &CartExpiryService{
+		deserializer:   deserializer,
+		processManager: processManager,
+		consumerGroup:  consumerGroup,
+	}
+
+	// Cart activity schedules expiry checks
+	consumerGroup.AddHandler(service.handleMessage)
+
+	// Expiry checks come back through the delay queue
+	delayQueue.AddHandler(processManager.HandleMessage)
+
+	return service
+}
+
+func (s *CartExpiryService) handleMessage(ctx context.Context, msg *dto.Message) error {
+	eventData, err := json.Marshal(msg.Data)
+	if err != nil {
+		return err
+	}
+
+	event, err := s.deserializer.Deserialize(ctx, msg.Type, eventData)
+	if err != nil {
+		return err
+	}
+
+	return s.processManager.Handle(ctx, event)
+}
+
+func (s *CartExpiryService) Start(ctx context.Context) error {
+	log.Println("Starting Cart Expiry Service...")
+	return s.consumerGroup.Start(ctx)
+}
+
+func (s *CartExpiryService) Close() error {
+	return s.consumerGroup.Close()
+}
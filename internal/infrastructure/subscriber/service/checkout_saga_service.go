@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/messaging"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/messaging/dto"
+)
+
+type CheckoutSagaService struct {
+	deserializer   repository.EventDeserializer
+	processManager messaging.ProcessManager
+	consumerGroup  messaging.ConsumerGroup
+}
+
+func NewCheckoutSagaService(
+	deserializer repository.EventDeserializer,
+	processManager messaging.ProcessManager,
+	consumerGroup messaging.ConsumerGroup,
+	delayQueue messaging.DelayQueue,
+) *CheckoutSagaService {
+	service := &CheckoutSagaService{
+		deserializer:   deserializer,
+		processManager: processManager,
+		consumerGroup:  consumerGroup,
+	}
+
+	// Cart events start sagas, participant replies move them forward
+	consumerGroup.AddHandler(service.handleMessage)
+
+	// Step timeouts come back through the delay queue
+	delayQueue.AddHandler(processManager.HandleMessage)
+
+	return service
+}
+
+func (s *CheckoutSagaService) handleMessage(ctx context.Context, msg *dto.Message) error {
+	eventData, err := json.Marshal(msg.Data)
+	if err != nil {
+		return err
+	}
+
+	// Anything that is not a domain event is a participant reply
+	event, err := s.deserializer.Deserialize(ctx, msg.Type, eventData)
+	if err != nil {
+		return s.processManager.HandleMessage(ctx, msg)
+	}
+
+	return s.processManager.Handle(ctx, event)
+}
+
+func (s *CheckoutSagaService) Start(ctx context.Context) error {
+	log.Println("Starting Checkout Saga Service...")
+	return s.consumerGroup.Start(ctx)
+}
+
+func (s *CheckoutSagaService) Close() error {
+	return s.consumerGroup.Close()
+}
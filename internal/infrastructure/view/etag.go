@@ -0,0 +1,9 @@
+package view
+
+import "strconv"
+
+// ETag is the entity tag of an aggregate at the version, which clients send
+// back in If-Match.
+func ETag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
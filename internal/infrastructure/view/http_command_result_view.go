@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 
+	appErrors "github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/presenter/viewmodel"
 )
 
@@ -20,15 +21,24 @@ func NewHTTPCommandResultView(w http.ResponseWriter) *HTTPCommandResultView {
 
 func (v *HTTPCommandResultView) Render(ctx context.Context, vm *viewmodel.CommandResultViewModel, status int, err error) error {
 	v.writer.Header().Set("Content-Type", "application/json")
-	v.writer.WriteHeader(status)
 
 	if err != nil {
 		errorResponse := map[string]any{
 			"status":  "error",
 			"message": err.Error(),
 		}
+		if details := appErrors.DetailsOf(err); len(details) > 0 {
+			errorResponse["details"] = details
+		}
+		if current, ok := appErrors.CurrentVersionOf(err); ok {
+			v.writer.Header().Set("ETag", ETag(current))
+			errorResponse["current_version"] = current
+		}
+		v.writer.WriteHeader(status)
 		return json.NewEncoder(v.writer).Encode(errorResponse)
 	}
 
+	v.writer.Header().Set("ETag", ETag(vm.Version))
+	v.writer.WriteHeader(status)
 	return json.NewEncoder(v.writer).Encode(vm)
 }
@@ -10,6 +10,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	appErrors "github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/presenter/viewmodel"
 )
 
@@ -19,6 +20,7 @@ func TestHTTPCommandResultView_Render(t *testing.T) {
 		status         int
 		err            error
 		expectedStatus int
+		expectedETag   string
 		expectedBody   map[string]any
 	}{
 		"success case": {
@@ -39,6 +41,7 @@ func TestHTTPCommandResultView_Render(t *testing.T) {
 			status:         http.StatusOK,
 			err:            nil,
 			expectedStatus: http.StatusOK,
+			expectedETag:   `"1"`,
 			expectedBody: map[string]any{
 				"aggregateId": "test-aggregate-id",
 				"version":     float64(1),
@@ -56,6 +59,33 @@ func TestHTTPCommandResultView_Render(t *testing.T) {
 				"message": "test error message",
 			},
 		},
+		"error case with details": {
+			vm:     nil,
+			status: http.StatusUnprocessableEntity,
+			err: appErrors.InvalidParameter.NewWithDetails("cart breaks the tenant's cart rules", []appErrors.Detail{
+				{Code: "MAX_LINES", Message: "cart can have at most 10 lines"},
+			}),
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedBody: map[string]any{
+				"status":  "error",
+				"message": "cart breaks the tenant's cart rules",
+				"details": []any{
+					map[string]any{"code": "MAX_LINES", "message": "cart can have at most 10 lines"},
+				},
+			},
+		},
+		"precondition failed case": {
+			vm:             nil,
+			status:         http.StatusPreconditionFailed,
+			err:            appErrors.NewVersionMismatch(7, 9),
+			expectedStatus: http.StatusPreconditionFailed,
+			expectedETag:   `"9"`,
+			expectedBody: map[string]any{
+				"status":          "error",
+				"message":         "expected version 7 but aggregate is at version 9",
+				"current_version": float64(9),
+			},
+		},
 		"error case with nil viewmodel": {
 			vm:             nil,
 			status:         http.StatusInternalServerError,
@@ -81,6 +111,7 @@ func TestHTTPCommandResultView_Render(t *testing.T) {
 			require.NoError(t, err)
 			assert.Equal(t, tt.expectedStatus, recorder.Code)
 			assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+			assert.Equal(t, tt.expectedETag, recorder.Header().Get("ETag"))
 
 			var response map[string]any
 			err = json.NewDecoder(recorder.Body).Decode(&response)
@@ -25,6 +25,11 @@ func (v *HTTPQueryResultView) Success(data []byte) error {
 	return err
 }
 
+func (v *HTTPQueryResultView) VersionedSuccess(data []byte, version int) error {
+	v.writer.Header().Set("ETag", ETag(version))
+	return v.Success(data)
+}
+
 func (v *HTTPQueryResultView) Error(err error) error {
 	v.writer.Header().Set("Content-Type", "application/json")
 
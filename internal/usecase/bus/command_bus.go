@@ -0,0 +1,123 @@
+package bus
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+)
+
+// Command is a use case input that can be dispatched through the bus. The
+// name identifies the handler, so it must not depend on the command's fields.
+type Command interface {
+	CommandName() string
+}
+
+// Handler is a command use case. Every use case in usecase/command already
+// has this shape.
+type Handler[C Command] interface {
+	Execute(ctx context.Context, cmd C, out presenter.CommandResultPresenter) error
+}
+
+// Result is what a handler presented on success, and when.
+type Result struct {
+	AggregateID string
+	Version     int
+	Events      []event.Event
+	ExecutedAt  time.Time
+}
+
+// HandlerFunc runs a command and returns its result instead of presenting it,
+// so that middleware can look at the outcome and retry before anything
+// reaches the caller.
+type HandlerFunc func(ctx context.Context, cmd Command) (Result, error)
+
+// Middleware wraps a handler with a concern shared by all commands.
+type Middleware func(next HandlerFunc) HandlerFunc
+
+type CommandBusInterface interface {
+	Dispatch(ctx context.Context, cmd Command, out presenter.CommandResultPresenter) error
+}
+
+type CommandBus struct {
+	handlers    map[string]HandlerFunc
+	middlewares []Middleware
+}
+
+// NewCommandBus returns a bus running every command through the middlewares,
+// the first one being the outermost.
+func NewCommandBus(middlewares ...Middleware) *CommandBus {
+	return &CommandBus{
+		handlers:    make(map[string]HandlerFunc),
+		middlewares: middlewares,
+	}
+}
+
+// Register routes commands of type C to the handler. It panics when C already
+// has a handler, as that is a wiring mistake.
+func Register[C Command](b *CommandBus, handler Handler[C]) {
+	var zero C
+	name := zero.CommandName()
+	if _, ok := b.handlers[name]; ok {
+		panic(fmt.Sprintf("command %s is already registered", name))
+	}
+
+	var next HandlerFunc = func(ctx context.Context, cmd Command) (Result, error) {
+		typed, ok := cmd.(C)
+		if !ok {
+			return Result{}, errors.InvalidParameter.New(fmt.Sprintf("command %s has unexpected type %s", name, reflect.TypeOf(cmd)))
+		}
+
+		recorder := &resultRecorder{}
+		if err := handler.Execute(ctx, typed, recorder); err != nil {
+			return Result{}, err
+		}
+		return recorder.result, recorder.err
+	}
+	for i := len(b.middlewares) - 1; i >= 0; i-- {
+		next = b.middlewares[i](next)
+	}
+
+	b.handlers[name] = next
+}
+
+func (b *CommandBus) Dispatch(ctx context.Context, cmd Command, out presenter.CommandResultPresenter) error {
+	handler, ok := b.handlers[cmd.CommandName()]
+	if !ok {
+		return out.PresentError(ctx, errors.NotFound.New(fmt.Sprintf("no handler registered for command %s", cmd.CommandName())))
+	}
+
+	result, err := handler(ctx, cmd)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	// A replayed result keeps the time it was first executed at
+	if timed, ok := out.(presenter.ExecutionTimePresenter); ok && !result.ExecutedAt.IsZero() {
+		return timed.PresentSuccessAt(ctx, result.AggregateID, result.Version, result.Events, result.ExecutedAt)
+	}
+	return out.PresentSuccess(ctx, result.AggregateID, result.Version, result.Events)
+}
+
+// resultRecorder keeps what the use case presented so the bus can hand it
+// to the caller's presenter once all middleware is done.
+type resultRecorder struct {
+	result Result
+	err    error
+}
+
+func (r *resultRecorder) PresentSuccess(ctx context.Context, aggregateID string, version int, events []event.Event) error {
+	r.result = Result{AggregateID: aggregateID, Version: version, Events: events, ExecutedAt: time.Now().UTC()}
+	r.err = nil
+	return nil
+}
+
+func (r *resultRecorder) PresentError(ctx context.Context, err error) error {
+	r.result = Result{}
+	r.err = err
+	return nil
+}
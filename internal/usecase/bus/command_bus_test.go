@@ -0,0 +1,448 @@
+package bus_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/bus"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+)
+
+type testCommand struct {
+	ID              string
+	TenantID        string `json:"tenant_id"`
+	UserID          string `json:"user_id"`
+	Quantity        int
+	Invalid         bool
+	AggregateID     string
+	ExpectedVersion *int
+	Gateway         bool
+}
+
+func (*testCommand) CommandName() string { return "Test" }
+
+func (c *testCommand) CommandID() string { return c.ID }
+
+func (c *testCommand) CallsOut() bool { return c.Gateway }
+
+func (c *testCommand) Validate() error {
+	if c.Invalid {
+		return errors.InvalidParameter.New("invalid command")
+	}
+	return nil
+}
+
+func (c *testCommand) VersionPrecondition() (string, int, bool) {
+	if c.ExpectedVersion == nil {
+		return "", 0, false
+	}
+	return c.AggregateID, *c.ExpectedVersion, true
+}
+
+type otherCommand struct{}
+
+func (*otherCommand) CommandName() string { return "Other" }
+
+// testHandler fails with the queued errors before it succeeds.
+type testHandler struct {
+	errs  []error
+	calls int
+	ctx   context.Context
+}
+
+func (h *testHandler) Execute(ctx context.Context, cmd *testCommand, out presenter.CommandResultPresenter) error {
+	h.calls++
+	h.ctx = ctx
+	if len(h.errs) > 0 {
+		err := h.errs[0]
+		h.errs = h.errs[1:]
+		return out.PresentError(ctx, err)
+	}
+	return out.PresentSuccess(ctx, "aggregate-1", h.calls, nil)
+}
+
+type testPresenter struct {
+	aggregateID string
+	version     int
+	executedAt  time.Time
+	err         error
+}
+
+func (p *testPresenter) PresentSuccess(ctx context.Context, aggregateID string, version int, events []event.Event) error {
+	p.aggregateID = aggregateID
+	p.version = version
+	return nil
+}
+
+func (p *testPresenter) PresentSuccessAt(ctx context.Context, aggregateID string, version int, events []event.Event, executedAt time.Time) error {
+	p.executedAt = executedAt
+	return p.PresentSuccess(ctx, aggregateID, version, events)
+}
+
+func (p *testPresenter) PresentError(ctx context.Context, err error) error {
+	p.err = err
+	return nil
+}
+
+type fakeTransaction struct{}
+
+func (fakeTransaction) RWTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (fakeTransaction) SharedRWTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (fakeTransaction) Shared(ctx context.Context) bool {
+	return false
+}
+
+func (fakeTransaction) AfterCommit(fn func() error) {}
+
+type sharedKey struct{}
+
+// sharingTransaction tells whether a context is within a SharedRWTx.
+type sharingTransaction struct {
+	fakeTransaction
+}
+
+func (sharingTransaction) SharedRWTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(context.WithValue(ctx, sharedKey{}, true))
+}
+
+func (sharingTransaction) Shared(ctx context.Context) bool {
+	return ctx.Value(sharedKey{}) != nil
+}
+
+type memoryStore struct {
+	processed map[string]bus.ProcessedCommand
+}
+
+func (s *memoryStore) Get(ctx context.Context, key string) (bus.ProcessedCommand, bool, error) {
+	processed, ok := s.processed[key]
+	return processed, ok, nil
+}
+
+func (s *memoryStore) Save(ctx context.Context, key string, processed bus.ProcessedCommand) error {
+	s.processed[key] = processed
+	return nil
+}
+
+func noDelay(attempt int) time.Duration { return 0 }
+
+func TestCommandBus_Dispatch(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		handlerErrs     []error
+		cmd             bus.Command
+		wantErrCode     errors.ErrCode
+		wantCalls       int
+		wantAggregateID string
+	}{
+		"routes the command to its handler": {
+			cmd:             &testCommand{},
+			wantCalls:       1,
+			wantAggregateID: "aggregate-1",
+		},
+		"presents the handler's error": {
+			handlerErrs: []error{errors.UnpermittedOp.New("not allowed")},
+			cmd:         &testCommand{},
+			wantErrCode: errors.UnpermittedOp,
+			wantCalls:   1,
+		},
+		"rejects an invalid command before its handler": {
+			cmd:         &testCommand{Invalid: true},
+			wantErrCode: errors.InvalidParameter,
+		},
+		"retries a lost optimistic lock": {
+			handlerErrs:     []error{errors.OptimisticLock.New("conflict"), errors.OptimisticLock.New("conflict")},
+			cmd:             &testCommand{ID: "cmd-1"},
+			wantCalls:       3,
+			wantAggregateID: "aggregate-1",
+		},
+		"gives up after the last attempt": {
+			handlerErrs: []error{errors.OptimisticLock.New("conflict"), errors.OptimisticLock.New("conflict"), errors.OptimisticLock.New("conflict")},
+			cmd:         &testCommand{ID: "cmd-1"},
+			wantErrCode: errors.OptimisticLock,
+			wantCalls:   3,
+		},
+		"leaves commands without an ID to retry their own writes": {
+			handlerErrs: []error{errors.OptimisticLock.New("conflict")},
+			cmd:         &testCommand{},
+			wantErrCode: errors.OptimisticLock,
+			wantCalls:   1,
+		},
+		"leaves commands that call out to retry their own writes": {
+			handlerErrs: []error{errors.OptimisticLock.New("conflict")},
+			cmd:         &testCommand{ID: "cmd-1", Gateway: true},
+			wantErrCode: errors.OptimisticLock,
+			wantCalls:   1,
+		},
+		"reports commands without a handler as not found": {
+			cmd:         &otherCommand{},
+			wantErrCode: errors.NotFound,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			handler := &testHandler{errs: tt.handlerErrs}
+			commandBus := bus.NewCommandBus(bus.Validation(), bus.Retry(3, noDelay))
+			bus.Register[*testCommand](commandBus, handler)
+			out := &testPresenter{}
+
+			// Act
+			err := commandBus.Dispatch(context.Background(), tt.cmd, out)
+
+			// Assert
+			require.NoError(t, err)
+			require.Equal(t, tt.wantCalls, handler.calls)
+			if tt.wantErrCode != "" {
+				require.True(t, errors.IsCode(out.err, tt.wantErrCode))
+				return
+			}
+			require.NoError(t, out.err)
+			require.Equal(t, tt.wantAggregateID, out.aggregateID)
+		})
+	}
+}
+
+func TestCommandBus_Idempotency(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		first       *testCommand
+		second      *testCommand
+		wantErrCode errors.ErrCode
+		wantCalls   int
+	}{
+		"replays a command sent twice": {
+			first:     &testCommand{ID: "cmd-1", Quantity: 1},
+			second:    &testCommand{ID: "cmd-1", Quantity: 1},
+			wantCalls: 1,
+		},
+		"rejects a different command under a used ID": {
+			first:       &testCommand{ID: "cmd-1", Quantity: 1},
+			second:      &testCommand{ID: "cmd-1", Quantity: 2},
+			wantErrCode: errors.InvalidParameter,
+			wantCalls:   1,
+		},
+		"handles commands with different IDs": {
+			first:     &testCommand{ID: "cmd-1", Quantity: 1},
+			second:    &testCommand{ID: "cmd-2", Quantity: 1},
+			wantCalls: 2,
+		},
+		"handles commands without an ID every time": {
+			first:     &testCommand{Quantity: 1},
+			second:    &testCommand{Quantity: 1},
+			wantCalls: 2,
+		},
+		"handles the same ID from another tenant": {
+			first:     &testCommand{ID: "cmd-1", TenantID: "tenant-1", Quantity: 1},
+			second:    &testCommand{ID: "cmd-1", TenantID: "tenant-2", Quantity: 2},
+			wantCalls: 2,
+		},
+		"handles the same ID from another user": {
+			first:     &testCommand{ID: "cmd-1", TenantID: "tenant-1", UserID: "user-1", Quantity: 1},
+			second:    &testCommand{ID: "cmd-1", TenantID: "tenant-1", UserID: "user-2", Quantity: 1},
+			wantCalls: 2,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			handler := &testHandler{}
+			commandBus := bus.NewCommandBus(bus.Idempotency(fakeTransaction{}, &memoryStore{processed: map[string]bus.ProcessedCommand{}}))
+			bus.Register[*testCommand](commandBus, handler)
+			first := &testPresenter{}
+			second := &testPresenter{}
+			require.NoError(t, commandBus.Dispatch(context.Background(), tt.first, first))
+
+			// Act
+			err := commandBus.Dispatch(context.Background(), tt.second, second)
+
+			// Assert
+			require.NoError(t, err)
+			require.Equal(t, tt.wantCalls, handler.calls)
+			if tt.wantErrCode != "" {
+				require.True(t, errors.IsCode(second.err, tt.wantErrCode))
+				return
+			}
+			require.NoError(t, second.err)
+			require.Equal(t, tt.wantCalls, second.version)
+			require.Equal(t, first.aggregateID, second.aggregateID)
+			require.False(t, second.executedAt.IsZero())
+			if tt.wantCalls == 1 {
+				require.Equal(t, first.executedAt, second.executedAt)
+			}
+		})
+	}
+}
+
+func TestCommandBus_IdempotencyCallingOut(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		cmd        *testCommand
+		wantCalls  int
+		wantShared bool
+	}{
+		"handles a command that calls out outside of the record's transaction": {
+			cmd:       &testCommand{ID: "cmd-1", Gateway: true},
+			wantCalls: 1,
+		},
+		"handles other commands in the record's transaction": {
+			cmd:        &testCommand{ID: "cmd-1"},
+			wantCalls:  1,
+			wantShared: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			tx := sharingTransaction{}
+			handler := &testHandler{}
+			commandBus := bus.NewCommandBus(bus.Idempotency(tx, &memoryStore{processed: map[string]bus.ProcessedCommand{}}))
+			bus.Register[*testCommand](commandBus, handler)
+			first := &testPresenter{}
+			second := &testPresenter{}
+
+			// Act
+			require.NoError(t, commandBus.Dispatch(context.Background(), tt.cmd, first))
+			shared := tx.Shared(handler.ctx)
+			err := commandBus.Dispatch(context.Background(), tt.cmd, second)
+
+			// Assert
+			require.NoError(t, err)
+			require.NoError(t, second.err)
+			require.Equal(t, tt.wantCalls, handler.calls)
+			require.Equal(t, tt.wantShared, shared)
+			require.Equal(t, first.executedAt, second.executedAt)
+			require.Equal(t, first.version, second.version)
+		})
+	}
+}
+
+func TestCommandBus_Tracing(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		ctx         context.Context
+		wantTraceID string
+	}{
+		"keeps the caller's trace ID": {
+			ctx:         bus.WithTraceID(context.Background(), "trace-1"),
+			wantTraceID: "trace-1",
+		},
+		"starts a trace when there is none": {
+			ctx: context.Background(),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			handler := &testHandler{}
+			commandBus := bus.NewCommandBus(bus.Tracing())
+			bus.Register[*testCommand](commandBus, handler)
+
+			// Act
+			err := commandBus.Dispatch(tt.ctx, &testCommand{}, &testPresenter{})
+
+			// Assert
+			require.NoError(t, err)
+			require.NotEmpty(t, bus.TraceID(handler.ctx))
+			if tt.wantTraceID != "" {
+				require.Equal(t, tt.wantTraceID, bus.TraceID(handler.ctx))
+			}
+		})
+	}
+}
+
+func TestCommandBus_Preconditions(t *testing.T) {
+	t.Parallel()
+
+	aggregateID := uuid.New()
+	version := 7
+
+	tests := map[string]struct {
+		cmd         *testCommand
+		wantErrCode errors.ErrCode
+		wantVersion *int
+	}{
+		"passes the expected version down": {
+			cmd:         &testCommand{AggregateID: aggregateID.String(), ExpectedVersion: &version},
+			wantVersion: &version,
+		},
+		"expects no version without a precondition": {
+			cmd: &testCommand{AggregateID: aggregateID.String()},
+		},
+		"rejects a precondition on an invalid aggregate ID": {
+			cmd:         &testCommand{AggregateID: "not-a-uuid", ExpectedVersion: &version},
+			wantErrCode: errors.InvalidParameter,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			handler := &testHandler{}
+			commandBus := bus.NewCommandBus(bus.Preconditions())
+			bus.Register[*testCommand](commandBus, handler)
+			out := &testPresenter{}
+
+			// Act
+			err := commandBus.Dispatch(context.Background(), tt.cmd, out)
+
+			// Assert
+			require.NoError(t, err)
+			if tt.wantErrCode != "" {
+				require.True(t, errors.IsCode(out.err, tt.wantErrCode))
+				require.Zero(t, handler.calls)
+				return
+			}
+			expected, ok := repository.ExpectedVersion(handler.ctx, aggregateID)
+			if tt.wantVersion == nil {
+				require.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			require.Equal(t, *tt.wantVersion, expected)
+		})
+	}
+}
+
+func TestRegister_PanicsOnSecondHandler(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	commandBus := bus.NewCommandBus()
+	bus.Register[*testCommand](commandBus, &testHandler{})
+
+	// Act & Assert
+	require.Panics(t, func() {
+		bus.Register[*testCommand](commandBus, &testHandler{})
+	})
+}
@@ -0,0 +1,183 @@
+package bus
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+)
+
+var ErrIdempotencyKeyReused = errors.InvalidParameter.New("idempotency key was already used for a different request")
+
+// Identifiable is implemented by commands that carry a client-supplied ID, so
+// that sending the same command twice only handles it once.
+type Identifiable interface {
+	CommandID() string
+}
+
+// CallsOut is implemented by commands that call a service outside the
+// database, such as a payment gateway, between their writes. Holding the
+// locks of a transaction while waiting on it, and its writes uncommitted
+// while it acts on them, is what they avoid, so their ID is recorded in a
+// transaction of its own instead of sharing one with the command.
+type CallsOut interface {
+	CallsOut() bool
+}
+
+func callsOut(cmd Command) bool {
+	caller, ok := cmd.(CallsOut)
+	return ok && caller.CallsOut()
+}
+
+// ProcessedCommand is the result of a command handled under an ID, with a
+// fingerprint of the command to tell a repeat from a different request.
+type ProcessedCommand struct {
+	Fingerprint string
+	Result      Result
+}
+
+type IdempotencyStore interface {
+	// Get returns the command processed under the key, if any.
+	Get(ctx context.Context, key string) (ProcessedCommand, bool, error)
+	// Save fails with OptimisticLock when the key was saved concurrently.
+	Save(ctx context.Context, key string, processed ProcessedCommand) error
+}
+
+// Idempotency replays the result of a command that was already handled under
+// the same ID, as it was first presented. IDs are chosen by clients, so they
+// only have to be unique for the tenant and user the command is sent for. The
+// command and its record share one transaction, so a command is either
+// handled and recorded or neither. A concurrent duplicate loses on the record
+// with OptimisticLock and is replayed once retried. Commands without an ID
+// are always handled.
+//
+// Commands that call out are looked up and recorded in transactions of their
+// own around the command. A concurrent duplicate may then be handled as
+// well, so they must be idempotent on their own; the first to be recorded is
+// what both are answered with.
+func Idempotency(tx repository.Transaction, store IdempotencyStore) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, cmd Command) (Result, error) {
+			identifiable, ok := cmd.(Identifiable)
+			if !ok || identifiable.CommandID() == "" {
+				return next(ctx, cmd)
+			}
+
+			payload, err := json.Marshal(cmd)
+			if err != nil {
+				return Result{}, errors.InvalidParameter.Wrap(err, "command cannot be fingerprinted")
+			}
+			key, err := idempotencyKey(cmd, identifiable.CommandID(), payload)
+			if err != nil {
+				return Result{}, err
+			}
+			fingerprint := fingerprintOf(payload)
+
+			if callsOut(cmd) {
+				return handleCallingOut(ctx, tx, store, key, fingerprint, next, cmd)
+			}
+
+			var result Result
+			err = tx.SharedRWTx(ctx, func(ctx context.Context) error {
+				processed, found, err := store.Get(ctx, key)
+				if err != nil {
+					return err
+				}
+				if found {
+					if processed.Fingerprint != fingerprint {
+						return ErrIdempotencyKeyReused
+					}
+					result = processed.Result
+					return nil
+				}
+
+				result, err = next(ctx, cmd)
+				if err != nil {
+					return err
+				}
+
+				return store.Save(ctx, key, ProcessedCommand{Fingerprint: fingerprint, Result: result})
+			})
+			if err != nil {
+				return Result{}, err
+			}
+
+			return result, nil
+		}
+	}
+}
+
+func handleCallingOut(ctx context.Context, tx repository.Transaction, store IdempotencyStore, key string, fingerprint string, next HandlerFunc, cmd Command) (Result, error) {
+	replay := func() (Result, bool, error) {
+		var processed ProcessedCommand
+		var found bool
+		err := tx.RWTx(ctx, func(ctx context.Context) error {
+			var err error
+			processed, found, err = store.Get(ctx, key)
+			return err
+		})
+		if err != nil || !found {
+			return Result{}, false, err
+		}
+		if processed.Fingerprint != fingerprint {
+			return Result{}, false, ErrIdempotencyKeyReused
+		}
+		return processed.Result, true, nil
+	}
+
+	result, found, err := replay()
+	if err != nil || found {
+		return result, err
+	}
+
+	result, err = next(ctx, cmd)
+	if err != nil {
+		return Result{}, err
+	}
+
+	err = tx.RWTx(ctx, func(ctx context.Context) error {
+		return store.Save(ctx, key, ProcessedCommand{Fingerprint: fingerprint, Result: result})
+	})
+	if errors.IsCode(err, errors.OptimisticLock) {
+		// A concurrent duplicate was recorded first
+		if replayed, found, err := replay(); err != nil || found {
+			return replayed, err
+		}
+	}
+	if err != nil {
+		return Result{}, err
+	}
+
+	return result, nil
+}
+
+// commandScope is who a command is sent for, as far as it names them. A
+// command that names no tenant is sent to a cart, which belongs to a single
+// tenant and stands in for it.
+type commandScope struct {
+	TenantID string `json:"tenant_id"`
+	UserID   string `json:"user_id"`
+	CartID   string `json:"cart_id"`
+}
+
+func idempotencyKey(cmd Command, commandID string, payload []byte) (string, error) {
+	var scope commandScope
+	if err := json.Unmarshal(payload, &scope); err != nil {
+		return "", errors.InvalidParameter.Wrap(err, "command cannot be scoped")
+	}
+
+	tenant := "tenant/" + scope.TenantID
+	if scope.TenantID == "" && scope.CartID != "" {
+		tenant = "cart/" + scope.CartID
+	}
+	return strings.Join([]string{cmd.CommandName(), tenant, "user/" + scope.UserID, commandID}, ":"), nil
+}
+
+func fingerprintOf(payload []byte) string {
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:])
+}
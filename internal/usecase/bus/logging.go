@@ -0,0 +1,23 @@
+package bus
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+func Logging() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, cmd Command) (Result, error) {
+			start := time.Now()
+			result, err := next(ctx, cmd)
+			if err != nil {
+				log.Printf("Command %s failed after %v (trace %s): %v", cmd.CommandName(), time.Since(start), TraceID(ctx), err)
+				return result, err
+			}
+
+			log.Printf("Command %s handled in %v (trace %s, aggregate %s, version %d)", cmd.CommandName(), time.Since(start), TraceID(ctx), result.AggregateID, result.Version)
+			return result, nil
+		}
+	}
+}
@@ -0,0 +1,21 @@
+package bus
+
+import (
+	"context"
+	"time"
+)
+
+type CommandMetrics interface {
+	ObserveCommand(name string, duration time.Duration, err error)
+}
+
+func Metrics(metrics CommandMetrics) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, cmd Command) (Result, error) {
+			start := time.Now()
+			result, err := next(ctx, cmd)
+			metrics.ObserveCommand(cmd.CommandName(), time.Since(start), err)
+			return result, err
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package bus
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+)
+
+// Preconditioned is implemented by commands that may only apply while their
+// aggregate is at a given version.
+type Preconditioned interface {
+	VersionPrecondition() (aggregateID string, version int, ok bool)
+}
+
+// Preconditions passes the expected version of a command down to the event
+// store, which refuses the append with PreconditionFailed when the aggregate
+// is at another version.
+func Preconditions() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, cmd Command) (Result, error) {
+			preconditioned, ok := cmd.(Preconditioned)
+			if !ok {
+				return next(ctx, cmd)
+			}
+
+			id, version, ok := preconditioned.VersionPrecondition()
+			if !ok {
+				return next(ctx, cmd)
+			}
+
+			aggregateID, err := uuid.Parse(id)
+			if err != nil {
+				return Result{}, errors.InvalidParameter.Wrap(err, "invalid aggregate id")
+			}
+
+			return next(repository.WithExpectedVersion(ctx, aggregateID, version), cmd)
+		}
+	}
+}
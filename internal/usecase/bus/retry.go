@@ -0,0 +1,39 @@
+package bus
+
+import (
+	"context"
+	"time"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+)
+
+// Retry runs a command with an ID again when it lost an optimistic lock,
+// waiting delay(attempt) between attempts. Such a command runs in one shared
+// transaction with its idempotency record, where its writes cannot retry on
+// their own. Commands without an ID retry each write instead, so they are not
+// run again here, and neither are commands that call out, whose writes do not
+// share the record's transaction.
+func Retry(maxAttempts int, delay func(attempt int) time.Duration) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, cmd Command) (Result, error) {
+			if identifiable, ok := cmd.(Identifiable); !ok || identifiable.CommandID() == "" || callsOut(cmd) {
+				return next(ctx, cmd)
+			}
+
+			for attempt := 0; ; attempt++ {
+				result, err := next(ctx, cmd)
+				if err == nil || !errors.IsCode(err, errors.OptimisticLock) || attempt >= maxAttempts-1 {
+					return result, err
+				}
+
+				timer := time.NewTimer(delay(attempt))
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return Result{}, ctx.Err()
+				case <-timer.C:
+				}
+			}
+		}
+	}
+}
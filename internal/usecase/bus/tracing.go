@@ -0,0 +1,35 @@
+package bus
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type traceIDKeyType string
+
+const traceIDKey traceIDKeyType = "trace_id"
+
+// WithTraceID sets the ID that ties together the logs of one request and of
+// the commands it dispatches.
+func WithTraceID(ctx context.Context, traceID string) context.Context {
+	return context.WithValue(ctx, traceIDKey, traceID)
+}
+
+func TraceID(ctx context.Context) string {
+	traceID, _ := ctx.Value(traceIDKey).(string)
+	return traceID
+}
+
+// Tracing gives every command a trace ID, keeping the one of the caller when
+// there is one.
+func Tracing() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, cmd Command) (Result, error) {
+			if TraceID(ctx) == "" {
+				ctx = WithTraceID(ctx, uuid.NewString())
+			}
+			return next(ctx, cmd)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package bus
+
+import "context"
+
+// Validator is implemented by commands that can reject malformed input before
+// any aggregate is loaded.
+type Validator interface {
+	Validate() error
+}
+
+func Validation() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, cmd Command) (Result, error) {
+			if v, ok := cmd.(Validator); ok {
+				if err := v.Validate(); err != nil {
+					return Result{}, err
+				}
+			}
+			return next(ctx, cmd)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+)
+
+type AcceptCartInvitationCommandInterface interface {
+	Execute(ctx context.Context, input *input.AcceptCartInvitationInput, out presenter.CommandResultPresenter) error
+}
+
+type AcceptCartInvitationCommand struct {
+	cartRepo   repository.AggregateRepository[*aggregate.CartAggregate]
+	tenantRepo repository.AggregateRepository[*aggregate.TenantAggregate]
+}
+
+func NewAcceptCartInvitationCommand(cartRepo repository.AggregateRepository[*aggregate.CartAggregate], tenantRepo repository.AggregateRepository[*aggregate.TenantAggregate]) AcceptCartInvitationCommandInterface {
+	return &AcceptCartInvitationCommand{
+		cartRepo:   cartRepo,
+		tenantRepo: tenantRepo,
+	}
+}
+
+func (u *AcceptCartInvitationCommand) Execute(ctx context.Context, input *input.AcceptCartInvitationInput, out presenter.CommandResultPresenter) error {
+	cartUUID, err := uuid.Parse(input.CartID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid cart id"))
+	}
+
+	userUUID, err := uuid.Parse(input.UserID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid user id"))
+	}
+
+	cart, events, err := u.cartRepo.Update(ctx, cartUUID, func(ctx context.Context, cart *aggregate.CartAggregate) error {
+		if err := checkTenantOpen(ctx, u.tenantRepo, cart.GetTenantID()); err != nil {
+			return err
+		}
+
+		cmd := command.AcceptCartInvitationCommand{
+			CartID: cartUUID,
+			UserID: userUUID,
+		}
+
+		return cart.ExecuteAcceptCartInvitationCommand(cmd)
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, cart.GetAggregateID().String(), cart.GetVersion(), events)
+}
@@ -2,123 +2,201 @@ package command
 
 import (
 	"context"
-	"time"
 
 	"github.com/google/uuid"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
 )
 
+// ErrItemTenantMismatch rejects adding to an existing cart under another
+// tenant, whose cart rules are not the ones the cart is held to.
+var ErrItemTenantMismatch = errors.InvalidParameter.New("tenant_id does not match the cart's tenant")
+
 type CartAddItemCommandInterface interface {
 	Execute(ctx context.Context, input *input.AddItemToCartInput, out presenter.CommandResultPresenter) error
 }
 
 type CartAddItemCommand struct {
-	tx         repository.Transaction
-	eventStore repository.EventStore
-	outboxRepo repository.OutboxRepository
+	cartRepo    repository.AggregateRepository[*aggregate.CartAggregate]
+	tenantRepo  repository.AggregateRepository[*aggregate.TenantAggregate]
+	rulesRepo   repository.AggregateRepository[*aggregate.TenantCartRulesAggregate]
+	couponStore readmodelstore.CouponStore
 }
 
-func NewCartAddItemCommand(tx repository.Transaction, eventStore repository.EventStore, outboxRepo repository.OutboxRepository) CartAddItemCommandInterface {
+func NewCartAddItemCommand(
+	cartRepo repository.AggregateRepository[*aggregate.CartAggregate],
+	tenantRepo repository.AggregateRepository[*aggregate.TenantAggregate],
+	rulesRepo repository.AggregateRepository[*aggregate.TenantCartRulesAggregate],
+	couponStore readmodelstore.CouponStore,
+) CartAddItemCommandInterface {
 	return &CartAddItemCommand{
-		tx:         tx,
-		eventStore: eventStore,
-		outboxRepo: outboxRepo,
+		cartRepo:    cartRepo,
+		tenantRepo:  tenantRepo,
+		rulesRepo:   rulesRepo,
+		couponStore: couponStore,
 	}
 }
 
 func (u *CartAddItemCommand) Execute(ctx context.Context, input *input.AddItemToCartInput, out presenter.CommandResultPresenter) error {
-	maxRetries := 3
-	var err error
-	var aggregateID string
-	var version int
-	var events []event.Event
-
-	for attempt := range maxRetries {
-		err = u.tx.RWTx(ctx, func(ctx context.Context) error {
-			cartUUID, err := uuid.Parse(input.CartID)
-			if err != nil {
-				return err
-			}
+	// Automatic promotions come from the read model, so one created moments
+	// ago may only be picked up by the next item added.
+	automaticPromotions, err := u.couponStore.ListAutomatic(ctx, input.TenantID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
 
-			userUUID, err := uuid.Parse(input.UserID)
-			if err != nil {
-				return err
-			}
+	cartUUID, err := uuid.Parse(input.CartID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	// Items added concurrently to the same cart do not conflict, so a lost
+	// race is resolved by adding ours after theirs under the same rules
+	var rules value.CartRules
+	resolve := func(current *aggregate.CartAggregate, theirs, ours []event.Event) bool {
+		return current.ResolveItemsAdded(theirs, ours, rules)
+	}
 
-			itemUUID, err := uuid.Parse(input.ItemID)
+	cart, events, err := u.cartRepo.UpdateResolving(ctx, cartUUID, func(ctx context.Context, cart *aggregate.CartAggregate) error {
+		// Guests have no user yet and are identified by their session
+		var userUUID uuid.UUID
+		var sessionID value.SessionID
+		var err error
+		if input.UserID != "" {
+			userUUID, err = uuid.Parse(input.UserID)
 			if err != nil {
 				return err
 			}
-
-			tenantUUID, err := uuid.Parse(input.TenantID)
+		} else if input.SessionID != "" {
+			sessionID, err = value.NewSessionID(input.SessionID)
 			if err != nil {
 				return err
 			}
+		}
 
-			loadedEvents, err := u.eventStore.LoadEvents(ctx, cartUUID)
-			if err != nil && !errors.IsCode(err, errors.NotFound) {
-				return err
-			}
+		itemUUID, err := uuid.Parse(input.ItemID)
+		if err != nil {
+			return err
+		}
 
-			cart := aggregate.NewCartAggregate()
-			if len(loadedEvents) > 0 {
-				if err := cart.Hydration(loadedEvents); err != nil {
-					return err
-				}
-			}
+		tenantUUID, err := uuid.Parse(input.TenantID)
+		if err != nil {
+			return err
+		}
 
-			cmd := command.AddItemToCartCommand{
-				CartID:   cartUUID,
-				UserID:   userUUID,
-				ItemID:   itemUUID,
-				Name:     input.Name,
-				Price:    input.Price,
-				TenantID: tenantUUID,
-			}
+		options, err := parseLineOptions(input.Options)
+		if err != nil {
+			return err
+		}
 
-			if err := cart.ExecuteAddItemToCartCommand(cmd); err != nil {
-				return err
+		// Existing carts stay with the tenant they were created for
+		cartTenantID := tenantUUID
+		if cart.GetTenantID() != uuid.Nil {
+			if cart.GetTenantID() != tenantUUID {
+				return ErrItemTenantMismatch
 			}
+			cartTenantID = cart.GetTenantID()
+		}
+		if err := checkTenantOpen(ctx, u.tenantRepo, cartTenantID); err != nil {
+			return err
+		}
 
-			if err := u.eventStore.SaveEvents(ctx, cart.GetAggregateID(), cart.GetUncommittedEvents()); err != nil {
-				return err
-			}
+		cartRules, err := u.rulesRepo.Load(ctx, aggregate.CartRulesIDForTenant(cartTenantID))
+		if err != nil {
+			return err
+		}
+		rules = cartRules.GetCartRules()
+
+		cmd := command.AddItemToCartCommand{
+			CartID:      cartUUID,
+			UserID:      userUUID,
+			SessionID:   sessionID,
+			ItemID:      itemUUID,
+			Name:        input.Name,
+			Price:       input.Price,
+			TenantID:    cartTenantID,
+			TaxCategory: input.TaxCategory,
+			WeightGrams: input.WeightGrams,
+			Options:     options,
+			Category:    input.Category,
+			CartRules:   rules,
+		}
 
-			events := cart.GetUncommittedEvents()
-			if len(events) > 0 {
-				if err := u.outboxRepo.SaveEvents(ctx, cart.GetAggregateID(), events); err != nil {
-					return err
-				}
-			}
+		if err := cart.ExecuteAddItemToCartCommand(cmd); err != nil {
+			return err
+		}
+
+		return applyAutomaticPromotions(cart, userUUID, automaticPromotions)
+	}, resolve)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, cart.GetAggregateID().String(), cart.GetVersion(), events)
+}
 
-			aggregateID = cart.GetAggregateID().String()
-			version = cart.GetVersion()
-			events = cart.GetUncommittedEvents()
+func applyAutomaticPromotions(cart *aggregate.CartAggregate, userID uuid.UUID, promotions []*dto.CouponViewDTO) error {
+	applied := make(map[string]struct{}, len(cart.GetCoupons()))
+	for _, coupon := range cart.GetCoupons() {
+		applied[coupon.GetCouponID().String()] = struct{}{}
+	}
 
-			cart.MarkEventsAsCommitted()
+	for _, view := range promotions {
+		if view.TenantID != cart.GetTenantID().String() {
+			continue
+		}
+		if _, ok := applied[view.ID]; ok {
+			continue
+		}
 
-			return nil
+		couponID, err := uuid.Parse(view.ID)
+		if err != nil {
+			return err
+		}
+
+		code, err := value.NewCouponCode(view.Code)
+		if err != nil {
+			return err
+		}
+
+		promotion, err := value.NewPromotion(view.PromotionType, view.Value, view.BuyQuantity, view.GetQuantity, view.MinimumTotal)
+		if err != nil {
+			return err
+		}
+
+		err = cart.ExecuteApplyCouponToCartCommand(command.ApplyCouponToCartCommand{
+			CartID:    cart.GetAggregateID(),
+			UserID:    userID,
+			CouponID:  couponID,
+			Code:      code,
+			Promotion: promotion,
+			Automatic: true,
 		})
 		if err != nil {
-			if errors.IsCode(err, errors.OptimisticLock) && attempt < maxRetries-1 {
-				waitTime := time.Duration(attempt+1) * 10 * time.Millisecond
-				time.Sleep(waitTime)
-				continue
-			}
-			break
+			return err
 		}
-		break
 	}
 
-	if err != nil {
-		return out.PresentError(ctx, err)
+	return nil
+}
+
+func parseLineOptions(inputs []input.ItemOptionInput) (value.LineOptions, error) {
+	lineOptions := make([]value.LineOption, 0, len(inputs))
+	for _, o := range inputs {
+		option, err := value.NewLineOption(o.Name, o.Value, o.PriceModifier)
+		if err != nil {
+			return value.LineOptions{}, err
+		}
+		lineOptions = append(lineOptions, option)
 	}
 
-	return out.PresentSuccess(ctx, aggregateID, version, events)
+	return value.NewLineOptions(lineOptions)
 }
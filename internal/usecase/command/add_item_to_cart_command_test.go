@@ -7,9 +7,13 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/aggregaterepo"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/eventstore"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/outbox"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/readmodel/coupon"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/testutil"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/transaction"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command"
@@ -53,6 +57,18 @@ func TestCartAddItemCommand_Execute(t *testing.T) {
 			expectedEventCount: 0,
 			expectedVersion:    2,
 		},
+		"add item to new guest cart": {
+			input: &input.AddItemToCartInput{
+				CartID:    uuid.New().String(),
+				SessionID: "sess_8f14e45f",
+				ItemID:    uuid.New().String(),
+				Name:      "Test Item",
+				Price:     100.0,
+				TenantID:  uuid.New().String(),
+			},
+			expectedEventCount: 0,
+			expectedVersion:    2,
+		},
 	}
 
 	for name, tt := range tests {
@@ -61,12 +77,15 @@ func TestCartAddItemCommand_Execute(t *testing.T) {
 			dbClient := testutil.NewTestDBClient(t)
 			ctx, tx := testutil.BeginTxCtx(t, dbClient)
 			txRepo := transaction.NewTransaction(dbClient.GetDB())
-			eventStore := eventstore.NewEventStore(testutil.FakeDeserializer{})
-			outboxRepo := outbox.NewOutboxRepository()
+			eventStore := eventstore.NewEventStore(testutil.FakeSerializer{}, testutil.FakeDeserializer{})
+			outboxRepo := outbox.NewOutboxRepository(testutil.FakeSerializer{})
+			cartRepo := aggregaterepo.NewAggregateRepository(txRepo, eventStore, outboxRepo, aggregate.NewCartAggregate, aggregaterepo.DefaultRetryPolicy())
+			tenantRepo := aggregaterepo.NewAggregateRepository(txRepo, eventStore, outboxRepo, aggregate.NewTenantAggregate, aggregaterepo.DefaultRetryPolicy())
+			rulesRepo := aggregaterepo.NewAggregateRepository(txRepo, eventStore, outboxRepo, aggregate.NewTenantCartRulesAggregate, aggregaterepo.DefaultRetryPolicy())
 			presenter := &testPresenter{}
 
 			// Create command
-			addItemCmd := command.NewCartAddItemCommand(txRepo, eventStore, outboxRepo)
+			addItemCmd := command.NewCartAddItemCommand(cartRepo, tenantRepo, rulesRepo, coupon.NewCouponReadModel(txRepo))
 
 			// Act
 			err := addItemCmd.Execute(ctx, tt.input, presenter)
@@ -92,3 +111,50 @@ func TestCartAddItemCommand_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestCartAddItemCommand_Execute_OtherTenant(t *testing.T) {
+	// Arrange
+	dbClient := testutil.NewTestDBClient(t)
+	ctx, tx := testutil.BeginTxCtx(t, dbClient)
+	txRepo := transaction.NewTransaction(dbClient.GetDB())
+	eventStore := eventstore.NewEventStore(testutil.FakeSerializer{}, testutil.FakeDeserializer{})
+	outboxRepo := outbox.NewOutboxRepository(testutil.FakeSerializer{})
+	cartRepo := aggregaterepo.NewAggregateRepository(txRepo, eventStore, outboxRepo, aggregate.NewCartAggregate, aggregaterepo.DefaultRetryPolicy())
+	tenantRepo := aggregaterepo.NewAggregateRepository(txRepo, eventStore, outboxRepo, aggregate.NewTenantAggregate, aggregaterepo.DefaultRetryPolicy())
+	rulesRepo := aggregaterepo.NewAggregateRepository(txRepo, eventStore, outboxRepo, aggregate.NewTenantCartRulesAggregate, aggregaterepo.DefaultRetryPolicy())
+	addItemCmd := command.NewCartAddItemCommand(cartRepo, tenantRepo, rulesRepo, coupon.NewCouponReadModel(txRepo))
+	cartID := uuid.New().String()
+	userID := uuid.New().String()
+	addItem := func(tenantID string) *testPresenter {
+		presenter := &testPresenter{}
+		err := addItemCmd.Execute(ctx, &input.AddItemToCartInput{
+			CartID:   cartID,
+			UserID:   userID,
+			ItemID:   uuid.New().String(),
+			Name:     "Test Item",
+			Price:    100.0,
+			TenantID: tenantID,
+		}, presenter)
+		require.NoError(t, err)
+		return presenter
+	}
+	created := addItem(uuid.New().String())
+	require.Nil(t, created.lastError)
+
+	// Act
+	presenter := addItem(uuid.New().String())
+
+	// Assert
+	require.ErrorIs(t, presenter.lastError, command.ErrItemTenantMismatch)
+	require.True(t, errors.IsCode(presenter.lastError, errors.InvalidParameter))
+
+	rollbackErr := tx.Rollback()
+	require.NoError(t, rollbackErr)
+
+	t.Cleanup(func() {
+		_, cleanupErr := dbClient.GetDB().Exec("DELETE FROM events WHERE aggregate_id = ?", cartID)
+		require.NoError(t, cleanupErr)
+		_, cleanupErr = dbClient.GetDB().Exec("DELETE FROM outbox WHERE aggregate_id = ?", cartID)
+		require.NoError(t, cleanupErr)
+	})
+}
@@ -0,0 +1,122 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+)
+
+type ApplyCouponCommandInterface interface {
+	Execute(ctx context.Context, input *input.ApplyCouponInput, out presenter.CommandResultPresenter) error
+}
+
+type ApplyCouponCommand struct {
+	cartRepo     repository.AggregateRepository[*aggregate.CartAggregate]
+	tenantRepo   repository.AggregateRepository[*aggregate.TenantAggregate]
+	couponRepo   repository.AggregateRepository[*aggregate.CouponAggregate]
+	streamWriter repository.StreamWriter
+}
+
+func NewApplyCouponCommand(
+	cartRepo repository.AggregateRepository[*aggregate.CartAggregate],
+	tenantRepo repository.AggregateRepository[*aggregate.TenantAggregate],
+	couponRepo repository.AggregateRepository[*aggregate.CouponAggregate],
+	streamWriter repository.StreamWriter,
+) ApplyCouponCommandInterface {
+	return &ApplyCouponCommand{
+		cartRepo:     cartRepo,
+		tenantRepo:   tenantRepo,
+		couponRepo:   couponRepo,
+		streamWriter: streamWriter,
+	}
+}
+
+// Execute redeems the coupon and applies it to the cart in one transaction,
+// so a redemption is never counted without the cart seeing the discount. Both
+// streams are appended at the versions they were loaded at, so a concurrent
+// change to either one retries the whole command.
+func (u *ApplyCouponCommand) Execute(ctx context.Context, input *input.ApplyCouponInput, out presenter.CommandResultPresenter) error {
+	cartUUID, err := uuid.Parse(input.CartID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid cart id"))
+	}
+
+	userUUID, err := parseActingUser(input.UserID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	code, err := value.NewCouponCode(input.Code)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	var version int
+	var events []event.Event
+	err = u.streamWriter.Update(ctx, func(ctx context.Context) ([]repository.StreamAppend, error) {
+		cart, err := u.cartRepo.Load(ctx, cartUUID)
+		if err != nil {
+			return nil, err
+		}
+		cartVersion := cart.GetVersion()
+
+		if err := checkTenantOpen(ctx, u.tenantRepo, cart.GetTenantID()); err != nil {
+			return nil, err
+		}
+
+		couponID := aggregate.CouponIDForCode(cart.GetTenantID(), code)
+		coupon, err := u.couponRepo.Load(ctx, couponID)
+		if err != nil {
+			return nil, err
+		}
+		couponVersion := coupon.GetVersion()
+
+		if err := coupon.ExecuteRedeemCouponCommand(command.RedeemCouponCommand{
+			CouponID: couponID,
+			CartID:   cartUUID,
+		}); err != nil {
+			return nil, err
+		}
+
+		if err := cart.ExecuteApplyCouponToCartCommand(command.ApplyCouponToCartCommand{
+			CartID:    cartUUID,
+			UserID:    userUUID,
+			CouponID:  couponID,
+			Code:      code,
+			Promotion: coupon.GetPromotion(),
+		}); err != nil {
+			return nil, err
+		}
+
+		version = cart.GetVersion()
+		events = append(cart.GetUncommittedEvents(), coupon.GetUncommittedEvents()...)
+
+		return []repository.StreamAppend{pendingAppend(coupon, couponVersion), pendingAppend(cart, cartVersion)}, nil
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, cartUUID.String(), version, events)
+}
+
+// parseActingUser reads the user making a change to a cart. Guests have none.
+func parseActingUser(userID string) (uuid.UUID, error) {
+	if userID == "" {
+		return uuid.Nil, nil
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return uuid.Nil, errors.InvalidParameter.Wrap(err, "invalid user id")
+	}
+	return userUUID, nil
+}
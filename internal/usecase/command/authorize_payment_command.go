@@ -0,0 +1,107 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/gateway"
+	gatewayDTO "github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/gateway/dto"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+)
+
+type AuthorizePaymentCommandInterface interface {
+	Execute(ctx context.Context, input *input.AuthorizePaymentInput, out presenter.CommandResultPresenter) error
+}
+
+type AuthorizePaymentCommand struct {
+	cartRepo       repository.AggregateRepository[*aggregate.CartAggregate]
+	paymentRepo    repository.AggregateRepository[*aggregate.PaymentAggregate]
+	paymentGateway gateway.PaymentGateway
+}
+
+func NewAuthorizePaymentCommand(cartRepo repository.AggregateRepository[*aggregate.CartAggregate], paymentRepo repository.AggregateRepository[*aggregate.PaymentAggregate], paymentGateway gateway.PaymentGateway) AuthorizePaymentCommandInterface {
+	return &AuthorizePaymentCommand{
+		cartRepo:       cartRepo,
+		paymentRepo:    paymentRepo,
+		paymentGateway: paymentGateway,
+	}
+}
+
+// Execute charges the amount the cart was submitted with. The attempt is
+// recorded first and the gateway is asked outside of any transaction, the
+// one of the command's idempotency record included, so a slow gateway holds
+// no locks; the outcome is recorded in a second one. An attempt left pending
+// by a crash in between is resumed by a retry, and the attempt's idempotency
+// key makes the gateway return its original result instead of charging
+// again.
+func (u *AuthorizePaymentCommand) Execute(ctx context.Context, input *input.AuthorizePaymentInput, out presenter.CommandResultPresenter) error {
+	cartID, err := uuid.Parse(input.CartID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid cart id"))
+	}
+
+	cardNumber, err := value.NewCardNumber(input.CardNumber)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	paymentID := aggregate.PaymentIDForCart(cartID)
+	payment, events, err := u.paymentRepo.Update(ctx, paymentID, func(ctx context.Context, payment *aggregate.PaymentAggregate) error {
+		cart, err := u.cartRepo.Load(ctx, cartID)
+		if err != nil {
+			return err
+		}
+
+		amount, err := cart.GetAmountDue()
+		if err != nil {
+			return err
+		}
+
+		return payment.ExecuteRequestPaymentAuthorizationCommand(command.RequestPaymentAuthorizationCommand{
+			CartID:     cartID,
+			Amount:     amount,
+			CardNumber: cardNumber,
+		})
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	// The gateway is only asked while an attempt is pending
+	if payment.GetStatus() != aggregate.PaymentStatusRequested {
+		return out.PresentSuccess(ctx, paymentID.String(), payment.GetVersion(), events)
+	}
+
+	result, err := u.paymentGateway.Authorize(ctx, &gatewayDTO.PaymentAuthorizationRequest{
+		IdempotencyKey: payment.GetIdempotencyKey(),
+		CardNumber:     cardNumber.String(),
+		Amount:         payment.GetAmount(),
+	})
+	if err != nil {
+		return out.PresentError(ctx, errors.Unknown.Wrap(err, "payment gateway authorization failed"))
+	}
+
+	payment, outcome, err := u.paymentRepo.Update(ctx, paymentID, func(ctx context.Context, payment *aggregate.PaymentAggregate) error {
+		if result.Approved {
+			return payment.ExecuteAuthorizePaymentCommand(command.AuthorizePaymentCommand{
+				PaymentID:       paymentID,
+				AuthorizationID: result.AuthorizationID,
+			})
+		}
+		return payment.ExecuteDeclinePaymentCommand(command.DeclinePaymentCommand{
+			PaymentID: paymentID,
+			Reason:    result.DeclineReason,
+		})
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, paymentID.String(), payment.GetVersion(), append(events, outcome...))
+}
@@ -0,0 +1,146 @@
+package command_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/aggregaterepo"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/eventstore"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/eventstore/deserializer"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/outbox"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/testutil"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/transaction"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/payment"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+)
+
+type paymentTestPresenter struct {
+	lastAggregateID string
+	lastVersion     int
+	lastEvents      []event.Event
+	lastError       error
+}
+
+func (p *paymentTestPresenter) PresentSuccess(ctx context.Context, aggregateID string, version int, events []event.Event) error {
+	p.lastAggregateID = aggregateID
+	p.lastVersion = version
+	p.lastEvents = events
+	return nil
+}
+
+func (p *paymentTestPresenter) PresentError(ctx context.Context, err error) error {
+	p.lastError = err
+	return nil
+}
+
+// submitCart stores the events of a cart submitted for the total.
+func submitCart(t *testing.T, txRepo repository.Transaction, eventStore repository.EventStore, cartID uuid.UUID, total float64) {
+	t.Helper()
+
+	tenantID := uuid.New()
+	err := txRepo.RWTx(context.Background(), func(ctx context.Context) error {
+		return eventStore.SaveEvents(ctx, cartID, []event.Event{
+			event.NewCartCreatedEvent(cartID, 1, uuid.New(), tenantID, ""),
+			event.NewItemAddedToCartEvent(cartID, 2, uuid.New(), "Shirt", 100, tenantID, "STANDARD", 0, uuid.Nil, nil, ""),
+			event.NewCartSubmittedEvent(cartID, 3, total, 100, 0, "EXCLUSIVE", "FLOOR", 100, 10, 0, 0, "", 0),
+		})
+	})
+	require.NoError(t, err)
+}
+
+func TestAuthorizePaymentCommand_Execute(t *testing.T) {
+	tests := map[string]struct {
+		open            bool
+		cardNumbers     []string
+		expectedVersion int
+		expectedEvents  []string
+		expectedErr     error
+	}{
+		"authorize payment": {
+			cardNumbers:     []string{"4242424242424242"},
+			expectedVersion: 2,
+			expectedEvents:  []string{"PaymentAuthorizationRequestedEvent", "PaymentAuthorizedEvent"},
+		},
+		"retried authorization does not charge twice": {
+			cardNumbers:     []string{"4242424242424242", "4242424242424242"},
+			expectedVersion: 2,
+			expectedEvents:  []string{},
+		},
+		"declined payment can be retried with another card": {
+			cardNumbers:     []string{payment.CardDeclined, "4242424242424242"},
+			expectedVersion: 4,
+			expectedEvents:  []string{"PaymentAuthorizationRequestedEvent", "PaymentAuthorizedEvent"},
+		},
+		"cart that was not submitted is not charged": {
+			open:        true,
+			cardNumbers: []string{"4242424242424242"},
+			expectedErr: aggregate.ErrCartNotSubmitted,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			dbClient := testutil.NewTestDBClient(t)
+			txRepo := transaction.NewTransaction(dbClient.GetDB())
+			eventStore := eventstore.NewEventStore(testutil.FakeSerializer{}, deserializer.NewEventDeserializer())
+			outboxRepo := outbox.NewOutboxRepository(testutil.FakeSerializer{})
+			cartRepo := aggregaterepo.NewAggregateRepository(txRepo, eventStore, outboxRepo, aggregate.NewCartAggregate, aggregaterepo.DefaultRetryPolicy())
+			paymentRepo := aggregaterepo.NewAggregateRepository(txRepo, eventStore, outboxRepo, aggregate.NewPaymentAggregate, aggregaterepo.DefaultRetryPolicy())
+			authorizeCmd := command.NewAuthorizePaymentCommand(cartRepo, paymentRepo, payment.NewFakePaymentGateway())
+			cartID := uuid.New()
+			paymentID := aggregate.PaymentIDForCart(cartID).String()
+
+			t.Cleanup(func() {
+				_, cleanupErr := dbClient.GetDB().Exec("DELETE FROM events WHERE aggregate_id IN (?, ?)", paymentID, cartID)
+				require.NoError(t, cleanupErr)
+				_, cleanupErr = dbClient.GetDB().Exec("DELETE FROM outbox WHERE aggregate_id = ?", paymentID)
+				require.NoError(t, cleanupErr)
+			})
+			if !tt.open {
+				submitCart(t, txRepo, eventStore, cartID, 110)
+			}
+
+			// Act
+			presenter := &paymentTestPresenter{}
+			for _, cardNumber := range tt.cardNumbers {
+				presenter = &paymentTestPresenter{}
+				err := authorizeCmd.Execute(context.Background(), &input.AuthorizePaymentInput{
+					CartID:     cartID.String(),
+					CardNumber: cardNumber,
+				}, presenter)
+				require.NoError(t, err)
+			}
+
+			// Assert
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, presenter.lastError, tt.expectedErr)
+				return
+			}
+			require.Nil(t, presenter.lastError)
+			require.Equal(t, paymentID, presenter.lastAggregateID)
+			require.Equal(t, tt.expectedVersion, presenter.lastVersion)
+			eventTypes := make([]string, 0, len(presenter.lastEvents))
+			for _, ev := range presenter.lastEvents {
+				eventTypes = append(eventTypes, ev.GetEventType())
+			}
+			require.Equal(t, tt.expectedEvents, eventTypes)
+
+			var charged float64
+			err := txRepo.RWTx(context.Background(), func(ctx context.Context) error {
+				loaded, err := paymentRepo.Load(ctx, aggregate.PaymentIDForCart(cartID))
+				charged = loaded.GetAmount()
+				return err
+			})
+			require.NoError(t, err)
+			require.Equal(t, 110.0, charged)
+		})
+	}
+}
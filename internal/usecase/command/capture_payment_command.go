@@ -0,0 +1,72 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/gateway"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+)
+
+type CapturePaymentCommandInterface interface {
+	Execute(ctx context.Context, input *input.CapturePaymentInput, out presenter.CommandResultPresenter) error
+}
+
+type CapturePaymentCommand struct {
+	paymentRepo    repository.AggregateRepository[*aggregate.PaymentAggregate]
+	paymentGateway gateway.PaymentGateway
+}
+
+func NewCapturePaymentCommand(paymentRepo repository.AggregateRepository[*aggregate.PaymentAggregate], paymentGateway gateway.PaymentGateway) CapturePaymentCommandInterface {
+	return &CapturePaymentCommand{
+		paymentRepo:    paymentRepo,
+		paymentGateway: paymentGateway,
+	}
+}
+
+// Execute captures an authorized payment the way AuthorizePaymentCommand charges
+// it: the capture is recorded first, the gateway is asked outside of any
+// transaction and the outcome is recorded in a second one. A capture left
+// pending by a crash in between is resumed by a retry, which the gateway
+// answers without moving the money again.
+func (u *CapturePaymentCommand) Execute(ctx context.Context, input *input.CapturePaymentInput, out presenter.CommandResultPresenter) error {
+	cartID, err := uuid.Parse(input.CartID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid cart id"))
+	}
+
+	paymentID := aggregate.PaymentIDForCart(cartID)
+	payment, events, err := u.paymentRepo.Update(ctx, paymentID, func(ctx context.Context, payment *aggregate.PaymentAggregate) error {
+		return payment.ExecuteRequestPaymentCaptureCommand(command.RequestPaymentCaptureCommand{
+			PaymentID: paymentID,
+		})
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	// The gateway is only asked while the capture is pending
+	if payment.GetStatus() != aggregate.PaymentStatusCaptureRequested {
+		return out.PresentSuccess(ctx, paymentID.String(), payment.GetVersion(), events)
+	}
+
+	if err := u.paymentGateway.Capture(ctx, payment.GetAuthorizationID(), payment.GetAmount()); err != nil {
+		return out.PresentError(ctx, errors.Unknown.Wrap(err, "payment gateway capture failed"))
+	}
+
+	payment, outcome, err := u.paymentRepo.Update(ctx, paymentID, func(ctx context.Context, payment *aggregate.PaymentAggregate) error {
+		return payment.ExecuteCapturePaymentCommand(command.CapturePaymentCommand{
+			PaymentID: paymentID,
+		})
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, paymentID.String(), payment.GetVersion(), append(events, outcome...))
+}
@@ -0,0 +1,67 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+)
+
+type ChangeTenantStatusCommandInterface interface {
+	Execute(ctx context.Context, input *input.ChangeTenantStatusInput, out presenter.CommandResultPresenter) error
+}
+
+type ChangeTenantStatusCommand struct {
+	tenantRepo repository.AggregateRepository[*aggregate.TenantAggregate]
+}
+
+func NewChangeTenantStatusCommand(tenantRepo repository.AggregateRepository[*aggregate.TenantAggregate]) ChangeTenantStatusCommandInterface {
+	return &ChangeTenantStatusCommand{
+		tenantRepo: tenantRepo,
+	}
+}
+
+// Execute suspends, reactivates or closes the tenant, depending on the
+// status it is moved to.
+func (u *ChangeTenantStatusCommand) Execute(ctx context.Context, input *input.ChangeTenantStatusInput, out presenter.CommandResultPresenter) error {
+	tenantUUID, err := uuid.Parse(input.TenantID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid tenant id"))
+	}
+
+	status, err := value.NewTenantStatus(input.Status)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	tenant, events, err := u.tenantRepo.Update(ctx, aggregate.TenantIDForStream(tenantUUID), func(ctx context.Context, tenant *aggregate.TenantAggregate) error {
+		var err error
+		switch status {
+		case value.TenantStatusSuspended:
+			err = tenant.ExecuteSuspendTenantCommand(command.SuspendTenantCommand{
+				TenantID: tenantUUID,
+				Reason:   input.Reason,
+			})
+		case value.TenantStatusActive:
+			err = tenant.ExecuteReactivateTenantCommand(command.ReactivateTenantCommand{
+				TenantID: tenantUUID,
+			})
+		case value.TenantStatusClosed:
+			err = tenant.ExecuteCloseTenantCommand(command.CloseTenantCommand{
+				TenantID: tenantUUID,
+			})
+		}
+		return err
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, tenantUUID.String(), tenant.GetVersion(), events)
+}
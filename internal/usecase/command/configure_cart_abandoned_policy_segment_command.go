@@ -0,0 +1,69 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+)
+
+type ConfigureCartAbandonedPolicySegmentCommandInterface interface {
+	Execute(ctx context.Context, input *input.ConfigureCartAbandonedPolicySegmentInput, out presenter.CommandResultPresenter) error
+}
+
+type ConfigureCartAbandonedPolicySegmentCommand struct {
+	policyRepo repository.AggregateRepository[*aggregate.TenantCartAbandonedPolicyAggregate]
+}
+
+func NewConfigureCartAbandonedPolicySegmentCommand(policyRepo repository.AggregateRepository[*aggregate.TenantCartAbandonedPolicyAggregate]) ConfigureCartAbandonedPolicySegmentCommandInterface {
+	return &ConfigureCartAbandonedPolicySegmentCommand{
+		policyRepo: policyRepo,
+	}
+}
+
+func (u *ConfigureCartAbandonedPolicySegmentCommand) Execute(ctx context.Context, input *input.ConfigureCartAbandonedPolicySegmentInput, out presenter.CommandResultPresenter) error {
+	tenantUUID, err := uuid.Parse(input.TenantID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid tenant id"))
+	}
+
+	segmentUUID, err := uuid.Parse(input.SegmentID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid segment id"))
+	}
+
+	customerSegment, err := value.NewCustomerSegment(input.CustomerSegment)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	conditions, err := value.NewAbandonmentConditions(input.MinCartTotal, input.MaxCartTotal, input.Categories, customerSegment)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	segment, err := value.NewAbandonmentSegment(segmentUUID, input.Title, input.Priority, input.AbandonedMinutes, conditions)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	policy, events, err := u.policyRepo.Update(ctx, tenantUUID, func(ctx context.Context, policy *aggregate.TenantCartAbandonedPolicyAggregate) error {
+		cmd := command.ConfigureCartAbandonedPolicySegmentCommand{
+			TenantID: tenantUUID,
+			Segment:  segment,
+		}
+
+		return policy.ExecuteConfigureCartAbandonedPolicySegmentCommand(cmd)
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, policy.GetAggregateID().String(), policy.GetVersion(), events)
+}
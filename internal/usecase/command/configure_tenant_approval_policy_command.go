@@ -0,0 +1,63 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+)
+
+type ConfigureTenantApprovalPolicyCommandInterface interface {
+	Execute(ctx context.Context, input *input.ConfigureTenantApprovalPolicyInput, out presenter.CommandResultPresenter) error
+}
+
+type ConfigureTenantApprovalPolicyCommand struct {
+	policyRepo repository.AggregateRepository[*aggregate.TenantApprovalPolicyAggregate]
+}
+
+func NewConfigureTenantApprovalPolicyCommand(policyRepo repository.AggregateRepository[*aggregate.TenantApprovalPolicyAggregate]) ConfigureTenantApprovalPolicyCommandInterface {
+	return &ConfigureTenantApprovalPolicyCommand{
+		policyRepo: policyRepo,
+	}
+}
+
+func (u *ConfigureTenantApprovalPolicyCommand) Execute(ctx context.Context, input *input.ConfigureTenantApprovalPolicyInput, out presenter.CommandResultPresenter) error {
+	tenantUUID, err := uuid.Parse(input.TenantID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid tenant id"))
+	}
+
+	approverIDs := make([]uuid.UUID, 0, len(input.ApproverIDs))
+	for _, approverID := range input.ApproverIDs {
+		approverUUID, err := uuid.Parse(approverID)
+		if err != nil {
+			return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid approver id"))
+		}
+		approverIDs = append(approverIDs, approverUUID)
+	}
+
+	approvalPolicy, err := value.NewApprovalPolicy(input.Threshold, approverIDs, input.DeadlineHours)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	policy, events, err := u.policyRepo.Update(ctx, aggregate.ApprovalPolicyIDForTenant(tenantUUID), func(ctx context.Context, policy *aggregate.TenantApprovalPolicyAggregate) error {
+		cmd := command.ConfigureTenantApprovalPolicyCommand{
+			TenantID:       tenantUUID,
+			ApprovalPolicy: approvalPolicy,
+		}
+
+		return policy.ExecuteConfigureTenantApprovalPolicyCommand(cmd)
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, policy.GetAggregateID().String(), policy.GetVersion(), events)
+}
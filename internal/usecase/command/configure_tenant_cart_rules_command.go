@@ -0,0 +1,66 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+)
+
+type ConfigureTenantCartRulesCommandInterface interface {
+	Execute(ctx context.Context, input *input.ConfigureTenantCartRulesInput, out presenter.CommandResultPresenter) error
+}
+
+type ConfigureTenantCartRulesCommand struct {
+	rulesRepo repository.AggregateRepository[*aggregate.TenantCartRulesAggregate]
+}
+
+func NewConfigureTenantCartRulesCommand(rulesRepo repository.AggregateRepository[*aggregate.TenantCartRulesAggregate]) ConfigureTenantCartRulesCommandInterface {
+	return &ConfigureTenantCartRulesCommand{
+		rulesRepo: rulesRepo,
+	}
+}
+
+func (u *ConfigureTenantCartRulesCommand) Execute(ctx context.Context, input *input.ConfigureTenantCartRulesInput, out presenter.CommandResultPresenter) error {
+	tenantUUID, err := uuid.Parse(input.TenantID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid tenant id"))
+	}
+
+	combinations := make([]value.CategoryCombination, 0, len(input.BlockedCategoryCombinations))
+	for _, pair := range input.BlockedCategoryCombinations {
+		if len(pair) != 2 {
+			return out.PresentError(ctx, value.ErrCategoryCombinationInvalid)
+		}
+		combination, err := value.NewCategoryCombination(pair[0], pair[1])
+		if err != nil {
+			return out.PresentError(ctx, err)
+		}
+		combinations = append(combinations, combination)
+	}
+
+	cartRules, err := value.NewCartRules(input.MaxLines, input.MaxQuantityPerItem, input.MinimumOrderValue, combinations)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	rules, events, err := u.rulesRepo.Update(ctx, aggregate.CartRulesIDForTenant(tenantUUID), func(ctx context.Context, rules *aggregate.TenantCartRulesAggregate) error {
+		cmd := command.ConfigureTenantCartRulesCommand{
+			TenantID:  tenantUUID,
+			CartRules: cartRules,
+		}
+
+		return rules.ExecuteConfigureTenantCartRulesCommand(cmd)
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, rules.GetAggregateID().String(), rules.GetVersion(), events)
+}
@@ -0,0 +1,54 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+)
+
+type ConfigureTenantTaxSettingsCommandInterface interface {
+	Execute(ctx context.Context, input *input.ConfigureTenantTaxSettingsInput, out presenter.CommandResultPresenter) error
+}
+
+type ConfigureTenantTaxSettingsCommand struct {
+	settingsRepo repository.AggregateRepository[*aggregate.TenantTaxSettingsAggregate]
+}
+
+func NewConfigureTenantTaxSettingsCommand(settingsRepo repository.AggregateRepository[*aggregate.TenantTaxSettingsAggregate]) ConfigureTenantTaxSettingsCommandInterface {
+	return &ConfigureTenantTaxSettingsCommand{
+		settingsRepo: settingsRepo,
+	}
+}
+
+func (u *ConfigureTenantTaxSettingsCommand) Execute(ctx context.Context, input *input.ConfigureTenantTaxSettingsInput, out presenter.CommandResultPresenter) error {
+	tenantUUID, err := uuid.Parse(input.TenantID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid tenant id"))
+	}
+
+	taxSettings, err := value.NewTaxSettings(input.TaxDisplay, input.RoundingMode)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	settings, events, err := u.settingsRepo.Update(ctx, aggregate.TaxSettingsIDForTenant(tenantUUID), func(ctx context.Context, settings *aggregate.TenantTaxSettingsAggregate) error {
+		cmd := command.ConfigureTenantTaxSettingsCommand{
+			TenantID:    tenantUUID,
+			TaxSettings: taxSettings,
+		}
+
+		return settings.ExecuteConfigureTenantTaxSettingsCommand(cmd)
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, settings.GetAggregateID().String(), settings.GetVersion(), events)
+}
@@ -0,0 +1,63 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+)
+
+type CreateCouponCommandInterface interface {
+	Execute(ctx context.Context, input *input.CreateCouponInput, out presenter.CommandResultPresenter) error
+}
+
+type CreateCouponCommand struct {
+	couponRepo repository.AggregateRepository[*aggregate.CouponAggregate]
+}
+
+func NewCreateCouponCommand(couponRepo repository.AggregateRepository[*aggregate.CouponAggregate]) CreateCouponCommandInterface {
+	return &CreateCouponCommand{
+		couponRepo: couponRepo,
+	}
+}
+
+func (u *CreateCouponCommand) Execute(ctx context.Context, input *input.CreateCouponInput, out presenter.CommandResultPresenter) error {
+	tenantUUID, err := uuid.Parse(input.TenantID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid tenant id"))
+	}
+
+	code, err := value.NewCouponCode(input.Code)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	promotion, err := value.NewPromotion(input.PromotionType, input.Value, input.BuyQuantity, input.GetQuantity, input.MinimumTotal)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	couponID := aggregate.CouponIDForCode(tenantUUID, code)
+	coupon, events, err := u.couponRepo.Update(ctx, couponID, func(ctx context.Context, coupon *aggregate.CouponAggregate) error {
+		cmd := command.CreateCouponCommand{
+			TenantID:   tenantUUID,
+			Code:       code,
+			Promotion:  promotion,
+			UsageLimit: input.UsageLimit,
+			Automatic:  input.Automatic,
+		}
+
+		return coupon.ExecuteCreateCouponCommand(cmd)
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, couponID.String(), coupon.GetVersion(), events)
+}
@@ -0,0 +1,75 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+)
+
+type DecideCartApprovalCommandInterface interface {
+	Execute(ctx context.Context, input *input.DecideCartApprovalInput, out presenter.CommandResultPresenter) error
+}
+
+type DecideCartApprovalCommand struct {
+	cartRepo           repository.AggregateRepository[*aggregate.CartAggregate]
+	tenantRepo         repository.AggregateRepository[*aggregate.TenantAggregate]
+	approvalPolicyRepo repository.AggregateRepository[*aggregate.TenantApprovalPolicyAggregate]
+}
+
+func NewDecideCartApprovalCommand(cartRepo repository.AggregateRepository[*aggregate.CartAggregate], tenantRepo repository.AggregateRepository[*aggregate.TenantAggregate], approvalPolicyRepo repository.AggregateRepository[*aggregate.TenantApprovalPolicyAggregate]) DecideCartApprovalCommandInterface {
+	return &DecideCartApprovalCommand{
+		cartRepo:           cartRepo,
+		tenantRepo:         tenantRepo,
+		approvalPolicyRepo: approvalPolicyRepo,
+	}
+}
+
+func (u *DecideCartApprovalCommand) Execute(ctx context.Context, input *input.DecideCartApprovalInput, out presenter.CommandResultPresenter) error {
+	cartUUID, err := uuid.Parse(input.CartID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid cart id"))
+	}
+
+	userUUID, err := uuid.Parse(input.UserID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid user id"))
+	}
+
+	decision, err := value.NewApprovalDecision(input.Decision)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	cart, events, err := u.cartRepo.Update(ctx, cartUUID, func(ctx context.Context, cart *aggregate.CartAggregate) error {
+		if err := checkTenantOpen(ctx, u.tenantRepo, cart.GetTenantID()); err != nil {
+			return err
+		}
+
+		policy, err := u.approvalPolicyRepo.Load(ctx, aggregate.ApprovalPolicyIDForTenant(cart.GetTenantID()))
+		if err != nil {
+			return err
+		}
+
+		cmd := command.DecideCartApprovalCommand{
+			CartID:         cartUUID,
+			UserID:         userUUID,
+			Decision:       decision,
+			Comment:        input.Comment,
+			ApprovalPolicy: policy.GetApprovalPolicy(),
+		}
+
+		return cart.ExecuteDecideCartApprovalCommand(cmd)
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, cart.GetAggregateID().String(), cart.GetVersion(), events)
+}
@@ -0,0 +1,98 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore"
+)
+
+type EraseDataSubjectCommandInterface interface {
+	Execute(ctx context.Context, input *input.EraseDataSubjectInput, out presenter.CommandResultPresenter) error
+}
+
+// EraseDataSubjectCommand opens an erase request, anonymises the subject in
+// the cart and saved list read models and then destroys the subject's key,
+// after which their personal data in every stored event reads as redacted.
+// It runs in one transaction with the events recording each step, so it
+// erases all or nothing and erasing the subject again starts over.
+type EraseDataSubjectCommand struct {
+	tx             repository.Transaction
+	requestRepo    repository.AggregateRepository[*aggregate.DataSubjectRequestAggregate]
+	cartStore      readmodelstore.CartStore
+	savedListStore readmodelstore.SavedListStore
+	keyStore       repository.KeyStore
+}
+
+func NewEraseDataSubjectCommand(
+	tx repository.Transaction,
+	requestRepo repository.AggregateRepository[*aggregate.DataSubjectRequestAggregate],
+	cartStore readmodelstore.CartStore,
+	savedListStore readmodelstore.SavedListStore,
+	keyStore repository.KeyStore,
+) EraseDataSubjectCommandInterface {
+	return &EraseDataSubjectCommand{
+		tx:             tx,
+		requestRepo:    requestRepo,
+		cartStore:      cartStore,
+		savedListStore: savedListStore,
+		keyStore:       keyStore,
+	}
+}
+
+func (u *EraseDataSubjectCommand) Execute(ctx context.Context, input *input.EraseDataSubjectInput, out presenter.CommandResultPresenter) error {
+	subjectID, err := uuid.Parse(input.SubjectID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid subject id"))
+	}
+
+	requestID := uuid.New()
+	var request *aggregate.DataSubjectRequestAggregate
+	var events []event.Event
+	// The read models and the key live outside the request's stream, so
+	// they share its transaction
+	err = u.tx.SharedRWTx(ctx, func(ctx context.Context) error {
+		request, events, err = u.requestRepo.Update(ctx, requestID, func(ctx context.Context, request *aggregate.DataSubjectRequestAggregate) error {
+			err := request.ExecuteOpenDataSubjectRequestCommand(command.OpenDataSubjectRequestCommand{
+				RequestID: requestID,
+				SubjectID: subjectID,
+				Kind:      string(aggregate.DataSubjectRequestKindErase),
+			})
+			if err != nil {
+				return err
+			}
+
+			cartCount, err := u.cartStore.AnonymiseSubject(ctx, subjectID.String())
+			if err != nil {
+				return err
+			}
+			if _, err := u.savedListStore.AnonymiseSubject(ctx, subjectID.String()); err != nil {
+				return err
+			}
+			err = request.ExecuteRecordDataSubjectAnonymisedCommand(command.RecordDataSubjectAnonymisedCommand{
+				CartCount: cartCount,
+			})
+			if err != nil {
+				return err
+			}
+
+			if err := u.keyStore.EraseKey(ctx, subjectID); err != nil {
+				return err
+			}
+			return request.ExecuteRecordDataSubjectErasedCommand(command.RecordDataSubjectErasedCommand{})
+		})
+		return err
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, request.GetAggregateID().String(), request.GetVersion(), events)
+}
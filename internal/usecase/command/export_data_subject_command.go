@@ -0,0 +1,227 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+type ExportDataSubjectCommandInterface interface {
+	Execute(ctx context.Context, input *input.ExportDataSubjectInput, out presenter.CommandResultPresenter) error
+}
+
+// ExportDataSubjectCommand opens an export request and completes it with a
+// bundle of the subject's carts, including those shared with them, their
+// saved lists, and the events of both and of the carts' payments, gathered
+// in the transaction that records it. Only the subject's own personal data is
+// exported: on a shared cart, the other members and their data are left out
+// or redacted.
+type ExportDataSubjectCommand struct {
+	tx             repository.Transaction
+	requestRepo    repository.AggregateRepository[*aggregate.DataSubjectRequestAggregate]
+	eventStore     repository.EventStore
+	cartStore      readmodelstore.CartStore
+	savedListStore readmodelstore.SavedListStore
+}
+
+func NewExportDataSubjectCommand(
+	tx repository.Transaction,
+	requestRepo repository.AggregateRepository[*aggregate.DataSubjectRequestAggregate],
+	eventStore repository.EventStore,
+	cartStore readmodelstore.CartStore,
+	savedListStore readmodelstore.SavedListStore,
+) ExportDataSubjectCommandInterface {
+	return &ExportDataSubjectCommand{
+		tx:             tx,
+		requestRepo:    requestRepo,
+		eventStore:     eventStore,
+		cartStore:      cartStore,
+		savedListStore: savedListStore,
+	}
+}
+
+func (u *ExportDataSubjectCommand) Execute(ctx context.Context, input *input.ExportDataSubjectInput, out presenter.CommandResultPresenter) error {
+	subjectID, err := uuid.Parse(input.SubjectID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid subject id"))
+	}
+
+	requestID := uuid.New()
+	_, opened, err := u.requestRepo.Update(ctx, requestID, func(ctx context.Context, request *aggregate.DataSubjectRequestAggregate) error {
+		return request.ExecuteOpenDataSubjectRequestCommand(command.OpenDataSubjectRequestCommand{
+			RequestID: requestID,
+			SubjectID: subjectID,
+			Kind:      string(aggregate.DataSubjectRequestKindExport),
+		})
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	var request *aggregate.DataSubjectRequestAggregate
+	var exported []event.Event
+	err = u.tx.SharedRWTx(ctx, func(ctx context.Context) error {
+		request, exported, err = u.requestRepo.Update(ctx, requestID, func(ctx context.Context, request *aggregate.DataSubjectRequestAggregate) error {
+			bundle, err := u.gather(ctx, subjectID)
+			if err != nil {
+				return err
+			}
+
+			data, err := json.Marshal(bundle)
+			if err != nil {
+				return err
+			}
+
+			eventCount := 0
+			for _, stream := range bundle.Events {
+				eventCount += len(stream.Events)
+			}
+
+			return request.ExecuteCompleteDataSubjectExportCommand(command.CompleteDataSubjectExportCommand{
+				Bundle:     string(data),
+				CartCount:  len(bundle.Carts),
+				EventCount: eventCount,
+			})
+		})
+		return err
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, request.GetAggregateID().String(), request.GetVersion(), append(opened, exported...))
+}
+
+func (u *ExportDataSubjectCommand) gather(ctx context.Context, subjectID uuid.UUID) (*dto.DataSubjectExportDTO, error) {
+	bundle := &dto.DataSubjectExportDTO{
+		SubjectID:  subjectID.String(),
+		ExportedAt: time.Now(),
+		Carts:      make([]dto.CartViewDTO, 0),
+		SavedLists: make([]dto.SavedListViewDTO, 0),
+		Events:     make([]dto.EventHistoryViewDTO, 0),
+	}
+
+	cartIDs, err := u.cartStore.ListIDsBySubject(ctx, subjectID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	// Each cart is followed by its payment, whose stream may not exist
+	streamIDs := make([]uuid.UUID, 0, len(cartIDs)*2)
+	for _, cartID := range cartIDs {
+		cart, err := u.cartStore.Get(ctx, cartID)
+		if err != nil {
+			return nil, err
+		}
+		bundle.Carts = append(bundle.Carts, subjectCartView(*cart, subjectID.String()))
+
+		streamID, err := uuid.Parse(cart.ID)
+		if err != nil {
+			return nil, err
+		}
+		streamIDs = append(streamIDs, streamID, aggregate.PaymentIDForCart(streamID))
+	}
+
+	listIDs, err := u.savedListStore.ListIDsBySubject(ctx, subjectID.String())
+	if err != nil {
+		return nil, err
+	}
+	for _, listID := range listIDs {
+		list, err := u.savedListStore.Get(ctx, listID)
+		if err != nil {
+			return nil, err
+		}
+		bundle.SavedLists = append(bundle.SavedLists, *list)
+
+		streamID, err := uuid.Parse(list.ID)
+		if err != nil {
+			return nil, err
+		}
+		streamIDs = append(streamIDs, streamID)
+	}
+
+	for _, streamID := range streamIDs {
+		stored, err := u.eventStore.ReadEvents(ctx, streamID, repository.EventRange{})
+		if err != nil {
+			if errors.IsCode(err, errors.NotFound) {
+				continue
+			}
+			return nil, err
+		}
+
+		history := dto.EventHistoryViewDTO{
+			AggregateID: streamID.String(),
+			Events:      make([]dto.EventViewDTO, 0, len(stored)),
+		}
+		for _, s := range stored {
+			event.RedactOthers(s.Event, subjectID)
+			view, err := toExportedEventView(s)
+			if err != nil {
+				return nil, err
+			}
+			history.Events = append(history.Events, view)
+		}
+		bundle.Events = append(bundle.Events, history)
+	}
+
+	return bundle, nil
+}
+
+// subjectCartView is the cart view with only the subject's personal data: the
+// owner and their shipping address are kept for the owner alone, and of the
+// members and who added each line only the subject is listed.
+func subjectCartView(cart dto.CartViewDTO, subjectID string) dto.CartViewDTO {
+	if cart.UserID != subjectID {
+		cart.UserID = ""
+		cart.ShippingAddress = nil
+	}
+
+	items := make([]dto.CartItemViewDTO, 0, len(cart.Items))
+	for _, item := range cart.Items {
+		if item.AddedBy != subjectID {
+			item.AddedBy = ""
+		}
+		items = append(items, item)
+	}
+	cart.Items = items
+
+	var members []dto.CartMemberViewDTO
+	for _, member := range cart.Members {
+		if member.UserID == subjectID {
+			members = append(members, member)
+		}
+	}
+	cart.Members = members
+
+	return cart
+}
+
+func toExportedEventView(s repository.StoredEvent) (dto.EventViewDTO, error) {
+	payload, err := json.Marshal(s.Event)
+	if err != nil {
+		return dto.EventViewDTO{}, err
+	}
+
+	return dto.EventViewDTO{
+		Version:   s.Event.GetVersion(),
+		EventType: s.Event.GetEventType(),
+		Payload:   payload,
+		Metadata: &dto.EventMetadataViewDTO{
+			EventID:       s.Event.GetEventID().String(),
+			AggregateType: s.Event.GetAggregateType(),
+			OccurredAt:    s.Event.GetTimestamp(),
+			RecordedAt:    s.RecordedAt,
+		},
+	}, nil
+}
@@ -0,0 +1,147 @@
+package command_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	domaincommand "github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/aggregaterepo"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/eventstore"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/eventstore/deserializer"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/outbox"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/readmodel/cart"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/readmodel/savedlist"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/testutil"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/transaction"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+func TestExportDataSubjectCommand_Execute_SharedCart(t *testing.T) {
+	ownerID := uuid.New()
+	memberID := uuid.New()
+
+	tests := map[string]struct {
+		subjectID   uuid.UUID
+		otherID     uuid.UUID
+		wantAddress bool
+		wantMembers int
+	}{
+		"owner sees their address but not the member": {
+			subjectID:   ownerID,
+			otherID:     memberID,
+			wantAddress: true,
+		},
+		"member sees neither the owner nor their address": {
+			subjectID:   memberID,
+			otherID:     ownerID,
+			wantMembers: 1,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Arrange
+			dbClient := testutil.NewTestDBClient(t)
+			txRepo := transaction.NewTransaction(dbClient.GetDB())
+			eventStore := eventstore.NewEventStore(testutil.FakeSerializer{}, deserializer.NewEventDeserializer())
+			outboxRepo := outbox.NewOutboxRepository(testutil.FakeSerializer{})
+			cartRepo := aggregaterepo.NewAggregateRepository(txRepo, eventStore, outboxRepo, aggregate.NewCartAggregate, aggregaterepo.DefaultRetryPolicy())
+			requestRepo := aggregaterepo.NewAggregateRepository(txRepo, eventStore, outboxRepo, aggregate.NewDataSubjectRequestAggregate, aggregaterepo.DefaultRetryPolicy())
+			cartStore := cart.NewCartReadModel(txRepo)
+			ctx := context.Background()
+
+			cartID := uuid.New()
+			tenantID := uuid.New()
+			address, err := value.NewShippingAddress("Taro Yamada", "100-0001", "Tokyo", "Chiyoda-ku", "1-1 Chiyoda", "", "03-1234-5678")
+			require.NoError(t, err)
+			_, _, err = cartRepo.Update(ctx, cartID, func(ctx context.Context, c *aggregate.CartAggregate) error {
+				if err := c.ExecuteAddItemToCartCommand(domaincommand.AddItemToCartCommand{
+					CartID: cartID, UserID: ownerID, ItemID: uuid.New(), Name: "Shirt", Price: 1000, TenantID: tenantID,
+				}); err != nil {
+					return err
+				}
+				if err := c.ExecuteInviteCartMemberCommand(domaincommand.InviteCartMemberCommand{CartID: cartID, UserID: ownerID, InviteeID: memberID}); err != nil {
+					return err
+				}
+				if err := c.ExecuteAcceptCartInvitationCommand(domaincommand.AcceptCartInvitationCommand{CartID: cartID, UserID: memberID}); err != nil {
+					return err
+				}
+				if err := c.ExecuteAddItemToCartCommand(domaincommand.AddItemToCartCommand{
+					CartID: cartID, UserID: memberID, ItemID: uuid.New(), Name: "Mug", Price: 500, TenantID: tenantID,
+				}); err != nil {
+					return err
+				}
+				return c.ExecuteSetShippingAddressCommand(domaincommand.SetShippingAddressCommand{CartID: cartID, UserID: ownerID, Address: address})
+			})
+			require.NoError(t, err)
+
+			require.NoError(t, cartStore.Upsert(ctx, cartID.String(), &dto.CartViewDTO{
+				ID:        cartID.String(),
+				UserID:    ownerID.String(),
+				TenantID:  tenantID.String(),
+				Status:    "OPEN",
+				CreatedAt: time.Now(),
+				UpdatedAt: time.Now(),
+				Version:   6,
+				Items: []dto.CartItemViewDTO{
+					{ID: uuid.New().String(), LineID: uuid.New().String(), CartID: cartID.String(), Name: "Shirt", Price: 1000, Quantity: 1, TaxCategory: "STANDARD", AddedBy: ownerID.String()},
+					{ID: uuid.New().String(), LineID: uuid.New().String(), CartID: cartID.String(), Name: "Mug", Price: 500, Quantity: 1, TaxCategory: "STANDARD", AddedBy: memberID.String()},
+				},
+				Members: []dto.CartMemberViewDTO{{UserID: memberID.String(), Status: "MEMBER"}},
+				ShippingAddress: &dto.CartShippingAddressViewDTO{
+					RecipientName: "Taro Yamada",
+					PostalCode:    "100-0001",
+					Prefecture:    "Tokyo",
+					City:          "Chiyoda-ku",
+					AddressLine1:  "1-1 Chiyoda",
+					Phone:         "03-1234-5678",
+				},
+			}))
+			t.Cleanup(func() {
+				_, _ = dbClient.GetDB().Exec("DELETE FROM cart_members WHERE cart_id = ?", cartID.String())
+				_, _ = dbClient.GetDB().Exec("DELETE FROM cart_items WHERE cart_id = ?", cartID.String())
+				_, _ = dbClient.GetDB().Exec("DELETE FROM carts WHERE id = ?", cartID.String())
+				_, _ = dbClient.GetDB().Exec("DELETE FROM events WHERE aggregate_id = ?", cartID)
+				_, _ = dbClient.GetDB().Exec("DELETE FROM outbox WHERE aggregate_id = ?", cartID)
+			})
+
+			exportCmd := command.NewExportDataSubjectCommand(txRepo, requestRepo, eventStore, cartStore, savedlist.NewSavedListReadModel(txRepo))
+			presenter := &testPresenter{}
+
+			// Act
+			err = exportCmd.Execute(ctx, &input.ExportDataSubjectInput{SubjectID: tt.subjectID.String()}, presenter)
+
+			// Assert
+			require.NoError(t, err)
+			require.Nil(t, presenter.lastError)
+
+			var raw string
+			for _, evt := range presenter.lastEvents {
+				if exported, ok := evt.(*event.DataSubjectDataExportedEvent); ok {
+					raw = exported.GetBundle()
+				}
+			}
+			var bundle dto.DataSubjectExportDTO
+			require.NoError(t, json.Unmarshal([]byte(raw), &bundle))
+			require.Len(t, bundle.Carts, 1)
+
+			got := bundle.Carts[0]
+			require.Equal(t, tt.wantAddress, got.ShippingAddress != nil)
+			require.Len(t, got.Members, tt.wantMembers)
+			require.Contains(t, raw, tt.subjectID.String())
+			require.NotContains(t, raw, tt.otherID.String())
+			require.Equal(t, tt.wantAddress, strings.Contains(raw, "Taro Yamada"))
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package input
+
+type AcceptCartInvitationInput struct {
+	CommandIdentity
+	Precondition
+
+	CartID string `json:"cart_id"`
+	UserID string `json:"user_id"`
+}
+
+func (*AcceptCartInvitationInput) CommandName() string {
+	return "AcceptCartInvitation"
+}
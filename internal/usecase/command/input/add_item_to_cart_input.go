@@ -1,10 +1,37 @@
 package input
 
 type AddItemToCartInput struct {
-	CartID   string  `json:"cart_id"`
-	UserID   string  `json:"user_id"`
-	ItemID   string  `json:"item_id"`
-	Name     string  `json:"name"`
-	Price    float64 `json:"price"`
-	TenantID string  `json:"tenant_id"`
+	CommandIdentity
+	Precondition
+
+	CartID      string            `json:"cart_id"`
+	UserID      string            `json:"user_id"`
+	SessionID   string            `json:"session_id"`
+	ItemID      string            `json:"item_id"`
+	Name        string            `json:"name"`
+	Price       float64           `json:"price"`
+	TenantID    string            `json:"tenant_id"`
+	TaxCategory string            `json:"tax_category"`
+	WeightGrams int               `json:"weight_grams"`
+	Options     []ItemOptionInput `json:"options"`
+	Category    string            `json:"category"`
+}
+
+func (*AddItemToCartInput) CommandName() string {
+	return "AddItemToCart"
+}
+
+func (i *AddItemToCartInput) Validate() error {
+	return validateIDs(
+		idField{name: "cart_id", value: i.CartID},
+		idField{name: "user_id", value: i.UserID, optional: true},
+		idField{name: "item_id", value: i.ItemID},
+		idField{name: "tenant_id", value: i.TenantID},
+	)
+}
+
+type ItemOptionInput struct {
+	Name          string  `json:"name"`
+	Value         string  `json:"value"`
+	PriceModifier float64 `json:"price_modifier"`
 }
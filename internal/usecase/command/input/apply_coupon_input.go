@@ -0,0 +1,14 @@
+package input
+
+type ApplyCouponInput struct {
+	CommandIdentity
+	Precondition
+
+	CartID string `json:"cart_id"`
+	UserID string `json:"user_id"`
+	Code   string `json:"code"`
+}
+
+func (*ApplyCouponInput) CommandName() string {
+	return "ApplyCoupon"
+}
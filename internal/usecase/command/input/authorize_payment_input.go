@@ -0,0 +1,18 @@
+package input
+
+type AuthorizePaymentInput struct {
+	CommandIdentity
+
+	CartID     string `json:"cart_id"`
+	CardNumber string `json:"card_number"`
+}
+
+func (*AuthorizePaymentInput) CommandName() string {
+	return "AuthorizePayment"
+}
+
+// CallsOut keeps the gateway call out of the transaction of the command's
+// idempotency record.
+func (*AuthorizePaymentInput) CallsOut() bool {
+	return true
+}
@@ -0,0 +1,17 @@
+package input
+
+type CapturePaymentInput struct {
+	CommandIdentity
+
+	CartID string `json:"cart_id"`
+}
+
+func (*CapturePaymentInput) CommandName() string {
+	return "CapturePayment"
+}
+
+// CallsOut keeps the gateway call out of the transaction of the command's
+// idempotency record.
+func (*CapturePaymentInput) CallsOut() bool {
+	return true
+}
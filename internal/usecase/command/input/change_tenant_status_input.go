@@ -0,0 +1,13 @@
+package input
+
+type ChangeTenantStatusInput struct {
+	CommandIdentity
+
+	TenantID string `json:"tenant_id"`
+	Status   string `json:"status"`
+	Reason   string `json:"reason"`
+}
+
+func (*ChangeTenantStatusInput) CommandName() string {
+	return "ChangeTenantStatus"
+}
@@ -0,0 +1,11 @@
+package input
+
+// CommandIdentity is the client-supplied ID of a command. A command sent
+// again with the same ID is handled once and its first result returned.
+type CommandIdentity struct {
+	IdempotencyKey string `json:"command_id"`
+}
+
+func (c *CommandIdentity) CommandID() string {
+	return c.IdempotencyKey
+}
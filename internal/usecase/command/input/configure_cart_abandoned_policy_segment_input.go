@@ -0,0 +1,20 @@
+package input
+
+type ConfigureCartAbandonedPolicySegmentInput struct {
+	CommandIdentity
+	Precondition
+
+	TenantID         string   `json:"tenant_id"`
+	SegmentID        string   `json:"segment_id"`
+	Title            string   `json:"title"`
+	Priority         int      `json:"priority"`
+	AbandonedMinutes int      `json:"abandoned_minutes"`
+	MinCartTotal     float64  `json:"min_cart_total"`
+	MaxCartTotal     float64  `json:"max_cart_total"`
+	Categories       []string `json:"categories"`
+	CustomerSegment  string   `json:"customer_segment"`
+}
+
+func (*ConfigureCartAbandonedPolicySegmentInput) CommandName() string {
+	return "ConfigureCartAbandonedPolicySegment"
+}
@@ -0,0 +1,14 @@
+package input
+
+type ConfigureTenantApprovalPolicyInput struct {
+	CommandIdentity
+
+	TenantID      string   `json:"tenant_id"`
+	Threshold     float64  `json:"threshold"`
+	ApproverIDs   []string `json:"approver_ids"`
+	DeadlineHours int      `json:"deadline_hours"`
+}
+
+func (*ConfigureTenantApprovalPolicyInput) CommandName() string {
+	return "ConfigureTenantApprovalPolicy"
+}
@@ -0,0 +1,15 @@
+package input
+
+type ConfigureTenantCartRulesInput struct {
+	CommandIdentity
+
+	TenantID                    string     `json:"tenant_id"`
+	MaxLines                    int        `json:"max_lines"`
+	MaxQuantityPerItem          int        `json:"max_quantity_per_item"`
+	MinimumOrderValue           float64    `json:"minimum_order_value"`
+	BlockedCategoryCombinations [][]string `json:"blocked_category_combinations"`
+}
+
+func (*ConfigureTenantCartRulesInput) CommandName() string {
+	return "ConfigureTenantCartRules"
+}
@@ -0,0 +1,13 @@
+package input
+
+type ConfigureTenantTaxSettingsInput struct {
+	CommandIdentity
+
+	TenantID     string `json:"tenant_id"`
+	TaxDisplay   string `json:"tax_display"`
+	RoundingMode string `json:"rounding_mode"`
+}
+
+func (*ConfigureTenantTaxSettingsInput) CommandName() string {
+	return "ConfigureTenantTaxSettings"
+}
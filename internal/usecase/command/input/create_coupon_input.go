@@ -0,0 +1,19 @@
+package input
+
+type CreateCouponInput struct {
+	CommandIdentity
+
+	TenantID      string  `json:"tenant_id"`
+	Code          string  `json:"code"`
+	PromotionType string  `json:"promotion_type"`
+	Value         float64 `json:"value"`
+	BuyQuantity   int     `json:"buy_quantity"`
+	GetQuantity   int     `json:"get_quantity"`
+	MinimumTotal  float64 `json:"minimum_total"`
+	UsageLimit    int     `json:"usage_limit"`
+	Automatic     bool    `json:"automatic"`
+}
+
+func (*CreateCouponInput) CommandName() string {
+	return "CreateCoupon"
+}
@@ -3,9 +3,16 @@ package input
 import "time"
 
 type CreateTenantCartAbandonedPolicyInput struct {
+	CommandIdentity
+
 	TenantID         string    `json:"tenant_id"`
 	Title            string    `json:"title"`
 	AbandonedMinutes int       `json:"abandoned_minutes"`
 	QuietTimeFrom    time.Time `json:"quiet_time_from"`
 	QuietTimeTo      time.Time `json:"quiet_time_to"`
+	CartExpiryDays   int       `json:"cart_expiry_days"`
+}
+
+func (*CreateTenantCartAbandonedPolicyInput) CommandName() string {
+	return "CreateTenantCartAbandonedPolicy"
 }
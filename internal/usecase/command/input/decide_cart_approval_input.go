@@ -0,0 +1,15 @@
+package input
+
+type DecideCartApprovalInput struct {
+	CommandIdentity
+	Precondition
+
+	CartID   string `json:"cart_id"`
+	UserID   string `json:"user_id"`
+	Decision string `json:"decision"`
+	Comment  string `json:"comment"`
+}
+
+func (*DecideCartApprovalInput) CommandName() string {
+	return "DecideCartApproval"
+}
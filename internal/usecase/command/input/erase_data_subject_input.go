@@ -0,0 +1,11 @@
+package input
+
+type EraseDataSubjectInput struct {
+	CommandIdentity
+
+	SubjectID string `json:"-"`
+}
+
+func (*EraseDataSubjectInput) CommandName() string {
+	return "EraseDataSubject"
+}
@@ -0,0 +1,11 @@
+package input
+
+type ExportDataSubjectInput struct {
+	CommandIdentity
+
+	SubjectID string `json:"-"`
+}
+
+func (*ExportDataSubjectInput) CommandName() string {
+	return "ExportDataSubject"
+}
@@ -0,0 +1,14 @@
+package input
+
+type InviteCartMemberInput struct {
+	CommandIdentity
+	Precondition
+
+	CartID    string `json:"cart_id"`
+	UserID    string `json:"user_id"`
+	InviteeID string `json:"invitee_id"`
+}
+
+func (*InviteCartMemberInput) CommandName() string {
+	return "InviteCartMember"
+}
@@ -0,0 +1,15 @@
+package input
+
+type MergeCartInput struct {
+	CommandIdentity
+	Precondition
+
+	GuestCartID string `json:"guest_cart_id"`
+	CartID      string `json:"cart_id"`
+	UserID      string `json:"user_id"`
+	SessionID   string `json:"session_id"`
+}
+
+func (*MergeCartInput) CommandName() string {
+	return "MergeCart"
+}
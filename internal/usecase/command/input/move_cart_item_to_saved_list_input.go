@@ -0,0 +1,13 @@
+package input
+
+type MoveCartItemToSavedListInput struct {
+	CommandIdentity
+
+	CartID string `json:"cart_id"`
+	LineID string `json:"line_id"`
+	UserID string `json:"user_id"`
+}
+
+func (*MoveCartItemToSavedListInput) CommandName() string {
+	return "MoveCartItemToSavedList"
+}
@@ -0,0 +1,14 @@
+package input
+
+type MoveSavedItemToCartInput struct {
+	CommandIdentity
+
+	UserID   string `json:"user_id"`
+	TenantID string `json:"tenant_id"`
+	ItemID   string `json:"item_id"`
+	CartID   string `json:"cart_id"`
+}
+
+func (*MoveSavedItemToCartInput) CommandName() string {
+	return "MoveSavedItemToCart"
+}
@@ -0,0 +1,16 @@
+package input
+
+// Precondition is the version a command expects its aggregate to be at, as
+// read from the ETag of the aggregate. A command whose aggregate has moved on
+// is refused instead of applied.
+type Precondition struct {
+	AggregateID     string `json:"-"`
+	ExpectedVersion *int   `json:"expected_version,omitempty"`
+}
+
+func (p *Precondition) VersionPrecondition() (string, int, bool) {
+	if p.ExpectedVersion == nil {
+		return "", 0, false
+	}
+	return p.AggregateID, *p.ExpectedVersion, true
+}
@@ -0,0 +1,17 @@
+package input
+
+type RefundPaymentInput struct {
+	CommandIdentity
+
+	CartID string `json:"cart_id"`
+}
+
+func (*RefundPaymentInput) CommandName() string {
+	return "RefundPayment"
+}
+
+// CallsOut keeps the gateway call out of the transaction of the command's
+// idempotency record.
+func (*RefundPaymentInput) CallsOut() bool {
+	return true
+}
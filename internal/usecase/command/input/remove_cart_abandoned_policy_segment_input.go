@@ -0,0 +1,13 @@
+package input
+
+type RemoveCartAbandonedPolicySegmentInput struct {
+	CommandIdentity
+	Precondition
+
+	TenantID  string `json:"tenant_id"`
+	SegmentID string `json:"segment_id"`
+}
+
+func (*RemoveCartAbandonedPolicySegmentInput) CommandName() string {
+	return "RemoveCartAbandonedPolicySegment"
+}
@@ -0,0 +1,14 @@
+package input
+
+type RemoveCartMemberInput struct {
+	CommandIdentity
+	Precondition
+
+	CartID   string `json:"cart_id"`
+	UserID   string `json:"user_id"`
+	MemberID string `json:"member_id"`
+}
+
+func (*RemoveCartMemberInput) CommandName() string {
+	return "RemoveCartMember"
+}
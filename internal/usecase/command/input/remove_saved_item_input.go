@@ -0,0 +1,13 @@
+package input
+
+type RemoveSavedItemInput struct {
+	CommandIdentity
+
+	UserID   string `json:"user_id"`
+	TenantID string `json:"tenant_id"`
+	ItemID   string `json:"item_id"`
+}
+
+func (*RemoveSavedItemInput) CommandName() string {
+	return "RemoveSavedItem"
+}
@@ -0,0 +1,13 @@
+package input
+
+type RequestCartApprovalInput struct {
+	CommandIdentity
+	Precondition
+
+	CartID string `json:"cart_id"`
+	UserID string `json:"user_id"`
+}
+
+func (*RequestCartApprovalInput) CommandName() string {
+	return "RequestCartApproval"
+}
@@ -0,0 +1,20 @@
+package input
+
+type SaveItemInput struct {
+	CommandIdentity
+
+	UserID      string            `json:"user_id"`
+	TenantID    string            `json:"tenant_id"`
+	ItemID      string            `json:"item_id"`
+	Name        string            `json:"name"`
+	Price       float64           `json:"price"`
+	TaxCategory string            `json:"tax_category"`
+	WeightGrams int               `json:"weight_grams"`
+	Options     []ItemOptionInput `json:"options"`
+	Category    string            `json:"category"`
+	Quantity    int               `json:"quantity"`
+}
+
+func (*SaveItemInput) CommandName() string {
+	return "SaveItem"
+}
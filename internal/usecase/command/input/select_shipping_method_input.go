@@ -0,0 +1,14 @@
+package input
+
+type SelectShippingMethodInput struct {
+	CommandIdentity
+	Precondition
+
+	CartID string `json:"cart_id"`
+	UserID string `json:"user_id"`
+	Method string `json:"method"`
+}
+
+func (*SelectShippingMethodInput) CommandName() string {
+	return "SelectShippingMethod"
+}
@@ -0,0 +1,20 @@
+package input
+
+type SetShippingAddressInput struct {
+	CommandIdentity
+	Precondition
+
+	CartID        string `json:"cart_id"`
+	UserID        string `json:"user_id"`
+	RecipientName string `json:"recipient_name"`
+	PostalCode    string `json:"postal_code"`
+	Prefecture    string `json:"prefecture"`
+	City          string `json:"city"`
+	AddressLine1  string `json:"address_line1"`
+	AddressLine2  string `json:"address_line2"`
+	Phone         string `json:"phone"`
+}
+
+func (*SetShippingAddressInput) CommandName() string {
+	return "SetShippingAddress"
+}
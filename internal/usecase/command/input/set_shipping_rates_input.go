@@ -0,0 +1,19 @@
+package input
+
+type SetShippingRatesInput struct {
+	CommandIdentity
+
+	TenantID string              `json:"tenant_id"`
+	Rates    []ShippingRateInput `json:"rates"`
+}
+
+func (*SetShippingRatesInput) CommandName() string {
+	return "SetShippingRates"
+}
+
+type ShippingRateInput struct {
+	Method         string  `json:"method"`
+	Prefecture     string  `json:"prefecture"`
+	MaxWeightGrams int     `json:"max_weight_grams"`
+	Fee            float64 `json:"fee"`
+}
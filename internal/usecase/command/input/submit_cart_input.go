@@ -1,5 +1,20 @@
 package input
 
 type SubmitCartInput struct {
+	CommandIdentity
+	Precondition
+
 	CartID string `json:"cart_id"`
+	UserID string `json:"user_id"`
+}
+
+func (*SubmitCartInput) CommandName() string {
+	return "SubmitCart"
+}
+
+func (i *SubmitCartInput) Validate() error {
+	return validateIDs(
+		idField{name: "cart_id", value: i.CartID},
+		idField{name: "user_id", value: i.UserID, optional: true},
+	)
 }
@@ -3,9 +3,19 @@ package input
 import "time"
 
 type UpdateTenantCartAbandonedPolicyInput struct {
+	CommandIdentity
+	Precondition
+
 	TenantID         string    `json:"tenant_id"`
 	Title            string    `json:"title"`
 	AbandonedMinutes int       `json:"abandoned_minutes"`
 	QuietTimeFrom    time.Time `json:"quiet_time_from"`
 	QuietTimeTo      time.Time `json:"quiet_time_to"`
+	CartExpiryDays   int       `json:"cart_expiry_days"`
+	EffectiveFrom    time.Time `json:"effective_from"`
+	EffectiveTo      time.Time `json:"effective_to"`
+}
+
+func (*UpdateTenantCartAbandonedPolicyInput) CommandName() string {
+	return "UpdateTenantCartAbandonedPolicy"
 }
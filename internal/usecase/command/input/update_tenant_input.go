@@ -0,0 +1,14 @@
+package input
+
+type UpdateTenantInput struct {
+	CommandIdentity
+
+	TenantID      string `json:"tenant_id"`
+	DisplayName   string `json:"display_name"`
+	Plan          string `json:"plan"`
+	DefaultLocale string `json:"default_locale"`
+}
+
+func (*UpdateTenantInput) CommandName() string {
+	return "UpdateTenant"
+}
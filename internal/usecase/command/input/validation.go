@@ -0,0 +1,34 @@
+package input
+
+import (
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+)
+
+type idField struct {
+	name     string
+	value    string
+	optional bool
+}
+
+// validateIDs rejects identifiers that are not UUIDs, listing each of them,
+// so that a malformed request fails before any aggregate is loaded.
+func validateIDs(fields ...idField) error {
+	var details []errors.Detail
+	for _, field := range fields {
+		if field.optional && field.value == "" {
+			continue
+		}
+		if _, err := uuid.Parse(field.value); err != nil {
+			details = append(details, errors.Detail{
+				Code:    "INVALID_ID",
+				Message: field.name + " must be a UUID",
+			})
+		}
+	}
+
+	if len(details) == 0 {
+		return nil
+	}
+	return errors.InvalidParameter.NewWithDetails("request has malformed identifiers", details)
+}
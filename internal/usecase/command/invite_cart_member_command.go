@@ -0,0 +1,65 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+)
+
+type InviteCartMemberCommandInterface interface {
+	Execute(ctx context.Context, input *input.InviteCartMemberInput, out presenter.CommandResultPresenter) error
+}
+
+type InviteCartMemberCommand struct {
+	cartRepo   repository.AggregateRepository[*aggregate.CartAggregate]
+	tenantRepo repository.AggregateRepository[*aggregate.TenantAggregate]
+}
+
+func NewInviteCartMemberCommand(cartRepo repository.AggregateRepository[*aggregate.CartAggregate], tenantRepo repository.AggregateRepository[*aggregate.TenantAggregate]) InviteCartMemberCommandInterface {
+	return &InviteCartMemberCommand{
+		cartRepo:   cartRepo,
+		tenantRepo: tenantRepo,
+	}
+}
+
+func (u *InviteCartMemberCommand) Execute(ctx context.Context, input *input.InviteCartMemberInput, out presenter.CommandResultPresenter) error {
+	cartUUID, err := uuid.Parse(input.CartID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid cart id"))
+	}
+
+	userUUID, err := uuid.Parse(input.UserID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid user id"))
+	}
+
+	inviteeUUID, err := uuid.Parse(input.InviteeID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid invitee id"))
+	}
+
+	cart, events, err := u.cartRepo.Update(ctx, cartUUID, func(ctx context.Context, cart *aggregate.CartAggregate) error {
+		if err := checkTenantOpen(ctx, u.tenantRepo, cart.GetTenantID()); err != nil {
+			return err
+		}
+
+		cmd := command.InviteCartMemberCommand{
+			CartID:    cartUUID,
+			UserID:    userUUID,
+			InviteeID: inviteeUUID,
+		}
+
+		return cart.ExecuteInviteCartMemberCommand(cmd)
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, cart.GetAggregateID().String(), cart.GetVersion(), events)
+}
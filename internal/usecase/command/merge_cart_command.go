@@ -0,0 +1,151 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+)
+
+type MergeCartCommandInterface interface {
+	Execute(ctx context.Context, input *input.MergeCartInput, out presenter.CommandResultPresenter) error
+}
+
+type MergeCartCommand struct {
+	cartRepo     repository.AggregateRepository[*aggregate.CartAggregate]
+	tenantRepo   repository.AggregateRepository[*aggregate.TenantAggregate]
+	rulesRepo    repository.AggregateRepository[*aggregate.TenantCartRulesAggregate]
+	couponRepo   repository.AggregateRepository[*aggregate.CouponAggregate]
+	streamWriter repository.StreamWriter
+}
+
+func NewMergeCartCommand(
+	cartRepo repository.AggregateRepository[*aggregate.CartAggregate],
+	tenantRepo repository.AggregateRepository[*aggregate.TenantAggregate],
+	rulesRepo repository.AggregateRepository[*aggregate.TenantCartRulesAggregate],
+	couponRepo repository.AggregateRepository[*aggregate.CouponAggregate],
+	streamWriter repository.StreamWriter,
+) MergeCartCommandInterface {
+	return &MergeCartCommand{
+		cartRepo:     cartRepo,
+		tenantRepo:   tenantRepo,
+		rulesRepo:    rulesRepo,
+		couponRepo:   couponRepo,
+		streamWriter: streamWriter,
+	}
+}
+
+// Execute moves a guest cart into the user's cart. Both streams are appended
+// at once at the versions they were loaded at, so a concurrent change to
+// either one fails the whole merge and it is retried against the latest
+// versions.
+func (u *MergeCartCommand) Execute(ctx context.Context, input *input.MergeCartInput, out presenter.CommandResultPresenter) error {
+	guestUUID, err := uuid.Parse(input.GuestCartID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid guest cart id"))
+	}
+
+	cartUUID, err := uuid.Parse(input.CartID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid cart id"))
+	}
+
+	userUUID, err := uuid.Parse(input.UserID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid user id"))
+	}
+
+	// The guest proves the cart is theirs with the session that started it
+	sessionID, err := value.NewSessionID(input.SessionID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	var version int
+	var events []event.Event
+	err = u.streamWriter.Update(ctx, func(ctx context.Context) ([]repository.StreamAppend, error) {
+		guest, err := u.cartRepo.Load(ctx, guestUUID)
+		if err != nil {
+			return nil, err
+		}
+		guestVersion := guest.GetVersion()
+
+		cart, err := u.cartRepo.Load(ctx, cartUUID)
+		if err != nil {
+			return nil, err
+		}
+		cartVersion := cart.GetVersion()
+
+		if err := checkTenantOpen(ctx, u.tenantRepo, guest.GetTenantID()); err != nil {
+			return nil, err
+		}
+
+		// Merged lines are held to the tenant's cart rules like any other
+		cartRules, err := u.rulesRepo.Load(ctx, aggregate.CartRulesIDForTenant(guest.GetTenantID()))
+		if err != nil {
+			return nil, err
+		}
+
+		cmd := command.MergeCartCommand{
+			CartID:     guestUUID,
+			IntoCartID: cartUUID,
+			UserID:     userUUID,
+			SessionID:  sessionID,
+			CartRules:  cartRules.GetCartRules(),
+		}
+
+		if err := guest.ExecuteMergeCartCommand(cmd, cart); err != nil {
+			return nil, err
+		}
+
+		// Coupons stay with the guest cart, so give their redemptions back
+		appends := []repository.StreamAppend{pendingAppend(guest, guestVersion), pendingAppend(cart, cartVersion)}
+		for _, applied := range guest.GetCoupons() {
+			coupon, err := u.couponRepo.Load(ctx, applied.GetCouponID())
+			if err != nil {
+				return nil, err
+			}
+			couponVersion := coupon.GetVersion()
+
+			if err := coupon.ExecuteReleaseCouponCommand(command.ReleaseCouponCommand{
+				CouponID: applied.GetCouponID(),
+				CartID:   guestUUID,
+			}); err != nil {
+				return nil, err
+			}
+			appends = append(appends, pendingAppend(coupon, couponVersion))
+		}
+
+		version = cart.GetVersion()
+		events = append(cart.GetUncommittedEvents(), guest.GetUncommittedEvents()...)
+
+		return appends, nil
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, cartUUID.String(), version, events)
+}
+
+type eventSourced interface {
+	GetAggregateID() uuid.UUID
+	GetUncommittedEvents() []event.Event
+}
+
+// pendingAppend is the uncommitted events of the stream, to be appended after
+// the version it was loaded at.
+func pendingAppend(stream eventSourced, loadedVersion int) repository.StreamAppend {
+	return repository.StreamAppend{
+		AggregateID:     stream.GetAggregateID(),
+		ExpectedVersion: loadedVersion,
+		Events:          stream.GetUncommittedEvents(),
+	}
+}
@@ -0,0 +1,99 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+)
+
+type MoveCartItemToSavedListCommandInterface interface {
+	Execute(ctx context.Context, input *input.MoveCartItemToSavedListInput, out presenter.CommandResultPresenter) error
+}
+
+type MoveCartItemToSavedListCommand struct {
+	cartRepo     repository.AggregateRepository[*aggregate.CartAggregate]
+	tenantRepo   repository.AggregateRepository[*aggregate.TenantAggregate]
+	listRepo     repository.AggregateRepository[*aggregate.SavedListAggregate]
+	streamWriter repository.StreamWriter
+}
+
+func NewMoveCartItemToSavedListCommand(
+	cartRepo repository.AggregateRepository[*aggregate.CartAggregate],
+	tenantRepo repository.AggregateRepository[*aggregate.TenantAggregate],
+	listRepo repository.AggregateRepository[*aggregate.SavedListAggregate],
+	streamWriter repository.StreamWriter,
+) MoveCartItemToSavedListCommandInterface {
+	return &MoveCartItemToSavedListCommand{
+		cartRepo:     cartRepo,
+		tenantRepo:   tenantRepo,
+		listRepo:     listRepo,
+		streamWriter: streamWriter,
+	}
+}
+
+// Execute takes the line off the cart and keeps it on the user's saved list.
+// Both streams are appended at once at the versions they were loaded at, so
+// the item is never in both places or in neither.
+func (u *MoveCartItemToSavedListCommand) Execute(ctx context.Context, input *input.MoveCartItemToSavedListInput, out presenter.CommandResultPresenter) error {
+	cartUUID, err := uuid.Parse(input.CartID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid cart id"))
+	}
+
+	lineUUID, err := uuid.Parse(input.LineID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid line id"))
+	}
+
+	userUUID, err := uuid.Parse(input.UserID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid user id"))
+	}
+
+	var version int
+	var events []event.Event
+	err = u.streamWriter.Update(ctx, func(ctx context.Context) ([]repository.StreamAppend, error) {
+		cart, err := u.cartRepo.Load(ctx, cartUUID)
+		if err != nil {
+			return nil, err
+		}
+		cartVersion := cart.GetVersion()
+
+		if err := checkTenantOpen(ctx, u.tenantRepo, cart.GetTenantID()); err != nil {
+			return nil, err
+		}
+
+		list, err := u.listRepo.Load(ctx, aggregate.SavedListIDForUser(cart.GetTenantID(), userUUID))
+		if err != nil {
+			return nil, err
+		}
+		listVersion := list.GetVersion()
+
+		cmd := command.MoveCartItemToSavedListCommand{
+			CartID: cartUUID,
+			UserID: userUUID,
+			LineID: lineUUID,
+		}
+
+		if err := cart.ExecuteMoveCartItemToSavedListCommand(cmd, list); err != nil {
+			return nil, err
+		}
+
+		version = cart.GetVersion()
+		events = append(cart.GetUncommittedEvents(), list.GetUncommittedEvents()...)
+
+		return []repository.StreamAppend{pendingAppend(cart, cartVersion), pendingAppend(list, listVersion)}, nil
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, cartUUID.String(), version, events)
+}
@@ -0,0 +1,109 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+)
+
+type MoveSavedItemToCartCommandInterface interface {
+	Execute(ctx context.Context, input *input.MoveSavedItemToCartInput, out presenter.CommandResultPresenter) error
+}
+
+type MoveSavedItemToCartCommand struct {
+	cartRepo     repository.AggregateRepository[*aggregate.CartAggregate]
+	tenantRepo   repository.AggregateRepository[*aggregate.TenantAggregate]
+	listRepo     repository.AggregateRepository[*aggregate.SavedListAggregate]
+	rulesRepo    repository.AggregateRepository[*aggregate.TenantCartRulesAggregate]
+	streamWriter repository.StreamWriter
+}
+
+func NewMoveSavedItemToCartCommand(
+	cartRepo repository.AggregateRepository[*aggregate.CartAggregate],
+	tenantRepo repository.AggregateRepository[*aggregate.TenantAggregate],
+	listRepo repository.AggregateRepository[*aggregate.SavedListAggregate],
+	rulesRepo repository.AggregateRepository[*aggregate.TenantCartRulesAggregate],
+	streamWriter repository.StreamWriter,
+) MoveSavedItemToCartCommandInterface {
+	return &MoveSavedItemToCartCommand{
+		cartRepo:     cartRepo,
+		tenantRepo:   tenantRepo,
+		listRepo:     listRepo,
+		rulesRepo:    rulesRepo,
+		streamWriter: streamWriter,
+	}
+}
+
+// Execute adds the saved item to the cart and takes it off the list. Both
+// streams are appended at once at the versions they were loaded at, so the
+// item is never in both places or in neither.
+func (u *MoveSavedItemToCartCommand) Execute(ctx context.Context, input *input.MoveSavedItemToCartInput, out presenter.CommandResultPresenter) error {
+	tenantUUID, userUUID, err := parseSavedListOwner(input.TenantID, input.UserID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	itemUUID, err := uuid.Parse(input.ItemID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid item id"))
+	}
+
+	cartUUID, err := uuid.Parse(input.CartID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid cart id"))
+	}
+
+	var version int
+	var events []event.Event
+	err = u.streamWriter.Update(ctx, func(ctx context.Context) ([]repository.StreamAppend, error) {
+		list, err := u.listRepo.Load(ctx, aggregate.SavedListIDForUser(tenantUUID, userUUID))
+		if err != nil {
+			return nil, err
+		}
+		listVersion := list.GetVersion()
+
+		cart, err := u.cartRepo.Load(ctx, cartUUID)
+		if err != nil {
+			return nil, err
+		}
+		cartVersion := cart.GetVersion()
+
+		if err := checkTenantOpen(ctx, u.tenantRepo, tenantUUID); err != nil {
+			return nil, err
+		}
+
+		cartRules, err := u.rulesRepo.Load(ctx, aggregate.CartRulesIDForTenant(tenantUUID))
+		if err != nil {
+			return nil, err
+		}
+
+		cmd := command.MoveSavedItemToCartCommand{
+			TenantID:  tenantUUID,
+			UserID:    userUUID,
+			ItemID:    itemUUID,
+			CartID:    cartUUID,
+			CartRules: cartRules.GetCartRules(),
+		}
+
+		if err := list.ExecuteMoveSavedItemToCartCommand(cmd, cart); err != nil {
+			return nil, err
+		}
+
+		version = cart.GetVersion()
+		events = append(cart.GetUncommittedEvents(), list.GetUncommittedEvents()...)
+
+		return []repository.StreamAppend{pendingAppend(cart, cartVersion), pendingAppend(list, listVersion)}, nil
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, cartUUID.String(), version, events)
+}
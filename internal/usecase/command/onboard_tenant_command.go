@@ -0,0 +1,146 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+)
+
+// The abandonment policy new tenants start with. Carts are reminded about
+// after half an hour and never expire.
+const (
+	defaultAbandonedPolicyTitle   = "Default Cart Abandonment Policy"
+	defaultAbandonedPolicyMinutes = 30
+)
+
+type OnboardTenantCommandInterface interface {
+	Execute(ctx context.Context, input *input.OnboardTenantInput, out presenter.CommandResultPresenter) error
+}
+
+type OnboardTenantCommand struct {
+	tenantRepo      repository.AggregateRepository[*aggregate.TenantAggregate]
+	policyRepo      repository.AggregateRepository[*aggregate.TenantCartAbandonedPolicyAggregate]
+	taxSettingsRepo repository.AggregateRepository[*aggregate.TenantTaxSettingsAggregate]
+	streamWriter    repository.StreamWriter
+}
+
+func NewOnboardTenantCommand(
+	tenantRepo repository.AggregateRepository[*aggregate.TenantAggregate],
+	policyRepo repository.AggregateRepository[*aggregate.TenantCartAbandonedPolicyAggregate],
+	taxSettingsRepo repository.AggregateRepository[*aggregate.TenantTaxSettingsAggregate],
+	streamWriter repository.StreamWriter,
+) OnboardTenantCommandInterface {
+	return &OnboardTenantCommand{
+		tenantRepo:      tenantRepo,
+		policyRepo:      policyRepo,
+		taxSettingsRepo: taxSettingsRepo,
+		streamWriter:    streamWriter,
+	}
+}
+
+// Execute onboards the tenant and provisions its default abandonment policy
+// and tax settings in the same transaction. Policies a tenant configured
+// before it was onboarded are kept.
+func (u *OnboardTenantCommand) Execute(ctx context.Context, input *input.OnboardTenantInput, out presenter.CommandResultPresenter) error {
+	// Tenants that already have carts or policies keep their ID
+	tenantUUID := uuid.New()
+	if input.TenantID != "" {
+		parsed, err := uuid.Parse(input.TenantID)
+		if err != nil {
+			return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid tenant id"))
+		}
+		tenantUUID = parsed
+	}
+
+	plan, err := value.NewTenantPlan(input.Plan)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	locale, err := value.NewLocale(input.DefaultLocale)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	var version int
+	var events []event.Event
+	err = u.streamWriter.Update(ctx, func(ctx context.Context) ([]repository.StreamAppend, error) {
+		tenant, err := u.tenantRepo.Load(ctx, aggregate.TenantIDForStream(tenantUUID))
+		if err != nil {
+			return nil, err
+		}
+		tenantVersion := tenant.GetVersion()
+
+		if err := tenant.ExecuteOnboardTenantCommand(command.OnboardTenantCommand{
+			TenantID:      tenantUUID,
+			DisplayName:   input.DisplayName,
+			Plan:          plan,
+			DefaultLocale: locale,
+		}); err != nil {
+			return nil, err
+		}
+
+		abandonedPolicy, err := u.policyRepo.Load(ctx, tenantUUID)
+		if err != nil {
+			return nil, err
+		}
+		abandonedPolicyVersion := abandonedPolicy.GetVersion()
+		if abandonedPolicyVersion == -1 {
+			if err := abandonedPolicy.ExecuteCreateTenantCartAbandonedPolicyCommand(command.CreateTenantCartAbandonedPolicyCommand{
+				TenantID:         tenantUUID,
+				Title:            defaultAbandonedPolicyTitle,
+				AbandonedMinutes: defaultAbandonedPolicyMinutes,
+			}); err != nil {
+				return nil, err
+			}
+		}
+
+		taxSettings, err := u.taxSettingsRepo.Load(ctx, aggregate.TaxSettingsIDForTenant(tenantUUID))
+		if err != nil {
+			return nil, err
+		}
+		taxSettingsVersion := taxSettings.GetVersion()
+		if taxSettingsVersion == -1 {
+			if err := taxSettings.ExecuteConfigureTenantTaxSettingsCommand(command.ConfigureTenantTaxSettingsCommand{
+				TenantID:    tenantUUID,
+				TaxSettings: value.DefaultTaxSettings(),
+			}); err != nil {
+				return nil, err
+			}
+		}
+
+		version = tenant.GetVersion()
+		events = make([]event.Event, 0)
+		events = append(events, tenant.GetUncommittedEvents()...)
+		events = append(events, abandonedPolicy.GetUncommittedEvents()...)
+		events = append(events, taxSettings.GetUncommittedEvents()...)
+
+		return []repository.StreamAppend{
+			pendingAppend(tenant, tenantVersion),
+			pendingAppend(abandonedPolicy, abandonedPolicyVersion),
+			pendingAppend(taxSettings, taxSettingsVersion),
+		}, nil
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, tenantUUID.String(), version, events)
+}
+
+// checkTenantOpen rejects cart commands of suspended and closed tenants.
+func checkTenantOpen(ctx context.Context, tenantRepo repository.AggregateRepository[*aggregate.TenantAggregate], tenantID uuid.UUID) error {
+	tenant, err := tenantRepo.Load(ctx, aggregate.TenantIDForStream(tenantID))
+	if err != nil {
+		return err
+	}
+	return tenant.CheckOpenForCarts()
+}
@@ -0,0 +1,72 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/gateway"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+)
+
+type RefundPaymentCommandInterface interface {
+	Execute(ctx context.Context, input *input.RefundPaymentInput, out presenter.CommandResultPresenter) error
+}
+
+type RefundPaymentCommand struct {
+	paymentRepo    repository.AggregateRepository[*aggregate.PaymentAggregate]
+	paymentGateway gateway.PaymentGateway
+}
+
+func NewRefundPaymentCommand(paymentRepo repository.AggregateRepository[*aggregate.PaymentAggregate], paymentGateway gateway.PaymentGateway) RefundPaymentCommandInterface {
+	return &RefundPaymentCommand{
+		paymentRepo:    paymentRepo,
+		paymentGateway: paymentGateway,
+	}
+}
+
+// Execute refunds a captured payment the way AuthorizePaymentCommand charges
+// it: the refund is recorded first, the gateway is asked outside of any
+// transaction and the outcome is recorded in a second one. A refund left
+// pending by a crash in between is resumed by a retry, which the gateway
+// answers without moving the money again.
+func (u *RefundPaymentCommand) Execute(ctx context.Context, input *input.RefundPaymentInput, out presenter.CommandResultPresenter) error {
+	cartID, err := uuid.Parse(input.CartID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid cart id"))
+	}
+
+	paymentID := aggregate.PaymentIDForCart(cartID)
+	payment, events, err := u.paymentRepo.Update(ctx, paymentID, func(ctx context.Context, payment *aggregate.PaymentAggregate) error {
+		return payment.ExecuteRequestPaymentRefundCommand(command.RequestPaymentRefundCommand{
+			PaymentID: paymentID,
+		})
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	// The gateway is only asked while the refund is pending
+	if payment.GetStatus() != aggregate.PaymentStatusRefundRequested {
+		return out.PresentSuccess(ctx, paymentID.String(), payment.GetVersion(), events)
+	}
+
+	if err := u.paymentGateway.Refund(ctx, payment.GetAuthorizationID(), payment.GetAmount()); err != nil {
+		return out.PresentError(ctx, errors.Unknown.Wrap(err, "payment gateway refund failed"))
+	}
+
+	payment, outcome, err := u.paymentRepo.Update(ctx, paymentID, func(ctx context.Context, payment *aggregate.PaymentAggregate) error {
+		return payment.ExecuteRefundPaymentCommand(command.RefundPaymentCommand{
+			PaymentID: paymentID,
+		})
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, paymentID.String(), payment.GetVersion(), append(events, outcome...))
+}
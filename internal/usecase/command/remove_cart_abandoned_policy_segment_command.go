@@ -0,0 +1,53 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+)
+
+type RemoveCartAbandonedPolicySegmentCommandInterface interface {
+	Execute(ctx context.Context, input *input.RemoveCartAbandonedPolicySegmentInput, out presenter.CommandResultPresenter) error
+}
+
+type RemoveCartAbandonedPolicySegmentCommand struct {
+	policyRepo repository.AggregateRepository[*aggregate.TenantCartAbandonedPolicyAggregate]
+}
+
+func NewRemoveCartAbandonedPolicySegmentCommand(policyRepo repository.AggregateRepository[*aggregate.TenantCartAbandonedPolicyAggregate]) RemoveCartAbandonedPolicySegmentCommandInterface {
+	return &RemoveCartAbandonedPolicySegmentCommand{
+		policyRepo: policyRepo,
+	}
+}
+
+func (u *RemoveCartAbandonedPolicySegmentCommand) Execute(ctx context.Context, input *input.RemoveCartAbandonedPolicySegmentInput, out presenter.CommandResultPresenter) error {
+	tenantUUID, err := uuid.Parse(input.TenantID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid tenant id"))
+	}
+
+	segmentUUID, err := uuid.Parse(input.SegmentID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid segment id"))
+	}
+
+	policy, events, err := u.policyRepo.Update(ctx, tenantUUID, func(ctx context.Context, policy *aggregate.TenantCartAbandonedPolicyAggregate) error {
+		cmd := command.RemoveCartAbandonedPolicySegmentCommand{
+			TenantID:  tenantUUID,
+			SegmentID: segmentUUID,
+		}
+
+		return policy.ExecuteRemoveCartAbandonedPolicySegmentCommand(cmd)
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, policy.GetAggregateID().String(), policy.GetVersion(), events)
+}
@@ -0,0 +1,111 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+)
+
+type RemoveCouponCommandInterface interface {
+	Execute(ctx context.Context, input *input.RemoveCouponInput, out presenter.CommandResultPresenter) error
+}
+
+type RemoveCouponCommand struct {
+	cartRepo     repository.AggregateRepository[*aggregate.CartAggregate]
+	tenantRepo   repository.AggregateRepository[*aggregate.TenantAggregate]
+	couponRepo   repository.AggregateRepository[*aggregate.CouponAggregate]
+	streamWriter repository.StreamWriter
+}
+
+func NewRemoveCouponCommand(
+	cartRepo repository.AggregateRepository[*aggregate.CartAggregate],
+	tenantRepo repository.AggregateRepository[*aggregate.TenantAggregate],
+	couponRepo repository.AggregateRepository[*aggregate.CouponAggregate],
+	streamWriter repository.StreamWriter,
+) RemoveCouponCommandInterface {
+	return &RemoveCouponCommand{
+		cartRepo:     cartRepo,
+		tenantRepo:   tenantRepo,
+		couponRepo:   couponRepo,
+		streamWriter: streamWriter,
+	}
+}
+
+// Execute removes the coupon from the cart and gives its redemption back in
+// the same transaction.
+func (u *RemoveCouponCommand) Execute(ctx context.Context, input *input.RemoveCouponInput, out presenter.CommandResultPresenter) error {
+	cartUUID, err := uuid.Parse(input.CartID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid cart id"))
+	}
+
+	userUUID, err := parseActingUser(input.UserID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	code, err := value.NewCouponCode(input.Code)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	var version int
+	var events []event.Event
+	err = u.streamWriter.Update(ctx, func(ctx context.Context) ([]repository.StreamAppend, error) {
+		cart, err := u.cartRepo.Load(ctx, cartUUID)
+		if err != nil {
+			return nil, err
+		}
+		cartVersion := cart.GetVersion()
+
+		if err := checkTenantOpen(ctx, u.tenantRepo, cart.GetTenantID()); err != nil {
+			return nil, err
+		}
+
+		var couponID uuid.UUID
+		for _, applied := range cart.GetCoupons() {
+			if applied.GetCode() == code {
+				couponID = applied.GetCouponID()
+			}
+		}
+
+		if err := cart.ExecuteRemoveCouponFromCartCommand(command.RemoveCouponFromCartCommand{
+			CartID: cartUUID,
+			UserID: userUUID,
+			Code:   code,
+		}); err != nil {
+			return nil, err
+		}
+
+		coupon, err := u.couponRepo.Load(ctx, couponID)
+		if err != nil {
+			return nil, err
+		}
+		couponVersion := coupon.GetVersion()
+
+		if err := coupon.ExecuteReleaseCouponCommand(command.ReleaseCouponCommand{
+			CouponID: couponID,
+			CartID:   cartUUID,
+		}); err != nil {
+			return nil, err
+		}
+
+		version = cart.GetVersion()
+		events = append(cart.GetUncommittedEvents(), coupon.GetUncommittedEvents()...)
+
+		return []repository.StreamAppend{pendingAppend(coupon, couponVersion), pendingAppend(cart, cartVersion)}, nil
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, cartUUID.String(), version, events)
+}
@@ -0,0 +1,54 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+)
+
+type RemoveSavedItemCommandInterface interface {
+	Execute(ctx context.Context, input *input.RemoveSavedItemInput, out presenter.CommandResultPresenter) error
+}
+
+type RemoveSavedItemCommand struct {
+	listRepo repository.AggregateRepository[*aggregate.SavedListAggregate]
+}
+
+func NewRemoveSavedItemCommand(listRepo repository.AggregateRepository[*aggregate.SavedListAggregate]) RemoveSavedItemCommandInterface {
+	return &RemoveSavedItemCommand{
+		listRepo: listRepo,
+	}
+}
+
+func (u *RemoveSavedItemCommand) Execute(ctx context.Context, input *input.RemoveSavedItemInput, out presenter.CommandResultPresenter) error {
+	tenantUUID, userUUID, err := parseSavedListOwner(input.TenantID, input.UserID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	itemUUID, err := uuid.Parse(input.ItemID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid item id"))
+	}
+
+	list, events, err := u.listRepo.Update(ctx, aggregate.SavedListIDForUser(tenantUUID, userUUID), func(ctx context.Context, list *aggregate.SavedListAggregate) error {
+		cmd := command.RemoveSavedItemCommand{
+			TenantID: tenantUUID,
+			UserID:   userUUID,
+			ItemID:   itemUUID,
+		}
+
+		return list.ExecuteRemoveSavedItemCommand(cmd)
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, list.GetAggregateID().String(), list.GetVersion(), events)
+}
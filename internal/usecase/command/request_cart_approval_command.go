@@ -0,0 +1,69 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+)
+
+type RequestCartApprovalCommandInterface interface {
+	Execute(ctx context.Context, input *input.RequestCartApprovalInput, out presenter.CommandResultPresenter) error
+}
+
+type RequestCartApprovalCommand struct {
+	cartRepo           repository.AggregateRepository[*aggregate.CartAggregate]
+	tenantRepo         repository.AggregateRepository[*aggregate.TenantAggregate]
+	approvalPolicyRepo repository.AggregateRepository[*aggregate.TenantApprovalPolicyAggregate]
+}
+
+func NewRequestCartApprovalCommand(cartRepo repository.AggregateRepository[*aggregate.CartAggregate], tenantRepo repository.AggregateRepository[*aggregate.TenantAggregate], approvalPolicyRepo repository.AggregateRepository[*aggregate.TenantApprovalPolicyAggregate]) RequestCartApprovalCommandInterface {
+	return &RequestCartApprovalCommand{
+		cartRepo:           cartRepo,
+		tenantRepo:         tenantRepo,
+		approvalPolicyRepo: approvalPolicyRepo,
+	}
+}
+
+func (u *RequestCartApprovalCommand) Execute(ctx context.Context, input *input.RequestCartApprovalInput, out presenter.CommandResultPresenter) error {
+	cartUUID, err := uuid.Parse(input.CartID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid cart id"))
+	}
+
+	userUUID, err := uuid.Parse(input.UserID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid user id"))
+	}
+
+	cart, events, err := u.cartRepo.Update(ctx, cartUUID, func(ctx context.Context, cart *aggregate.CartAggregate) error {
+		if err := checkTenantOpen(ctx, u.tenantRepo, cart.GetTenantID()); err != nil {
+			return err
+		}
+
+		// The threshold and approvers in force now decide whether the
+		// cart needs approval at all
+		policy, err := u.approvalPolicyRepo.Load(ctx, aggregate.ApprovalPolicyIDForTenant(cart.GetTenantID()))
+		if err != nil {
+			return err
+		}
+
+		cmd := command.RequestCartApprovalCommand{
+			CartID:         cartUUID,
+			UserID:         userUUID,
+			ApprovalPolicy: policy.GetApprovalPolicy(),
+		}
+
+		return cart.ExecuteRequestCartApprovalCommand(cmd)
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, cart.GetAggregateID().String(), cart.GetVersion(), events)
+}
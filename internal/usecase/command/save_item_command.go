@@ -0,0 +1,80 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+)
+
+type SaveItemCommandInterface interface {
+	Execute(ctx context.Context, input *input.SaveItemInput, out presenter.CommandResultPresenter) error
+}
+
+type SaveItemCommand struct {
+	listRepo repository.AggregateRepository[*aggregate.SavedListAggregate]
+}
+
+func NewSaveItemCommand(listRepo repository.AggregateRepository[*aggregate.SavedListAggregate]) SaveItemCommandInterface {
+	return &SaveItemCommand{
+		listRepo: listRepo,
+	}
+}
+
+func (u *SaveItemCommand) Execute(ctx context.Context, input *input.SaveItemInput, out presenter.CommandResultPresenter) error {
+	tenantUUID, userUUID, err := parseSavedListOwner(input.TenantID, input.UserID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	itemUUID, err := uuid.Parse(input.ItemID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid item id"))
+	}
+
+	options, err := parseLineOptions(input.Options)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	list, events, err := u.listRepo.Update(ctx, aggregate.SavedListIDForUser(tenantUUID, userUUID), func(ctx context.Context, list *aggregate.SavedListAggregate) error {
+		cmd := command.SaveItemCommand{
+			TenantID:    tenantUUID,
+			UserID:      userUUID,
+			ItemID:      itemUUID,
+			Name:        input.Name,
+			Price:       input.Price,
+			TaxCategory: input.TaxCategory,
+			WeightGrams: input.WeightGrams,
+			Options:     options,
+			Category:    input.Category,
+			Quantity:    input.Quantity,
+		}
+
+		return list.ExecuteSaveItemCommand(cmd)
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, list.GetAggregateID().String(), list.GetVersion(), events)
+}
+
+func parseSavedListOwner(tenantID, userID string) (uuid.UUID, uuid.UUID, error) {
+	tenantUUID, err := uuid.Parse(tenantID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, errors.InvalidParameter.Wrap(err, "invalid tenant id")
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, errors.InvalidParameter.Wrap(err, "invalid user id")
+	}
+
+	return tenantUUID, userUUID, nil
+}
@@ -0,0 +1,74 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+)
+
+type SelectShippingMethodCommandInterface interface {
+	Execute(ctx context.Context, input *input.SelectShippingMethodInput, out presenter.CommandResultPresenter) error
+}
+
+type SelectShippingMethodCommand struct {
+	cartRepo          repository.AggregateRepository[*aggregate.CartAggregate]
+	tenantRepo        repository.AggregateRepository[*aggregate.TenantAggregate]
+	shippingRatesRepo repository.AggregateRepository[*aggregate.TenantShippingRatesAggregate]
+}
+
+func NewSelectShippingMethodCommand(cartRepo repository.AggregateRepository[*aggregate.CartAggregate], tenantRepo repository.AggregateRepository[*aggregate.TenantAggregate], shippingRatesRepo repository.AggregateRepository[*aggregate.TenantShippingRatesAggregate]) SelectShippingMethodCommandInterface {
+	return &SelectShippingMethodCommand{
+		cartRepo:          cartRepo,
+		tenantRepo:        tenantRepo,
+		shippingRatesRepo: shippingRatesRepo,
+	}
+}
+
+func (u *SelectShippingMethodCommand) Execute(ctx context.Context, input *input.SelectShippingMethodInput, out presenter.CommandResultPresenter) error {
+	cartUUID, err := uuid.Parse(input.CartID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid cart id"))
+	}
+
+	userUUID, err := parseActingUser(input.UserID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	method, err := value.NewShippingMethod(input.Method)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	cart, events, err := u.cartRepo.Update(ctx, cartUUID, func(ctx context.Context, cart *aggregate.CartAggregate) error {
+		if err := checkTenantOpen(ctx, u.tenantRepo, cart.GetTenantID()); err != nil {
+			return err
+		}
+
+		shippingRates, err := u.shippingRatesRepo.Load(ctx, aggregate.ShippingRatesIDForTenant(cart.GetTenantID()))
+		if err != nil {
+			return err
+		}
+
+		cmd := command.SelectShippingMethodCommand{
+			CartID:        cartUUID,
+			UserID:        userUUID,
+			Method:        method,
+			ShippingRates: shippingRates.GetRateTable(),
+		}
+
+		return cart.ExecuteSelectShippingMethodCommand(cmd)
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, cart.GetAggregateID().String(), cart.GetVersion(), events)
+}
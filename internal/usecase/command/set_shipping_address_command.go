@@ -0,0 +1,74 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+)
+
+type SetShippingAddressCommandInterface interface {
+	Execute(ctx context.Context, input *input.SetShippingAddressInput, out presenter.CommandResultPresenter) error
+}
+
+type SetShippingAddressCommand struct {
+	cartRepo   repository.AggregateRepository[*aggregate.CartAggregate]
+	tenantRepo repository.AggregateRepository[*aggregate.TenantAggregate]
+}
+
+func NewSetShippingAddressCommand(cartRepo repository.AggregateRepository[*aggregate.CartAggregate], tenantRepo repository.AggregateRepository[*aggregate.TenantAggregate]) SetShippingAddressCommandInterface {
+	return &SetShippingAddressCommand{
+		cartRepo:   cartRepo,
+		tenantRepo: tenantRepo,
+	}
+}
+
+func (u *SetShippingAddressCommand) Execute(ctx context.Context, input *input.SetShippingAddressInput, out presenter.CommandResultPresenter) error {
+	cartUUID, err := uuid.Parse(input.CartID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid cart id"))
+	}
+
+	userUUID, err := parseActingUser(input.UserID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	address, err := value.NewShippingAddress(
+		input.RecipientName,
+		input.PostalCode,
+		input.Prefecture,
+		input.City,
+		input.AddressLine1,
+		input.AddressLine2,
+		input.Phone,
+	)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	cart, events, err := u.cartRepo.Update(ctx, cartUUID, func(ctx context.Context, cart *aggregate.CartAggregate) error {
+		if err := checkTenantOpen(ctx, u.tenantRepo, cart.GetTenantID()); err != nil {
+			return err
+		}
+
+		cmd := command.SetShippingAddressCommand{
+			CartID:  cartUUID,
+			UserID:  userUUID,
+			Address: address,
+		}
+
+		return cart.ExecuteSetShippingAddressCommand(cmd)
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, cart.GetAggregateID().String(), cart.GetVersion(), events)
+}
@@ -0,0 +1,59 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+)
+
+type SetShippingRatesCommandInterface interface {
+	Execute(ctx context.Context, input *input.SetShippingRatesInput, out presenter.CommandResultPresenter) error
+}
+
+type SetShippingRatesCommand struct {
+	shippingRatesRepo repository.AggregateRepository[*aggregate.TenantShippingRatesAggregate]
+}
+
+func NewSetShippingRatesCommand(shippingRatesRepo repository.AggregateRepository[*aggregate.TenantShippingRatesAggregate]) SetShippingRatesCommandInterface {
+	return &SetShippingRatesCommand{
+		shippingRatesRepo: shippingRatesRepo,
+	}
+}
+
+func (u *SetShippingRatesCommand) Execute(ctx context.Context, input *input.SetShippingRatesInput, out presenter.CommandResultPresenter) error {
+	tenantUUID, err := uuid.Parse(input.TenantID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid tenant id"))
+	}
+
+	rates := make([]value.ShippingRate, 0, len(input.Rates))
+	for _, r := range input.Rates {
+		rate, err := value.NewShippingRate(r.Method, r.Prefecture, r.MaxWeightGrams, r.Fee)
+		if err != nil {
+			return out.PresentError(ctx, err)
+		}
+		rates = append(rates, rate)
+	}
+
+	ratesID := aggregate.ShippingRatesIDForTenant(tenantUUID)
+	shippingRates, events, err := u.shippingRatesRepo.Update(ctx, ratesID, func(ctx context.Context, shippingRates *aggregate.TenantShippingRatesAggregate) error {
+		cmd := command.SetShippingRatesCommand{
+			TenantID: tenantUUID,
+			Rates:    rates,
+		}
+
+		return shippingRates.ExecuteSetShippingRatesCommand(cmd)
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, ratesID.String(), shippingRates.GetVersion(), events)
+}
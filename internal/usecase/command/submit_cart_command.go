@@ -2,14 +2,11 @@ package command
 
 import (
 	"context"
-	"time"
 
 	"github.com/google/uuid"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
-	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
-	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
 )
@@ -19,85 +16,86 @@ type SubmitCartCommandInterface interface {
 }
 
 type SubmitCartCommand struct {
-	tx         repository.Transaction
-	eventStore repository.EventStore
-	outboxRepo repository.OutboxRepository
+	cartRepo           repository.AggregateRepository[*aggregate.CartAggregate]
+	tenantRepo         repository.AggregateRepository[*aggregate.TenantAggregate]
+	taxSettingsRepo    repository.AggregateRepository[*aggregate.TenantTaxSettingsAggregate]
+	shippingRatesRepo  repository.AggregateRepository[*aggregate.TenantShippingRatesAggregate]
+	approvalPolicyRepo repository.AggregateRepository[*aggregate.TenantApprovalPolicyAggregate]
+	rulesRepo          repository.AggregateRepository[*aggregate.TenantCartRulesAggregate]
 }
 
-func NewSubmitCartCommand(tx repository.Transaction, eventStore repository.EventStore, outboxRepo repository.OutboxRepository) SubmitCartCommandInterface {
+func NewSubmitCartCommand(
+	cartRepo repository.AggregateRepository[*aggregate.CartAggregate],
+	tenantRepo repository.AggregateRepository[*aggregate.TenantAggregate],
+	taxSettingsRepo repository.AggregateRepository[*aggregate.TenantTaxSettingsAggregate],
+	shippingRatesRepo repository.AggregateRepository[*aggregate.TenantShippingRatesAggregate],
+	approvalPolicyRepo repository.AggregateRepository[*aggregate.TenantApprovalPolicyAggregate],
+	rulesRepo repository.AggregateRepository[*aggregate.TenantCartRulesAggregate],
+) SubmitCartCommandInterface {
 	return &SubmitCartCommand{
-		tx:         tx,
-		eventStore: eventStore,
-		outboxRepo: outboxRepo,
+		cartRepo:           cartRepo,
+		tenantRepo:         tenantRepo,
+		taxSettingsRepo:    taxSettingsRepo,
+		shippingRatesRepo:  shippingRatesRepo,
+		approvalPolicyRepo: approvalPolicyRepo,
+		rulesRepo:          rulesRepo,
 	}
 }
 
 func (s *SubmitCartCommand) Execute(ctx context.Context, input *input.SubmitCartInput, out presenter.CommandResultPresenter) error {
-	maxRetries := 3
-	var err error
-	var aggregateID string
-	var version int
-	var events []event.Event
-
-	for attempt := range maxRetries {
-		err = s.tx.RWTx(ctx, func(ctx context.Context) error {
-			cartID, err := uuid.Parse(input.CartID)
-			if err != nil {
-				return err
-			}
-
-			loadedEvents, err := s.eventStore.LoadEvents(ctx, cartID)
-			if err != nil && !errors.IsCode(err, errors.NotFound) {
-				return err
-			}
-
-			cart := aggregate.NewCartAggregate()
-			if len(loadedEvents) > 0 {
-				if err := cart.Hydration(loadedEvents); err != nil {
-					return err
-				}
-			}
+	cartID, err := uuid.Parse(input.CartID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
 
-			cmd := command.SubmitCartCommand{
-				CartID: cartID,
-			}
+	userUUID, err := parseActingUser(input.UserID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
 
-			err = cart.ExecuteSubmitCartCommand(cmd)
-			if err != nil {
-				return err
-			}
+	cart, events, err := s.cartRepo.Update(ctx, cartID, func(ctx context.Context, cart *aggregate.CartAggregate) error {
+		if err := checkTenantOpen(ctx, s.tenantRepo, cart.GetTenantID()); err != nil {
+			return err
+		}
 
-			if err := s.eventStore.SaveEvents(ctx, cart.GetAggregateID(), cart.GetUncommittedEvents()); err != nil {
-				return err
-			}
-			events := cart.GetUncommittedEvents()
-			if len(events) > 0 {
-				if err := s.outboxRepo.SaveEvents(ctx, cart.GetAggregateID(), events); err != nil {
-					return err
-				}
-			}
+		// Tax settings are read from their own stream so a submission
+		// always uses the settings in force at that moment.
+		taxSettings, err := s.taxSettingsRepo.Load(ctx, aggregate.TaxSettingsIDForTenant(cart.GetTenantID()))
+		if err != nil {
+			return err
+		}
 
-			aggregateID = cart.GetAggregateID().String()
-			version = cart.GetVersion()
-			events = cart.GetUncommittedEvents()
+		// The shipping fee is re-quoted from the current rates
+		shippingRates, err := s.shippingRatesRepo.Load(ctx, aggregate.ShippingRatesIDForTenant(cart.GetTenantID()))
+		if err != nil {
+			return err
+		}
 
-			cart.MarkEventsAsCommitted()
+		// Carts above the tenant's threshold need an approval first
+		approvalPolicy, err := s.approvalPolicyRepo.Load(ctx, aggregate.ApprovalPolicyIDForTenant(cart.GetTenantID()))
+		if err != nil {
+			return err
+		}
 
-			return nil
-		})
+		cartRules, err := s.rulesRepo.Load(ctx, aggregate.CartRulesIDForTenant(cart.GetTenantID()))
 		if err != nil {
-			if errors.IsCode(err, errors.OptimisticLock) && attempt < maxRetries-1 {
-				waitTime := time.Duration(attempt+1) * 10 * time.Millisecond
-				time.Sleep(waitTime)
-				continue
-			}
-			break
+			return err
 		}
-		break
-	}
+
+		cmd := command.SubmitCartCommand{
+			CartID:         cartID,
+			UserID:         userUUID,
+			TaxSettings:    taxSettings.GetTaxSettings(),
+			ShippingRates:  shippingRates.GetRateTable(),
+			ApprovalPolicy: approvalPolicy.GetApprovalPolicy(),
+			CartRules:      cartRules.GetCartRules(),
+		}
+
+		return cart.ExecuteSubmitCartCommand(cmd)
+	})
 	if err != nil {
 		return out.PresentError(ctx, err)
 	}
 
-	return out.PresentSuccess(ctx, aggregateID, version, events)
+	return out.PresentSuccess(ctx, cart.GetAggregateID().String(), cart.GetVersion(), events)
 }
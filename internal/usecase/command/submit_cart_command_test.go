@@ -7,10 +7,13 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/aggregaterepo"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/eventstore"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/eventstore/deserializer"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/outbox"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/readmodel/coupon"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/testutil"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/infrastructure/database/transaction"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command"
@@ -36,6 +39,7 @@ func (p *submitTestPresenter) PresentError(ctx context.Context, err error) error
 
 func TestSubmitCartCommand_Execute(t *testing.T) {
 	cartID := uuid.New().String()
+	userID := uuid.New().String()
 
 	tests := map[string]struct {
 		input           *input.SubmitCartInput
@@ -44,8 +48,9 @@ func TestSubmitCartCommand_Execute(t *testing.T) {
 		"submit cart with items": {
 			input: &input.SubmitCartInput{
 				CartID: cartID,
+				UserID: userID,
 			},
-			expectedVersion: 3,
+			expectedVersion: 5,
 		},
 	}
 
@@ -54,24 +59,57 @@ func TestSubmitCartCommand_Execute(t *testing.T) {
 			// Arrange
 			dbClient := testutil.NewTestDBClient(t)
 			txRepo := transaction.NewTransaction(dbClient.GetDB())
-			eventStore := eventstore.NewEventStore(deserializer.NewEventDeserializer())
-			outboxRepo := outbox.NewOutboxRepository()
+			eventStore := eventstore.NewEventStore(testutil.FakeSerializer{}, deserializer.NewEventDeserializer())
+			outboxRepo := outbox.NewOutboxRepository(testutil.FakeSerializer{})
+			cartRepo := aggregaterepo.NewAggregateRepository(txRepo, eventStore, outboxRepo, aggregate.NewCartAggregate, aggregaterepo.DefaultRetryPolicy())
+			tenantRepo := aggregaterepo.NewAggregateRepository(txRepo, eventStore, outboxRepo, aggregate.NewTenantAggregate, aggregaterepo.DefaultRetryPolicy())
+			rulesRepo := aggregaterepo.NewAggregateRepository(txRepo, eventStore, outboxRepo, aggregate.NewTenantCartRulesAggregate, aggregaterepo.DefaultRetryPolicy())
+			taxSettingsRepo := aggregaterepo.NewAggregateRepository(txRepo, eventStore, outboxRepo, aggregate.NewTenantTaxSettingsAggregate, aggregaterepo.DefaultRetryPolicy())
+			shippingRatesRepo := aggregaterepo.NewAggregateRepository(txRepo, eventStore, outboxRepo, aggregate.NewTenantShippingRatesAggregate, aggregaterepo.DefaultRetryPolicy())
+			approvalPolicyRepo := aggregaterepo.NewAggregateRepository(txRepo, eventStore, outboxRepo, aggregate.NewTenantApprovalPolicyAggregate, aggregaterepo.DefaultRetryPolicy())
 
 			// First add an item to the cart
-			addItemCmd := command.NewCartAddItemCommand(txRepo, eventStore, outboxRepo)
+			addItemCmd := command.NewCartAddItemCommand(cartRepo, tenantRepo, rulesRepo, coupon.NewCouponReadModel(txRepo))
 			addItemPresenter := &submitTestPresenter{}
+			tenantID := uuid.New()
 			err := addItemCmd.Execute(context.Background(), &input.AddItemToCartInput{
-				CartID:   tt.input.CartID,
-				UserID:   uuid.New().String(),
-				ItemID:   uuid.New().String(),
-				Name:     "Test Item",
-				Price:    100.0,
-				TenantID: uuid.New().String(),
+				CartID:      tt.input.CartID,
+				UserID:      tt.input.UserID,
+				ItemID:      uuid.New().String(),
+				Name:        "Test Item",
+				Price:       100.0,
+				TenantID:    tenantID.String(),
+				WeightGrams: 500,
 			}, addItemPresenter)
 			require.NoError(t, err)
 
+			// A cart can only be submitted once it has somewhere to ship to
+			shippingPresenter := &submitTestPresenter{}
+			err = command.NewSetShippingRatesCommand(shippingRatesRepo).Execute(context.Background(), &input.SetShippingRatesInput{
+				TenantID: tenantID.String(),
+				Rates:    []input.ShippingRateInput{{Method: "STANDARD", Prefecture: "Tokyo", MaxWeightGrams: 2000, Fee: 800}},
+			}, shippingPresenter)
+			require.NoError(t, err)
+			err = command.NewSetShippingAddressCommand(cartRepo, tenantRepo).Execute(context.Background(), &input.SetShippingAddressInput{
+				CartID:        tt.input.CartID,
+				UserID:        tt.input.UserID,
+				RecipientName: "Taro Yamada",
+				PostalCode:    "100-0001",
+				Prefecture:    "Tokyo",
+				City:          "Chiyoda-ku",
+				AddressLine1:  "1-1 Chiyoda",
+			}, shippingPresenter)
+			require.NoError(t, err)
+			err = command.NewSelectShippingMethodCommand(cartRepo, tenantRepo, shippingRatesRepo).Execute(context.Background(), &input.SelectShippingMethodInput{
+				CartID: tt.input.CartID,
+				UserID: tt.input.UserID,
+				Method: "STANDARD",
+			}, shippingPresenter)
+			require.NoError(t, err)
+			require.Nil(t, shippingPresenter.lastError)
+
 			// Then submit the cart
-			submitCmd := command.NewSubmitCartCommand(txRepo, eventStore, outboxRepo)
+			submitCmd := command.NewSubmitCartCommand(cartRepo, tenantRepo, taxSettingsRepo, shippingRatesRepo, approvalPolicyRepo, rulesRepo)
 			presenter := &submitTestPresenter{}
 
 			// Act
@@ -91,6 +129,11 @@ func TestSubmitCartCommand_Execute(t *testing.T) {
 				require.NoError(t, cleanupErr)
 				_, cleanupErr = dbClient.GetDB().Exec("DELETE FROM outbox WHERE aggregate_id = ?", tt.input.CartID)
 				require.NoError(t, cleanupErr)
+				ratesID := aggregate.ShippingRatesIDForTenant(tenantID).String()
+				_, cleanupErr = dbClient.GetDB().Exec("DELETE FROM events WHERE aggregate_id = ?", ratesID)
+				require.NoError(t, cleanupErr)
+				_, cleanupErr = dbClient.GetDB().Exec("DELETE FROM outbox WHERE aggregate_id = ?", ratesID)
+				require.NoError(t, cleanupErr)
 			})
 		})
 	}
@@ -0,0 +1,74 @@
+package command
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/command"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/command/input"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+)
+
+type UpdateTenantCommandInterface interface {
+	Execute(ctx context.Context, input *input.UpdateTenantInput, out presenter.CommandResultPresenter) error
+}
+
+type UpdateTenantCommand struct {
+	tenantRepo repository.AggregateRepository[*aggregate.TenantAggregate]
+}
+
+func NewUpdateTenantCommand(tenantRepo repository.AggregateRepository[*aggregate.TenantAggregate]) UpdateTenantCommandInterface {
+	return &UpdateTenantCommand{
+		tenantRepo: tenantRepo,
+	}
+}
+
+// Execute updates the tenant's profile and plan. A plan or locale left out
+// of the input keeps its current value.
+func (u *UpdateTenantCommand) Execute(ctx context.Context, input *input.UpdateTenantInput, out presenter.CommandResultPresenter) error {
+	tenantUUID, err := uuid.Parse(input.TenantID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid tenant id"))
+	}
+
+	tenant, events, err := u.tenantRepo.Update(ctx, aggregate.TenantIDForStream(tenantUUID), func(ctx context.Context, tenant *aggregate.TenantAggregate) error {
+		var err error
+		plan := tenant.GetPlan()
+		if input.Plan != "" {
+			plan, err = value.NewTenantPlan(input.Plan)
+			if err != nil {
+				return err
+			}
+		}
+
+		locale := tenant.GetDefaultLocale()
+		if input.DefaultLocale != "" {
+			locale, err = value.NewLocale(input.DefaultLocale)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := tenant.ExecuteUpdateTenantProfileCommand(command.UpdateTenantProfileCommand{
+			TenantID:      tenantUUID,
+			DisplayName:   input.DisplayName,
+			DefaultLocale: locale,
+		}); err != nil {
+			return err
+		}
+
+		return tenant.ExecuteChangeTenantPlanCommand(command.ChangeTenantPlanCommand{
+			TenantID: tenantUUID,
+			Plan:     plan,
+		})
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, tenantUUID.String(), tenant.GetVersion(), events)
+}
@@ -0,0 +1,8 @@
+package gateway
+
+import "context"
+
+type CartApprovalDeadlineService interface {
+	Start(ctx context.Context) error
+	Close() error
+}
@@ -0,0 +1,8 @@
+package gateway
+
+import "context"
+
+type CartExpiryService interface {
+	Start(ctx context.Context) error
+	Close() error
+}
@@ -0,0 +1,8 @@
+package gateway
+
+import "context"
+
+type CheckoutSagaService interface {
+	Start(ctx context.Context) error
+	Close() error
+}
@@ -0,0 +1,13 @@
+package dto
+
+type PaymentAuthorizationRequest struct {
+	IdempotencyKey string
+	CardNumber     string
+	Amount         float64
+}
+
+type PaymentAuthorizationResult struct {
+	Approved        bool
+	AuthorizationID string
+	DeclineReason   string
+}
@@ -0,0 +1,16 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/gateway/dto"
+)
+
+// PaymentGateway is implemented by payment providers. Every call must be
+// idempotent: authorizing twice with the same key, or capturing or refunding
+// the same authorization twice, must not move money twice.
+type PaymentGateway interface {
+	Authorize(ctx context.Context, req *dto.PaymentAuthorizationRequest) (*dto.PaymentAuthorizationResult, error)
+	Capture(ctx context.Context, authorizationID string, amount float64) error
+	Refund(ctx context.Context, authorizationID string, amount float64) error
+}
@@ -9,5 +9,6 @@ import (
 
 type DelayQueue interface {
 	PublishDelayedMessage(topic, key string, message *dto.Message, delay time.Duration) error
+	AddHandler(handler MessageHandler)
 	Start(ctx context.Context) error
 }
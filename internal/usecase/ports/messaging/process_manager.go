@@ -0,0 +1,12 @@
+package messaging
+
+import (
+	"context"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/messaging/dto"
+)
+
+type ProcessManager interface {
+	Subscriber
+	HandleMessage(ctx context.Context, msg *dto.Message) error
+}
@@ -2,6 +2,7 @@ package presenter
 
 import (
 	"context"
+	"time"
 
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
 )
@@ -10,3 +11,10 @@ type CommandResultPresenter interface {
 	PresentSuccess(ctx context.Context, aggregateID string, version int, events []event.Event) error
 	PresentError(ctx context.Context, err error) error
 }
+
+// ExecutionTimePresenter is implemented by presenters that show when the
+// command was executed. A result replayed for a repeated command is presented
+// with the time of the first execution, so it reads exactly as it did then.
+type ExecutionTimePresenter interface {
+	PresentSuccessAt(ctx context.Context, aggregateID string, version int, events []event.Event, executedAt time.Time) error
+}
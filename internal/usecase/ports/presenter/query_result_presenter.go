@@ -4,5 +4,8 @@ import "context"
 
 type QueryResultPresenter interface {
 	PresentSuccess(ctx context.Context, data []byte) error
+	// PresentVersionedSuccess is PresentSuccess for the state of an aggregate
+	// at the version, which the caller can send back to expect that version.
+	PresentVersionedSuccess(ctx context.Context, data []byte, version int) error
 	PresentError(ctx context.Context, err error) error
 }
@@ -0,0 +1,13 @@
+package readmodelstore
+
+import (
+	"context"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+type CartApprovalStore interface {
+	Get(ctx context.Context, cartID string) (*dto.CartApprovalViewDTO, error)
+	ListPending(ctx context.Context, tenantID string) ([]dto.CartApprovalViewDTO, error)
+	Upsert(ctx context.Context, view *dto.CartApprovalViewDTO) error
+}
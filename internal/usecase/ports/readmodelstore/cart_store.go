@@ -9,4 +9,14 @@ import (
 type CartStore interface {
 	Get(ctx context.Context, aggregateID string) (*dto.CartViewDTO, error)
 	Upsert(ctx context.Context, aggregateID string, view *dto.CartViewDTO) error
+	// CountSubmitted returns how many carts the user has submitted to the tenant.
+	CountSubmitted(ctx context.Context, tenantID, userID string) (int, error)
+	// ListIDsBySubject returns the carts of the user, the carts shared with
+	// them, and the guest cart whose ID it is.
+	ListIDsBySubject(ctx context.Context, subjectID string) ([]string, error)
+	// AnonymiseSubject removes the subject from the carts it owns, along with
+	// their session and shipping address, from the items it added to and the
+	// members of other carts, and from their approvals. It returns how many
+	// carts were anonymised.
+	AnonymiseSubject(ctx context.Context, subjectID string) (int, error)
 }
@@ -0,0 +1,12 @@
+package readmodelstore
+
+import (
+	"context"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+type CheckoutSagaStore interface {
+	Get(ctx context.Context, sagaID string) (*dto.CheckoutSagaViewDTO, error)
+	Upsert(ctx context.Context, sagaID string, view *dto.CheckoutSagaViewDTO) error
+}
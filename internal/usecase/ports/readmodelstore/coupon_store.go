@@ -0,0 +1,13 @@
+package readmodelstore
+
+import (
+	"context"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+type CouponStore interface {
+	Get(ctx context.Context, couponID string) (*dto.CouponViewDTO, error)
+	ListAutomatic(ctx context.Context, tenantID string) ([]*dto.CouponViewDTO, error)
+	Upsert(ctx context.Context, couponID string, view *dto.CouponViewDTO) error
+}
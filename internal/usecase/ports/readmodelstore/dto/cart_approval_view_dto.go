@@ -0,0 +1,27 @@
+package dto
+
+import (
+	"time"
+)
+
+// CartApprovalViewDTO is the latest approval request of a cart.
+type CartApprovalViewDTO struct {
+	CartID      string     `json:"cart_id"`
+	TenantID    string     `json:"tenant_id"`
+	Status      string     `json:"status"`
+	Amount      float64    `json:"amount"`
+	RequestedBy string     `json:"requested_by"`
+	RequestedAt time.Time  `json:"requested_at"`
+	DeadlineAt  time.Time  `json:"deadline_at"`
+	DecidedBy   string     `json:"decided_by,omitempty"`
+	DecidedAt   *time.Time `json:"decided_at,omitempty"`
+	Comment     string     `json:"comment,omitempty"`
+	Version     int        `json:"version"`
+}
+
+// ApprovalInboxViewDTO lists the requests waiting for a tenant's approvers,
+// oldest deadline first.
+type ApprovalInboxViewDTO struct {
+	TenantID  string                `json:"tenant_id"`
+	Approvals []CartApprovalViewDTO `json:"approvals"`
+}
@@ -5,22 +5,92 @@ import (
 )
 
 type CartViewDTO struct {
-	ID          string            `json:"id"`
-	UserID      string            `json:"user_id"`
-	TenantID    string            `json:"tenant_id"`
-	Status      string            `json:"status"`
-	TotalAmount float64           `json:"total_amount"`
-	ItemCount   int               `json:"item_count"`
-	Items       []CartItemViewDTO `json:"items"`
-	CreatedAt   time.Time         `json:"created_at"`
-	UpdatedAt   time.Time         `json:"updated_at"`
-	PurchasedAt *time.Time        `json:"purchased_at,omitempty"`
-	Version     int               `json:"version"`
+	ID               string                      `json:"id"`
+	UserID           string                      `json:"user_id"`
+	SessionID        string                      `json:"session_id,omitempty"`
+	TenantID         string                      `json:"tenant_id"`
+	Status           string                      `json:"status"`
+	ApprovalStatus   string                      `json:"approval_status,omitempty"`
+	MergedIntoCartID string                      `json:"merged_into_cart_id,omitempty"`
+	Subtotal         float64                     `json:"subtotal"`
+	DiscountTotal    float64                     `json:"discount_total"`
+	TotalAmount      float64                     `json:"total_amount"`
+	ItemCount        int                         `json:"item_count"`
+	Items            []CartItemViewDTO           `json:"items"`
+	Members          []CartMemberViewDTO         `json:"members,omitempty"`
+	Discounts        []CartDiscountViewDTO       `json:"discounts"`
+	Tax              *CartTaxViewDTO             `json:"tax,omitempty"`
+	ShippingAddress  *CartShippingAddressViewDTO `json:"shipping_address,omitempty"`
+	ShippingMethod   string                      `json:"shipping_method,omitempty"`
+	ShippingFee      float64                     `json:"shipping_fee"`
+	CreatedAt        time.Time                   `json:"created_at"`
+	UpdatedAt        time.Time                   `json:"updated_at"`
+	PurchasedAt      *time.Time                  `json:"purchased_at,omitempty"`
+	ClosedAt         *time.Time                  `json:"closed_at,omitempty"`
+	Version          int                         `json:"version"`
 }
 
+// CartItemViewDTO is a cart line. The same item with other options is a
+// separate line; adding it again with the same options raises the quantity.
 type CartItemViewDTO struct {
-	ID     string  `json:"id"`
-	CartID string  `json:"cart_id"`
-	Name   string  `json:"name"`
-	Price  float64 `json:"price"`
+	ID          string                  `json:"id"`
+	LineID      string                  `json:"line_id"`
+	CartID      string                  `json:"cart_id"`
+	Name        string                  `json:"name"`
+	Price       float64                 `json:"price"`
+	Quantity    int                     `json:"quantity"`
+	TaxCategory string                  `json:"tax_category"`
+	WeightGrams int                     `json:"weight_grams"`
+	Category    string                  `json:"category,omitempty"`
+	AddedBy     string                  `json:"added_by,omitempty"`
+	Options     []CartItemOptionViewDTO `json:"options,omitempty"`
+}
+
+// CartMemberViewDTO is a user invited to a shared cart. The status is
+// INVITED until they accept and MEMBER after. The owner is not listed.
+type CartMemberViewDTO struct {
+	UserID string `json:"user_id"`
+	Status string `json:"status"`
+}
+
+// CartItemOptionViewDTO is a chosen option. Price of the line already
+// includes its modifier.
+type CartItemOptionViewDTO struct {
+	Name          string  `json:"name"`
+	Value         string  `json:"value"`
+	PriceModifier float64 `json:"price_modifier"`
+}
+
+type CartShippingAddressViewDTO struct {
+	RecipientName string `json:"recipient_name"`
+	PostalCode    string `json:"postal_code"`
+	Prefecture    string `json:"prefecture"`
+	City          string `json:"city"`
+	AddressLine1  string `json:"address_line1"`
+	AddressLine2  string `json:"address_line2"`
+	Phone         string `json:"phone"`
+}
+
+// CartTaxViewDTO is the consumption tax breakdown, fixed when the cart is
+// submitted.
+type CartTaxViewDTO struct {
+	Display               string  `json:"display"`
+	RoundingMode          string  `json:"rounding_mode"`
+	StandardTaxableAmount float64 `json:"standard_taxable_amount"`
+	StandardTaxAmount     float64 `json:"standard_tax_amount"`
+	ReducedTaxableAmount  float64 `json:"reduced_taxable_amount"`
+	ReducedTaxAmount      float64 `json:"reduced_tax_amount"`
+	TaxTotal              float64 `json:"tax_total"`
+}
+
+type CartDiscountViewDTO struct {
+	CouponID      string  `json:"coupon_id"`
+	Code          string  `json:"code"`
+	PromotionType string  `json:"promotion_type"`
+	Value         float64 `json:"value,omitempty"`
+	BuyQuantity   int     `json:"buy_quantity,omitempty"`
+	GetQuantity   int     `json:"get_quantity,omitempty"`
+	MinimumTotal  float64 `json:"minimum_total"`
+	Automatic     bool    `json:"automatic"`
+	Amount        float64 `json:"amount"`
 }
@@ -0,0 +1,27 @@
+package dto
+
+import (
+	"time"
+)
+
+type CheckoutSagaViewDTO struct {
+	ID            string                    `json:"id"`
+	CartID        string                    `json:"cart_id"`
+	Status        string                    `json:"status"`
+	CurrentStep   string                    `json:"current_step,omitempty"`
+	TotalAmount   float64                   `json:"total_amount"`
+	FailureReason string                    `json:"failure_reason,omitempty"`
+	Steps         []CheckoutSagaStepViewDTO `json:"steps"`
+	CreatedAt     time.Time                 `json:"created_at"`
+	UpdatedAt     time.Time                 `json:"updated_at"`
+	Version       int                       `json:"version"`
+}
+
+type CheckoutSagaStepViewDTO struct {
+	SagaID    string    `json:"saga_id"`
+	Step      string    `json:"step"`
+	Status    string    `json:"status"`
+	Reference string    `json:"reference,omitempty"`
+	Reason    string    `json:"reason,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
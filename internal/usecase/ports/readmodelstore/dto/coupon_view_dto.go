@@ -0,0 +1,20 @@
+package dto
+
+import "time"
+
+type CouponViewDTO struct {
+	ID              string    `json:"id"`
+	TenantID        string    `json:"tenant_id"`
+	Code            string    `json:"code"`
+	PromotionType   string    `json:"promotion_type"`
+	Value           float64   `json:"value,omitempty"`
+	BuyQuantity     int       `json:"buy_quantity,omitempty"`
+	GetQuantity     int       `json:"get_quantity,omitempty"`
+	MinimumTotal    float64   `json:"minimum_total"`
+	UsageLimit      int       `json:"usage_limit"`
+	RedemptionCount int       `json:"redemption_count"`
+	Automatic       bool      `json:"automatic"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+	Version         int       `json:"version"`
+}
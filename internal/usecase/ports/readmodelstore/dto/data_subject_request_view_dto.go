@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// DataSubjectRequestViewDTO is the progress of an export or erasure. Bundle
+// is set once an export completes, and is left out after the subject has
+// been erased since it can no longer be read.
+type DataSubjectRequestViewDTO struct {
+	ID          string          `json:"id"`
+	SubjectID   string          `json:"subject_id"`
+	Kind        string          `json:"kind"`
+	Status      string          `json:"status"`
+	CartCount   int             `json:"cart_count"`
+	EventCount  int             `json:"event_count"`
+	Redacted    bool            `json:"redacted"`
+	Bundle      json.RawMessage `json:"bundle,omitempty"`
+	OpenedAt    time.Time       `json:"opened_at"`
+	CompletedAt *time.Time      `json:"completed_at,omitempty"`
+	Version     int             `json:"version"`
+}
+
+// DataSubjectExportDTO is everything held about a data subject: the carts
+// they own, their saved lists and the events of both.
+type DataSubjectExportDTO struct {
+	SubjectID  string                `json:"subject_id"`
+	ExportedAt time.Time             `json:"exported_at"`
+	Carts      []CartViewDTO         `json:"carts"`
+	SavedLists []SavedListViewDTO    `json:"saved_lists"`
+	Events     []EventHistoryViewDTO `json:"events"`
+}
@@ -0,0 +1,30 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// EventHistoryViewDTO is a page of the events of one aggregate, oldest
+// first. NextCursor is set while the range has more events.
+type EventHistoryViewDTO struct {
+	AggregateID string         `json:"aggregate_id"`
+	Events      []EventViewDTO `json:"events"`
+	NextCursor  string         `json:"next_cursor,omitempty"`
+}
+
+type EventViewDTO struct {
+	Version   int                   `json:"version"`
+	EventType string                `json:"event_type"`
+	Payload   json.RawMessage       `json:"payload"`
+	Metadata  *EventMetadataViewDTO `json:"metadata,omitempty"`
+}
+
+// EventMetadataViewDTO tells when the event happened and when the event
+// store recorded it.
+type EventMetadataViewDTO struct {
+	EventID       string    `json:"event_id"`
+	AggregateType string    `json:"aggregate_type"`
+	OccurredAt    time.Time `json:"occurred_at"`
+	RecordedAt    time.Time `json:"recorded_at"`
+}
@@ -0,0 +1,29 @@
+package dto
+
+import (
+	"time"
+)
+
+type SavedListViewDTO struct {
+	ID        string             `json:"id"`
+	TenantID  string             `json:"tenant_id"`
+	UserID    string             `json:"user_id"`
+	Items     []SavedItemViewDTO `json:"items"`
+	CreatedAt time.Time          `json:"created_at"`
+	UpdatedAt time.Time          `json:"updated_at"`
+	Version   int                `json:"version"`
+}
+
+// SavedItemViewDTO is a saved item as it is to go into the cart. Price is
+// the unit price, options included, as on a cart line.
+type SavedItemViewDTO struct {
+	ID          string                  `json:"id"`
+	Name        string                  `json:"name"`
+	Price       float64                 `json:"price"`
+	Quantity    int                     `json:"quantity"`
+	TaxCategory string                  `json:"tax_category"`
+	WeightGrams int                     `json:"weight_grams"`
+	Category    string                  `json:"category,omitempty"`
+	Options     []CartItemOptionViewDTO `json:"options,omitempty"`
+	SavedAt     time.Time               `json:"saved_at"`
+}
@@ -0,0 +1,15 @@
+package dto
+
+import (
+	"time"
+)
+
+type TenantApprovalPolicyViewDTO struct {
+	TenantID      string    `json:"tenant_id"`
+	Threshold     float64   `json:"threshold"`
+	ApproverIDs   []string  `json:"approver_ids"`
+	DeadlineHours int       `json:"deadline_hours"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+	Version       int       `json:"version"`
+}
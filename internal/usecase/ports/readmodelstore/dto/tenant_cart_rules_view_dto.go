@@ -0,0 +1,16 @@
+package dto
+
+import (
+	"time"
+)
+
+type TenantCartRulesViewDTO struct {
+	TenantID                    string     `json:"tenant_id"`
+	MaxLines                    int        `json:"max_lines"`
+	MaxQuantityPerItem          int        `json:"max_quantity_per_item"`
+	MinimumOrderValue           float64    `json:"minimum_order_value"`
+	BlockedCategoryCombinations [][]string `json:"blocked_category_combinations"`
+	CreatedAt                   time.Time  `json:"created_at"`
+	UpdatedAt                   time.Time  `json:"updated_at"`
+	Version                     int        `json:"version"`
+}
@@ -0,0 +1,25 @@
+package dto
+
+import (
+	"time"
+)
+
+type TenantPolicyVersionViewDTO struct {
+	Version          int        `json:"version"`
+	Kind             string     `json:"kind"`
+	Status           string     `json:"status"`
+	InEffect         bool       `json:"in_effect"`
+	Title            string     `json:"title"`
+	AbandonedMinutes int        `json:"abandoned_minutes"`
+	QuietTimeFrom    time.Time  `json:"quiet_time_from"`
+	QuietTimeTo      time.Time  `json:"quiet_time_to"`
+	CartExpiryDays   int        `json:"cart_expiry_days"`
+	EffectiveFrom    time.Time  `json:"effective_from"`
+	EffectiveTo      *time.Time `json:"effective_to"`
+	RecordedAt       time.Time  `json:"recorded_at"`
+}
+
+type TenantPolicyHistoryViewDTO struct {
+	TenantID string                       `json:"tenant_id"`
+	Versions []TenantPolicyVersionViewDTO `json:"versions"`
+}
@@ -0,0 +1,28 @@
+package dto
+
+import (
+	"time"
+)
+
+type TenantPolicySegmentViewDTO struct {
+	ID               string    `json:"id"`
+	TenantID         string    `json:"tenant_id"`
+	Title            string    `json:"title"`
+	Priority         int       `json:"priority"`
+	AbandonedMinutes int       `json:"abandoned_minutes"`
+	MinCartTotal     float64   `json:"min_cart_total"`
+	MaxCartTotal     float64   `json:"max_cart_total"`
+	Categories       []string  `json:"categories"`
+	CustomerSegment  string    `json:"customer_segment"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+	Version          int       `json:"version"`
+}
+
+// TenantPoliciesViewDTO lists every abandonment policy of a tenant: the
+// default policy and the segment policies in the order they are tried.
+type TenantPoliciesViewDTO struct {
+	TenantID string                       `json:"tenant_id"`
+	Default  *TenantPolicyViewDTO         `json:"default"`
+	Segments []TenantPolicySegmentViewDTO `json:"segments"`
+}
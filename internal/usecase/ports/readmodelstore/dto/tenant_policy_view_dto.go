@@ -10,6 +10,7 @@ type TenantPolicyViewDTO struct {
 	AbandonedMinutes int       `json:"abandoned_minutes"`
 	QuietTimeFrom    time.Time `json:"quiet_time_from"`
 	QuietTimeTo      time.Time `json:"quiet_time_to"`
+	CartExpiryDays   int       `json:"cart_expiry_days"`
 	CreatedAt        time.Time `json:"created_at"`
 	UpdatedAt        time.Time `json:"updated_at"`
 	Version          int       `json:"version"`
@@ -0,0 +1,19 @@
+package dto
+
+import (
+	"time"
+)
+
+type TenantShippingRatesViewDTO struct {
+	TenantID string                `json:"tenant_id"`
+	Rates    []ShippingRateViewDTO `json:"rates"`
+	Version  int                   `json:"version"`
+}
+
+type ShippingRateViewDTO struct {
+	Method         string    `json:"method"`
+	Prefecture     string    `json:"prefecture"`
+	MaxWeightGrams int       `json:"max_weight_grams"`
+	Fee            float64   `json:"fee"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
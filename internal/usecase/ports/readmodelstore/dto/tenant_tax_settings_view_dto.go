@@ -0,0 +1,14 @@
+package dto
+
+import (
+	"time"
+)
+
+type TenantTaxSettingsViewDTO struct {
+	TenantID     string    `json:"tenant_id"`
+	TaxDisplay   string    `json:"tax_display"`
+	RoundingMode string    `json:"rounding_mode"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	Version      int       `json:"version"`
+}
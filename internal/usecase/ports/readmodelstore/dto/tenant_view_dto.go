@@ -0,0 +1,17 @@
+package dto
+
+import (
+	"time"
+)
+
+type TenantViewDTO struct {
+	TenantID         string    `json:"tenant_id"`
+	DisplayName      string    `json:"display_name"`
+	Plan             string    `json:"plan"`
+	Status           string    `json:"status"`
+	DefaultLocale    string    `json:"default_locale"`
+	SuspensionReason string    `json:"suspension_reason,omitempty"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+	Version          int       `json:"version"`
+}
@@ -0,0 +1,17 @@
+package readmodelstore
+
+import (
+	"context"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+type SavedListStore interface {
+	Get(ctx context.Context, listID string) (*dto.SavedListViewDTO, error)
+	Upsert(ctx context.Context, listID string, view *dto.SavedListViewDTO) error
+	// ListIDsBySubject returns the saved lists of the user in every tenant.
+	ListIDsBySubject(ctx context.Context, subjectID string) ([]string, error)
+	// AnonymiseSubject replaces the user of their saved lists with the ID of
+	// each list. It returns how many lists were anonymised.
+	AnonymiseSubject(ctx context.Context, subjectID string) (int, error)
+}
@@ -9,4 +9,10 @@ import (
 type TenantPolicyStore interface {
 	Get(ctx context.Context, tenantID string) (*dto.TenantPolicyViewDTO, error)
 	Upsert(ctx context.Context, tenantID string, view *dto.TenantPolicyViewDTO) error
+	GetSegment(ctx context.Context, segmentID string) (*dto.TenantPolicySegmentViewDTO, error)
+	// ListSegments returns the segments of the tenant by priority and then ID,
+	// the order the abandonment check tries them in.
+	ListSegments(ctx context.Context, tenantID string) ([]dto.TenantPolicySegmentViewDTO, error)
+	UpsertSegment(ctx context.Context, segmentID string, view *dto.TenantPolicySegmentViewDTO) error
+	DeleteSegment(ctx context.Context, segmentID string) error
 }
@@ -0,0 +1,12 @@
+package readmodelstore
+
+import (
+	"context"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+type TenantStore interface {
+	Get(ctx context.Context, tenantID string) (*dto.TenantViewDTO, error)
+	Upsert(ctx context.Context, tenantID string, view *dto.TenantViewDTO) error
+}
@@ -0,0 +1,12 @@
+package readmodelstore
+
+import (
+	"context"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+type TenantTaxSettingsStore interface {
+	Get(ctx context.Context, tenantID string) (*dto.TenantTaxSettingsViewDTO, error)
+	Upsert(ctx context.Context, tenantID string, view *dto.TenantTaxSettingsViewDTO) error
+}
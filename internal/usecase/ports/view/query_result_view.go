@@ -2,5 +2,6 @@ package view
 
 type QueryResultView interface {
 	Success(data []byte) error
+	VersionedSuccess(data []byte, version int) error
 	Error(err error) error
 }
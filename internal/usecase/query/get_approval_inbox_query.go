@@ -0,0 +1,64 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+type GetApprovalInboxQueryInterface interface {
+	Query(ctx context.Context, tenantID, approverID string, out presenter.QueryResultPresenter) error
+}
+
+type GetApprovalInboxQuery struct {
+	tenantApprovalPolicyStore readmodelstore.TenantApprovalPolicyStore
+	cartApprovalStore         readmodelstore.CartApprovalStore
+}
+
+func NewGetApprovalInboxQuery(tenantApprovalPolicyStore readmodelstore.TenantApprovalPolicyStore, cartApprovalStore readmodelstore.CartApprovalStore) GetApprovalInboxQueryInterface {
+	return &GetApprovalInboxQuery{
+		tenantApprovalPolicyStore: tenantApprovalPolicyStore,
+		cartApprovalStore:         cartApprovalStore,
+	}
+}
+
+func (q *GetApprovalInboxQuery) Query(ctx context.Context, tenantID, approverID string, out presenter.QueryResultPresenter) error {
+	if _, err := uuid.Parse(tenantID); err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid tenant id"))
+	}
+
+	approverUUID, err := uuid.Parse(approverID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid approver id"))
+	}
+
+	// Only the tenant's approvers may see what is waiting for them
+	policy, err := q.tenantApprovalPolicyStore.Get(ctx, tenantID)
+	if err != nil && !errors.IsCode(err, errors.NotFound) {
+		return out.PresentError(ctx, err)
+	}
+	if policy == nil || !slices.Contains(policy.ApproverIDs, approverUUID.String()) {
+		return out.PresentError(ctx, errors.UnpermittedOp.New("user is not an approver of the tenant"))
+	}
+
+	approvals, err := q.cartApprovalStore.ListPending(ctx, tenantID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	jsonData, err := json.Marshal(&dto.ApprovalInboxViewDTO{
+		TenantID:  tenantID,
+		Approvals: approvals,
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, jsonData)
+}
@@ -0,0 +1,240 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+type GetCartAsOfQueryInterface interface {
+	QueryAsOfVersion(ctx context.Context, aggregateID string, version int, out presenter.QueryResultPresenter) error
+	QueryAsOf(ctx context.Context, aggregateID string, at time.Time, out presenter.QueryResultPresenter) error
+}
+
+// GetCartAsOfQuery rebuilds a cart as it was at an earlier version or time by
+// replaying its events, leaving the read model alone. The purchase time is
+// only kept by the read model, so it is left out.
+type GetCartAsOfQuery struct {
+	tx         repository.Transaction
+	eventStore repository.EventStore
+}
+
+func NewGetCartAsOfQuery(tx repository.Transaction, eventStore repository.EventStore) GetCartAsOfQueryInterface {
+	return &GetCartAsOfQuery{
+		tx:         tx,
+		eventStore: eventStore,
+	}
+}
+
+func (q *GetCartAsOfQuery) QueryAsOfVersion(ctx context.Context, aggregateID string, version int, out presenter.QueryResultPresenter) error {
+	return q.query(ctx, aggregateID, out, func(ctx context.Context, id uuid.UUID) ([]event.Event, error) {
+		return q.eventStore.LoadEventsUntilVersion(ctx, id, version)
+	})
+}
+
+func (q *GetCartAsOfQuery) QueryAsOf(ctx context.Context, aggregateID string, at time.Time, out presenter.QueryResultPresenter) error {
+	return q.query(ctx, aggregateID, out, func(ctx context.Context, id uuid.UUID) ([]event.Event, error) {
+		return q.eventStore.LoadEventsUntil(ctx, id, at)
+	})
+}
+
+func (q *GetCartAsOfQuery) query(ctx context.Context, aggregateID string, out presenter.QueryResultPresenter, load func(ctx context.Context, id uuid.UUID) ([]event.Event, error)) error {
+	cartID, err := uuid.Parse(aggregateID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid cart id"))
+	}
+
+	cart := aggregate.NewCartAggregate()
+	var events []event.Event
+	err = q.tx.RWTx(ctx, func(ctx context.Context) error {
+		events, err = load(ctx, cartID)
+		if err != nil {
+			return err
+		}
+		return cart.Hydration(events)
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	view := toCartView(cart, events[0].GetTimestamp(), events[len(events)-1].GetTimestamp())
+	addEventDetails(view, events)
+
+	jsonData, err := json.Marshal(view)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, jsonData)
+}
+
+func toCartView(cart *aggregate.CartAggregate, createdAt, updatedAt time.Time) *dto.CartViewDTO {
+	userID := ""
+	if cart.GetUserID() != uuid.Nil {
+		userID = cart.GetUserID().String()
+	}
+
+	view := &dto.CartViewDTO{
+		ID:             cart.GetAggregateID().String(),
+		UserID:         userID,
+		SessionID:      string(cart.GetSessionID()),
+		TenantID:       cart.GetTenantID().String(),
+		Status:         string(cart.GetStatus()),
+		ApprovalStatus: string(cart.GetApprovalStatus()),
+		Subtotal:       cart.GetSubtotal().Float64(),
+		DiscountTotal:  cart.GetDiscountTotal(),
+		TotalAmount:    cart.GetTotalAmount().Float64(),
+		Items:          []dto.CartItemViewDTO{},
+		Discounts:      []dto.CartDiscountViewDTO{},
+		ShippingMethod: string(cart.GetShippingMethod()),
+		ShippingFee:    cart.GetShippingFee(),
+		CreatedAt:      createdAt,
+		UpdatedAt:      updatedAt,
+		Version:        cart.GetVersion(),
+	}
+
+	// The aggregate holds one item per unit; the view has one line per options
+	lines := make(map[string]int)
+	for _, item := range cart.GetItems() {
+		view.ItemCount++
+		lineID := item.GetLineID().String()
+		if i, ok := lines[lineID]; ok {
+			view.Items[i].Quantity++
+			continue
+		}
+
+		lines[lineID] = len(view.Items)
+		view.Items = append(view.Items, dto.CartItemViewDTO{
+			ID:          item.GetItemID().String(),
+			LineID:      lineID,
+			CartID:      view.ID,
+			Name:        item.GetName(),
+			Price:       item.GetPrice().Float64(),
+			Quantity:    1,
+			TaxCategory: item.GetTaxCategory().String(),
+			WeightGrams: item.GetWeightGrams(),
+			Category:    item.GetCategory(),
+			Options:     toOptionViews(item.GetOptions().Options()),
+		})
+	}
+
+	amounts := cart.GetDiscounts()
+	for i, coupon := range cart.GetCoupons() {
+		promotion := coupon.GetPromotion()
+		view.Discounts = append(view.Discounts, dto.CartDiscountViewDTO{
+			CouponID:      coupon.GetCouponID().String(),
+			Code:          coupon.GetCode().String(),
+			PromotionType: string(promotion.Type()),
+			Value:         promotion.Value(),
+			BuyQuantity:   promotion.BuyQuantity(),
+			GetQuantity:   promotion.GetQuantity(),
+			MinimumTotal:  promotion.MinimumTotal(),
+			Automatic:     coupon.IsAutomatic(),
+			Amount:        amounts[i],
+		})
+	}
+
+	if address := cart.GetShippingAddress(); address != nil {
+		view.ShippingAddress = &dto.CartShippingAddressViewDTO{
+			RecipientName: address.RecipientName(),
+			PostalCode:    address.PostalCode(),
+			Prefecture:    address.Prefecture().String(),
+			City:          address.City(),
+			AddressLine1:  address.AddressLine1(),
+			AddressLine2:  address.AddressLine2(),
+			Phone:         address.Phone(),
+		}
+	}
+
+	return view
+}
+
+// addEventDetails fills in what the cart aggregate does not keep but its
+// events do: who added each line, the tax fixed on submission and when the
+// cart was merged or closed.
+func addEventDetails(view *dto.CartViewDTO, events []event.Event) {
+	addedBy := make(map[string]string)
+	for _, evt := range events {
+		switch e := evt.(type) {
+		case *event.ItemAddedToCartEvent:
+			lineID := e.GetLineOptions().LineID(e.GetItemID()).String()
+			if _, ok := addedBy[lineID]; ok {
+				continue
+			}
+			// Lines added before carts could be shared were all added by the owner
+			addedBy[lineID] = view.UserID
+			if e.GetAddedBy() != uuid.Nil {
+				addedBy[lineID] = e.GetAddedBy().String()
+			}
+		case *event.CartItemMovedToSavedListEvent:
+			// A line added again after the move was added by whoever added it then
+			delete(addedBy, e.GetLineID().String())
+		case *event.CartSubmittedEvent:
+			if e.GetTaxDisplay() == "" {
+				continue
+			}
+			view.Tax = &dto.CartTaxViewDTO{
+				Display:               e.GetTaxDisplay(),
+				RoundingMode:          e.GetTaxRoundingMode(),
+				StandardTaxableAmount: e.GetStandardTaxableAmount(),
+				StandardTaxAmount:     e.GetStandardTaxAmount(),
+				ReducedTaxableAmount:  e.GetReducedTaxableAmount(),
+				ReducedTaxAmount:      e.GetReducedTaxAmount(),
+				TaxTotal:              e.GetTaxTotal(),
+			}
+		case *event.CartMemberInvitedEvent:
+			// An erased member reads as the nil ID and is left out
+			if e.GetUserID() != uuid.Nil {
+				view.Members = append(view.Members, dto.CartMemberViewDTO{UserID: e.GetUserID().String(), Status: "INVITED"})
+			}
+		case *event.CartInvitationAcceptedEvent:
+			for i := range view.Members {
+				if view.Members[i].UserID == e.GetUserID().String() {
+					view.Members[i].Status = "MEMBER"
+				}
+			}
+		case *event.CartMemberRemovedEvent:
+			members := view.Members[:0]
+			for _, member := range view.Members {
+				if member.UserID != e.GetUserID().String() {
+					members = append(members, member)
+				}
+			}
+			view.Members = members
+		case *event.CartMergedEvent:
+			view.MergedIntoCartID = e.GetIntoCartID().String()
+		case *event.CartClosedEvent:
+			closedAt := e.GetTimestamp()
+			view.ClosedAt = &closedAt
+		}
+	}
+
+	for i := range view.Items {
+		view.Items[i].AddedBy = addedBy[view.Items[i].LineID]
+	}
+}
+
+func toOptionViews(options []value.LineOption) []dto.CartItemOptionViewDTO {
+	if len(options) == 0 {
+		return nil
+	}
+
+	views := make([]dto.CartItemOptionViewDTO, 0, len(options))
+	for _, option := range options {
+		views = append(views, dto.CartItemOptionViewDTO{
+			Name:          option.Name(),
+			Value:         option.Value(),
+			PriceModifier: option.PriceModifier(),
+		})
+	}
+	return views
+}
@@ -0,0 +1,226 @@
+package query_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/query"
+)
+
+type fakeTransaction struct{}
+
+func (fakeTransaction) RWTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (fakeTransaction) SharedRWTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (fakeTransaction) Shared(ctx context.Context) bool {
+	return false
+}
+
+func (fakeTransaction) AfterCommit(fn func() error) {}
+
+type fakeEventStore struct {
+	repository.EventStore
+	events []event.Event
+}
+
+func (s *fakeEventStore) LoadEvents(ctx context.Context, aggregateID uuid.UUID) ([]event.Event, error) {
+	return s.until(aggregateID, func(e event.Event) bool { return true })
+}
+
+func (s *fakeEventStore) LoadEventsUntilVersion(ctx context.Context, aggregateID uuid.UUID, version int) ([]event.Event, error) {
+	return s.until(aggregateID, func(e event.Event) bool { return e.GetVersion() <= version })
+}
+
+func (s *fakeEventStore) LoadEventsUntil(ctx context.Context, aggregateID uuid.UUID, until time.Time) ([]event.Event, error) {
+	return s.until(aggregateID, func(e event.Event) bool { return !e.GetTimestamp().After(until) })
+}
+
+func (s *fakeEventStore) ReadEvents(ctx context.Context, aggregateID uuid.UUID, r repository.EventRange) ([]repository.StoredEvent, error) {
+	stored := make([]repository.StoredEvent, 0)
+	exists := false
+	for _, e := range s.events {
+		if e.GetAggregateID() != aggregateID {
+			continue
+		}
+		exists = true
+		if r.FromVersion != nil && e.GetVersion() < *r.FromVersion ||
+			r.ToVersion != nil && e.GetVersion() > *r.ToVersion ||
+			!r.From.IsZero() && e.GetTimestamp().Before(r.From) ||
+			!r.To.IsZero() && e.GetTimestamp().After(r.To) {
+			continue
+		}
+		if r.Limit > 0 && len(stored) == r.Limit {
+			break
+		}
+		stored = append(stored, repository.StoredEvent{Event: e, RecordedAt: e.GetTimestamp()})
+	}
+	if !exists {
+		return nil, errors.NotFound.New("stream not found")
+	}
+	return stored, nil
+}
+
+func (s *fakeEventStore) until(aggregateID uuid.UUID, keep func(e event.Event) bool) ([]event.Event, error) {
+	var events []event.Event
+	for _, e := range s.events {
+		if e.GetAggregateID() == aggregateID && keep(e) {
+			events = append(events, e)
+		}
+	}
+	if len(events) == 0 {
+		return nil, errors.NotFound.New("cart not found")
+	}
+	return events, nil
+}
+
+func TestGetCartAsOfQuery(t *testing.T) {
+	cartID := uuid.New()
+	userID := uuid.New()
+	tenantID := uuid.New()
+	itemID := uuid.New()
+	created := event.NewCartCreatedEvent(cartID, 1, userID, tenantID, "")
+	firstAdd := event.NewItemAddedToCartEvent(cartID, 2, itemID, "Tea", 100.0, tenantID, "STANDARD", 200, userID, nil, "")
+	secondAdd := event.NewItemAddedToCartEvent(cartID, 3, itemID, "Tea", 100.0, tenantID, "STANDARD", 200, userID, nil, "")
+	coupon := event.NewCouponAppliedToCartEvent(cartID, 4, uuid.New(), "TEN", "PERCENT_OFF", 10, 0, 0, 0, false)
+	secondAdd.Timestamp = firstAdd.Timestamp.Add(time.Hour)
+	coupon.Timestamp = firstAdd.Timestamp.Add(2 * time.Hour)
+
+	tests := map[string]struct {
+		query         func(q query.GetCartAsOfQueryInterface, out *queryTestPresenter) error
+		wantVersion   int
+		wantQuantity  int
+		wantSubtotal  float64
+		wantDiscount  float64
+		wantUpdatedAt time.Time
+		wantNotFound  bool
+	}{
+		"as of a version": {
+			query: func(q query.GetCartAsOfQueryInterface, out *queryTestPresenter) error {
+				return q.QueryAsOfVersion(context.Background(), cartID.String(), 2, out)
+			},
+			wantVersion:   2,
+			wantQuantity:  1,
+			wantSubtotal:  100.0,
+			wantUpdatedAt: firstAdd.Timestamp,
+		},
+		"as of a time": {
+			query: func(q query.GetCartAsOfQueryInterface, out *queryTestPresenter) error {
+				return q.QueryAsOf(context.Background(), cartID.String(), firstAdd.Timestamp.Add(90*time.Minute), out)
+			},
+			wantVersion:   3,
+			wantQuantity:  2,
+			wantSubtotal:  200.0,
+			wantUpdatedAt: secondAdd.Timestamp,
+		},
+		"as of the latest version": {
+			query: func(q query.GetCartAsOfQueryInterface, out *queryTestPresenter) error {
+				return q.QueryAsOfVersion(context.Background(), cartID.String(), 10, out)
+			},
+			wantVersion:   4,
+			wantQuantity:  2,
+			wantSubtotal:  200.0,
+			wantDiscount:  20.0,
+			wantUpdatedAt: coupon.Timestamp,
+		},
+		"before the cart was created": {
+			query: func(q query.GetCartAsOfQueryInterface, out *queryTestPresenter) error {
+				return q.QueryAsOfVersion(context.Background(), cartID.String(), 0, out)
+			},
+			wantNotFound: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			eventStore := &fakeEventStore{events: []event.Event{created, firstAdd, secondAdd, coupon}}
+			q := query.NewGetCartAsOfQuery(fakeTransaction{}, eventStore)
+			out := &queryTestPresenter{}
+
+			// Act
+			err := tt.query(q, out)
+
+			// Assert
+			require.NoError(t, err)
+			if tt.wantNotFound {
+				require.True(t, errors.IsCode(out.lastError, errors.NotFound))
+				return
+			}
+			require.NoError(t, out.lastError)
+
+			var cart dto.CartViewDTO
+			require.NoError(t, json.Unmarshal(out.lastData, &cart))
+			require.Equal(t, cartID.String(), cart.ID)
+			require.Equal(t, "OPEN", cart.Status)
+			require.Equal(t, tt.wantVersion, cart.Version)
+			require.Len(t, cart.Items, 1)
+			require.Equal(t, tt.wantQuantity, cart.Items[0].Quantity)
+			require.Equal(t, tt.wantQuantity, cart.ItemCount)
+			require.Equal(t, tt.wantSubtotal, cart.Subtotal)
+			require.Equal(t, tt.wantDiscount, cart.DiscountTotal)
+			require.Equal(t, tt.wantSubtotal-tt.wantDiscount, cart.TotalAmount)
+			require.True(t, tt.wantUpdatedAt.Equal(cart.UpdatedAt))
+			require.True(t, created.Timestamp.Equal(cart.CreatedAt))
+			require.Equal(t, userID.String(), cart.Items[0].AddedBy)
+			require.Nil(t, cart.Tax)
+		})
+	}
+}
+
+func TestGetCartAsOfQuery_EventDetails(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	cartID := uuid.New()
+	ownerID := uuid.New()
+	memberID := uuid.New()
+	leftID := uuid.New()
+	tenantID := uuid.New()
+	otherCartID := uuid.New()
+	eventStore := &fakeEventStore{events: []event.Event{
+		event.NewCartCreatedEvent(cartID, 1, ownerID, tenantID, ""),
+		event.NewCartMemberInvitedEvent(cartID, 2, memberID, ownerID),
+		event.NewCartInvitationAcceptedEvent(cartID, 3, memberID),
+		event.NewCartMemberInvitedEvent(cartID, 4, leftID, ownerID),
+		event.NewCartMemberRemovedEvent(cartID, 5, leftID, ownerID),
+		event.NewItemAddedToCartEvent(cartID, 6, uuid.New(), "Tea", 100.0, tenantID, "REDUCED", 200, memberID, nil, ""),
+		event.NewCartSubmittedEvent(cartID, 7, 108.0, 100.0, 0, "EXCLUSIVE", "FLOOR", 0, 0, 100.0, 8.0, "", 0),
+		event.NewCartCreatedEvent(otherCartID, 1, memberID, tenantID, ""),
+	}}
+	q := query.NewGetCartAsOfQuery(fakeTransaction{}, eventStore)
+	out := &queryTestPresenter{}
+
+	// Act
+	err := q.QueryAsOfVersion(context.Background(), cartID.String(), 7, out)
+
+	// Assert
+	require.NoError(t, err)
+	require.NoError(t, out.lastError)
+
+	var cart dto.CartViewDTO
+	require.NoError(t, json.Unmarshal(out.lastData, &cart))
+	require.Equal(t, 7, cart.Version)
+	require.Len(t, cart.Items, 1)
+	require.Equal(t, memberID.String(), cart.Items[0].AddedBy)
+	require.Equal(t, []dto.CartMemberViewDTO{{UserID: memberID.String(), Status: "MEMBER"}}, cart.Members)
+	require.NotNil(t, cart.Tax)
+	require.Equal(t, "EXCLUSIVE", cart.Tax.Display)
+	require.Equal(t, 8.0, cart.Tax.ReducedTaxAmount)
+	require.Equal(t, 8.0, cart.Tax.TaxTotal)
+}
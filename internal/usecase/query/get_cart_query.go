@@ -33,5 +33,5 @@ func (q *GetCartQuery) Query(ctx context.Context, aggregateID string, out presen
 		return out.PresentError(ctx, err)
 	}
 
-	return out.PresentSuccess(ctx, jsonData)
+	return out.PresentVersionedSuccess(ctx, jsonData, cartView.Version)
 }
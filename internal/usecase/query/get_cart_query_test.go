@@ -16,8 +16,9 @@ import (
 )
 
 type queryTestPresenter struct {
-	lastData  []byte
-	lastError error
+	lastData    []byte
+	lastVersion *int
+	lastError   error
 }
 
 func (p *queryTestPresenter) PresentSuccess(ctx context.Context, data []byte) error {
@@ -25,6 +26,12 @@ func (p *queryTestPresenter) PresentSuccess(ctx context.Context, data []byte) er
 	return nil
 }
 
+func (p *queryTestPresenter) PresentVersionedSuccess(ctx context.Context, data []byte, version int) error {
+	p.lastData = data
+	p.lastVersion = &version
+	return nil
+}
+
 func (p *queryTestPresenter) PresentError(ctx context.Context, err error) error {
 	p.lastError = err
 	return nil
@@ -69,9 +76,9 @@ func TestGetCartQuery_Query(t *testing.T) {
 			require.NoError(t, err)
 
 			_, err = tx.ExecContext(ctx, `
-				INSERT INTO cart_items (id, cart_id, name, price) 
-				VALUES (?, ?, ?, ?), (?, ?, ?, ?)
-			`, uniqueItemID1, uniqueCartID, "Test Item 1", 100.0, uniqueItemID2, uniqueCartID, "Test Item 2", 50.0)
+				INSERT INTO cart_items (id, line_id, cart_id, name, price) 
+				VALUES (?, ?, ?, ?, ?), (?, ?, ?, ?, ?)
+			`, uniqueItemID1, uniqueItemID1, uniqueCartID, "Test Item 1", 100.0, uniqueItemID2, uniqueItemID2, uniqueCartID, "Test Item 2", 50.0)
 			require.NoError(t, err)
 
 			// Commit the transaction so the data is visible to the query
@@ -88,6 +95,8 @@ func TestGetCartQuery_Query(t *testing.T) {
 			require.NoError(t, err)
 			require.Nil(t, presenter.lastError)
 			require.NotNil(t, presenter.lastData)
+			require.NotNil(t, presenter.lastVersion)
+			require.Equal(t, 1, *presenter.lastVersion)
 			var actualCart dto.CartViewDTO
 			err = json.Unmarshal(presenter.lastData, &actualCart)
 			require.NoError(t, err)
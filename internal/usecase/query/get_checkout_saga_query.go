@@ -0,0 +1,45 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore"
+)
+
+type GetCheckoutSagaQueryInterface interface {
+	Query(ctx context.Context, cartID string, out presenter.QueryResultPresenter) error
+}
+
+type GetCheckoutSagaQuery struct {
+	checkoutSagaStore readmodelstore.CheckoutSagaStore
+}
+
+func NewGetCheckoutSagaQuery(checkoutSagaStore readmodelstore.CheckoutSagaStore) GetCheckoutSagaQueryInterface {
+	return &GetCheckoutSagaQuery{
+		checkoutSagaStore: checkoutSagaStore,
+	}
+}
+
+func (q *GetCheckoutSagaQuery) Query(ctx context.Context, cartID string, out presenter.QueryResultPresenter) error {
+	cartUUID, err := uuid.Parse(cartID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid cart id"))
+	}
+
+	sagaView, err := q.checkoutSagaStore.Get(ctx, aggregate.CheckoutSagaIDForCart(cartUUID).String())
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	jsonData, err := json.Marshal(sagaView)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, jsonData)
+}
@@ -0,0 +1,51 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore"
+)
+
+type GetCouponQueryInterface interface {
+	Query(ctx context.Context, tenantID, code string, out presenter.QueryResultPresenter) error
+}
+
+type GetCouponQuery struct {
+	couponStore readmodelstore.CouponStore
+}
+
+func NewGetCouponQuery(couponStore readmodelstore.CouponStore) GetCouponQueryInterface {
+	return &GetCouponQuery{
+		couponStore: couponStore,
+	}
+}
+
+func (q *GetCouponQuery) Query(ctx context.Context, tenantID, code string, out presenter.QueryResultPresenter) error {
+	tenantUUID, err := uuid.Parse(tenantID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid tenant id"))
+	}
+
+	couponCode, err := value.NewCouponCode(code)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	couponView, err := q.couponStore.Get(ctx, aggregate.CouponIDForCode(tenantUUID, couponCode).String())
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	jsonData, err := json.Marshal(couponView)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, jsonData)
+}
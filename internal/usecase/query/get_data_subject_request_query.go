@@ -0,0 +1,92 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+type GetDataSubjectRequestQueryInterface interface {
+	Query(ctx context.Context, requestID string, out presenter.QueryResultPresenter) error
+}
+
+// GetDataSubjectRequestQuery replays a data subject request from its stream.
+// Requests are rare and only read by operators, so they have no read model.
+type GetDataSubjectRequestQuery struct {
+	tx         repository.Transaction
+	eventStore repository.EventStore
+}
+
+func NewGetDataSubjectRequestQuery(tx repository.Transaction, eventStore repository.EventStore) GetDataSubjectRequestQueryInterface {
+	return &GetDataSubjectRequestQuery{
+		tx:         tx,
+		eventStore: eventStore,
+	}
+}
+
+func (q *GetDataSubjectRequestQuery) Query(ctx context.Context, requestID string, out presenter.QueryResultPresenter) error {
+	id, err := uuid.Parse(requestID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid request id"))
+	}
+
+	request := aggregate.NewDataSubjectRequestAggregate()
+	err = q.tx.RWTx(ctx, func(ctx context.Context) error {
+		events, err := q.eventStore.LoadEvents(ctx, id)
+		if err != nil {
+			if errors.IsCode(err, errors.NotFound) {
+				return aggregate.ErrDataSubjectRequestNotFound
+			}
+			return err
+		}
+		return request.Hydration(events)
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+	// Streams of other aggregates hydrate nothing
+	if request.GetVersion() == -1 {
+		return out.PresentError(ctx, aggregate.ErrDataSubjectRequestNotFound)
+	}
+
+	jsonData, err := json.Marshal(toDataSubjectRequestView(request))
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, jsonData)
+}
+
+func toDataSubjectRequestView(request *aggregate.DataSubjectRequestAggregate) *dto.DataSubjectRequestViewDTO {
+	view := &dto.DataSubjectRequestViewDTO{
+		ID:         request.GetAggregateID().String(),
+		SubjectID:  request.GetSubjectID().String(),
+		Kind:       string(request.GetKind()),
+		Status:     string(request.GetStatus()),
+		CartCount:  request.GetCartCount(),
+		EventCount: request.GetEventCount(),
+		OpenedAt:   request.GetOpenedAt(),
+		Version:    request.GetVersion(),
+	}
+	if request.GetStatus() == aggregate.DataSubjectRequestStatusCompleted {
+		completedAt := request.GetCompletedAt()
+		view.CompletedAt = &completedAt
+	}
+
+	switch bundle := request.GetBundle(); bundle {
+	case "":
+	case event.Redacted:
+		view.Redacted = true
+	default:
+		view.Bundle = json.RawMessage(bundle)
+	}
+
+	return view
+}
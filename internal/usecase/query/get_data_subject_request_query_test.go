@@ -0,0 +1,90 @@
+package query_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/query"
+)
+
+func TestGetDataSubjectRequestQuery_Query(t *testing.T) {
+	requestID := uuid.New()
+	subjectID := uuid.New()
+	opened := event.NewDataSubjectRequestOpenedEvent(requestID, 1, subjectID, "EXPORT")
+
+	tests := map[string]struct {
+		events       []event.Event
+		wantErrCode  errors.ErrCode
+		wantStatus   string
+		wantBundle   string
+		wantRedacted bool
+	}{
+		"open request without bundle": {
+			events:     []event.Event{opened},
+			wantStatus: "OPEN",
+		},
+		"completed export with its bundle": {
+			events: []event.Event{
+				opened,
+				event.NewDataSubjectDataExportedEvent(requestID, 2, subjectID, `{"carts":[]}`, 0, 0),
+			},
+			wantStatus: "COMPLETED",
+			wantBundle: `{"carts":[]}`,
+		},
+		"export of an erased subject": {
+			events: []event.Event{
+				opened,
+				event.NewDataSubjectDataExportedEvent(requestID, 2, subjectID, event.Redacted, 0, 0),
+			},
+			wantStatus:   "COMPLETED",
+			wantRedacted: true,
+		},
+		"stream of another aggregate": {
+			events:      []event.Event{event.NewCartCreatedEvent(requestID, 1, uuid.New(), uuid.New(), "")},
+			wantErrCode: errors.NotFound,
+		},
+		"unknown request": {
+			wantErrCode: errors.NotFound,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			q := query.NewGetDataSubjectRequestQuery(fakeTransaction{}, &fakeEventStore{events: tt.events})
+			out := &queryTestPresenter{}
+
+			// Act
+			err := q.Query(context.Background(), requestID.String(), out)
+
+			// Assert
+			require.NoError(t, err)
+			if tt.wantErrCode != "" {
+				require.True(t, errors.IsCode(out.lastError, tt.wantErrCode))
+				return
+			}
+			require.NoError(t, out.lastError)
+
+			var got dto.DataSubjectRequestViewDTO
+			require.NoError(t, json.Unmarshal(out.lastData, &got))
+			require.Equal(t, subjectID.String(), got.SubjectID)
+			require.Equal(t, "EXPORT", got.Kind)
+			require.Equal(t, tt.wantStatus, got.Status)
+			require.Equal(t, tt.wantRedacted, got.Redacted)
+			if tt.wantBundle == "" {
+				require.Empty(t, got.Bundle)
+			} else {
+				require.JSONEq(t, tt.wantBundle, string(got.Bundle))
+			}
+		})
+	}
+}
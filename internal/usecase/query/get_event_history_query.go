@@ -0,0 +1,161 @@
+package query
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+const (
+	defaultEventHistoryLimit = 100
+	maxEventHistoryLimit     = 500
+)
+
+// EventHistoryFilter narrows the events to a version range and a range of
+// the times they were recorded; nil versions and zero times leave that end
+// open. Cursor is the NextCursor of the previous page.
+type EventHistoryFilter struct {
+	FromVersion     *int
+	ToVersion       *int
+	From            time.Time
+	To              time.Time
+	Cursor          string
+	Limit           int
+	IncludeMetadata bool
+}
+
+type GetEventHistoryQueryInterface interface {
+	Query(ctx context.Context, aggregateID string, filter EventHistoryFilter, out presenter.QueryResultPresenter) error
+}
+
+// GetEventHistoryQuery pages through the event stream of one kind of
+// aggregate, with each payload as the typed event.
+type GetEventHistoryQuery struct {
+	tx            repository.Transaction
+	eventStore    repository.EventStore
+	aggregateType string
+}
+
+func NewGetEventHistoryQuery(tx repository.Transaction, eventStore repository.EventStore, aggregateType string) GetEventHistoryQueryInterface {
+	return &GetEventHistoryQuery{
+		tx:            tx,
+		eventStore:    eventStore,
+		aggregateType: aggregateType,
+	}
+}
+
+func (q *GetEventHistoryQuery) Query(ctx context.Context, aggregateID string, filter EventHistoryFilter, out presenter.QueryResultPresenter) error {
+	streamID, err := uuid.Parse(aggregateID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid aggregate id"))
+	}
+
+	limit := filter.Limit
+	if limit == 0 {
+		limit = defaultEventHistoryLimit
+	}
+	if limit < 0 || limit > maxEventHistoryLimit {
+		return out.PresentError(ctx, errors.InvalidParameter.New("limit must be between 1 and "+strconv.Itoa(maxEventHistoryLimit)))
+	}
+
+	fromVersion := filter.FromVersion
+	if filter.Cursor != "" {
+		after, err := decodeEventCursor(filter.Cursor)
+		if err != nil {
+			return out.PresentError(ctx, err)
+		}
+		if fromVersion == nil || *fromVersion <= after {
+			next := after + 1
+			fromVersion = &next
+		}
+	}
+
+	var stored []repository.StoredEvent
+	err = q.tx.RWTx(ctx, func(ctx context.Context) error {
+		// The first event tells the kind of aggregate, even when the page
+		// asked for is empty
+		first, err := q.eventStore.ReadEvents(ctx, streamID, repository.EventRange{Limit: 1})
+		if err != nil {
+			return err
+		}
+		if len(first) == 0 || first[0].Event.GetAggregateType() != q.aggregateType {
+			return errors.NotFound.New("stream not found")
+		}
+
+		// One more than the page tells whether there is a next page
+		stored, err = q.eventStore.ReadEvents(ctx, streamID, repository.EventRange{
+			FromVersion: fromVersion,
+			ToVersion:   filter.ToVersion,
+			From:        filter.From,
+			To:          filter.To,
+			Limit:       limit + 1,
+		})
+		return err
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	history := &dto.EventHistoryViewDTO{
+		AggregateID: aggregateID,
+		Events:      make([]dto.EventViewDTO, 0, min(len(stored), limit)),
+	}
+	if len(stored) > limit {
+		stored = stored[:limit]
+		history.NextCursor = encodeEventCursor(stored[limit-1].Event.GetVersion())
+	}
+
+	for _, s := range stored {
+		payload, err := json.Marshal(s.Event)
+		if err != nil {
+			return out.PresentError(ctx, err)
+		}
+
+		view := dto.EventViewDTO{
+			Version:   s.Event.GetVersion(),
+			EventType: s.Event.GetEventType(),
+			Payload:   payload,
+		}
+		if filter.IncludeMetadata {
+			view.Metadata = &dto.EventMetadataViewDTO{
+				EventID:       s.Event.GetEventID().String(),
+				AggregateType: s.Event.GetAggregateType(),
+				OccurredAt:    s.Event.GetTimestamp(),
+				RecordedAt:    s.RecordedAt,
+			}
+		}
+		history.Events = append(history.Events, view)
+	}
+
+	jsonData, err := json.Marshal(history)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, jsonData)
+}
+
+// The cursor is the last version of the page, kept opaque to clients.
+func encodeEventCursor(version int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(version)))
+}
+
+func decodeEventCursor(cursor string) (int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, errors.InvalidParameter.Wrap(err, "invalid cursor")
+	}
+	version, err := strconv.Atoi(string(data))
+	if err != nil {
+		return 0, errors.InvalidParameter.Wrap(err, "invalid cursor")
+	}
+	return version, nil
+}
@@ -0,0 +1,139 @@
+package query_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/event"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/query"
+)
+
+func TestGetEventHistoryQuery_Query(t *testing.T) {
+	cartID := uuid.New()
+	tenantID := uuid.New()
+	events := []event.Event{event.NewCartCreatedEvent(cartID, 1, uuid.New(), tenantID, "")}
+	for version := 2; version <= 5; version++ {
+		added := event.NewItemAddedToCartEvent(cartID, version, uuid.New(), "Tea", 100.0, tenantID, "STANDARD", 200, uuid.Nil, nil, "")
+		added.Timestamp = events[0].GetTimestamp().Add(time.Duration(version) * time.Hour)
+		events = append(events, added)
+	}
+	two, four := 2, 4
+
+	tests := map[string]struct {
+		aggregateType string
+		filter        query.EventHistoryFilter
+		pages         int
+		wantVersions  []int
+		wantMetadata  bool
+		wantErrCode   errors.ErrCode
+	}{
+		"whole stream": {
+			aggregateType: "Cart",
+			pages:         1,
+			wantVersions:  []int{1, 2, 3, 4, 5},
+		},
+		"version range": {
+			aggregateType: "Cart",
+			filter:        query.EventHistoryFilter{FromVersion: &two, ToVersion: &four},
+			pages:         1,
+			wantVersions:  []int{2, 3, 4},
+		},
+		"time range": {
+			aggregateType: "Cart",
+			filter:        query.EventHistoryFilter{From: events[0].GetTimestamp().Add(150 * time.Minute)},
+			pages:         1,
+			wantVersions:  []int{3, 4, 5},
+		},
+		"pages through the cursor": {
+			aggregateType: "Cart",
+			filter:        query.EventHistoryFilter{Limit: 2},
+			pages:         3,
+			wantVersions:  []int{1, 2, 3, 4, 5},
+		},
+		"with metadata": {
+			aggregateType: "Cart",
+			filter:        query.EventHistoryFilter{ToVersion: &two, IncludeMetadata: true},
+			pages:         1,
+			wantVersions:  []int{1, 2},
+			wantMetadata:  true,
+		},
+		"invalid cursor": {
+			aggregateType: "Cart",
+			filter:        query.EventHistoryFilter{Cursor: "not a cursor"},
+			pages:         1,
+			wantErrCode:   errors.InvalidParameter,
+		},
+		"limit over the maximum": {
+			aggregateType: "Cart",
+			filter:        query.EventHistoryFilter{Limit: 1000},
+			pages:         1,
+			wantErrCode:   errors.InvalidParameter,
+		},
+		"stream of another aggregate": {
+			aggregateType: "TenantCartAbandonedPolicy",
+			pages:         1,
+			wantErrCode:   errors.NotFound,
+		},
+		"empty page of another aggregate's stream": {
+			aggregateType: "TenantCartAbandonedPolicy",
+			filter:        query.EventHistoryFilter{FromVersion: &four, ToVersion: &two},
+			pages:         1,
+			wantErrCode:   errors.NotFound,
+		},
+		"empty page": {
+			aggregateType: "Cart",
+			filter:        query.EventHistoryFilter{FromVersion: &four, ToVersion: &two},
+			pages:         1,
+			wantVersions:  nil,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			eventStore := &fakeEventStore{events: events}
+			q := query.NewGetEventHistoryQuery(fakeTransaction{}, eventStore, tt.aggregateType)
+			filter := tt.filter
+
+			// Act
+			var versions []int
+			var lastPage dto.EventHistoryViewDTO
+			var lastErr error
+			for range tt.pages {
+				out := &queryTestPresenter{}
+				err := q.Query(context.Background(), cartID.String(), filter, out)
+				require.NoError(t, err)
+				if lastErr = out.lastError; lastErr != nil {
+					break
+				}
+
+				lastPage = dto.EventHistoryViewDTO{}
+				require.NoError(t, json.Unmarshal(out.lastData, &lastPage))
+				for _, e := range lastPage.Events {
+					versions = append(versions, e.Version)
+					require.Equal(t, tt.wantMetadata, e.Metadata != nil)
+				}
+				filter.Cursor = lastPage.NextCursor
+			}
+
+			// Assert
+			if tt.wantErrCode != "" {
+				require.True(t, errors.IsCode(lastErr, tt.wantErrCode))
+				return
+			}
+			require.NoError(t, lastErr)
+			require.Equal(t, tt.wantVersions, versions)
+			require.Empty(t, lastPage.NextCursor)
+			require.Equal(t, cartID.String(), lastPage.AggregateID)
+		})
+	}
+}
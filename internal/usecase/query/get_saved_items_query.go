@@ -0,0 +1,62 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+type GetSavedItemsQueryInterface interface {
+	Query(ctx context.Context, tenantID, userID string, out presenter.QueryResultPresenter) error
+}
+
+type GetSavedItemsQuery struct {
+	savedListStore readmodelstore.SavedListStore
+}
+
+func NewGetSavedItemsQuery(savedListStore readmodelstore.SavedListStore) GetSavedItemsQueryInterface {
+	return &GetSavedItemsQuery{
+		savedListStore: savedListStore,
+	}
+}
+
+func (q *GetSavedItemsQuery) Query(ctx context.Context, tenantID, userID string, out presenter.QueryResultPresenter) error {
+	tenantUUID, err := uuid.Parse(tenantID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid tenant id"))
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid user id"))
+	}
+
+	listID := aggregate.SavedListIDForUser(tenantUUID, userUUID).String()
+
+	listView, err := q.savedListStore.Get(ctx, listID)
+	if err != nil {
+		if !errors.IsCode(err, errors.NotFound) {
+			return out.PresentError(ctx, err)
+		}
+		// Nothing saved yet is an empty list, not a missing resource
+		listView = &dto.SavedListViewDTO{
+			ID:       listID,
+			TenantID: tenantID,
+			UserID:   userID,
+			Items:    make([]dto.SavedItemViewDTO, 0),
+		}
+	}
+
+	jsonData, err := json.Marshal(listView)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, jsonData)
+}
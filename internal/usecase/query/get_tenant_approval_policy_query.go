@@ -0,0 +1,38 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore"
+)
+
+type GetTenantApprovalPolicyQueryInterface interface {
+	Query(ctx context.Context, tenantID string, out presenter.QueryResultPresenter) error
+}
+
+type GetTenantApprovalPolicyQueryImpl struct {
+	tenantApprovalPolicyStore readmodelstore.TenantApprovalPolicyStore
+}
+
+func NewGetTenantApprovalPolicyQuery(tenantApprovalPolicyStore readmodelstore.TenantApprovalPolicyStore) GetTenantApprovalPolicyQueryInterface {
+	return &GetTenantApprovalPolicyQueryImpl{
+		tenantApprovalPolicyStore: tenantApprovalPolicyStore,
+	}
+}
+
+func (g *GetTenantApprovalPolicyQueryImpl) Query(ctx context.Context, tenantID string, out presenter.QueryResultPresenter) error {
+	// Tenants without a policy have nothing to show, so NotFound is passed on
+	policy, err := g.tenantApprovalPolicyStore.Get(ctx, tenantID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	jsonData, err := json.Marshal(policy)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, jsonData)
+}
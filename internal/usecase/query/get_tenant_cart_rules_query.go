@@ -0,0 +1,47 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+type GetTenantCartRulesQueryInterface interface {
+	Query(ctx context.Context, tenantID string, out presenter.QueryResultPresenter) error
+}
+
+type GetTenantCartRulesQueryImpl struct {
+	tenantCartRulesStore readmodelstore.TenantCartRulesStore
+}
+
+func NewGetTenantCartRulesQuery(tenantCartRulesStore readmodelstore.TenantCartRulesStore) GetTenantCartRulesQueryInterface {
+	return &GetTenantCartRulesQueryImpl{
+		tenantCartRulesStore: tenantCartRulesStore,
+	}
+}
+
+func (g *GetTenantCartRulesQueryImpl) Query(ctx context.Context, tenantID string, out presenter.QueryResultPresenter) error {
+	rules, err := g.tenantCartRulesStore.Get(ctx, tenantID)
+	if err != nil {
+		if !errors.IsCode(err, errors.NotFound) {
+			return out.PresentError(ctx, err)
+		}
+
+		// Tenants that never configured rules have no limits
+		rules = &dto.TenantCartRulesViewDTO{
+			TenantID:                    tenantID,
+			BlockedCategoryCombinations: [][]string{},
+		}
+	}
+
+	jsonData, err := json.Marshal(rules)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, jsonData)
+}
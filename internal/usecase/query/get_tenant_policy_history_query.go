@@ -0,0 +1,108 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/aggregate"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/repository"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+type GetTenantPolicyHistoryQueryInterface interface {
+	Query(ctx context.Context, tenantID string, out presenter.QueryResultPresenter) error
+}
+
+// GetTenantPolicyHistoryQuery reads the policy versions straight from the
+// event store, since scheduled changes have no read model of their own.
+type GetTenantPolicyHistoryQuery struct {
+	tx         repository.Transaction
+	eventStore repository.EventStore
+}
+
+func NewGetTenantPolicyHistoryQuery(tx repository.Transaction, eventStore repository.EventStore) GetTenantPolicyHistoryQueryInterface {
+	return &GetTenantPolicyHistoryQuery{
+		tx:         tx,
+		eventStore: eventStore,
+	}
+}
+
+func (q *GetTenantPolicyHistoryQuery) Query(ctx context.Context, tenantID string, out presenter.QueryResultPresenter) error {
+	tenantUUID, err := uuid.Parse(tenantID)
+	if err != nil {
+		return out.PresentError(ctx, errors.InvalidParameter.Wrap(err, "invalid tenant id"))
+	}
+
+	policy := aggregate.NewTenantCartAbandonedPolicyAggregate()
+	err = q.tx.RWTx(ctx, func(ctx context.Context) error {
+		events, err := q.eventStore.LoadEvents(ctx, tenantUUID)
+		if err != nil && !errors.IsCode(err, errors.NotFound) {
+			return err
+		}
+		return policy.Hydration(events)
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	if policy.GetVersion() == -1 {
+		return out.PresentError(ctx, errors.NotFound.New("tenant policy not found"))
+	}
+
+	now := time.Now()
+	inEffect := policy.PolicyAt(now).Version
+
+	versions := make([]dto.TenantPolicyVersionViewDTO, 0)
+	for _, v := range policy.GetPolicyVersions() {
+		versions = append(versions, toPolicyVersionView(v, now, v.Version == inEffect))
+	}
+
+	jsonData, err := json.Marshal(&dto.TenantPolicyHistoryViewDTO{
+		TenantID: tenantID,
+		Versions: versions,
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, jsonData)
+}
+
+func toPolicyVersionView(v aggregate.TenantCartAbandonedPolicyVersion, now time.Time, inEffect bool) dto.TenantPolicyVersionViewDTO {
+	kind := "UPDATE"
+	if v.Scheduled {
+		kind = "SCHEDULED"
+	}
+
+	status := "CURRENT"
+	switch {
+	case now.Before(v.EffectiveFrom):
+		status = "UPCOMING"
+	case !v.Covers(now):
+		status = "PAST"
+	}
+
+	var effectiveTo *time.Time
+	if !v.EffectiveTo.IsZero() {
+		effectiveTo = &v.EffectiveTo
+	}
+
+	return dto.TenantPolicyVersionViewDTO{
+		Version:          v.Version,
+		Kind:             kind,
+		Status:           status,
+		InEffect:         inEffect,
+		Title:            v.Title,
+		AbandonedMinutes: v.AbandonedMinutes,
+		QuietTimeFrom:    v.QuietTimeFrom,
+		QuietTimeTo:      v.QuietTimeTo,
+		CartExpiryDays:   v.CartExpiryDays,
+		EffectiveFrom:    v.EffectiveFrom,
+		EffectiveTo:      effectiveTo,
+		RecordedAt:       v.RecordedAt,
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
 	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
 )
 
 type GetTenantPolicyQueryInterface interface {
@@ -28,10 +29,19 @@ func (g *GetTenantPolicyQueryImpl) Query(ctx context.Context, tenantID string, o
 		return out.PresentError(ctx, err)
 	}
 
-	jsonData, err := json.Marshal(tenantPolicy)
+	segments, err := g.tenantPolicyStore.ListSegments(ctx, tenantID)
 	if err != nil {
 		return out.PresentError(ctx, err)
 	}
 
-	return out.PresentSuccess(ctx, jsonData)
+	jsonData, err := json.Marshal(&dto.TenantPoliciesViewDTO{
+		TenantID: tenantID,
+		Default:  tenantPolicy,
+		Segments: segments,
+	})
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentVersionedSuccess(ctx, jsonData, tenantPolicy.Version)
 }
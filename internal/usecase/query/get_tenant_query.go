@@ -0,0 +1,37 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore"
+)
+
+type GetTenantQueryInterface interface {
+	Query(ctx context.Context, tenantID string, out presenter.QueryResultPresenter) error
+}
+
+type GetTenantQueryImpl struct {
+	tenantStore readmodelstore.TenantStore
+}
+
+func NewGetTenantQuery(tenantStore readmodelstore.TenantStore) GetTenantQueryInterface {
+	return &GetTenantQueryImpl{
+		tenantStore: tenantStore,
+	}
+}
+
+func (g *GetTenantQueryImpl) Query(ctx context.Context, tenantID string, out presenter.QueryResultPresenter) error {
+	tenant, err := g.tenantStore.Get(ctx, tenantID)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	jsonData, err := json.Marshal(tenant)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, jsonData)
+}
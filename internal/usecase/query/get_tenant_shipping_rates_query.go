@@ -0,0 +1,47 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+type GetTenantShippingRatesQueryInterface interface {
+	Query(ctx context.Context, tenantID string, out presenter.QueryResultPresenter) error
+}
+
+type GetTenantShippingRatesQueryImpl struct {
+	tenantShippingRatesStore readmodelstore.TenantShippingRatesStore
+}
+
+func NewGetTenantShippingRatesQuery(tenantShippingRatesStore readmodelstore.TenantShippingRatesStore) GetTenantShippingRatesQueryInterface {
+	return &GetTenantShippingRatesQueryImpl{
+		tenantShippingRatesStore: tenantShippingRatesStore,
+	}
+}
+
+func (g *GetTenantShippingRatesQueryImpl) Query(ctx context.Context, tenantID string, out presenter.QueryResultPresenter) error {
+	rates, err := g.tenantShippingRatesStore.Get(ctx, tenantID)
+	if err != nil {
+		if !errors.IsCode(err, errors.NotFound) {
+			return out.PresentError(ctx, err)
+		}
+
+		// A tenant without rates simply cannot ship yet
+		rates = &dto.TenantShippingRatesViewDTO{
+			TenantID: tenantID,
+			Rates:    make([]dto.ShippingRateViewDTO, 0),
+		}
+	}
+
+	jsonData, err := json.Marshal(rates)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, jsonData)
+}
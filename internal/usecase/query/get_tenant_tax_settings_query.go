@@ -0,0 +1,50 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/domain/value"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/errors"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/presenter"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore"
+	"github.com/tomoki-yamamura/eventsourcing-ec/internal/usecase/ports/readmodelstore/dto"
+)
+
+type GetTenantTaxSettingsQueryInterface interface {
+	Query(ctx context.Context, tenantID string, out presenter.QueryResultPresenter) error
+}
+
+type GetTenantTaxSettingsQueryImpl struct {
+	tenantTaxSettingsStore readmodelstore.TenantTaxSettingsStore
+}
+
+func NewGetTenantTaxSettingsQuery(tenantTaxSettingsStore readmodelstore.TenantTaxSettingsStore) GetTenantTaxSettingsQueryInterface {
+	return &GetTenantTaxSettingsQueryImpl{
+		tenantTaxSettingsStore: tenantTaxSettingsStore,
+	}
+}
+
+func (g *GetTenantTaxSettingsQueryImpl) Query(ctx context.Context, tenantID string, out presenter.QueryResultPresenter) error {
+	settings, err := g.tenantTaxSettingsStore.Get(ctx, tenantID)
+	if err != nil {
+		if !errors.IsCode(err, errors.NotFound) {
+			return out.PresentError(ctx, err)
+		}
+
+		// Tenants that never configured tax are charged with the defaults
+		defaults := value.DefaultTaxSettings()
+		settings = &dto.TenantTaxSettingsViewDTO{
+			TenantID:     tenantID,
+			TaxDisplay:   string(defaults.Display()),
+			RoundingMode: string(defaults.RoundingMode()),
+		}
+	}
+
+	jsonData, err := json.Marshal(settings)
+	if err != nil {
+		return out.PresentError(ctx, err)
+	}
+
+	return out.PresentSuccess(ctx, jsonData)
+}
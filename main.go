@@ -44,6 +44,24 @@ func main() {
 			log.Printf("Cart abandonment service stopped: %v", err)
 		}
 	}()
+
+	go func() {
+		if err := cont.CheckoutSagaService.Start(ctx); err != nil {
+			log.Printf("Checkout saga service stopped: %v", err)
+		}
+	}()
+
+	go func() {
+		if err := cont.CartExpiryService.Start(ctx); err != nil {
+			log.Printf("Cart expiry service stopped: %v", err)
+		}
+	}()
+
+	go func() {
+		if err := cont.CartApprovalDeadlineService.Start(ctx); err != nil {
+			log.Printf("Cart approval deadline service stopped: %v", err)
+		}
+	}()
 	log.Println("Background workers started successfully")
 
 	handlerRegister := register.NewHandlerRegister(cont)
@@ -72,6 +90,18 @@ func main() {
 		log.Printf("Cart abandonment service close error: %v", err)
 	}
 
+	if err := cont.CheckoutSagaService.Close(); err != nil {
+		log.Printf("Checkout saga service close error: %v", err)
+	}
+
+	if err := cont.CartExpiryService.Close(); err != nil {
+		log.Printf("Cart expiry service close error: %v", err)
+	}
+
+	if err := cont.CartApprovalDeadlineService.Close(); err != nil {
+		log.Printf("Cart approval deadline service close error: %v", err)
+	}
+
 	if err := cont.ProjectorService.Close(); err != nil {
 		log.Printf("Projector service close error: %v", err)
 	}